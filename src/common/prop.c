@@ -417,6 +417,30 @@ daos_prop_valid(daos_prop_t *prop, bool pool, bool input)
 				return false;
 			}
 			break;
+		case DAOS_PROP_PO_REBUILD_THROTTLE:
+			val = prop->dpp_entries[i].dpe_val;
+			if ((val < DAOS_PROP_PO_REBUILD_THROTTLE_MIN) ||
+			    (val > DAOS_PROP_PO_REBUILD_THROTTLE_MAX)) {
+				D_ERROR("invalid rebuild_throttle " DF_U64 ".\n", val);
+				return false;
+			}
+			break;
+		case DAOS_PROP_PO_REBUILD_PRIORITY:
+			val = prop->dpp_entries[i].dpe_val;
+			if (val != DAOS_REBUILD_PRIORITY_LOW &&
+			    val != DAOS_REBUILD_PRIORITY_NORMAL &&
+			    val != DAOS_REBUILD_PRIORITY_HIGH) {
+				D_ERROR("invalid rebuild_priority " DF_U64 ".\n", val);
+				return false;
+			}
+			break;
+		case DAOS_PROP_PO_REBUILD_PAUSE:
+			val = prop->dpp_entries[i].dpe_val;
+			if (val != DAOS_REBUILD_PAUSE_OFF && val != DAOS_REBUILD_PAUSE_ON) {
+				D_ERROR("invalid rebuild_pause " DF_U64 ".\n", val);
+				return false;
+			}
+			break;
 		/* container-only properties */
 		case DAOS_PROP_CO_LAYOUT_TYPE:
 			val = prop->dpp_entries[i].dpe_val;
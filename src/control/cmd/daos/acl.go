@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -33,15 +34,21 @@ invalid_gid(void)
 */
 import "C"
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"unsafe"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 )
 
 func getAclStrings(e *C.struct_daos_prop_entry) (out []string) {
@@ -207,6 +214,214 @@ func aclToC(acl *control.AccessControlList) (*C.struct_daos_acl, func(), error)
 	return cACL, func() { C.daos_acl_free(cACL) }, nil
 }
 
+const (
+	// agentSockDirEnv and defaultAgentSockDir locate the agent's dRPC socket
+	// in the same way as libdaos (see src/include/daos/agent.h).
+	agentSockDirEnv     = "DAOS_AGENT_DRPC_DIR"
+	defaultAgentSockDir = "/var/run/daos_agent"
+	agentSockName       = "daos_agent.sock"
+)
+
+func agentSockPath() string {
+	dir := os.Getenv(agentSockDirEnv)
+	if dir == "" {
+		dir = defaultAgentSockDir
+	}
+	return filepath.Join(dir, agentSockName)
+}
+
+// getACLTemplate fetches a named ACL template from the system attributes. The
+// request is made through the local agent, as clients are not able to query the
+// management service directly.
+func getACLTemplate(ctx context.Context, sysName, name string) (*control.AccessControlList, error) {
+	client := drpc.NewClientConnection(agentSockPath())
+	if err := client.Connect(ctx); err != nil {
+		return nil, errors.Wrap(err, "unable to connect to the DAOS agent")
+	}
+	defer client.Close()
+
+	body, err := proto.Marshal(&mgmtpb.GetACLTemplateReq{Sys: sysName, Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	method := drpc.MethodGetACLTemplate
+	drpcResp, err := client.SendMsg(ctx, &drpc.Call{
+		Module: method.Module().ID(),
+		Method: method.ID(),
+		Body:   body,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get ACL template %q", name)
+	}
+	if drpcResp.Status != drpc.Status_SUCCESS {
+		return nil, errors.Errorf("failed to get ACL template %q: agent returned %s",
+			name, drpcResp.Status)
+	}
+
+	resp := new(mgmtpb.GetACLTemplateResp)
+	if err := proto.Unmarshal(drpcResp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal GetACLTemplate response")
+	}
+	if resp.Status == int32(daos.Nonexistent) {
+		return nil, errors.Errorf("ACL template %q not found", name)
+	}
+	if err := daos.ErrorFromRC(int(resp.Status)); err != nil {
+		return nil, errors.Wrapf(err, "failed to get ACL template %q", name)
+	}
+
+	return &control.AccessControlList{Entries: resp.Entries}, nil
+}
+
+// containerACLCmd is the struct representing the container ACL template
+// subcommands.
+type containerACLCmd struct {
+	ApplyTemplate containerApplyACLTemplateCmd `command:"apply-template" description:"apply an ACL template to one or more containers in a pool"`
+}
+
+type containerApplyACLTemplateCmd struct {
+	poolBaseCmd
+
+	Template   string   `long:"template" short:"t" required:"1" description:"name of the ACL template to apply (see dmg pool acl list-templates)"`
+	Containers []string `long:"cont" short:"c" description:"container label or UUID to apply the template to (may be repeated)"`
+	All        bool     `long:"all" short:"a" description:"apply the template to all containers in the pool"`
+	Overwrite  bool     `long:"overwrite" description:"replace each container's ACL with the template"`
+	DryRun     bool     `long:"dry-run" short:"n" description:"display the changes without applying them"`
+}
+
+type contACLApplyResult struct {
+	Container string           `json:"container"`
+	Diff      *control.ACLDiff `json:"diff"`
+	Error     string           `json:"error,omitempty"`
+}
+
+func (cmd *containerApplyACLTemplateCmd) getContIDs() ([]string, error) {
+	switch {
+	case cmd.All && len(cmd.Containers) > 0:
+		return nil, errors.New("--all may not be mixed with --cont")
+	case !cmd.All && len(cmd.Containers) == 0:
+		return nil, errors.New("one of --all or --cont must be supplied")
+	case !cmd.All:
+		return cmd.Containers, nil
+	}
+
+	contIDs, err := listContainers(cmd.cPoolHandle)
+	if err != nil {
+		return nil, errors.Wrapf(err,
+			"unable to list containers for pool %s", cmd.PoolID())
+	}
+
+	ids := make([]string, 0, len(contIDs))
+	for _, id := range contIDs {
+		if id.Label != "" {
+			ids = append(ids, id.Label)
+			continue
+		}
+		ids = append(ids, id.UUID.String())
+	}
+
+	return ids, nil
+}
+
+func (cmd *containerApplyACLTemplateCmd) applyToContainer(contID string, tmpl *control.AccessControlList) (*control.ACLDiff, error) {
+	hdl, _, err := containerOpen(cmd.cPoolHandle, contID, C.DAOS_COO_RW, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open container %s", contID)
+	}
+	defer func() {
+		if err := containerCloseAPI(hdl); err != nil {
+			cmd.Errorf("failed to close container %s: %s", contID, err)
+		}
+	}()
+
+	props, cleanup, err := getContAcl(hdl)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query ACL for container %s", contID)
+	}
+	defer cleanup()
+
+	diff := control.DiffACL(convertACLProps(props), tmpl)
+	if cmd.DryRun || !diff.HasChanges(cmd.Overwrite) {
+		return diff, nil
+	}
+
+	update := tmpl
+	if !cmd.Overwrite {
+		update = &control.AccessControlList{Entries: diff.Updates()}
+	}
+	cACL, cleanupACL, err := aclToC(update)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanupACL()
+
+	var rc C.int
+	if cmd.Overwrite {
+		rc = C.daos_cont_overwrite_acl(hdl, cACL, nil)
+	} else {
+		rc = C.daos_cont_update_acl(hdl, cACL, nil)
+	}
+	if err := daosError(rc); err != nil {
+		return diff, errors.Wrapf(err, "failed to apply ACL template to container %s", contID)
+	}
+
+	return diff, nil
+}
+
+func (cmd *containerApplyACLTemplateCmd) Execute(_ []string) error {
+	tmpl, err := getACLTemplate(cmd.MustLogCtx(), cmd.SysName, cmd.Template)
+	if err != nil {
+		return err
+	}
+
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_PC_RO, nil)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	contIDs, err := cmd.getContIDs()
+	if err != nil {
+		return err
+	}
+
+	var results []*contACLApplyResult
+	var nrFailed int
+	for _, contID := range contIDs {
+		diff, err := cmd.applyToContainer(contID, tmpl)
+		result := &contACLApplyResult{Container: contID, Diff: diff}
+		if err != nil {
+			result.Error = err.Error()
+			nrFailed++
+		}
+		results = append(results, result)
+	}
+
+	err = nil
+	if nrFailed > 0 {
+		err = errors.Errorf("failed to apply ACL template to %d of %d containers",
+			nrFailed, len(contIDs))
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(results, err)
+	}
+
+	var bld strings.Builder
+	for _, result := range results {
+		fmt.Fprintf(&bld, "Container %s:\n", result.Container)
+		if result.Diff != nil {
+			bld.WriteString(control.FormatACLDiff(result.Diff, cmd.Overwrite))
+		}
+		if result.Error != "" {
+			fmt.Fprintf(&bld, "# Error: %s\n", result.Error)
+		}
+	}
+	cmd.Info(bld.String())
+
+	return err
+}
+
 type containerUpdateACLCmd struct {
 	aclCmd
 
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -28,6 +29,7 @@ type cliOptions struct {
 	Debug         bool             `long:"debug" description:"Enable debug output"`
 	Verbose       bool             `long:"verbose" description:"Enable verbose output (when applicable)"`
 	JSON          bool             `long:"json" short:"j" description:"Enable JSON output"`
+	Output        string           `long:"output" description:"Output format: json, yaml, csv, template=<go-template> or jsonpath=<expr>"`
 	SysName       string           `long:"sys-name" short:"G" description:"DAOS system name (optional)"`
 	Container     containerCmd     `command:"container" alias:"cont" description:"Perform tasks related to DAOS containers"`
 	Pool          poolCmd          `command:"pool" description:"Perform tasks related to DAOS pools"`
@@ -93,6 +95,7 @@ func exitWithError(log logging.Logger, err error) {
 
 func parseOpts(args []string, opts *cliOptions, log *logging.LeveledLogger) error {
 	var wroteJSON atm.Bool
+	var outFmt *cmdutil.OutputFormat
 	p := flags.NewParser(opts, flags.Default)
 	p.Name = "daos"
 	p.ShortDescription = "Command to manage DAOS pool/container/object"
@@ -126,8 +129,26 @@ or query/manage an object inside a container.`
 			log.Debug("debug output enabled")
 		}
 
-		if jsonCmd, ok := cmd.(cmdutil.JSONOutputter); ok && opts.JSON {
+		if opts.Output != "" {
+			if opts.JSON {
+				return errors.New("--json and --output may not be used together")
+			}
+			var err error
+			if outFmt, err = cmdutil.ParseOutputFormat(opts.Output); err != nil {
+				return err
+			}
+			// JSON output is handled in the same way as --json
+			if outFmt.IsJSON() {
+				opts.JSON = true
+				outFmt = nil
+			}
+		}
+
+		if jsonCmd, ok := cmd.(cmdutil.JSONOutputter); ok && (opts.JSON || outFmt != nil) {
 			jsonCmd.EnableJSONOutput(os.Stdout, &wroteJSON)
+			if fmtCmd, ok := cmd.(cmdutil.OutputFormatter); ok && outFmt != nil {
+				fmtCmd.SetOutputFormat(outFmt)
+			}
 			// disable output on stdout other than JSON
 			log.ClearLevel(logging.LogLevelInfo)
 		}
@@ -197,6 +218,9 @@ or query/manage an object inside a container.`
 	if opts.JSON && wroteJSON.IsFalse() {
 		return cmdutil.OutputJSON(os.Stdout, nil, err)
 	}
+	if outFmt != nil && wroteJSON.IsFalse() {
+		return outFmt.Write(os.Stdout, nil, err)
+	}
 	return err
 }
 
@@ -0,0 +1,313 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	defaultAPProbeInterval = 10 * time.Second
+	apRequestTimeout       = 10 * time.Second
+	apBackoffBase          = 2 * time.Second
+	apBackoffJitter        = 200 * time.Millisecond
+	apBackoffLimit         = 6
+)
+
+type leaderQueryFn func(context.Context, control.UnaryInvoker, *control.LeaderQueryReq) (*control.LeaderQueryResp, error)
+
+// errNoAccessPoints indicates that every access point is backing off after
+// failed requests.
+var errNoAccessPoints = errors.New("no access points are reachable")
+
+// isAccessPointFailure checks whether an error indicates that the access point
+// could not be reached, rather than an error returned by the server.
+func isAccessPointFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if control.IsConnErr(err) || control.IsMSConnectionFailure(err) {
+		return true
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	f, ok := errors.Cause(err).(*fault.Fault)
+	return ok && f.Code == code.ClientRpcTimeout
+}
+
+// accessPointStatus records the failures of an access point.
+type accessPointStatus struct {
+	failures uint64
+	retryAt  time.Time
+	lastErr  error
+}
+
+// available checks whether requests may be sent to the access point.
+func (s *accessPointStatus) available(now time.Time) bool {
+	return s == nil || s.failures == 0 || !now.Before(s.retryAt)
+}
+
+// accessPointTracker tracks the health of the configured access points and of
+// the MS replicas learned from them, so that requests are sent to the current
+// MS leader where possible and unreachable hosts are skipped until their
+// backoff expires.
+type accessPointTracker struct {
+	sync.RWMutex
+	log          logging.Logger
+	accessPoints []string
+	leader       string
+	replicas     []string
+	status       map[string]*accessPointStatus
+	queryLeader  leaderQueryFn
+}
+
+func newAccessPointTracker(log logging.Logger, accessPoints []string) *accessPointTracker {
+	return &accessPointTracker{
+		log:          log,
+		accessPoints: accessPoints,
+		status:       make(map[string]*accessPointStatus),
+		queryLeader:  control.LeaderQuery,
+	}
+}
+
+// setAccessPoints replaces the configured access points. If they have changed,
+// the learned leader and replicas are forgotten, as they may belong to a
+// different system.
+func (t *accessPointTracker) setAccessPoints(accessPoints []string) {
+	if t == nil {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if common.NewStringSet(accessPoints...).String() == common.NewStringSet(t.accessPoints...).String() {
+		return
+	}
+	t.accessPoints = accessPoints
+	t.leader = ""
+	t.replicas = nil
+	t.status = make(map[string]*accessPointStatus)
+}
+
+// hosts returns all known hosts: the leader first, followed by the other MS
+// replicas and then any configured access points that are not replicas.
+func (t *accessPointTracker) hosts() []string {
+	seen := common.NewStringSet()
+	var hosts []string
+	add := func(host string) {
+		if host == "" || seen.Has(host) {
+			return
+		}
+		seen.Add(host)
+		hosts = append(hosts, host)
+	}
+
+	add(t.leader)
+	for _, host := range t.replicas {
+		add(host)
+	}
+	for _, host := range t.accessPoints {
+		add(host)
+	}
+	return hosts
+}
+
+// candidates returns the hosts to try, in order of preference, skipping
+// those that are backing off after a failure.
+func (t *accessPointTracker) candidates() []string {
+	if t == nil {
+		return nil
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	now := time.Now()
+	var candidates []string
+	for _, host := range t.hosts() {
+		if t.status[host].available(now) {
+			candidates = append(candidates, host)
+		}
+	}
+	return candidates
+}
+
+// lastError returns the most recent error seen from any host.
+func (t *accessPointTracker) lastError() error {
+	t.RLock()
+	defer t.RUnlock()
+
+	var lastErr error
+	var lastAt time.Time
+	for _, status := range t.status {
+		if status.lastErr != nil && status.retryAt.After(lastAt) {
+			lastErr = status.lastErr
+			lastAt = status.retryAt
+		}
+	}
+	return lastErr
+}
+
+// success records a successful request to the host.
+func (t *accessPointTracker) success(host string) {
+	t.Lock()
+	defer t.Unlock()
+
+	if status, found := t.status[host]; found && status.failures > 0 {
+		t.log.Noticef("access point %s is reachable again", host)
+	}
+	delete(t.status, host)
+}
+
+// failure records a failed request to the host and sets the time after which
+// it may be retried.
+func (t *accessPointTracker) failure(host string, err error) {
+	t.Lock()
+	defer t.Unlock()
+
+	status, found := t.status[host]
+	if !found {
+		status = new(accessPointStatus)
+		t.status[host] = status
+	}
+	status.failures++
+	status.lastErr = err
+	backoff := common.ExpBackoffWithJitter(apBackoffBase, apBackoffJitter, status.failures, apBackoffLimit)
+	status.retryAt = time.Now().Add(backoff)
+
+	if status.failures == 1 {
+		t.log.Noticef("access point %s is unreachable: %s", host, err)
+	}
+	t.log.Debugf("access point %s: %d failures; retrying after %s", host, status.failures, backoff)
+
+	if host == t.leader {
+		t.leader = ""
+	}
+}
+
+// updateLeader records the current MS leader and replicas. Replicas that the
+// leader reports as down are not used.
+func (t *accessPointTracker) updateLeader(resp *control.LeaderQueryResp) {
+	down := common.NewStringSet(resp.DownReplicas...)
+	var replicas []string
+	for _, replica := range resp.Replicas {
+		if !down.Has(replica) {
+			replicas = append(replicas, replica)
+		}
+	}
+	sort.Strings(replicas)
+
+	t.Lock()
+	defer t.Unlock()
+
+	if resp.Leader != t.leader && resp.Leader != "" {
+		t.log.Debugf("MS leader: %s (replicas: %s)", resp.Leader, strings.Join(replicas, ","))
+	}
+	t.leader = resp.Leader
+	t.replicas = replicas
+}
+
+// probe queries each known host that is not backing off for the current MS
+// leader, updating their health and the leader in the process.
+func (t *accessPointTracker) probe(ctx context.Context, rpcClient control.UnaryInvoker) {
+	if t == nil {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, host := range t.candidates() {
+		wg.Add(1)
+		go func(host string) {
+			defer wg.Done()
+
+			req := new(control.LeaderQueryReq)
+			req.SetHostList([]string{host})
+			req.SetTimeout(apRequestTimeout)
+			resp, err := t.queryLeader(ctx, rpcClient, req)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				t.failure(host, err)
+				return
+			}
+			t.success(host)
+			t.updateLeader(resp)
+		}(host)
+	}
+	wg.Wait()
+}
+
+// run probes the access points at the given interval until the context is
+// canceled.
+func (t *accessPointTracker) run(ctx context.Context, rpcClient control.UnaryInvoker, interval time.Duration) {
+	if t == nil {
+		return
+	}
+	if interval <= 0 {
+		interval = defaultAPProbeInterval
+	}
+
+	t.probe(ctx, rpcClient)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.probe(ctx, rpcClient)
+		}
+	}
+}
+
+// getAttachInfo sends the request to each available host in turn, starting with
+// the MS leader, until one of them can be reached.
+func (t *accessPointTracker) getAttachInfo(ctx context.Context, rpcClient control.UnaryInvoker, req *control.GetAttachInfoReq, fetch getAttachInfoFn) (*control.GetAttachInfoResp, error) {
+	candidates := t.candidates()
+	if len(candidates) == 0 {
+		if lastErr := t.lastError(); lastErr != nil {
+			return nil, errors.Wrap(lastErr, errNoAccessPoints.Error())
+		}
+		return nil, errNoAccessPoints
+	}
+
+	var err error
+	for _, host := range candidates {
+		req.HostList = []string{host}
+		req.SetTimeout(apRequestTimeout)
+
+		var resp *control.GetAttachInfoResp
+		resp, err = fetch(ctx, rpcClient, req)
+		if err == nil {
+			t.success(host)
+			return resp, nil
+		}
+		if ctx.Err() != nil || !isAccessPointFailure(err) {
+			return nil, err
+		}
+		t.failure(host, err)
+	}
+
+	return nil, err
+}
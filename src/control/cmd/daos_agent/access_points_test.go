@@ -0,0 +1,240 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_isAccessPointFailure(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err       error
+		expResult bool
+	}{
+		"nil": {},
+		"connection refused": {
+			err:       control.FaultConnectionRefused("host1:10001"),
+			expResult: true,
+		},
+		"wrapped connection error": {
+			err:       errors.Wrap(control.FaultConnectionTimedOut("host1:10001"), "wrapped"),
+			expResult: true,
+		},
+		"request timeout": {
+			err:       control.FaultRpcTimeout(new(control.GetAttachInfoReq)),
+			expResult: true,
+		},
+		"deadline exceeded": {
+			err:       context.DeadlineExceeded,
+			expResult: true,
+		},
+		"server error": {
+			err: errors.New("system name mismatch"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expResult, isAccessPointFailure(tc.err), "")
+		})
+	}
+}
+
+func TestAgent_accessPointTracker_candidates(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	tracker := newAccessPointTracker(log, []string{"ap1:10001", "ap2:10001"})
+
+	cmpCandidates := func(t *testing.T, exp []string) {
+		t.Helper()
+		if diff := cmp.Diff(exp, tracker.candidates()); diff != "" {
+			t.Fatalf("unexpected candidates (-want, +got):\n%s\n", diff)
+		}
+	}
+
+	cmpCandidates(t, []string{"ap1:10001", "ap2:10001"})
+
+	// The leader is preferred, followed by the replicas that aren't down.
+	tracker.updateLeader(&control.LeaderQueryResp{
+		Leader:       "ap2:10001",
+		Replicas:     []string{"ap2:10001", "rep2:10001", "rep1:10001", "ap1:10001"},
+		DownReplicas: []string{"rep2:10001"},
+	})
+	cmpCandidates(t, []string{"ap2:10001", "ap1:10001", "rep1:10001"})
+
+	// A failed host is skipped while backing off, and the leader is
+	// forgotten if it fails.
+	tracker.failure("ap2:10001", control.FaultConnectionRefused("ap2:10001"))
+	cmpCandidates(t, []string{"ap1:10001", "rep1:10001"})
+
+	// The host is retried once the backoff has expired.
+	tracker.Lock()
+	tracker.status["ap2:10001"].retryAt = time.Now().Add(-time.Second)
+	tracker.Unlock()
+	cmpCandidates(t, []string{"ap1:10001", "ap2:10001", "rep1:10001"})
+
+	tracker.failure("ap2:10001", control.FaultConnectionRefused("ap2:10001"))
+	tracker.success("ap2:10001")
+	cmpCandidates(t, []string{"ap1:10001", "ap2:10001", "rep1:10001"})
+
+	// Changing the access points forgets the learned replicas.
+	tracker.failure("ap1:10001", control.FaultConnectionRefused("ap1:10001"))
+	tracker.setAccessPoints([]string{"ap3:10001"})
+	cmpCandidates(t, []string{"ap3:10001"})
+}
+
+func TestAgent_accessPointTracker_backoff(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	tracker := newAccessPointTracker(log, []string{"ap1:10001"})
+
+	var lastBackoff time.Duration
+	for i := 0; i < 4; i++ {
+		tracker.failure("ap1:10001", control.FaultConnectionRefused("ap1:10001"))
+		backoff := time.Until(tracker.status["ap1:10001"].retryAt)
+		if backoff < apBackoffBase {
+			t.Fatalf("backoff %s is less than the minimum %s", backoff, apBackoffBase)
+		}
+		if i > 1 && backoff <= lastBackoff {
+			t.Fatalf("backoff %s did not increase from %s", backoff, lastBackoff)
+		}
+		lastBackoff = backoff
+	}
+}
+
+func TestAgent_accessPointTracker_probe(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	tracker := newAccessPointTracker(log, []string{"ap1:10001", "ap2:10001", "ap3:10001"})
+
+	var mutex sync.Mutex
+	var probed []string
+	tracker.queryLeader = func(_ context.Context, _ control.UnaryInvoker, req *control.LeaderQueryReq) (*control.LeaderQueryResp, error) {
+		host := req.HostList[0]
+		mutex.Lock()
+		probed = append(probed, host)
+		mutex.Unlock()
+
+		if host == "ap3:10001" {
+			return nil, control.FaultConnectionRefused(host)
+		}
+		return &control.LeaderQueryResp{
+			Leader:   "ap2:10001",
+			Replicas: []string{"ap1:10001", "ap2:10001", "ap3:10001"},
+		}, nil
+	}
+
+	tracker.probe(test.Context(t), nil)
+
+	test.AssertEqual(t, 3, len(probed), "all hosts should have been probed")
+	if diff := cmp.Diff([]string{"ap2:10001", "ap1:10001"}, tracker.candidates()); diff != "" {
+		t.Fatalf("unexpected candidates (-want, +got):\n%s\n", diff)
+	}
+
+	// Hosts that are backing off are not probed again.
+	probed = nil
+	tracker.probe(test.Context(t), nil)
+	test.AssertEqual(t, 2, len(probed), "unreachable host should not have been probed")
+}
+
+func TestAgent_accessPointTracker_getAttachInfo(t *testing.T) {
+	for name, tc := range map[string]struct {
+		leader   string
+		failed   []string
+		hostErrs map[string]error
+		expErr   error
+		expTried []string
+		expCands []string
+	}{
+		"leader preferred": {
+			leader:   "ap2:10001",
+			expTried: []string{"ap2:10001"},
+			expCands: []string{"ap2:10001", "ap1:10001", "ap3:10001"},
+		},
+		"failover": {
+			hostErrs: map[string]error{
+				"ap1:10001": control.FaultConnectionRefused("ap1:10001"),
+				"ap2:10001": control.FaultRpcTimeout(new(control.GetAttachInfoReq)),
+			},
+			expTried: []string{"ap1:10001", "ap2:10001", "ap3:10001"},
+			expCands: []string{"ap3:10001"},
+		},
+		"skips host backing off": {
+			failed:   []string{"ap1:10001"},
+			expTried: []string{"ap2:10001"},
+			expCands: []string{"ap2:10001", "ap3:10001"},
+		},
+		"server error is not retried": {
+			hostErrs: map[string]error{
+				"ap1:10001": errors.New("mock server error"),
+			},
+			expErr:   errors.New("mock server error"),
+			expTried: []string{"ap1:10001"},
+			expCands: []string{"ap1:10001", "ap2:10001", "ap3:10001"},
+		},
+		"all hosts unreachable": {
+			hostErrs: map[string]error{
+				"ap1:10001": control.FaultConnectionRefused("ap1:10001"),
+				"ap2:10001": control.FaultConnectionRefused("ap2:10001"),
+				"ap3:10001": control.FaultConnectionRefused("ap3:10001"),
+			},
+			expErr:   errors.New("refused"),
+			expTried: []string{"ap1:10001", "ap2:10001", "ap3:10001"},
+		},
+		"all hosts backing off": {
+			failed: []string{"ap1:10001", "ap2:10001", "ap3:10001"},
+			expErr: errNoAccessPoints,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tracker := newAccessPointTracker(log, []string{"ap1:10001", "ap2:10001", "ap3:10001"})
+			if tc.leader != "" {
+				tracker.updateLeader(&control.LeaderQueryResp{Leader: tc.leader})
+			}
+			for _, host := range tc.failed {
+				tracker.failure(host, errors.New("mock failure"))
+			}
+
+			var tried []string
+			fetch := func(_ context.Context, _ control.UnaryInvoker, req *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+				host := req.HostList[0]
+				tried = append(tried, host)
+				if err, found := tc.hostErrs[host]; found {
+					return nil, err
+				}
+				return &control.GetAttachInfoResp{System: host}, nil
+			}
+
+			resp, err := tracker.getAttachInfo(test.Context(t), nil, new(control.GetAttachInfoReq), fetch)
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expTried, tried); diff != "" {
+				t.Fatalf("unexpected hosts tried (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expCands, tracker.candidates()); diff != "" {
+				t.Fatalf("unexpected candidates (-want, +got):\n%s\n", diff)
+			}
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tried[len(tried)-1], resp.System, "")
+		})
+	}
+}
@@ -0,0 +1,128 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+// adminModule is the daos_agent dRPC module for administrative requests made by
+// the daos_agent command against a running agent. Requests are only accepted
+// from root or the user running the agent.
+type adminModule struct {
+	log       logging.Logger
+	monitor   *procMon
+	credCache *credentialCache
+	reloader  *configReloader
+	agentUid  uint32
+}
+
+func (mod *adminModule) ID() drpc.ModuleID {
+	return drpc.ModuleAgentAdmin
+}
+
+// isAuthorized checks whether the user is allowed to make admin requests.
+func (mod *adminModule) isAuthorized(uid uint32) bool {
+	return uid == 0 || uid == mod.agentUid
+}
+
+func (mod *adminModule) HandleCall(ctx context.Context, session *drpc.Session, method drpc.Method, req []byte) ([]byte, error) {
+	if session == nil {
+		return nil, drpc.NewFailureWithMessage("session is nil")
+	}
+
+	uConn, ok := session.Conn.(*net.UnixConn)
+	if !ok {
+		return nil, drpc.NewFailureWithMessage("connection is not a unix socket")
+	}
+
+	info, err := security.DomainInfoFromUnixConn(mod.log, uConn)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get credentials for client socket")
+	}
+
+	if !mod.isAuthorized(info.Uid()) {
+		mod.log.Errorf("%s: permission denied for %s", info, method)
+		return nil, drpc.NewFailureWithMessage("permission denied")
+	}
+
+	switch method {
+	case drpc.MethodListClients:
+		return mod.handleListClients(ctx, req)
+	case drpc.MethodEvictClient:
+		return mod.handleEvictClient(ctx, req)
+	case drpc.MethodReloadConfig:
+		return mod.handleReloadConfig(ctx, req)
+	}
+
+	return nil, drpc.UnknownMethodFailure()
+}
+
+func (mod *adminModule) handleListClients(ctx context.Context, reqb []byte) ([]byte, error) {
+	pbReq := new(mgmtpb.ListClientsReq)
+	if err := proto.Unmarshal(reqb, pbReq); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	clients, err := mod.monitor.ListClients(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return drpc.Marshal(&mgmtpb.ListClientsResp{
+		Clients:     clients,
+		Credentials: mod.credCache.list(ctx),
+	})
+}
+
+func (mod *adminModule) handleEvictClient(ctx context.Context, reqb []byte) ([]byte, error) {
+	pbReq := new(mgmtpb.EvictClientReq)
+	if err := proto.Unmarshal(reqb, pbReq); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	resp := new(mgmtpb.EvictClientResp)
+	count, err := mod.monitor.EvictClient(ctx, pbReq.Pid)
+	if err != nil {
+		var ds daos.Status
+		if !errors.As(err, &ds) {
+			return nil, err
+		}
+		mod.log.Errorf("evict client: %s", err)
+		resp.Status = ds.Int32()
+	}
+	resp.Count = count
+
+	return drpc.Marshal(resp)
+}
+
+func (mod *adminModule) handleReloadConfig(ctx context.Context, reqb []byte) ([]byte, error) {
+	pbReq := new(mgmtpb.ReloadConfigReq)
+	if err := proto.Unmarshal(reqb, pbReq); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	resp, err := mod.reloader.reload(ctx)
+	if err != nil {
+		// dRPC failures are not reported to the caller, so return the
+		// reason in the response.
+		mod.log.Errorf("reload config: %s", err)
+		resp = &mgmtpb.ReloadConfigResp{Error: err.Error()}
+	}
+
+	return drpc.Marshal(resp)
+}
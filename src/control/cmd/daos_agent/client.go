@@ -0,0 +1,220 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+type clientCmd struct {
+	List  clientListCmd  `command:"list" description:"List client processes monitored by the running agent"`
+	Evict clientEvictCmd `command:"evict" description:"Evict the open pool handles of a client process"`
+}
+
+// agentAdminCmd is embedded by commands that make administrative requests to
+// the running agent over its dRPC socket.
+type agentAdminCmd struct {
+	configCmd
+	cmdutil.LogCmd
+	cmdutil.JSONOutputCmd
+}
+
+// checkAdminUser returns an error if the user is neither root nor the owner of
+// the agent socket, as the agent only accepts administrative requests from root
+// or the user running it.
+func checkAdminUser(sockPath string, uid uint32) error {
+	fi, err := os.Stat(sockPath)
+	if err != nil {
+		// Leave it to the connection attempt to report the problem.
+		return nil
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok || uid == 0 || uid == st.Uid {
+		return nil
+	}
+
+	return errors.Errorf("permission denied: requests may only be made by root or the agent user (uid %d)",
+		st.Uid)
+}
+
+func (cmd *agentAdminCmd) invokeAgent(ctx context.Context, method drpc.Method, req, resp proto.Message) error {
+	sockPath := filepath.Join(cmd.cfg.RuntimeDir, agentSockName)
+	if err := checkAdminUser(sockPath, uint32(os.Geteuid())); err != nil {
+		return errors.Wrapf(err, "%s request failed", method)
+	}
+
+	client := drpc.NewClientConnection(sockPath)
+	if err := client.Connect(ctx); err != nil {
+		return errors.Wrapf(err, "unable to connect to agent socket %s", sockPath)
+	}
+	defer client.Close()
+
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	drpcResp, err := client.SendMsg(ctx, &drpc.Call{
+		Module: method.Module().ID(),
+		Method: method.ID(),
+		Body:   body,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "%s request failed", method)
+	}
+
+	switch drpcResp.Status {
+	case drpc.Status_SUCCESS:
+	case drpc.Status_UNKNOWN_MODULE:
+		return errors.New("running agent does not support administrative requests")
+	default:
+		return errors.Errorf("%s request failed: %s (see the agent log for details)", method,
+			drpcResp.Status)
+	}
+
+	return proto.Unmarshal(drpcResp.Body, resp)
+}
+
+type clientListCmd struct {
+	agentAdminCmd
+	Verbose bool `short:"v" long:"verbose" description:"Show open pool handles and credential cache entries"`
+}
+
+func (cmd *clientListCmd) Execute(_ []string) error {
+	resp := new(mgmtpb.ListClientsResp)
+	err := cmd.invokeAgent(cmd.MustLogCtx(), drpc.MethodListClients, new(mgmtpb.ListClientsReq), resp)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	printClientList(&out, resp, cmd.Verbose)
+	cmd.Info(out.String())
+
+	return nil
+}
+
+const clientTimeFormat = "2006-01-02 15:04:05"
+
+func formatUnixTime(secs uint64) string {
+	if secs == 0 {
+		return "-"
+	}
+	return time.Unix(int64(secs), 0).Format(clientTimeFormat)
+}
+
+func printClientList(out io.Writer, resp *mgmtpb.ListClientsResp, verbose bool) {
+	if len(resp.Clients) == 0 {
+		fmt.Fprintln(out, "No monitored client processes")
+	} else {
+		pidTitle := "PID"
+		nameTitle := "Name"
+		jobTitle := "Job ID"
+		startTitle := "Started"
+		ifaceTitle := "Interface"
+		handlesTitle := "Handles"
+
+		formatter := txtfmt.NewTableFormatter(pidTitle, nameTitle, jobTitle, startTitle,
+			ifaceTitle, handlesTitle)
+		var table []txtfmt.TableRow
+		for _, c := range resp.Clients {
+			var numHandles int
+			for _, p := range c.Pools {
+				numHandles += len(p.Handles)
+			}
+			iface := c.Interface
+			if iface == "" {
+				iface = "-"
+			} else if c.Domain != "" && c.Domain != c.Interface {
+				iface = fmt.Sprintf("%s (%s)", c.Interface, c.Domain)
+			}
+
+			table = append(table, txtfmt.TableRow{
+				pidTitle:     fmt.Sprintf("%d", c.Pid),
+				nameTitle:    c.Name,
+				jobTitle:     c.Jobid,
+				startTitle:   formatUnixTime(c.StartTime),
+				ifaceTitle:   iface,
+				handlesTitle: fmt.Sprintf("%d", numHandles),
+			})
+		}
+		fmt.Fprint(out, formatter.Format(table))
+	}
+
+	if !verbose {
+		return
+	}
+
+	for _, c := range resp.Clients {
+		if len(c.Pools) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "\npid:%d (%s) pool handles:\n", c.Pid, c.Name)
+		for _, p := range c.Pools {
+			fmt.Fprintf(out, "  %s: %s\n", p.PoolUuid, strings.Join(p.Handles, ", "))
+		}
+	}
+
+	fmt.Fprintln(out)
+	if len(resp.Credentials) == 0 {
+		fmt.Fprintln(out, "No cached credentials")
+		return
+	}
+
+	keyTitle := "Credential"
+	expTitle := "Expires"
+	formatter := txtfmt.NewTableFormatter(keyTitle, expTitle)
+	var table []txtfmt.TableRow
+	for _, cred := range resp.Credentials {
+		table = append(table, txtfmt.TableRow{
+			keyTitle: cred.Key,
+			expTitle: formatUnixTime(cred.ExpiresAt),
+		})
+	}
+	fmt.Fprint(out, formatter.Format(table))
+}
+
+type clientEvictCmd struct {
+	agentAdminCmd
+	Pid int32 `short:"p" long:"pid" required:"1" description:"PID of the client process to evict"`
+}
+
+func (cmd *clientEvictCmd) Execute(_ []string) error {
+	resp := new(mgmtpb.EvictClientResp)
+	err := cmd.invokeAgent(cmd.MustLogCtx(), drpc.MethodEvictClient, &mgmtpb.EvictClientReq{Pid: cmd.Pid}, resp)
+	if err == nil && resp.Status != 0 {
+		err = errors.Wrapf(daos.Status(resp.Status), "failed to evict pid:%d", cmd.Pid)
+	}
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("evicted %d pool handle(s) from pid:%d", resp.Count, cmd.Pid)
+	return nil
+}
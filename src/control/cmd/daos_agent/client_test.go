@@ -0,0 +1,148 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestAgent_printClientList(t *testing.T) {
+	started := time.Unix(1700000000, 0).Format(clientTimeFormat)
+	expires := time.Unix(1700000060, 0).Format(clientTimeFormat)
+
+	resp := &mgmtpb.ListClientsResp{
+		Clients: []*mgmtpb.ClientProcess{
+			{
+				Pid:       1234,
+				Name:      "ior",
+				Jobid:     "job1",
+				StartTime: 1700000000,
+				Interface: "eth0",
+				Domain:    "eth0",
+				Pools: []*mgmtpb.ClientPoolHandles{
+					{
+						PoolUuid: test.MockUUID(1),
+						Handles:  []string{test.MockUUID(2), test.MockUUID(3)},
+					},
+				},
+			},
+			{
+				Pid:       5678,
+				Name:      "dfuse",
+				Interface: "ib0",
+				Domain:    "mlx5_0",
+			},
+		},
+		Credentials: []*mgmtpb.CachedCredential{
+			{Key: "1000:1000:", ExpiresAt: 1700000060},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		resp    *mgmtpb.ListClientsResp
+		verbose bool
+		expOut  string
+	}{
+		"no clients": {
+			resp: &mgmtpb.ListClientsResp{},
+			expOut: `
+No monitored client processes
+`,
+		},
+		"no clients; verbose": {
+			resp:    &mgmtpb.ListClientsResp{},
+			verbose: true,
+			expOut: `
+No monitored client processes
+
+No cached credentials
+`,
+		},
+		"clients": {
+			resp: resp,
+			expOut: fmt.Sprintf(`
+PID  Name  Job ID Started             Interface    Handles 
+---  ----  ------ -------             ---------    ------- 
+1234 ior   job1   %s eth0         2       
+5678 dfuse        -                   ib0 (mlx5_0) 0       
+`, started),
+		},
+		"clients; verbose": {
+			resp:    resp,
+			verbose: true,
+			expOut: fmt.Sprintf(`
+PID  Name  Job ID Started             Interface    Handles 
+---  ----  ------ -------             ---------    ------- 
+1234 ior   job1   %s eth0         2       
+5678 dfuse        -                   ib0 (mlx5_0) 0       
+
+pid:1234 (ior) pool handles:
+  %s: %s, %s
+
+Credential Expires             
+---------- -------             
+1000:1000: %s 
+`, started, test.MockUUID(1), test.MockUUID(2), test.MockUUID(3), expires),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			printClientList(&out, tc.resp, tc.verbose)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_checkAdminUser(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	sockPath := test.CreateTestFile(t, tmpDir, "")
+	owner := uint32(os.Getuid())
+
+	for name, tc := range map[string]struct {
+		sockPath string
+		uid      uint32
+		expErr   error
+	}{
+		"root": {
+			sockPath: sockPath,
+			uid:      0,
+		},
+		"agent user": {
+			sockPath: sockPath,
+			uid:      owner,
+		},
+		"other user": {
+			sockPath: sockPath,
+			uid:      owner + 1,
+			expErr:   errors.New("permission denied"),
+		},
+		"missing socket": {
+			sockPath: filepath.Join(tmpDir, "missing.sock"),
+			uid:      owner + 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, checkAdminUser(tc.sockPath, tc.uid))
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -57,6 +58,8 @@ type Config struct {
 	ExcludeFabricIfaces common.StringSet           `yaml:"exclude_fabric_ifaces,omitempty"`
 	IncludeFabricIfaces common.StringSet           `yaml:"include_fabric_ifaces,omitempty"`
 	FabricInterfaces    []*NUMAFabricConfig        `yaml:"fabric_ifaces,omitempty"`
+	FabricIfaceSelect   fabricSelectionPolicy      `yaml:"fabric_iface_selection,omitempty"`
+	FabricHealthPeriod  time.Duration              `yaml:"fabric_health_interval,omitempty"`
 	ProviderIdx         uint                       // TODO SRS-31: Enable with multiprovider functionality
 	TelemetryPort       int                        `yaml:"telemetry_port,omitempty"`
 	TelemetryEnabled    bool                       `yaml:"telemetry_enabled,omitempty"`
@@ -85,6 +88,18 @@ func (c *Config) Validate() error {
 		return errors.New("cannot specify both exclude_fabric_ifaces and include_fabric_ifaces")
 	}
 
+	if err := c.FabricIfaceSelect.Validate(); err != nil {
+		return err
+	}
+
+	if c.FabricHealthPeriod < 0 {
+		return errors.New("fabric_health_interval must not be negative")
+	}
+
+	if err := c.CredentialConfig.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
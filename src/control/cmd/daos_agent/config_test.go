@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -56,6 +57,8 @@ credential_config:
 transport_config:
   allow_insecure: true
 exclude_fabric_ifaces: ["ib3"]
+fabric_iface_selection: least-connections
+fabric_health_interval: 10s
 fabric_ifaces:
 -
   numa_node: 0
@@ -88,6 +91,17 @@ transport_config:
   allow_insecure: true
 `)
 
+	badSelectionCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+runtime_dir: /tmp/runtime
+log_file: /home/frodo/logfile
+transport_config:
+  allow_insecure: true
+fabric_iface_selection: random
+`)
+
 	badFilterCfg := test.CreateTestFile(t, dir, `
 name: shire
 access_points: ["one:10001", "two:10001"]
@@ -100,6 +114,20 @@ include_fabric_ifaces: ["ib0"]
 exclude_fabric_ifaces: ["ib3"]
 `)
 
+	badIdentityCfg := test.CreateTestFile(t, dir, `
+name: shire
+access_points: ["one:10001", "two:10001"]
+port: 4242
+runtime_dir: /tmp/runtime
+log_file: /home/frodo/logfile
+transport_config:
+  allow_insecure: true
+credential_config:
+  identity_providers:
+  - type: ldap
+    path: /etc/daos/ldap.conf
+`)
+
 	for name, tc := range map[string]struct {
 		path      string
 		expResult *Config
@@ -144,6 +172,14 @@ exclude_fabric_ifaces: ["ib3"]
 			path:   badFilterCfg,
 			expErr: errors.New("cannot specify both exclude_fabric_ifaces and include_fabric_ifaces"),
 		},
+		"bad fabric interface selection": {
+			path:   badSelectionCfg,
+			expErr: errors.New("invalid fabric_iface_selection"),
+		},
+		"bad identity provider": {
+			path:   badIdentityCfg,
+			expErr: errors.New("unknown identity provider type"),
+		},
 		"all options": {
 			path: optCfg,
 			expResult: &Config{
@@ -171,6 +207,8 @@ exclude_fabric_ifaces: ["ib3"]
 					CertificateConfig: DefaultConfig().TransportConfig.CertificateConfig,
 				},
 				ExcludeFabricIfaces: common.NewStringSet("ib3"),
+				FabricIfaceSelect:   fabricSelectLeastConns,
+				FabricHealthPeriod:  10 * time.Second,
 				FabricInterfaces: []*NUMAFabricConfig{
 					{
 						NUMANode: 0,
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -126,6 +127,7 @@ type NUMAFabric struct {
 	currentNumaDevIdx map[int]int   // current device idx to use on each NUMA node
 	currentNUMANode   int           // current NUMA node to search
 	ifaceFilter       *deviceFilter // set of interface names for filtering
+	selector          *fabricSelector
 
 	getAddrInterface func(name string) (addrFI, error)
 }
@@ -153,6 +155,15 @@ func (n *NUMAFabric) WithDeviceFilter(filter *deviceFilter) *NUMAFabric {
 	return n
 }
 
+// WithSelector sets the selector used to apply the interface selection policy
+// and to skip interfaces that are down.
+func (n *NUMAFabric) WithSelector(selector *fabricSelector) *NUMAFabric {
+	if selector != nil {
+		n.selector = selector
+	}
+	return n
+}
+
 // NumDevices gets the number of devices on a given NUMA node.
 func (n *NUMAFabric) NumDevices(numaNode int) int {
 	if n == nil {
@@ -262,35 +273,69 @@ func copyFI(fi *FabricInterface) *FabricInterface {
 }
 
 func (n *NUMAFabric) getDeviceFromNUMA(numaNode int, netDevClass hardware.NetDevClass, provider string) (*FabricInterface, error) {
-	for checked := 0; checked < n.getNumDevices(numaNode); checked++ {
-		fabricIF := n.getNextDevice(numaNode)
+	numDevs := n.getNumDevices(numaNode)
+	if numDevs == 0 {
+		return nil, FabricNotFoundErr(netDevClass)
+	}
 
-		if n.ifaceFilter.ShouldIgnore(fabricIF.Name) {
-			n.log.Tracef("device %s: ignored (filter: %+v)", fabricIF, n.ifaceFilter)
-			continue
+	if n.selector.isRoundRobin() {
+		for checked := 0; checked < numDevs; checked++ {
+			fabricIF := n.getNextDevice(numaNode)
+			if n.isSuitableDevice(fabricIF, netDevClass, provider) {
+				return fabricIF, nil
+			}
+		}
+		return nil, FabricNotFoundErr(netDevClass)
+	}
+
+	// Gather all suitable devices, starting from the next round-robin
+	// index, and let the selector choose between them.
+	devs := n.numaMap[numaNode]
+	start := n.getNextDevIndex(numaNode)
+	candidates := make([]*FabricInterface, 0, numDevs)
+	for i := 0; i < numDevs; i++ {
+		fabricIF := devs[(start+i)%numDevs]
+		if n.isSuitableDevice(fabricIF, netDevClass, provider) {
+			candidates = append(candidates, fabricIF)
 		}
+	}
+	if len(candidates) == 0 {
+		return nil, FabricNotFoundErr(netDevClass)
+	}
 
-		// Manually-provided interfaces can be assumed to support what's needed by the system.
-		if fabricIF.NetDevClass != FabricDevClassManual {
-			if fabricIF.NetDevClass != netDevClass {
-				n.log.Tracef("device %s: excluded (netDevClass %s != %s)", fabricIF, fabricIF.NetDevClass, netDevClass)
-				continue
-			}
+	return n.selector.choose(candidates), nil
+}
 
-			if !fabricIF.HasProvider(provider) {
-				n.log.Tracef("device %s: excluded (provider %s not supported)", fabricIF, provider)
-				continue
-			}
+func (n *NUMAFabric) isSuitableDevice(fabricIF *FabricInterface, netDevClass hardware.NetDevClass, provider string) bool {
+	if n.ifaceFilter.ShouldIgnore(fabricIF.Name) {
+		n.log.Tracef("device %s: ignored (filter: %+v)", fabricIF, n.ifaceFilter)
+		return false
+	}
+
+	// Manually-provided interfaces can be assumed to support what's needed by the system.
+	if fabricIF.NetDevClass != FabricDevClassManual {
+		if fabricIF.NetDevClass != netDevClass {
+			n.log.Tracef("device %s: excluded (netDevClass %s != %s)", fabricIF, fabricIF.NetDevClass, netDevClass)
+			return false
 		}
 
-		if err := n.validateDevice(fabricIF); err != nil {
-			n.log.Noticef("device %s: excluded (%s)", fabricIF, err)
-			continue
+		if !fabricIF.HasProvider(provider) {
+			n.log.Tracef("device %s: excluded (provider %s not supported)", fabricIF, provider)
+			return false
 		}
+	}
 
-		return fabricIF, nil
+	if n.selector.isDown(fabricIF.Name) {
+		n.log.Tracef("device %s: excluded (interface is down)", fabricIF)
+		return false
 	}
-	return nil, FabricNotFoundErr(netDevClass)
+
+	if err := n.validateDevice(fabricIF); err != nil {
+		n.log.Noticef("device %s: excluded (%s)", fabricIF, err)
+		return false
+	}
+
+	return true
 }
 
 // getAddrFI wraps net.InterfaceByName to allow using the addrFI interface as
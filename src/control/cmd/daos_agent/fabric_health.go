@@ -0,0 +1,168 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const defaultFabricHealthInterval = 5 * time.Second
+
+func netDevStateString(state hardware.NetDevState) string {
+	switch state {
+	case hardware.NetDevStateDown:
+		return "down"
+	case hardware.NetDevStateNotReady:
+		return "not ready"
+	case hardware.NetDevStateReady:
+		return "ready"
+	default:
+		return "unknown"
+	}
+}
+
+// fabricIfaceStatus is the last observed status of a fabric interface.
+type fabricIfaceStatus struct {
+	state hardware.NetDevState
+	speed uint64 // link speed in Mb/s, 0 if unknown
+}
+
+// isDown checks whether the interface is unable to carry traffic. Interfaces
+// whose state can't be determined are assumed to be usable.
+func (s fabricIfaceStatus) isDown() bool {
+	return s.state == hardware.NetDevStateDown || s.state == hardware.NetDevStateNotReady
+}
+
+// fabricHealthMonitor tracks the state and link speed of the fabric interfaces
+// handed out to clients. Interfaces are tracked from the first time they are
+// considered for selection, and are then periodically rechecked so that
+// interfaces that go down are skipped until they recover.
+type fabricHealthMonitor struct {
+	sync.RWMutex
+	log       logging.Logger
+	stateProv hardware.NetDevStateProvider
+	speedProv hardware.NetDevSpeedProvider
+	ifaces    map[string]fabricIfaceStatus
+}
+
+func newFabricHealthMonitor(log logging.Logger, stateProv hardware.NetDevStateProvider, speedProv hardware.NetDevSpeedProvider) *fabricHealthMonitor {
+	return &fabricHealthMonitor{
+		log:       log,
+		stateProv: stateProv,
+		speedProv: speedProv,
+		ifaces:    make(map[string]fabricIfaceStatus),
+	}
+}
+
+// check fetches the current status of the interface.
+func (m *fabricHealthMonitor) check(iface string) fabricIfaceStatus {
+	var status fabricIfaceStatus
+
+	state, err := m.stateProv.GetNetDevState(iface)
+	if err != nil {
+		m.log.Tracef("fabric interface %s: unable to get state: %s", iface, err)
+	}
+	status.state = state
+
+	if m.speedProv != nil && !status.isDown() {
+		speed, err := m.speedProv.GetNetDevSpeed(iface)
+		if err != nil {
+			m.log.Tracef("fabric interface %s: unable to get link speed: %s", iface, err)
+		}
+		status.speed = speed
+	}
+
+	return status
+}
+
+// update records the new status of the interface, logging any change in
+// whether it is usable.
+func (m *fabricHealthMonitor) update(iface string, status fabricIfaceStatus) {
+	m.Lock()
+	defer m.Unlock()
+
+	prev, found := m.ifaces[iface]
+	m.ifaces[iface] = status
+
+	switch {
+	case status.isDown() && (!found || !prev.isDown()):
+		m.log.Noticef("fabric interface %s is %s; not assigning it to new clients",
+			iface, netDevStateString(status.state))
+	case !status.isDown() && found && prev.isDown():
+		m.log.Noticef("fabric interface %s has recovered (%s)", iface, netDevStateString(status.state))
+	}
+}
+
+// status returns the last observed status of the interface. Interfaces that are
+// not yet tracked are checked immediately.
+func (m *fabricHealthMonitor) status(iface string) fabricIfaceStatus {
+	m.RLock()
+	status, found := m.ifaces[iface]
+	m.RUnlock()
+	if found {
+		return status
+	}
+
+	status = m.check(iface)
+	m.update(iface, status)
+	return status
+}
+
+// IsDown checks whether the interface has been marked down.
+func (m *fabricHealthMonitor) IsDown(iface string) bool {
+	if m == nil {
+		return false
+	}
+	return m.status(iface).isDown()
+}
+
+// LinkSpeed returns the link speed of the interface in Mb/s, or 0 if unknown.
+func (m *fabricHealthMonitor) LinkSpeed(iface string) uint64 {
+	if m == nil {
+		return 0
+	}
+	return m.status(iface).speed
+}
+
+func (m *fabricHealthMonitor) checkAll() {
+	m.RLock()
+	ifaces := make([]string, 0, len(m.ifaces))
+	for iface := range m.ifaces {
+		ifaces = append(ifaces, iface)
+	}
+	m.RUnlock()
+
+	for _, iface := range ifaces {
+		m.update(iface, m.check(iface))
+	}
+}
+
+// run rechecks the tracked interfaces at the given interval until the context
+// is canceled.
+func (m *fabricHealthMonitor) run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultFabricHealthInterval
+	}
+	m.log.Debugf("monitoring fabric interface health every %s", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.checkAll()
+		}
+	}
+}
@@ -0,0 +1,141 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// mockIfaceStatusProvider provides interface states and link speeds for tests.
+// Interfaces without a state are reported as ready, and interfaces without a
+// speed are reported as having an unknown speed.
+type mockIfaceStatusProvider struct {
+	sync.Mutex
+	states map[string]hardware.NetDevState
+	speeds map[string]uint64
+}
+
+func (m *mockIfaceStatusProvider) setState(iface string, state hardware.NetDevState) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.states == nil {
+		m.states = make(map[string]hardware.NetDevState)
+	}
+	m.states[iface] = state
+}
+
+func (m *mockIfaceStatusProvider) GetNetDevState(iface string) (hardware.NetDevState, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	state, found := m.states[iface]
+	if !found {
+		return hardware.NetDevStateReady, nil
+	}
+	return state, nil
+}
+
+func (m *mockIfaceStatusProvider) GetNetDevSpeed(iface string) (uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	speed, found := m.speeds[iface]
+	if !found {
+		return 0, errors.Errorf("link speed of %q is unknown", iface)
+	}
+	return speed, nil
+}
+
+func newTestFabricHealthMonitor(log logging.Logger, prov *mockIfaceStatusProvider) *fabricHealthMonitor {
+	return newFabricHealthMonitor(log, prov, prov)
+}
+
+func TestAgent_fabricHealthMonitor_IsDown(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nilMonitor bool
+		state      hardware.NetDevState
+		stateErr   error
+		expDown    bool
+	}{
+		"nil": {
+			nilMonitor: true,
+		},
+		"ready": {
+			state: hardware.NetDevStateReady,
+		},
+		"down": {
+			state:   hardware.NetDevStateDown,
+			expDown: true,
+		},
+		"not ready": {
+			state:   hardware.NetDevStateNotReady,
+			expDown: true,
+		},
+		"unknown": {
+			state: hardware.NetDevStateUnknown,
+		},
+		"state error": {
+			stateErr: errors.New("mock GetNetDevState"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var m *fabricHealthMonitor
+			if !tc.nilMonitor {
+				m = newFabricHealthMonitor(log, &hardware.MockNetDevStateProvider{
+					GetStateReturn: []hardware.MockNetDevStateResult{
+						{State: tc.state, Err: tc.stateErr},
+					},
+				}, nil)
+			}
+
+			test.AssertEqual(t, tc.expDown, m.IsDown("ib0"), "")
+		})
+	}
+}
+
+func TestAgent_fabricHealthMonitor_checkAll(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	prov := &mockIfaceStatusProvider{
+		speeds: map[string]uint64{
+			"ib0": 100000,
+			"ib1": 200000,
+		},
+	}
+	m := newTestFabricHealthMonitor(log, prov)
+
+	test.AssertFalse(t, m.IsDown("ib0"), "ib0 should be up")
+	test.AssertFalse(t, m.IsDown("ib1"), "ib1 should be up")
+	test.AssertEqual(t, uint64(100000), m.LinkSpeed("ib0"), "")
+	test.AssertEqual(t, uint64(0), m.LinkSpeed("ib2"), "")
+
+	// Status is cached until the interfaces are rechecked.
+	prov.setState("ib0", hardware.NetDevStateDown)
+	test.AssertFalse(t, m.IsDown("ib0"), "ib0 should not be rechecked")
+
+	m.checkAll()
+	test.AssertTrue(t, m.IsDown("ib0"), "ib0 should be down")
+	test.AssertFalse(t, m.IsDown("ib1"), "ib1 should be up")
+	test.AssertEqual(t, uint64(0), m.LinkSpeed("ib0"), "down interface should have no link speed")
+
+	prov.setState("ib0", hardware.NetDevStateReady)
+	m.checkAll()
+	test.AssertFalse(t, m.IsDown("ib0"), "ib0 should have recovered")
+	test.AssertEqual(t, uint64(100000), m.LinkSpeed("ib0"), "")
+}
@@ -0,0 +1,245 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// fabricSelectionPolicy determines how a fabric interface is chosen from the
+// suitable interfaces on a NUMA node.
+type fabricSelectionPolicy string
+
+const (
+	// fabricSelectRoundRobin hands out each suitable interface in turn.
+	fabricSelectRoundRobin fabricSelectionPolicy = "round-robin"
+	// fabricSelectLeastConns hands out the interface with the fewest
+	// connections from monitored client processes.
+	fabricSelectLeastConns fabricSelectionPolicy = "least-connections"
+	// fabricSelectLinkSpeed hands out interfaces in proportion to their
+	// link speed.
+	fabricSelectLinkSpeed fabricSelectionPolicy = "link-speed"
+)
+
+// Validate checks that the policy is known. An empty policy selects the
+// default round-robin behavior.
+func (p fabricSelectionPolicy) Validate() error {
+	switch p {
+	case "", fabricSelectRoundRobin, fabricSelectLeastConns, fabricSelectLinkSpeed:
+		return nil
+	}
+	return errors.Errorf("invalid fabric_iface_selection %q (valid: %s, %s, %s)", p,
+		fabricSelectRoundRobin, fabricSelectLeastConns, fabricSelectLinkSpeed)
+}
+
+func (p fabricSelectionPolicy) String() string {
+	if p == "" {
+		return string(fabricSelectRoundRobin)
+	}
+	return string(p)
+}
+
+// pendingAssignTimeout is the time after which an interface assignment is no
+// longer counted as a pending connection if the client never connects to a
+// pool.
+const pendingAssignTimeout = 30 * time.Second
+
+// ifaceLoadFn returns the number of connections using a fabric interface.
+type ifaceLoadFn func(iface string) int
+
+// ifaceConnectFn is called when a client process assigned a fabric interface
+// connects to a pool.
+type ifaceConnectFn func(iface string)
+
+// fabricSelector applies the selection policy and interface health to the
+// choice of fabric interface. It is shared by each NUMAFabric generated by the
+// agent, so that its state persists across fabric rescans.
+type fabricSelector struct {
+	sync.Mutex
+	log     logging.Logger
+	policy  fabricSelectionPolicy
+	health  *fabricHealthMonitor
+	getLoad ifaceLoadFn
+	weights map[string]int         // current weights for link-speed selection
+	pending map[string][]time.Time // assignments not yet seen to connect
+}
+
+func newFabricSelector(log logging.Logger, policy fabricSelectionPolicy, health *fabricHealthMonitor) *fabricSelector {
+	return &fabricSelector{
+		log:     log,
+		policy:  policy,
+		health:  health,
+		weights: make(map[string]int),
+		pending: make(map[string][]time.Time),
+	}
+}
+
+// setLoadFn sets the function used to get interface load for the
+// least-connections policy.
+func (s *fabricSelector) setLoadFn(fn ifaceLoadFn) {
+	if s == nil {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.getLoad = fn
+}
+
+// connected stops counting the oldest pending assignment of the interface, as
+// the connection is now included in the interface load.
+func (s *fabricSelector) connected(iface string) {
+	if s == nil {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if len(s.pending[iface]) == 0 {
+		return
+	}
+	s.pending[iface] = s.pending[iface][1:]
+	if len(s.pending[iface]) == 0 {
+		delete(s.pending, iface)
+	}
+}
+
+// numPending returns the number of assignments of the interface that have not
+// yet connected, discarding those that have timed out.
+func (s *fabricSelector) numPending(iface string, now time.Time) int {
+	assigned := s.pending[iface]
+	for len(assigned) > 0 && now.Sub(assigned[0]) > pendingAssignTimeout {
+		assigned = assigned[1:]
+	}
+	if len(assigned) == 0 {
+		delete(s.pending, iface)
+		return 0
+	}
+	s.pending[iface] = assigned
+
+	return len(assigned)
+}
+
+// setPolicy changes the selection policy.
+func (s *fabricSelector) setPolicy(policy fabricSelectionPolicy) {
+	if s == nil {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if policy != s.policy {
+		s.log.Debugf("fabric interface selection policy: %s", policy)
+	}
+	s.policy = policy
+}
+
+// isRoundRobin checks whether devices are selected round-robin.
+func (s *fabricSelector) isRoundRobin() bool {
+	if s == nil {
+		return true
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	return s.policy == "" || s.policy == fabricSelectRoundRobin
+}
+
+// isDown checks whether the health monitor has marked the interface down.
+func (s *fabricSelector) isDown(iface string) bool {
+	if s == nil {
+		return false
+	}
+	return s.health.IsDown(iface)
+}
+
+// choose selects a device from the suitable candidates according to the
+// policy. Candidates are passed in round-robin order, so that ties are broken
+// in round-robin fashion.
+func (s *fabricSelector) choose(candidates []*FabricInterface) *FabricInterface {
+	if s == nil || len(candidates) == 0 {
+		return nil
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	switch s.policy {
+	case fabricSelectLeastConns:
+		return s.leastLoaded(candidates)
+	case fabricSelectLinkSpeed:
+		return s.weightedBySpeed(candidates)
+	}
+	return candidates[0]
+}
+
+func (s *fabricSelector) leastLoaded(candidates []*FabricInterface) *FabricInterface {
+	if s.getLoad == nil {
+		return candidates[0]
+	}
+
+	// The interface load only includes processes that have connected to a
+	// pool, so interfaces handed out since then are counted separately to
+	// spread a burst of requests across the interfaces.
+	now := time.Now()
+	var chosen *FabricInterface
+	minLoad := -1
+	for _, fi := range candidates {
+		conns := s.getLoad(fi.Name)
+		pending := s.numPending(fi.Name, now)
+		s.log.Tracef("device %s: %d connections, %d pending", fi, conns, pending)
+		if load := conns + pending; minLoad < 0 || load < minLoad {
+			chosen = fi
+			minLoad = load
+		}
+	}
+	s.pending[chosen.Name] = append(s.pending[chosen.Name], now)
+
+	return chosen
+}
+
+// speedWeight converts a link speed in Mb/s to a weight, with each Gb/s
+// counting as one. Interfaces with unknown speed are weighted as 1 Gb/s.
+func speedWeight(speed uint64) int {
+	if speed < 1000 {
+		return 1
+	}
+	return int(speed / 1000)
+}
+
+func fabricWeightKey(fi *FabricInterface) string {
+	return fi.Name + "/" + fi.Domain
+}
+
+// weightedBySpeed uses smooth weighted round-robin, which spreads selections of
+// each interface evenly in proportion to its weight rather than in bursts.
+func (s *fabricSelector) weightedBySpeed(candidates []*FabricInterface) *FabricInterface {
+	var chosen *FabricInterface
+	var total int
+	for _, fi := range candidates {
+		weight := speedWeight(s.health.LinkSpeed(fi.Name))
+		total += weight
+
+		key := fabricWeightKey(fi)
+		s.weights[key] += weight
+		if chosen == nil || s.weights[key] > s.weights[fabricWeightKey(chosen)] {
+			chosen = fi
+		}
+	}
+	s.weights[fabricWeightKey(chosen)] -= total
+
+	return chosen
+}
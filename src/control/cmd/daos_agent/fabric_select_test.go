@@ -0,0 +1,252 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_fabricSelectionPolicy_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policy fabricSelectionPolicy
+		expErr error
+	}{
+		"default": {},
+		"round-robin": {
+			policy: fabricSelectRoundRobin,
+		},
+		"least-connections": {
+			policy: fabricSelectLeastConns,
+		},
+		"link-speed": {
+			policy: fabricSelectLinkSpeed,
+		},
+		"invalid": {
+			policy: "random",
+			expErr: errors.New("invalid fabric_iface_selection"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.policy.Validate())
+		})
+	}
+}
+
+func testSelectionFabric(names ...string) *NUMAFabric {
+	nf := newNUMAFabric(nil)
+	for _, name := range names {
+		nf.numaMap[0] = append(nf.numaMap[0], fabricInterfacesFromHardware(&hardware.FabricInterface{
+			NetInterfaces: common.NewStringSet(name),
+			Name:          name,
+			DeviceClass:   hardware.Ether,
+			Providers:     testFabricProviderSet("ofi+tcp"),
+		})[0])
+	}
+	nf.getAddrInterface = getMockNetInterfaceSuccess
+	return nf
+}
+
+func TestAgent_NUMAFabric_GetDevice_Selection(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policy    fabricSelectionPolicy
+		states    map[string]hardware.NetDevState
+		speeds    map[string]uint64
+		load      map[string]int
+		burst     bool // no selections connect during the calls
+		numCalls  int
+		expErr    error
+		expResult []string
+		expCounts map[string]int
+	}{
+		"round-robin skips down interface": {
+			policy: fabricSelectRoundRobin,
+			states: map[string]hardware.NetDevState{
+				"t2": hardware.NetDevStateDown,
+			},
+			numCalls:  4,
+			expResult: []string{"t1", "t3", "t1", "t3"},
+		},
+		"all interfaces down": {
+			policy: fabricSelectLeastConns,
+			states: map[string]hardware.NetDevState{
+				"t1": hardware.NetDevStateDown,
+				"t2": hardware.NetDevStateNotReady,
+				"t3": hardware.NetDevStateDown,
+			},
+			numCalls: 1,
+			expErr:   errors.New("no suitable fabric interface"),
+		},
+		"least-connections": {
+			policy: fabricSelectLeastConns,
+			load: map[string]int{
+				"t1": 2,
+				"t2": 0,
+				"t3": 1,
+			},
+			numCalls:  4,
+			expResult: []string{"t2", "t2", "t3", "t1"},
+		},
+		"least-connections burst before connect": {
+			policy: fabricSelectLeastConns,
+			load: map[string]int{
+				"t1": 2,
+				"t2": 0,
+				"t3": 1,
+			},
+			burst:     true,
+			numCalls:  4,
+			expResult: []string{"t2", "t2", "t3", "t1"},
+		},
+		"least-connections skips down interface": {
+			policy: fabricSelectLeastConns,
+			states: map[string]hardware.NetDevState{
+				"t2": hardware.NetDevStateDown,
+			},
+			load: map[string]int{
+				"t1": 2,
+				"t2": 0,
+				"t3": 1,
+			},
+			numCalls:  3,
+			expResult: []string{"t3", "t3", "t1"},
+		},
+		"link-speed": {
+			policy: fabricSelectLinkSpeed,
+			speeds: map[string]uint64{
+				"t1": 200000,
+				"t2": 100000,
+				"t3": 100000,
+			},
+			numCalls: 8,
+			expCounts: map[string]int{
+				"t1": 4,
+				"t2": 2,
+				"t3": 2,
+			},
+		},
+		"link-speed with unknown speed": {
+			policy: fabricSelectLinkSpeed,
+			speeds: map[string]uint64{
+				"t1": 2000,
+				"t2": 1000,
+			},
+			numCalls: 4,
+			expCounts: map[string]int{
+				"t1": 2,
+				"t2": 1,
+				"t3": 1,
+			},
+		},
+		"link-speed skips down interface": {
+			policy: fabricSelectLinkSpeed,
+			states: map[string]hardware.NetDevState{
+				"t1": hardware.NetDevStateDown,
+			},
+			speeds: map[string]uint64{
+				"t1": 200000,
+				"t2": 100000,
+				"t3": 100000,
+			},
+			numCalls: 4,
+			expCounts: map[string]int{
+				"t2": 2,
+				"t3": 2,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			prov := &mockIfaceStatusProvider{
+				states: tc.states,
+				speeds: tc.speeds,
+			}
+			selector := newFabricSelector(log, tc.policy, newTestFabricHealthMonitor(log, prov))
+			if tc.load != nil {
+				selector.setLoadFn(func(iface string) int {
+					return tc.load[iface]
+				})
+			}
+
+			nf := testSelectionFabric("t1", "t2", "t3")
+			nf.log = log
+			nf = nf.WithSelector(selector)
+
+			var results []string
+			counts := make(map[string]int)
+			for i := 0; i < tc.numCalls; i++ {
+				fi, err := nf.GetDevice(&FabricIfaceParams{
+					Provider: "ofi+tcp",
+					DevClass: hardware.Ether,
+				})
+				test.CmpErr(t, tc.expErr, err)
+				if tc.expErr != nil {
+					return
+				}
+				results = append(results, fi.Name)
+				counts[fi.Name]++
+
+				// Each selection adds a connection to the interface.
+				if tc.load != nil && !tc.burst {
+					tc.load[fi.Name]++
+					selector.connected(fi.Name)
+				}
+			}
+
+			if tc.expResult != nil {
+				if diff := cmp.Diff(tc.expResult, results); diff != "" {
+					t.Fatalf("unexpected selections (-want, +got):\n%s\n", diff)
+				}
+			}
+			if tc.expCounts != nil {
+				if diff := cmp.Diff(tc.expCounts, counts); diff != "" {
+					t.Fatalf("unexpected selection counts (-want, +got):\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAgent_fabricSelector_pending(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	selector := newFabricSelector(log, fabricSelectLeastConns, nil)
+	selector.setLoadFn(func(string) int { return 0 })
+
+	nf := testSelectionFabric("t1")
+	candidates := nf.numaMap[0]
+	for i := 0; i < 3; i++ {
+		selector.choose(candidates)
+	}
+
+	now := time.Now()
+	test.AssertEqual(t, 3, selector.numPending("t1", now), "unexpected pending after selection")
+
+	selector.connected("t1")
+	test.AssertEqual(t, 2, selector.numPending("t1", now), "unexpected pending after connect")
+
+	// Connections without a pending assignment are ignored.
+	selector.connected("t2")
+	test.AssertEqual(t, 0, selector.numPending("t2", now), "unexpected pending for other interface")
+
+	later := now.Add(pendingAssignTimeout + time.Second)
+	test.AssertEqual(t, 0, selector.numPending("t1", later), "unexpected pending after timeout")
+	if _, found := selector.pending["t1"]; found {
+		t.Fatal("expected timed out assignments to be discarded")
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -37,13 +38,20 @@ type fabricScanFn func(ctx context.Context, providers ...string) (*NUMAFabric, e
 
 // NewInfoCache creates a new InfoCache with appropriate parameters set.
 func NewInfoCache(ctx context.Context, log logging.Logger, client control.UnaryInvoker, cfg *Config) *InfoCache {
+	health := newFabricHealthMonitor(log, network.DefaultNetDevStateProvider(log),
+		network.DefaultNetDevSpeedProvider(log))
+	selector := newFabricSelector(log, cfg.FabricIfaceSelect, health)
+
 	ic := &InfoCache{
 		log:             log,
 		ignoreIfaces:    cfg.ExcludeFabricIfaces,
 		client:          client,
 		cache:           cache.NewItemCache(log),
 		getAttachInfoCb: control.GetAttachInfo,
-		fabricScan:      getFabricScanFn(log, cfg, network.DefaultFabricScanner(log)),
+		fabricScan:      getFabricScanFn(log, network.DefaultFabricScanner(log)),
+		fabricSelector:  selector,
+		accessPoints:    newAccessPointTracker(log, cfg.AccessPoints),
+		devFilter:       fabricDeviceFilter(cfg),
 		netIfaces:       net.Interfaces,
 		devClassGetter:  network.DefaultNetDevClassProvider(log),
 		devStateGetter:  network.DefaultNetDevStateProvider(log),
@@ -60,7 +68,7 @@ func NewInfoCache(ctx context.Context, log logging.Logger, client control.UnaryI
 
 	ic.EnableAttachInfoCache(time.Duration(cfg.CacheExpiration))
 	if len(cfg.FabricInterfaces) > 0 {
-		nf := NUMAFabricFromConfig(log, cfg.FabricInterfaces)
+		nf := NUMAFabricFromConfig(log, cfg.FabricInterfaces).WithSelector(selector)
 		ic.EnableStaticFabricCache(ctx, nf)
 	} else {
 		ic.EnableFabricCache()
@@ -76,13 +84,13 @@ func fabricDeviceFilter(cfg *Config) *deviceFilter {
 	return newDeviceFilter(cfg.IncludeFabricIfaces, filterModeInclude)
 }
 
-func getFabricScanFn(log logging.Logger, cfg *Config, scanner *hardware.FabricScanner) fabricScanFn {
+func getFabricScanFn(log logging.Logger, scanner *hardware.FabricScanner) fabricScanFn {
 	return func(ctx context.Context, provs ...string) (*NUMAFabric, error) {
 		fis, err := scanner.Scan(ctx, provs...)
 		if err != nil {
 			return nil, err
 		}
-		return NUMAFabricFromScan(ctx, log, fis).WithDeviceFilter(fabricDeviceFilter(cfg)), nil
+		return NUMAFabricFromScan(ctx, log, fis), nil
 	}
 }
 
@@ -109,10 +117,12 @@ var _ cache.RefreshableItem = (*cachedAttachInfo)(nil)
 
 type cachedAttachInfo struct {
 	cacheItem
+	log          logging.Logger
 	fetch        getAttachInfoFn
 	system       string
 	rpcClient    control.UnaryInvoker
 	lastResponse *control.GetAttachInfoResp
+	staleSince   time.Time
 }
 
 func newCachedAttachInfo(refreshInterval time.Duration, system string, rpcClient control.UnaryInvoker, fetchFn getAttachInfoFn) *cachedAttachInfo {
@@ -155,8 +165,23 @@ func (ci *cachedAttachInfo) RefreshIfNeeded(ctx context.Context) (bool, error) {
 		return false, errors.New("cachedAttachInfo is nil")
 	}
 
-	if ci.needsRefresh() {
-		return true, ci.refresh(ctx)
+	if !ci.needsRefresh() {
+		return false, nil
+	}
+
+	err := ci.refresh(ctx)
+	if err == nil || ci.lastResponse == nil {
+		return true, err
+	}
+
+	// Continue serving the previous attach info while the MS can't be
+	// reached, as it remains valid unless the system has been reconfigured.
+	if ci.staleSince.IsZero() {
+		ci.staleSince = time.Now()
+		if ci.log != nil {
+			ci.log.Noticef("serving cached attach info for system %q from %s: %s",
+				ci.system, common.FormatTime(ci.lastCached), err)
+		}
 	}
 	return false, nil
 }
@@ -173,6 +198,11 @@ func (ci *cachedAttachInfo) refresh(ctx context.Context) error {
 		return errors.Wrap(err, "refreshing cached attach info failed")
 	}
 
+	if !ci.staleSince.IsZero() && ci.log != nil {
+		ci.log.Noticef("refreshed attach info for system %q after serving cached data for %s",
+			ci.system, time.Since(ci.staleSince).Round(time.Second))
+	}
+	ci.staleSince = time.Time{}
 	ci.lastResponse = resp
 	ci.lastCached = time.Now()
 	return nil
@@ -289,13 +319,17 @@ type InfoCache struct {
 
 	getAttachInfoCb getAttachInfoFn
 	fabricScan      fabricScanFn
+	fabricSelector  *fabricSelector
+	accessPoints    *accessPointTracker
+	devFilter       *deviceFilter
 	netIfaces       func() ([]net.Interface, error)
 	devClassGetter  hardware.NetDevClassProvider
 	devStateGetter  hardware.NetDevStateProvider
 
 	client            control.UnaryInvoker
-	attachInfoRefresh time.Duration
 	providers         common.StringSet
+	cfgLock           sync.RWMutex // protects settings that may be reconfigured
+	attachInfoRefresh time.Duration
 	ignoreIfaces      common.StringSet
 }
 
@@ -310,6 +344,83 @@ func (c *InfoCache) AddProvider(prov string) {
 	c.providers.Add(prov)
 }
 
+// SetInterfaceLoadFunc sets the function used to get the number of connections
+// on a fabric interface for the least-connections selection policy.
+func (c *InfoCache) SetInterfaceLoadFunc(fn ifaceLoadFn) {
+	if c == nil {
+		return
+	}
+	c.fabricSelector.setLoadFn(fn)
+}
+
+// InterfaceConnected notifies the least-connections selection policy that a
+// client process assigned the fabric interface has connected to a pool.
+func (c *InfoCache) InterfaceConnected(iface string) {
+	if c == nil {
+		return
+	}
+	c.fabricSelector.connected(iface)
+}
+
+// MonitorFabricHealth starts periodically checking the health of the fabric
+// interfaces handed out to clients. Interfaces found to be down are skipped
+// until they recover.
+func (c *InfoCache) MonitorFabricHealth(ctx context.Context, interval time.Duration) {
+	if c == nil || c.fabricSelector == nil {
+		return
+	}
+	go c.fabricSelector.health.run(ctx, interval)
+}
+
+// MonitorAccessPoints starts periodically probing the access points for the
+// current MS leader and replicas. Attach info requests are sent to the leader
+// where possible, failing over to the other reachable hosts.
+func (c *InfoCache) MonitorAccessPoints(ctx context.Context, interval time.Duration) {
+	if c == nil || c.accessPoints == nil {
+		return
+	}
+	go c.accessPoints.run(ctx, c.client, interval)
+}
+
+// isFabricIfaceDown checks whether the health monitor has marked the fabric
+// interface down.
+func (c *InfoCache) isFabricIfaceDown(iface string) bool {
+	if c == nil {
+		return false
+	}
+	return c.fabricSelector.isDown(iface)
+}
+
+// Reconfigure applies the reloadable settings from an updated agent
+// configuration. Cached data is discarded so that it is fetched again using the
+// new settings.
+func (c *InfoCache) Reconfigure(ctx context.Context, cfg *Config) {
+	if c == nil || cfg == nil {
+		return
+	}
+
+	c.cfgLock.Lock()
+	c.ignoreIfaces = cfg.ExcludeFabricIfaces
+	c.devFilter = fabricDeviceFilter(cfg)
+	c.attachInfoRefresh = time.Duration(cfg.CacheExpiration)
+	c.cfgLock.Unlock()
+
+	c.fabricSelector.setPolicy(cfg.FabricIfaceSelect)
+	c.accessPoints.setAccessPoints(cfg.AccessPoints)
+
+	for _, key := range c.cache.Keys() {
+		if key == fabricKey || strings.HasPrefix(key, attachInfoKey) {
+			c.log.Debugf("discarding cached %s", key)
+			c.cache.Delete(key)
+		}
+	}
+
+	if len(cfg.FabricInterfaces) > 0 && c.IsFabricCacheEnabled() {
+		nf := NUMAFabricFromConfig(c.log, cfg.FabricInterfaces).WithSelector(c.fabricSelector)
+		c.EnableStaticFabricCache(ctx, nf)
+	}
+}
+
 // IsAttachInfoEnabled checks whether the GetAttachInfo cache is enabled.
 func (c *InfoCache) IsAttachInfoCacheEnabled() bool {
 	if c == nil {
@@ -331,7 +442,9 @@ func (c *InfoCache) EnableAttachInfoCache(interval time.Duration) {
 	if c == nil {
 		return
 	}
+	c.cfgLock.Lock()
 	c.attachInfoRefresh = interval
+	c.cfgLock.Unlock()
 	c.attachInfoCacheDisabled.Store(false)
 }
 
@@ -387,7 +500,13 @@ func (c *InfoCache) getAttachInfo(ctx context.Context, rpcClient control.UnaryIn
 		return nil, errors.New("getAttachInfoFn is nil")
 	}
 
-	resp, err := c.getAttachInfoCb(ctx, rpcClient, req)
+	var resp *control.GetAttachInfoResp
+	var err error
+	if c.accessPoints != nil {
+		resp, err = c.accessPoints.getAttachInfo(ctx, rpcClient, req, c.getAttachInfoCb)
+	} else {
+		resp, err = c.getAttachInfoCb(ctx, rpcClient, req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -430,7 +549,12 @@ func (c *InfoCache) GetAttachInfo(ctx context.Context, sys string) (*control.Get
 	}
 	createItem := func() (cache.Item, error) {
 		c.log.Debugf("cache miss for %s", sysAttachInfoKey(sys))
-		return newCachedAttachInfo(c.attachInfoRefresh, sys, c.client, c.getAttachInfo), nil
+		c.cfgLock.RLock()
+		refresh := c.attachInfoRefresh
+		c.cfgLock.RUnlock()
+		cai := newCachedAttachInfo(refresh, sys, c.client, c.getAttachInfo)
+		cai.log = c.log
+		return cai, nil
 	}
 
 	item, release, err := c.cache.GetOrCreate(ctx, sysAttachInfoKey(sys), createItem)
@@ -507,13 +631,28 @@ func (c *InfoCache) GetFabricDevice(ctx context.Context, params *FabricIfacePara
 	return nf.GetDevice(params)
 }
 
+// scanFabric scans the local fabric interfaces and applies the current
+// interface filter and selection policy to the result.
+func (c *InfoCache) scanFabric(ctx context.Context, providers ...string) (*NUMAFabric, error) {
+	nf, err := c.fabricScan(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cfgLock.RLock()
+	filter := c.devFilter
+	c.cfgLock.RUnlock()
+
+	return nf.WithDeviceFilter(filter).WithSelector(c.fabricSelector), nil
+}
+
 func (c *InfoCache) getNUMAFabric(ctx context.Context, netDevClass hardware.NetDevClass, providers ...string) (*NUMAFabric, error) {
 	if !c.IsFabricCacheEnabled() {
 		c.log.Debug("NUMAFabric not cached, rescanning")
 		if err := c.waitFabricReady(ctx, netDevClass); err != nil {
 			return nil, err
 		}
-		return c.fabricScan(ctx, providers...)
+		return c.scanFabric(ctx, providers...)
 	}
 
 	createItem := func() (cache.Item, error) {
@@ -521,7 +660,7 @@ func (c *InfoCache) getNUMAFabric(ctx context.Context, netDevClass hardware.NetD
 		if err := c.waitFabricReady(ctx, netDevClass); err != nil {
 			return nil, err
 		}
-		return newCachedFabricInfo(c.fabricScan, netDevClass, providers...), nil
+		return newCachedFabricInfo(c.scanFabric, netDevClass, providers...), nil
 	}
 
 	item, release, err := c.cache.GetOrCreate(ctx, fabricKey, createItem)
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -1615,3 +1616,46 @@ func TestAgent_InfoCache_waitFabricReady(t *testing.T) {
 		})
 	}
 }
+
+func TestAgent_cachedAttachInfo_RefreshIfNeeded_Stale(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	cachedResp := &control.GetAttachInfoResp{System: "cached"}
+	freshResp := &control.GetAttachInfoResp{System: "fresh"}
+
+	var fetchErr error
+	ai := newCachedAttachInfo(time.Second, "test", nil,
+		func(_ context.Context, _ control.UnaryInvoker, _ *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
+			if fetchErr != nil {
+				return nil, fetchErr
+			}
+			return freshResp, nil
+		})
+	ai.log = log
+
+	// With nothing cached, the error is returned.
+	fetchErr = errors.New("mock GetAttachInfo")
+	_, err := ai.RefreshIfNeeded(test.Context(t))
+	test.CmpErr(t, fetchErr, err)
+
+	// Once cached, stale data is served while the refresh fails.
+	ai.lastResponse = cachedResp
+	ai.lastCached = time.Now().Add(-time.Minute)
+	refreshed, err := ai.RefreshIfNeeded(test.Context(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertFalse(t, refreshed, "")
+	test.AssertEqual(t, cachedResp, ai.lastResponse, "")
+	test.AssertFalse(t, ai.staleSince.IsZero(), "stale time should be set")
+
+	fetchErr = nil
+	refreshed, err = ai.RefreshIfNeeded(test.Context(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, refreshed, "")
+	test.AssertEqual(t, freshResp, ai.lastResponse, "")
+	test.AssertTrue(t, ai.staleSince.IsZero(), "stale time should be cleared")
+}
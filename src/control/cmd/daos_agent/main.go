@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -40,6 +41,8 @@ type cliOptions struct {
 	DumpTopo      cmdutil.DumpTopologyCmd `command:"dump-topology" description:"Dump system topology"`
 	NetScan       netScanCmd              `command:"net-scan" description:"Perform local network fabric scan"`
 	Support       supportCmd              `command:"support" description:"Perform debug tasks to help support team"`
+	Client        clientCmd               `command:"client" description:"Inspect client processes monitored by the running agent"`
+	Config        agentConfigCmd          `command:"config" description:"Manage the configuration of the running agent"`
 }
 
 type (
@@ -70,6 +73,15 @@ func (cmd *configCmd) setConfig(cfg *Config) {
 	cmd.cfg = cfg
 }
 
+type (
+	// configLoaderFn reloads the agent configuration from its source.
+	configLoaderFn func() (*Config, error)
+
+	configLoader interface {
+		setConfigLoader(configLoaderFn)
+	}
+)
+
 func versionString() string {
 	return build.String(build.AgentName)
 }
@@ -203,15 +215,17 @@ func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *lo
 			suppCmd.setSupportConf(cfgPath)
 		}
 
+		if loaderCmd, ok := cmd.(configLoader); ok {
+			loaderCmd.setConfigLoader(func() (*Config, error) {
+				if cfgPath == "" {
+					return nil, errors.New("agent was started without a configuration file")
+				}
+				return loadConfig(log, opts, cfgPath)
+			})
+		}
+
 		if ctlCmd, ok := cmd.(ctlInvoker); ok {
-			// Generate a control config based on the loaded agent config.
-			ctlCfg := control.DefaultConfig()
-			ctlCfg.TransportConfig = cfg.TransportConfig
-			ctlCfg.HostList = cfg.AccessPoints
-			ctlCfg.SystemName = cfg.SystemName
-			ctlCfg.ControlPort = cfg.ControlPort
-
-			invoker.SetConfig(ctlCfg)
+			invoker.SetConfig(controlConfig(cfg))
 			ctlCmd.setInvoker(invoker)
 		}
 
@@ -228,6 +242,29 @@ func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *lo
 }
 
 func processConfig(log logging.Logger, cmd flags.Commander, opts *cliOptions, cfgPath string) (*Config, error) {
+	cfg, err := loadConfig(log, opts, cfgPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := configureLogging(log, cmd, cfg, opts); err != nil {
+		return nil, err
+	}
+
+	if cfgCmd, ok := cmd.(configSetter); ok {
+		cfgCmd.setConfig(cfg)
+	}
+
+	if cfgPath != "" {
+		log.Infof("loaded agent config from path: %s", cfgPath)
+	}
+
+	return cfg, nil
+}
+
+// loadConfig loads the agent configuration from the given path, or the default
+// configuration if no path is supplied, and applies the command-line overrides.
+func loadConfig(log logging.Logger, opts *cliOptions, cfgPath string) (*Config, error) {
 	cfg := DefaultConfig()
 	if cfgPath != "" {
 		var err error
@@ -245,10 +282,6 @@ func processConfig(log logging.Logger, cmd flags.Commander, opts *cliOptions, cf
 		cfg.LogLevel = common.ControlLogLevelTrace
 	}
 
-	if err := configureLogging(log, cmd, cfg, opts); err != nil {
-		return nil, err
-	}
-
 	if opts.RuntimeDir != "" {
 		log.Debugf("Overriding socket path from config file with %s", opts.RuntimeDir)
 		cfg.RuntimeDir = opts.RuntimeDir
@@ -268,15 +301,19 @@ func processConfig(log logging.Logger, cmd flags.Commander, opts *cliOptions, cf
 		return nil, errors.Wrap(err, "Failed to parse config access_points")
 	}
 
-	if cfgCmd, ok := cmd.(configSetter); ok {
-		cfgCmd.setConfig(cfg)
-	}
+	return cfg, nil
+}
 
-	if cfgPath != "" {
-		log.Infof("loaded agent config from path: %s", cfgPath)
-	}
+// controlConfig generates a control API client configuration based on the
+// agent configuration.
+func controlConfig(cfg *Config) *control.Config {
+	ctlCfg := control.DefaultConfig()
+	ctlCfg.TransportConfig = cfg.TransportConfig
+	ctlCfg.HostList = cfg.AccessPoints
+	ctlCfg.SystemName = cfg.SystemName
+	ctlCfg.ControlPort = cfg.ControlPort
 
-	return cfg, nil
+	return ctlCfg
 }
 
 func configureLogging(log logging.Logger, cmd flags.Commander, cfg *Config, opts *cliOptions) error {
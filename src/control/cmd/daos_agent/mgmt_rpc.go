@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -80,6 +81,8 @@ func (mod *mgmtModule) HandleCall(ctx context.Context, session *drpc.Session, me
 		return nil, mod.handleNotifyPoolConnect(ctx, req, cred.Pid)
 	case drpc.MethodNotifyPoolDisconnect:
 		return nil, mod.handleNotifyPoolDisconnect(ctx, req, cred.Pid)
+	case drpc.MethodGetACLTemplate:
+		return mod.handleGetACLTemplate(ctx, req)
 	case drpc.MethodNotifyExit:
 		// There isn't anything we can do here if this fails so just
 		// call the disconnect handler and return success.
@@ -155,11 +158,45 @@ func (mod *mgmtModule) handleGetAttachInfo(ctx context.Context, reqb []byte, pid
 		mod.log.Infof("%s: numa:%d iface:%s dom:%s prov:%s srx:%d", client, numaNode,
 			resp.ClientNetHint.Interface, resp.ClientNetHint.Domain,
 			resp.ClientNetHint.Provider, resp.ClientNetHint.SrvSrxSet)
+		mod.monitor.RecordFabricInterface(ctx, pid, resp.ClientNetHint.Interface,
+			resp.ClientNetHint.Domain)
 	}
 	mod.log.Tracef("%s: %s", client, pblog.Debug(resp))
 	return proto.Marshal(resp)
 }
 
+// handleGetACLTemplate looks up a named ACL template in the system attributes
+// held by the MS on behalf of a client, which is not able to query the MS
+// itself.
+func (mod *mgmtModule) handleGetACLTemplate(ctx context.Context, reqb []byte) ([]byte, error) {
+	pbReq := new(mgmtpb.GetACLTemplateReq)
+	if err := proto.Unmarshal(reqb, pbReq); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	resp := new(mgmtpb.GetACLTemplateResp)
+	if pbReq.Sys != "" && pbReq.Sys != mod.sys {
+		mod.log.Errorf("%s: unknown system name", pbReq.Sys)
+		resp.Status = int32(daos.InvalidInput)
+		return proto.Marshal(resp)
+	}
+
+	acl, err := control.GetACLTemplate(ctx, mod.ctlInvoker, pbReq.Name)
+	switch {
+	case control.IsACLTemplateNotFound(err):
+		resp.Status = int32(daos.Nonexistent)
+	case control.IsMSConnectionFailure(err):
+		resp.Status = int32(daos.Unreachable)
+	case err != nil:
+		mod.log.Errorf("failed to get ACL template %q: %s", pbReq.Name, err)
+		return nil, err
+	default:
+		resp.Entries = acl.Entries
+	}
+
+	return proto.Marshal(resp)
+}
+
 func (mod *mgmtModule) getNUMANode(ctx context.Context, pid int32) (uint, error) {
 	if mod.useDefaultNUMA.IsTrue() {
 		return 0, nil
@@ -350,6 +387,10 @@ func (mod *mgmtModule) populateNUMAFabricMap(ctx context.Context, resp *mgmtpb.G
 		if exists {
 			pbFIs.Ifaces = make([]*mgmtpb.FabricInterface, 0, len(fis))
 			for _, fi := range fis {
+				if mod.cache.isFabricIfaceDown(fi.Name) {
+					mod.log.Tracef("device %s: excluded from NUMA fabric map (interface is down)", fi)
+					continue
+				}
 				if fi.HasProvider(resp.ClientNetHint.Provider) || fi.NetDevClass == FabricDevClassManual {
 					pbFIs.Ifaces = append(pbFIs.Ifaces, &mgmtpb.FabricInterface{
 						NumaNode:  uint32(numaNode),
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -678,3 +679,91 @@ func TestAgent_handleSetupClientTelemetry(t *testing.T) {
 		})
 	}
 }
+
+func TestAgent_mgmtModule_handleGetACLTemplate(t *testing.T) {
+	testSys := "test_sys"
+	attrResp := &mgmtpb.SystemGetAttrResp{
+		Attributes: map[string]string{
+			control.ACLTemplateAttrPrefix + "ro": "A::OWNER@:r,A:G:GROUP@:r",
+		},
+	}
+	reqBytes := func(req *mgmtpb.GetACLTemplateReq) []byte {
+		t.Helper()
+		bytes, err := proto.Marshal(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bytes
+	}
+
+	for name, tc := range map[string]struct {
+		reqBytes []byte
+		mic      *control.MockInvokerConfig
+		expResp  *mgmtpb.GetACLTemplateResp
+		expErr   error
+	}{
+		"garbage request": {
+			reqBytes: []byte("invalid"),
+			expErr:   drpc.UnmarshalingPayloadFailure(),
+		},
+		"wrong system": {
+			reqBytes: reqBytes(&mgmtpb.GetACLTemplateReq{Sys: "bad", Name: "ro"}),
+			expResp:  &mgmtpb.GetACLTemplateResp{Status: int32(daos.InvalidInput)},
+		},
+		"not found": {
+			reqBytes: reqBytes(&mgmtpb.GetACLTemplateReq{Name: "missing"}),
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("", nil, attrResp),
+			},
+			expResp: &mgmtpb.GetACLTemplateResp{Status: int32(daos.Nonexistent)},
+		},
+		"MS connection error": {
+			reqBytes: reqBytes(&mgmtpb.GetACLTemplateReq{Name: "ro"}),
+			mic: &control.MockInvokerConfig{
+				UnaryError: errors.Errorf("unable to contact the %s", build.ManagementServiceName),
+			},
+			expResp: &mgmtpb.GetACLTemplateResp{Status: int32(daos.Unreachable)},
+		},
+		"request fails": {
+			reqBytes: reqBytes(&mgmtpb.GetACLTemplateReq{Name: "ro"}),
+			mic: &control.MockInvokerConfig{
+				UnaryError: errors.New("remote failed"),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			reqBytes: reqBytes(&mgmtpb.GetACLTemplateReq{Sys: testSys, Name: "ro"}),
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("", nil, attrResp),
+			},
+			expResp: &mgmtpb.GetACLTemplateResp{
+				Entries: []string{"A::OWNER@:r", "A:G:GROUP@:r"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mod := &mgmtModule{
+				log:        log,
+				sys:        testSys,
+				ctlInvoker: control.NewMockInvoker(log, tc.mic),
+			}
+
+			respBytes, err := mod.handleGetACLTemplate(test.Context(t), tc.reqBytes)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			resp := new(mgmtpb.GetACLTemplateResp)
+			if err := proto.Unmarshal(respBytes, resp); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expResp, resp, protocmp.Transform()); diff != "" {
+				t.Fatalf("want-, got+:\n%s", diff)
+			}
+		})
+	}
+}
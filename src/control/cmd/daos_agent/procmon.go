@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,13 +10,17 @@ package main
 import (
 	"context"
 	"fmt"
-	"os"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/daos-stack/daos/src/control/common"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security/auth"
 )
@@ -23,8 +28,15 @@ import (
 const (
 	// Agent-internal methods not linked to engine handlers.
 	flushAllHandles drpc.MgmtMethod = drpc.MgmtMethod(^uint32(0) >> 1)
+	recordAttach    drpc.MgmtMethod = flushAllHandles - 1
+	listClients     drpc.MgmtMethod = flushAllHandles - 2
+	evictClient     drpc.MgmtMethod = flushAllHandles - 3
 )
 
+// attachPruneInterval is the interval at which attach info recorded for
+// processes that have since exited is discarded.
+const attachPruneInterval = time.Minute
+
 // dbgId returns a truncated representation of the UUID string.
 func dbgId(uuidStr string) string {
 	return uuidStr[:8]
@@ -39,15 +51,41 @@ type procMonRequest struct {
 	poolUUID string
 	// The UUID of the pool handle associated with this request
 	poolHandleUUID string
+	// The job ID supplied by the process on pool connect
+	jobID string
+	// The fabric interface and domain selected for the process
+	iface  string
+	domain string
 	// If the request should be blocking, the caller should
 	// supply a channel to be closed when the request is
 	// complete.
 	doneChan chan struct{}
+	// If the request returns a result, the caller should supply
+	// a channel on which the result will be sent.
+	resultChan chan *procMonResult
+}
+
+// procAttachInfo records the fabric interface selected for a process that
+// requested attach info, so that it can be applied when the process is
+// monitored.
+type procAttachInfo struct {
+	startTime uint64
+	iface     string
+	domain    string
+	connected bool // the process has connected using the interface
+}
+
+type procMonResult struct {
+	clients []*mgmtpb.ClientProcess
+	evicted uint32
+	err     error
 }
 
 type procMonResponse struct {
 	// Pid the response is coming from
 	pid int32
+	// Start time of the process, used to detect pid reuse
+	startTime uint64
 	// Error indicating why the process "died"
 	err error
 }
@@ -62,54 +100,44 @@ func (phm poolHandleMap) add(poolUUID, handleUUID string) {
 }
 
 type procInfo struct {
-	log       logging.Logger
 	pid       int32
+	startTime uint64
 	name      string
-	cancelCtx func()
-	response  chan *procMonResponse
+	jobID     string
+	iface     string
+	domain    string
 	handles   poolHandleMap
 }
 
-func checkProcPidExists(pid int32) error {
-	pidPath := fmt.Sprintf("/proc/%d", pid)
-	_, err := os.Stat(pidPath)
-	return err
+func (p *procInfo) numHandles() (count int) {
+	for _, handles := range p.handles {
+		count += len(handles)
+	}
+	return
 }
 
-func (p *procInfo) sendResponse(ctx context.Context, pid int32, err error) {
-	response := &procMonResponse{
-		pid: pid,
-		err: err,
+func (p *procInfo) toClientProcess() *mgmtpb.ClientProcess {
+	cp := &mgmtpb.ClientProcess{
+		Pid:       p.pid,
+		Name:      p.name,
+		Jobid:     p.jobID,
+		Interface: p.iface,
+		Domain:    p.domain,
 	}
-	select {
-	case <-ctx.Done():
-	case p.response <- response:
+	if startTime, err := common.ProcStartTimeToTime(p.startTime); err == nil {
+		cp.StartTime = uint64(startTime.Unix())
 	}
-}
-
-const MonWaitTime = 3 * time.Second
-
-// monitorProcess is used by procMon to kick off monitoring individual processes
-// under their own child context to allow for terminating individual monitoring routines.
-func (p *procInfo) monitorProcess(ctx context.Context) {
-	monTicker := time.NewTicker(MonWaitTime)
-	defer monTicker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-monTicker.C:
-			if err := checkProcPidExists(p.pid); err != nil {
-				if os.IsNotExist(err) {
-					p.sendResponse(ctx, p.pid, fmt.Errorf("%s terminated unexpectedly", p))
-				} else {
-					p.sendResponse(ctx, p.pid, err)
-				}
-				return
-			}
-		}
+	for poolUUID, handles := range p.handles {
+		cp.Pools = append(cp.Pools, &mgmtpb.ClientPoolHandles{
+			PoolUuid: poolUUID,
+			Handles:  handles.ToSlice(),
+		})
 	}
+	sort.Slice(cp.Pools, func(i, j int) bool {
+		return cp.Pools[i].PoolUuid < cp.Pools[j].PoolUuid
+	})
+
+	return cp
 }
 
 func (p *procInfo) String() string {
@@ -124,24 +152,36 @@ func (p *procInfo) String() string {
 // monitor and disconnect processes. Once created it is started by passing a
 // context into the startMonitoring call.
 type procMon struct {
-	log        logging.Logger
-	procs      map[int32]*procInfo
-	request    chan *procMonRequest
-	response   chan *procMonResponse
-	ctlInvoker control.Invoker
-	systemName string
+	log          logging.Logger
+	procs        map[int32]*procInfo
+	attached     map[int32]*procAttachInfo
+	request      chan *procMonRequest
+	response     chan *procMonResponse
+	watcher      procWatcher
+	getStartTime procStartTimeFn
+	ctlInvoker   control.Invoker
+	systemName   string
+	onConnect    ifaceConnectFn
+
+	ifaceLoadLock sync.RWMutex
+	ifaceLoad     map[string]int
 }
 
 // NewProcMon creates a new process monitor struct setting initializing the
-// internal process map and the request channel.
+// internal process map and the request channel. Process exits are detected
+// with pidfds where supported by the kernel, or by polling otherwise.
 func NewProcMon(logger logging.Logger, ctlInvoker control.Invoker, systemName string) *procMon {
+	response := make(chan *procMonResponse)
 	return &procMon{
-		log:        logger,
-		procs:      make(map[int32]*procInfo),
-		request:    make(chan *procMonRequest),
-		response:   make(chan *procMonResponse),
-		ctlInvoker: ctlInvoker,
-		systemName: systemName,
+		log:          logger,
+		procs:        make(map[int32]*procInfo),
+		attached:     make(map[int32]*procAttachInfo),
+		request:      make(chan *procMonRequest),
+		response:     response,
+		watcher:      newProcWatcher(logger, response),
+		getStartTime: getProcStartTime,
+		ctlInvoker:   ctlInvoker,
+		systemName:   systemName,
 	}
 }
 
@@ -151,6 +191,7 @@ func (p *procMon) AddPoolHandle(ctx context.Context, Pid int32, poolReq *mgmtpb.
 		action:         drpc.MethodNotifyPoolConnect,
 		poolUUID:       poolReq.PoolUUID,
 		poolHandleUUID: poolReq.PoolHandleUUID,
+		jobID:          poolReq.Jobid,
 	}
 	p.submitRequest(ctx, req)
 }
@@ -192,6 +233,69 @@ func (p *procMon) FlushAllHandles(ctx context.Context) {
 	<-done
 }
 
+// SetInterfaceConnectFunc sets the function called when a process connects to
+// a pool using the fabric interface recorded for it. It must be set before
+// monitoring is started.
+func (p *procMon) SetInterfaceConnectFunc(fn ifaceConnectFn) {
+	if p == nil {
+		return
+	}
+	p.onConnect = fn
+}
+
+// RecordFabricInterface records the fabric interface and domain selected for
+// the process. The process is not monitored until it connects to a pool.
+func (p *procMon) RecordFabricInterface(ctx context.Context, Pid int32, iface, domain string) {
+	if p == nil {
+		return
+	}
+
+	p.submitRequest(ctx, &procMonRequest{
+		pid:    Pid,
+		action: recordAttach,
+		iface:  iface,
+		domain: domain,
+	})
+}
+
+// ListClients returns the details of all monitored client processes.
+func (p *procMon) ListClients(ctx context.Context) ([]*mgmtpb.ClientProcess, error) {
+	result, err := p.submitResultRequest(ctx, &procMonRequest{
+		action: listClients,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.clients, nil
+}
+
+// EvictClient evicts all open pool handles for the client process and stops
+// monitoring it, returning the number of handles evicted.
+func (p *procMon) EvictClient(ctx context.Context, Pid int32) (uint32, error) {
+	result, err := p.submitResultRequest(ctx, &procMonRequest{
+		pid:    Pid,
+		action: evictClient,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return result.evicted, result.err
+}
+
+func (p *procMon) submitResultRequest(ctx context.Context, request *procMonRequest) (*procMonResult, error) {
+	request.resultChan = make(chan *procMonResult, 1)
+	p.submitRequest(ctx, request)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-request.resultChan:
+		return result, nil
+	}
+}
+
 func (p *procMon) submitRequest(ctx context.Context, request *procMonRequest) {
 	select {
 	case <-ctx.Done():
@@ -199,33 +303,137 @@ func (p *procMon) submitRequest(ctx context.Context, request *procMonRequest) {
 	}
 }
 
-func (p *procMon) handleNotifyPoolConnect(ctx context.Context, request *procMonRequest) {
-	info, found := p.procs[request.pid]
-	if !found {
-		procName, err := common.GetProcName(int(request.pid))
-		if err != nil {
-			p.log.Errorf("failed to get process name for pid %d: %s", request.pid, err)
-			return
-		}
+// getProcInfo returns the monitored process info for the requested pid, adding
+// it and starting to monitor the process if it is not already monitored.
+func (p *procMon) getProcInfo(request *procMonRequest) (*procInfo, error) {
+	if info, found := p.procs[request.pid]; found {
+		return info, nil
+	}
 
-		child, cancel := context.WithCancel(ctx)
-		info = &procInfo{
-			log:       p.log,
-			pid:       request.pid,
-			name:      procName,
-			cancelCtx: cancel,
-			response:  p.response,
-			handles:   make(poolHandleMap),
-		}
+	procName, err := common.GetProcName(int(request.pid))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get process name for pid %d", request.pid)
+	}
+	startTime, err := p.getStartTime(request.pid)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get process start time for pid %d", request.pid)
+	}
+
+	info := &procInfo{
+		pid:       request.pid,
+		startTime: startTime,
+		name:      procName,
+		handles:   make(poolHandleMap),
+	}
+	attach, found := p.attached[request.pid]
+	if found && attach.startTime == startTime {
+		info.iface = attach.iface
+		info.domain = attach.domain
+	}
+	if err := p.watcher.watch(info.pid, info.startTime); err != nil {
+		return nil, errors.Wrapf(err, "%s: failed to monitor process", info)
+	}
+	p.procs[request.pid] = info
+	if info.iface != "" {
+		p.interfaceConnected(attach)
+	}
+
+	return info, nil
+}
 
-		p.procs[request.pid] = info
-		go info.monitorProcess(child)
+func (p *procMon) handleNotifyPoolConnect(ctx context.Context, request *procMonRequest) {
+	info, err := p.getProcInfo(request)
+	if err != nil {
+		// The process may already have exited, in which case the new
+		// handle is leaked and must be cleaned up now.
+		p.log.Error(err.Error())
+		info = &procInfo{pid: request.pid, handles: make(poolHandleMap)}
+		info.handles.add(request.poolUUID, request.poolHandleUUID)
+		p.cleanupLeakedHandles(ctx, info)
+		return
 	}
 
+	if request.jobID != "" {
+		info.jobID = request.jobID
+	}
 	p.log.Debugf("%s, connect %s/%s", info, dbgId(request.poolUUID), dbgId(request.poolHandleUUID))
 	info.handles.add(request.poolUUID, request.poolHandleUUID)
 }
 
+func (p *procMon) handleRecordAttach(request *procMonRequest) {
+	startTime, err := p.getStartTime(request.pid)
+	if err != nil {
+		p.log.Debugf("failed to get process start time for pid %d: %s", request.pid, err)
+		return
+	}
+
+	attach := &procAttachInfo{
+		startTime: startTime,
+		iface:     request.iface,
+		domain:    request.domain,
+	}
+	p.attached[request.pid] = attach
+
+	if info, found := p.procs[request.pid]; found && info.startTime == startTime {
+		info.iface = request.iface
+		info.domain = request.domain
+		p.interfaceConnected(attach)
+	}
+}
+
+// interfaceConnected notifies the selector the first time that the process
+// connects using the interface, as it is then included in the interface load
+// and is no longer pending.
+func (p *procMon) interfaceConnected(attach *procAttachInfo) {
+	if attach.iface == "" || attach.connected {
+		return
+	}
+	attach.connected = true
+	if p.onConnect != nil {
+		p.onConnect(attach.iface)
+	}
+}
+
+// pruneAttachInfo removes the recorded attach info of processes that have
+// exited since they requested it.
+func (p *procMon) pruneAttachInfo() {
+	for pid, attach := range p.attached {
+		if startTime, err := p.getStartTime(pid); err != nil || startTime != attach.startTime {
+			delete(p.attached, pid)
+		}
+	}
+}
+
+func (p *procMon) handleListClients() *procMonResult {
+	result := &procMonResult{
+		clients: make([]*mgmtpb.ClientProcess, 0, len(p.procs)),
+	}
+	for _, info := range p.procs {
+		result.clients = append(result.clients, info.toClientProcess())
+	}
+	sort.Slice(result.clients, func(i, j int) bool {
+		return result.clients[i].Pid < result.clients[j].Pid
+	})
+
+	return result
+}
+
+func (p *procMon) handleEvictClient(ctx context.Context, request *procMonRequest) *procMonResult {
+	info, found := p.procs[request.pid]
+	if !found {
+		return &procMonResult{
+			err: errors.Wrapf(daos.Nonexistent, "pid:%d is not a monitored client process", request.pid),
+		}
+	}
+
+	p.log.Noticef("%s: evicting client process handles on request", info)
+	evicted := uint32(info.numHandles())
+	p.watcher.unwatch(info.pid)
+	p.cleanupLeakedHandles(ctx, info)
+
+	return &procMonResult{evicted: evicted}
+}
+
 func (p *procMon) handleNotifyPoolDisconnect(request *procMonRequest) {
 	info, found := p.procs[request.pid]
 	if !found || len(info.handles) == 0 {
@@ -240,7 +448,7 @@ func (p *procMon) handleNotifyPoolDisconnect(request *procMonRequest) {
 			delete(info.handles, request.poolUUID)
 		}
 		if len(info.handles) == 0 {
-			info.cancelCtx()
+			p.watcher.unwatch(info.pid)
 			delete(p.procs, info.pid)
 		}
 	}
@@ -285,9 +493,10 @@ func (p *procMon) cleanupLeakedHandles(ctx context.Context, info *procInfo) {
 func (p *procMon) handleNotifyExit(ctx context.Context, request *procMonRequest) {
 	info, found := p.procs[request.pid]
 	if found {
-		info.cancelCtx()
+		p.watcher.unwatch(info.pid)
 		p.cleanupLeakedHandles(ctx, info)
 	}
+	delete(p.attached, request.pid)
 }
 
 func (p *procMon) flushAllHandles(ctx context.Context) {
@@ -303,6 +512,7 @@ func (p *procMon) flushAllHandles(ctx context.Context) {
 
 		// NB: This is best-effort cleanup, so if something fails we can't
 		// retry it.
+		p.watcher.unwatch(info.pid)
 		delete(p.procs, info.pid)
 	}
 
@@ -310,10 +520,15 @@ func (p *procMon) flushAllHandles(ctx context.Context) {
 }
 
 func (p *procMon) handleRequests(ctx context.Context) {
+	pruneTicker := time.NewTicker(attachPruneInterval)
+	defer pruneTicker.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-pruneTicker.C:
+			p.pruneAttachInfo()
 		case request := <-p.request:
 			switch request.action {
 			case drpc.MethodNotifyPoolConnect:
@@ -324,28 +539,73 @@ func (p *procMon) handleRequests(ctx context.Context) {
 				p.handleNotifyExit(ctx, request)
 			case flushAllHandles:
 				p.flushAllHandles(ctx)
+			case recordAttach:
+				p.handleRecordAttach(request)
+			case listClients:
+				request.resultChan <- p.handleListClients()
+			case evictClient:
+				request.resultChan <- p.handleEvictClient(ctx, request)
 			default:
 				p.log.Errorf("failed to handle request with invalid action type %s", request.action)
 			}
 
+			p.updateInterfaceLoad()
+
 			if request.doneChan != nil {
 				close(request.doneChan)
 			}
 		case resp := <-p.response:
+			// Ignore exits of an earlier process that had the same pid.
 			info, found := p.procs[resp.pid]
-			if found {
+			if found && info.startTime == resp.startTime {
+				p.log.Debugf("%s: %s", info, resp.err)
 				p.cleanupLeakedHandles(ctx, info)
+				delete(p.attached, resp.pid)
+				p.updateInterfaceLoad()
 			}
 		}
 	}
 }
 
+// updateInterfaceLoad recalculates the number of connections on each fabric
+// interface from the monitored processes. Each process counts as a connection
+// on the interface assigned to it, as does each of its open pool handles.
+func (p *procMon) updateInterfaceLoad() {
+	load := make(map[string]int)
+	for _, info := range p.procs {
+		if info.iface == "" {
+			continue
+		}
+		load[info.iface] += 1 + info.numHandles()
+	}
+
+	p.ifaceLoadLock.Lock()
+	defer p.ifaceLoadLock.Unlock()
+
+	p.ifaceLoad = load
+}
+
+// InterfaceLoad returns the number of connections from monitored client
+// processes using the fabric interface.
+func (p *procMon) InterfaceLoad(iface string) int {
+	if p == nil {
+		return 0
+	}
+
+	p.ifaceLoadLock.RLock()
+	defer p.ifaceLoadLock.RUnlock()
+
+	return p.ifaceLoad[iface]
+}
+
 // startMonitoring is the main driver which starts the process monitor. The
 // passed in context is used to terminate all monitoring in the event of shutdown.
 func (p *procMon) startMonitoring(ctx context.Context, cleanOnStart bool) {
 	if cleanOnStart {
 		p.cleanupServerHandles(ctx)
 	}
+	p.log.Debugf("using %s process watcher", p.watcher)
+	go p.watcher.run(ctx)
 	go p.handleRequests(ctx)
 }
 
@@ -0,0 +1,217 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_procMon_clients(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx := test.Context(t)
+	invoker := control.NewMockInvoker(log, &control.MockInvokerConfig{
+		UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.PoolEvictResp{}),
+	})
+	pm := NewProcMon(log, invoker, "daos_server")
+	pw := newPollWatcher(log, pm.response)
+	pm.watcher = pw
+	pm.getStartTime = func(int32) (uint64, error) {
+		return 42, nil
+	}
+	var connected []string
+	pm.SetInterfaceConnectFunc(func(iface string) {
+		connected = append(connected, iface)
+	})
+
+	// Use the test process so that its name can be resolved.
+	pid := int32(os.Getpid())
+	poolUUID := test.MockUUID(1)
+	handleUUID := test.MockUUID(2)
+	procName, err := common.GetProcName(int(pid))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmpOpts := []cmp.Option{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(&mgmtpb.ClientProcess{}, "start_time"),
+	}
+	checkClients := func(t *testing.T, expClients []*mgmtpb.ClientProcess) {
+		t.Helper()
+
+		result := pm.handleListClients()
+		if diff := cmp.Diff(expClients, result.clients, cmpOpts...); diff != "" {
+			t.Fatalf("unexpected clients (-want, +got):\n%s\n", diff)
+		}
+	}
+
+	// Requesting attach info does not start monitoring the process.
+	pm.handleRecordAttach(&procMonRequest{
+		pid:    pid,
+		action: recordAttach,
+		iface:  "eth0",
+		domain: "eth0",
+	})
+	checkClients(t, []*mgmtpb.ClientProcess{})
+	test.AssertEqual(t, 0, len(pw.procs), "unexpected watched processes")
+
+	pm.handleNotifyPoolConnect(ctx, &procMonRequest{
+		pid:            pid,
+		action:         drpc.MethodNotifyPoolConnect,
+		poolUUID:       poolUUID,
+		poolHandleUUID: handleUUID,
+		jobID:          "job1",
+	})
+	checkClients(t, []*mgmtpb.ClientProcess{
+		{
+			Pid:       pid,
+			Name:      procName,
+			Jobid:     "job1",
+			Interface: "eth0",
+			Domain:    "eth0",
+			Pools: []*mgmtpb.ClientPoolHandles{
+				{PoolUuid: poolUUID, Handles: []string{handleUUID}},
+			},
+		},
+	})
+
+	test.AssertEqual(t, []string{"eth0"}, connected, "unexpected interface connections")
+
+	pm.updateInterfaceLoad()
+	test.AssertEqual(t, 2, pm.InterfaceLoad("eth0"), "unexpected interface load")
+	test.AssertEqual(t, 0, pm.InterfaceLoad("eth1"), "unexpected interface load")
+
+	// The process is no longer monitored after its last handle is disconnected.
+	pm.handleNotifyPoolDisconnect(&procMonRequest{
+		pid:            pid,
+		action:         drpc.MethodNotifyPoolDisconnect,
+		poolUUID:       poolUUID,
+		poolHandleUUID: handleUUID,
+	})
+	checkClients(t, []*mgmtpb.ClientProcess{})
+	test.AssertEqual(t, 0, len(pw.procs), "unexpected watched processes")
+
+	pm.updateInterfaceLoad()
+	test.AssertEqual(t, 0, pm.InterfaceLoad("eth0"), "unexpected interface load")
+
+	// The recorded interface is applied when the process connects again.
+	pm.handleNotifyPoolConnect(ctx, &procMonRequest{
+		pid:            pid,
+		action:         drpc.MethodNotifyPoolConnect,
+		poolUUID:       poolUUID,
+		poolHandleUUID: handleUUID,
+	})
+	checkClients(t, []*mgmtpb.ClientProcess{
+		{
+			Pid:       pid,
+			Name:      procName,
+			Interface: "eth0",
+			Domain:    "eth0",
+			Pools: []*mgmtpb.ClientPoolHandles{
+				{PoolUuid: poolUUID, Handles: []string{handleUUID}},
+			},
+		},
+	})
+	// Only the first connection using the interface was pending.
+	test.AssertEqual(t, []string{"eth0"}, connected, "unexpected interface connections")
+
+	result := pm.handleEvictClient(ctx, &procMonRequest{pid: pid, action: evictClient})
+	if result.err != nil {
+		t.Fatal(result.err)
+	}
+	test.AssertEqual(t, uint32(1), result.evicted, "unexpected evicted count")
+	test.AssertEqual(t, 1, invoker.GetInvokeCount(), "unexpected invoke count")
+	test.AssertEqual(t, 0, len(pw.procs), "unexpected watched processes")
+	checkClients(t, []*mgmtpb.ClientProcess{})
+	pm.updateInterfaceLoad()
+	test.AssertEqual(t, 0, pm.InterfaceLoad("eth0"), "unexpected interface load")
+
+	result = pm.handleEvictClient(ctx, &procMonRequest{pid: pid, action: evictClient})
+	if !errors.Is(result.err, daos.Nonexistent) {
+		t.Fatalf("expected %s, got %v", daos.Nonexistent, result.err)
+	}
+}
+
+func TestAgent_procMon_exitedProcess(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx := test.Context(t)
+	invoker := control.NewMockInvoker(log, &control.MockInvokerConfig{
+		UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.PoolEvictResp{}),
+	})
+	pm := NewProcMon(log, invoker, "daos_server")
+	pm.watcher = newPollWatcher(log, pm.response)
+	pm.getStartTime = func(int32) (uint64, error) {
+		return 0, os.ErrNotExist
+	}
+
+	// A handle from a process that has already exited is evicted immediately.
+	pm.handleNotifyPoolConnect(ctx, &procMonRequest{
+		pid:            int32(os.Getpid()),
+		action:         drpc.MethodNotifyPoolConnect,
+		poolUUID:       test.MockUUID(1),
+		poolHandleUUID: test.MockUUID(2),
+	})
+	test.AssertEqual(t, 1, invoker.GetInvokeCount(), "unexpected invoke count")
+	test.AssertEqual(t, 0, len(pm.procs), "unexpected monitored processes")
+}
+
+func TestAgent_procMon_pruneAttachInfo(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	pm := NewProcMon(log, nil, "daos_server")
+	startTimes := map[int32]uint64{
+		1: 42,
+		2: 42,
+	}
+	pm.getStartTime = func(pid int32) (uint64, error) {
+		startTime, found := startTimes[pid]
+		if !found {
+			return 0, os.ErrNotExist
+		}
+		return startTime, nil
+	}
+
+	for pid := range startTimes {
+		pm.handleRecordAttach(&procMonRequest{pid: pid, action: recordAttach, iface: "eth0"})
+	}
+	test.AssertEqual(t, 2, len(pm.attached), "unexpected attached processes")
+
+	// Recording attach info does not check the other recorded processes.
+	delete(startTimes, 1)
+	startTimes[2] = 43
+	pm.handleRecordAttach(&procMonRequest{pid: 3, action: recordAttach, iface: "eth0"})
+	test.AssertEqual(t, 2, len(pm.attached), "unexpected attached processes")
+
+	startTimes[3] = 42
+	pm.handleRecordAttach(&procMonRequest{pid: 3, action: recordAttach, iface: "eth0"})
+	test.AssertEqual(t, 3, len(pm.attached), "unexpected attached processes")
+
+	// Exited and reused pids are discarded when pruned.
+	pm.pruneAttachInfo()
+	test.AssertEqual(t, 1, len(pm.attached), "unexpected attached processes")
+	if _, found := pm.attached[3]; !found {
+		t.Fatal("expected attach info for pid:3 to be retained")
+	}
+}
@@ -0,0 +1,153 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// MonWaitTime is the interval between checks of watched processes when the
+// polling process watcher is in use.
+const MonWaitTime = 3 * time.Second
+
+// procWatcher is implemented by process exit detection backends. A single
+// watcher tracks all monitored processes and reports exits on the response
+// channel supplied when it was created.
+type procWatcher interface {
+	// watch starts monitoring the process identified by pid and start time.
+	watch(pid int32, startTime uint64) error
+	// unwatch stops monitoring the process.
+	unwatch(pid int32)
+	// run processes events until the context is canceled.
+	run(ctx context.Context)
+	// String returns the name of the watcher backend.
+	String() string
+}
+
+type procStartTimeFn func(pid int32) (uint64, error)
+
+func getProcStartTime(pid int32) (uint64, error) {
+	return common.GetProcStartTime(int(pid))
+}
+
+// checkProcAlive verifies that the process identified by pid and start time is
+// still running, returning an error describing why it is not.
+func checkProcAlive(getStartTime procStartTimeFn, pid int32, startTime uint64) error {
+	curStartTime, err := getStartTime(pid)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return errors.Errorf("pid:%d terminated unexpectedly", pid)
+		}
+		return err
+	}
+	if curStartTime != startTime {
+		return errors.Errorf("pid:%d terminated unexpectedly (pid reused)", pid)
+	}
+
+	return nil
+}
+
+// newProcWatcher returns a pidfd-based process watcher if supported by the
+// kernel, falling back to the polling watcher otherwise.
+func newProcWatcher(log logging.Logger, response chan *procMonResponse) procWatcher {
+	pw, err := newPidfdWatcher(log, response)
+	if err == nil {
+		return pw
+	}
+	log.Debugf("pidfd process watcher unavailable, falling back to polling: %s", err)
+
+	return newPollWatcher(log, response)
+}
+
+// pollWatcher detects process exits by checking all watched processes at a
+// fixed interval from a single goroutine.
+type pollWatcher struct {
+	sync.Mutex
+	log          logging.Logger
+	interval     time.Duration
+	getStartTime procStartTimeFn
+	procs        map[int32]uint64
+	response     chan *procMonResponse
+}
+
+func newPollWatcher(log logging.Logger, response chan *procMonResponse) *pollWatcher {
+	return &pollWatcher{
+		log:          log,
+		interval:     MonWaitTime,
+		getStartTime: getProcStartTime,
+		procs:        make(map[int32]uint64),
+		response:     response,
+	}
+}
+
+func (pw *pollWatcher) String() string {
+	return "polling"
+}
+
+func (pw *pollWatcher) watch(pid int32, startTime uint64) error {
+	pw.Lock()
+	defer pw.Unlock()
+
+	pw.procs[pid] = startTime
+	return nil
+}
+
+func (pw *pollWatcher) unwatch(pid int32) {
+	pw.Lock()
+	defer pw.Unlock()
+
+	delete(pw.procs, pid)
+}
+
+// check returns responses for all watched processes that have exited and
+// stops watching them.
+func (pw *pollWatcher) check() []*procMonResponse {
+	pw.Lock()
+	defer pw.Unlock()
+
+	var exited []*procMonResponse
+	for pid, startTime := range pw.procs {
+		if err := checkProcAlive(pw.getStartTime, pid, startTime); err != nil {
+			exited = append(exited, &procMonResponse{
+				pid:       pid,
+				startTime: startTime,
+				err:       err,
+			})
+			delete(pw.procs, pid)
+		}
+	}
+
+	return exited
+}
+
+func (pw *pollWatcher) run(ctx context.Context) {
+	ticker := time.NewTicker(pw.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, resp := range pw.check() {
+				select {
+				case <-ctx.Done():
+					return
+				case pw.response <- resp:
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,280 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// pidfdMaxEvents is the maximum number of events retrieved per epoll_wait call.
+const pidfdMaxEvents = 128
+
+type pidfdProc struct {
+	pid       int32
+	startTime uint64
+	fd        int
+	gen       int32
+}
+
+// pidfdWatcher detects process exits using pidfds registered with an epoll
+// instance, which is waited on by a single goroutine. A pidfd becomes readable
+// when the process it refers to exits, and it continues to refer to the same
+// process even if the pid is reused.
+type pidfdWatcher struct {
+	sync.Mutex
+	log          logging.Logger
+	getStartTime procStartTimeFn
+	epollWait    func(epfd int, events []unix.EpollEvent, msec int) (int, error)
+	epfd         int
+	wakefd       int
+	closed       bool
+	nextGen      int32
+	procs        map[int32]*pidfdProc
+	fds          map[int]*pidfdProc
+	response     chan *procMonResponse
+	pollInterval time.Duration
+	fallback     *pollWatcher
+}
+
+func newPidfdWatcher(log logging.Logger, response chan *procMonResponse) (*pidfdWatcher, error) {
+	// Verify that the kernel supports pidfd_open(2) (Linux 5.3+).
+	fd, err := unix.PidfdOpen(os.Getpid(), 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "pidfd_open")
+	}
+	unix.Close(fd)
+
+	epfd, err := unix.EpollCreate1(unix.EPOLL_CLOEXEC)
+	if err != nil {
+		return nil, errors.Wrap(err, "epoll_create1")
+	}
+
+	wakefd, err := unix.Eventfd(0, unix.EFD_CLOEXEC|unix.EFD_NONBLOCK)
+	if err != nil {
+		unix.Close(epfd)
+		return nil, errors.Wrap(err, "eventfd")
+	}
+
+	// Generation 0 is reserved for the wakeup eventfd.
+	ev := &unix.EpollEvent{Events: unix.EPOLLIN, Fd: int32(wakefd)}
+	if err := unix.EpollCtl(epfd, unix.EPOLL_CTL_ADD, wakefd, ev); err != nil {
+		unix.Close(wakefd)
+		unix.Close(epfd)
+		return nil, errors.Wrap(err, "epoll_ctl")
+	}
+
+	return &pidfdWatcher{
+		log:          log,
+		getStartTime: getProcStartTime,
+		epollWait:    unix.EpollWait,
+		epfd:         epfd,
+		wakefd:       wakefd,
+		nextGen:      1,
+		procs:        make(map[int32]*pidfdProc),
+		fds:          make(map[int]*pidfdProc),
+		response:     response,
+		pollInterval: MonWaitTime,
+	}, nil
+}
+
+func (pw *pidfdWatcher) String() string {
+	return "pidfd"
+}
+
+func (pw *pidfdWatcher) watch(pid int32, startTime uint64) error {
+	pw.Lock()
+	defer pw.Unlock()
+
+	if pw.fallback != nil {
+		return pw.fallback.watch(pid, startTime)
+	}
+	if pw.closed {
+		return errors.New("pidfd process watcher is closed")
+	}
+
+	if proc, found := pw.procs[pid]; found {
+		pw.remove(proc)
+	}
+
+	fd, err := unix.PidfdOpen(int(pid), 0)
+	if err != nil {
+		if errors.Is(err, unix.ESRCH) {
+			return errors.Errorf("pid:%d terminated unexpectedly", pid)
+		}
+		return errors.Wrapf(err, "pidfd_open(%d)", pid)
+	}
+
+	// The pid may have been reused before the pidfd was opened. The pidfd
+	// pins the process it was opened for, so if the start time matches now
+	// it refers to the expected process.
+	if err := checkProcAlive(pw.getStartTime, pid, startTime); err != nil {
+		unix.Close(fd)
+		return err
+	}
+
+	proc := &pidfdProc{
+		pid:       pid,
+		startTime: startTime,
+		fd:        fd,
+		gen:       pw.nextGen,
+	}
+	pw.nextGen++
+	if pw.nextGen <= 0 {
+		pw.nextGen = 1
+	}
+
+	// The generation is stored alongside the fd in the event data so that
+	// stale events for a closed and reused fd number can be discarded.
+	ev := &unix.EpollEvent{Events: unix.EPOLLIN, Fd: int32(fd), Pad: proc.gen}
+	if err := unix.EpollCtl(pw.epfd, unix.EPOLL_CTL_ADD, fd, ev); err != nil {
+		unix.Close(fd)
+		return errors.Wrapf(err, "epoll_ctl(%d)", pid)
+	}
+
+	pw.procs[pid] = proc
+	pw.fds[fd] = proc
+	return nil
+}
+
+// remove deregisters and closes the pidfd for the process. Must be called with
+// the lock held.
+func (pw *pidfdWatcher) remove(proc *pidfdProc) {
+	if err := unix.EpollCtl(pw.epfd, unix.EPOLL_CTL_DEL, proc.fd, nil); err != nil {
+		pw.log.Debugf("pid:%d: epoll_ctl(DEL): %s", proc.pid, err)
+	}
+	unix.Close(proc.fd)
+	delete(pw.procs, proc.pid)
+	delete(pw.fds, proc.fd)
+}
+
+func (pw *pidfdWatcher) unwatch(pid int32) {
+	pw.Lock()
+	defer pw.Unlock()
+
+	if pw.fallback != nil {
+		pw.fallback.unwatch(pid)
+		return
+	}
+	if proc, found := pw.procs[pid]; found {
+		pw.remove(proc)
+	}
+}
+
+// exited stops watching the process associated with the event and returns the
+// response to be sent for it, or nil if the event is stale.
+func (pw *pidfdWatcher) exited(ev unix.EpollEvent) *procMonResponse {
+	pw.Lock()
+	defer pw.Unlock()
+
+	proc, found := pw.fds[int(ev.Fd)]
+	if !found || proc.gen != ev.Pad {
+		return nil
+	}
+	pw.remove(proc)
+
+	return &procMonResponse{
+		pid:       proc.pid,
+		startTime: proc.startTime,
+		err:       errors.Errorf("pid:%d terminated unexpectedly", proc.pid),
+	}
+}
+
+func (pw *pidfdWatcher) wake() {
+	pw.Lock()
+	defer pw.Unlock()
+
+	if pw.closed {
+		return
+	}
+
+	buf := make([]byte, 8)
+	binary.NativeEndian.PutUint64(buf, 1)
+	if _, err := unix.Write(pw.wakefd, buf); err != nil {
+		pw.log.Debugf("pidfd process watcher: eventfd write: %s", err)
+	}
+}
+
+// fallBack hands the watched processes over to a polling watcher, which takes
+// over all subsequent watch and unwatch requests.
+func (pw *pidfdWatcher) fallBack() *pollWatcher {
+	pw.Lock()
+	defer pw.Unlock()
+
+	poll := newPollWatcher(pw.log, pw.response)
+	poll.interval = pw.pollInterval
+	poll.getStartTime = pw.getStartTime
+	for pid, proc := range pw.procs {
+		poll.procs[pid] = proc.startTime
+		pw.remove(proc)
+	}
+	pw.fallback = poll
+
+	return poll
+}
+
+func (pw *pidfdWatcher) close() {
+	pw.Lock()
+	defer pw.Unlock()
+
+	for _, proc := range pw.procs {
+		pw.remove(proc)
+	}
+	unix.Close(pw.wakefd)
+	unix.Close(pw.epfd)
+	pw.closed = true
+}
+
+func (pw *pidfdWatcher) run(ctx context.Context) {
+	defer pw.close()
+
+	go func() {
+		<-ctx.Done()
+		pw.wake()
+	}()
+
+	events := make([]unix.EpollEvent, pidfdMaxEvents)
+	for {
+		n, err := pw.epollWait(pw.epfd, events, -1)
+		if err != nil {
+			if errors.Is(err, unix.EINTR) {
+				continue
+			}
+			// Keep detecting process exits rather than silently
+			// giving up on the processes being watched.
+			pw.log.Errorf("pidfd process watcher: epoll_wait: %s; falling back to polling", err)
+			pw.fallBack().run(ctx)
+			return
+		}
+
+		for _, ev := range events[:n] {
+			if ev.Pad == 0 {
+				// Woken up by context cancellation.
+				return
+			}
+
+			resp := pw.exited(ev)
+			if resp == nil {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case pw.response <- resp:
+			}
+		}
+	}
+}
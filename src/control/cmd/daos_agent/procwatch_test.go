@@ -0,0 +1,269 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestAgent_checkProcAlive(t *testing.T) {
+	for name, tc := range map[string]struct {
+		startTime    uint64
+		getStartTime procStartTimeFn
+		expErr       error
+	}{
+		"alive": {
+			startTime: 42,
+			getStartTime: func(int32) (uint64, error) {
+				return 42, nil
+			},
+		},
+		"not running": {
+			startTime: 42,
+			getStartTime: func(int32) (uint64, error) {
+				return 0, errors.Wrap(os.ErrNotExist, "failed to read")
+			},
+			expErr: errors.New("terminated unexpectedly"),
+		},
+		"pid reused": {
+			startTime: 42,
+			getStartTime: func(int32) (uint64, error) {
+				return 43, nil
+			},
+			expErr: errors.New("pid reused"),
+		},
+		"other error": {
+			startTime: 42,
+			getStartTime: func(int32) (uint64, error) {
+				return 0, errors.New("whoops")
+			},
+			expErr: errors.New("whoops"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotErr := checkProcAlive(tc.getStartTime, 1, tc.startTime)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestAgent_pollWatcher_check(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	running := map[int32]uint64{
+		1: 100,
+		2: 200,
+	}
+	pw := newPollWatcher(log, make(chan *procMonResponse))
+	pw.getStartTime = func(pid int32) (uint64, error) {
+		startTime, found := running[pid]
+		if !found {
+			return 0, os.ErrNotExist
+		}
+		return startTime, nil
+	}
+
+	for pid, startTime := range map[int32]uint64{1: 100, 2: 200, 3: 300} {
+		if err := pw.watch(pid, startTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// pid 2 reused by a new process; pid 3 exited.
+	running[2] = 201
+
+	exited := make(map[int32]uint64)
+	for _, resp := range pw.check() {
+		if resp.err == nil {
+			t.Fatalf("pid:%d: expected error", resp.pid)
+		}
+		exited[resp.pid] = resp.startTime
+	}
+	test.AssertEqual(t, map[int32]uint64{2: 200, 3: 300}, exited, "unexpected exited processes")
+	test.AssertEqual(t, map[int32]uint64{1: 100}, pw.procs, "unexpected watched processes")
+
+	pw.unwatch(1)
+	test.AssertEqual(t, 0, len(pw.check()), "unexpected exited processes")
+	test.AssertEqual(t, 0, len(pw.procs), "unexpected watched processes")
+}
+
+func startTestProcess(t *testing.T) (*exec.Cmd, uint64) {
+	t.Helper()
+
+	cmd := exec.Command("sleep", "60")
+	if err := cmd.Start(); err != nil {
+		t.Skipf("unable to start test process: %s", err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+
+	startTime, err := getProcStartTime(int32(cmd.Process.Pid))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cmd, startTime
+}
+
+func TestAgent_pidfdWatcher(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	response := make(chan *procMonResponse)
+	pw, err := newPidfdWatcher(log, response)
+	if err != nil {
+		t.Skipf("pidfd process watcher not supported: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(test.Context(t))
+	done := make(chan struct{})
+	go func() {
+		pw.run(ctx)
+		close(done)
+	}()
+
+	exiting, exitingStart := startTestProcess(t)
+	unwatched, unwatchedStart := startTestProcess(t)
+	for _, cmd := range []*exec.Cmd{exiting, unwatched} {
+		startTime := exitingStart
+		if cmd == unwatched {
+			startTime = unwatchedStart
+		}
+		if err := pw.watch(int32(cmd.Process.Pid), startTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A stale start time indicates that the pid has been reused.
+	if err := pw.watch(int32(exiting.Process.Pid), exitingStart+1); err == nil {
+		t.Fatal("expected error watching reused pid")
+	}
+	if err := pw.watch(int32(exiting.Process.Pid), exitingStart); err != nil {
+		t.Fatal(err)
+	}
+
+	pw.unwatch(int32(unwatched.Process.Pid))
+	unwatched.Process.Kill()
+	exiting.Process.Kill()
+
+	select {
+	case resp := <-response:
+		test.AssertEqual(t, int32(exiting.Process.Pid), resp.pid, "unexpected pid")
+		test.AssertEqual(t, exitingStart, resp.startTime, "unexpected start time")
+		test.CmpErr(t, errors.New("terminated unexpectedly"), resp.err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for process exit")
+	}
+
+	select {
+	case resp := <-response:
+		t.Fatalf("unexpected response for pid:%d", resp.pid)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for watcher to stop")
+	}
+
+	if err := pw.watch(int32(os.Getpid()), 0); err == nil {
+		t.Fatal("expected error watching with closed watcher")
+	}
+}
+
+func TestAgent_pidfdWatcher_fallback(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	response := make(chan *procMonResponse)
+	pw, err := newPidfdWatcher(log, response)
+	if err != nil {
+		t.Skipf("pidfd process watcher not supported: %s", err)
+	}
+	pw.pollInterval = 10 * time.Millisecond
+
+	failWait := make(chan struct{})
+	pw.epollWait = func(epfd int, events []unix.EpollEvent, msec int) (int, error) {
+		<-failWait
+		return 0, unix.EBADF
+	}
+
+	// Watched before the failure, so must be handed over to the fallback.
+	before, beforeStart := startTestProcess(t)
+	if err := pw.watch(int32(before.Process.Pid), beforeStart); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(test.Context(t))
+	done := make(chan struct{})
+	go func() {
+		pw.run(ctx)
+		close(done)
+	}()
+	close(failWait)
+
+	fellBack := func() bool {
+		pw.Lock()
+		defer pw.Unlock()
+		return pw.fallback != nil
+	}
+	deadline := time.Now().Add(10 * time.Second)
+	for !fellBack() {
+		if time.Now().After(deadline) {
+			t.Fatal("watcher did not fall back to polling")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Watched after the failure, so must be handled by the fallback.
+	after, afterStart := startTestProcess(t)
+	if err := pw.watch(int32(after.Process.Pid), afterStart); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		cmd       *exec.Cmd
+		startTime uint64
+	}{
+		{before, beforeStart},
+		{after, afterStart},
+	} {
+		// Reap the process so that it disappears from /proc.
+		tc.cmd.Process.Kill()
+		tc.cmd.Wait()
+
+		select {
+		case resp := <-response:
+			test.AssertEqual(t, int32(tc.cmd.Process.Pid), resp.pid, "unexpected pid")
+			test.AssertEqual(t, tc.startTime, resp.startTime, "unexpected start time")
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for pid:%d exit", tc.cmd.Process.Pid)
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for watcher to stop")
+	}
+}
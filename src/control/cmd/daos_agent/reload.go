@@ -0,0 +1,299 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+// agentConfigSetting describes how a change to a configuration setting is
+// handled when the agent configuration is reloaded.
+type agentConfigSetting struct {
+	name    string
+	changed func(cur, new *Config) bool
+	// apply copies the new value of a reloadable setting into the running
+	// configuration. It is nil for settings that require a restart.
+	apply func(cur, new *Config)
+}
+
+func credentialConfig(cfg *Config) *security.CredentialConfig {
+	if cfg.CredentialConfig == nil {
+		return &security.CredentialConfig{}
+	}
+	return cfg.CredentialConfig
+}
+
+func transportConfigChanged(cur, new *security.TransportConfig) bool {
+	if cur == nil || new == nil {
+		return cur != new
+	}
+	return cur.AllowInsecure != new.AllowInsecure ||
+		cur.ClientCertDir != new.ClientCertDir ||
+		cur.CARootPath != new.CARootPath ||
+		cur.CertificatePath != new.CertificatePath ||
+		cur.PrivateKeyPath != new.PrivateKeyPath ||
+		cur.CRLPath != new.CRLPath ||
+		cur.CertCheckInterval != new.CertCheckInterval ||
+		!reflect.DeepEqual(cur.CertExpiryWarningDays, new.CertExpiryWarningDays)
+}
+
+var agentConfigSettings = []agentConfigSetting{
+	{
+		name:    "access_points",
+		changed: func(cur, new *Config) bool { return !reflect.DeepEqual(cur.AccessPoints, new.AccessPoints) },
+		apply:   func(cur, new *Config) { cur.AccessPoints = new.AccessPoints },
+	},
+	{
+		name:    "port",
+		changed: func(cur, new *Config) bool { return cur.ControlPort != new.ControlPort },
+		apply:   func(cur, new *Config) { cur.ControlPort = new.ControlPort },
+	},
+	{
+		name:    "control_log_mask",
+		changed: func(cur, new *Config) bool { return cur.LogLevel != new.LogLevel },
+		apply:   func(cur, new *Config) { cur.LogLevel = new.LogLevel },
+	},
+	{
+		name:    "cache_expiration",
+		changed: func(cur, new *Config) bool { return cur.CacheExpiration != new.CacheExpiration },
+		apply:   func(cur, new *Config) { cur.CacheExpiration = new.CacheExpiration },
+	},
+	{
+		name: "exclude_fabric_ifaces",
+		changed: func(cur, new *Config) bool {
+			return !reflect.DeepEqual(cur.ExcludeFabricIfaces, new.ExcludeFabricIfaces)
+		},
+		apply: func(cur, new *Config) { cur.ExcludeFabricIfaces = new.ExcludeFabricIfaces },
+	},
+	{
+		name: "include_fabric_ifaces",
+		changed: func(cur, new *Config) bool {
+			return !reflect.DeepEqual(cur.IncludeFabricIfaces, new.IncludeFabricIfaces)
+		},
+		apply: func(cur, new *Config) { cur.IncludeFabricIfaces = new.IncludeFabricIfaces },
+	},
+	{
+		name:    "fabric_ifaces",
+		changed: func(cur, new *Config) bool { return !reflect.DeepEqual(cur.FabricInterfaces, new.FabricInterfaces) },
+		apply:   func(cur, new *Config) { cur.FabricInterfaces = new.FabricInterfaces },
+	},
+	{
+		name:    "fabric_iface_selection",
+		changed: func(cur, new *Config) bool { return cur.FabricIfaceSelect != new.FabricIfaceSelect },
+		apply:   func(cur, new *Config) { cur.FabricIfaceSelect = new.FabricIfaceSelect },
+	},
+	{
+		name: "credential_config.client_user_map",
+		changed: func(cur, new *Config) bool {
+			return !reflect.DeepEqual(credentialConfig(cur).ClientUserMap, credentialConfig(new).ClientUserMap)
+		},
+		apply: func(cur, new *Config) {
+			credCfg := *credentialConfig(cur)
+			credCfg.ClientUserMap = credentialConfig(new).ClientUserMap
+			cur.CredentialConfig = &credCfg
+		},
+	},
+	{
+		name:    "name",
+		changed: func(cur, new *Config) bool { return cur.SystemName != new.SystemName },
+	},
+	{
+		name:    "runtime_dir",
+		changed: func(cur, new *Config) bool { return cur.RuntimeDir != new.RuntimeDir },
+	},
+	{
+		name:    "log_file",
+		changed: func(cur, new *Config) bool { return cur.LogFile != new.LogFile },
+	},
+	{
+		name:    "transport_config",
+		changed: func(cur, new *Config) bool { return transportConfigChanged(cur.TransportConfig, new.TransportConfig) },
+	},
+	{
+		name: "credential_config.cache_expiration",
+		changed: func(cur, new *Config) bool {
+			return credentialConfig(cur).CacheExpiration != credentialConfig(new).CacheExpiration
+		},
+	},
+	{
+		name: "credential_config.identity_providers",
+		changed: func(cur, new *Config) bool {
+			return !reflect.DeepEqual(credentialConfig(cur).IdentityProviders, credentialConfig(new).IdentityProviders)
+		},
+	},
+	{
+		name: "credential_config.token_provider",
+		changed: func(cur, new *Config) bool {
+			return !reflect.DeepEqual(credentialConfig(cur).TokenProvider, credentialConfig(new).TokenProvider)
+		},
+	},
+	{
+		name:    "disable_caching",
+		changed: func(cur, new *Config) bool { return cur.DisableCache != new.DisableCache },
+	},
+	{
+		name:    "disable_auto_evict",
+		changed: func(cur, new *Config) bool { return cur.DisableAutoEvict != new.DisableAutoEvict },
+	},
+	{
+		name:    "enable_evict_on_start",
+		changed: func(cur, new *Config) bool { return cur.EvictOnStart != new.EvictOnStart },
+	},
+	{
+		name:    "fabric_health_interval",
+		changed: func(cur, new *Config) bool { return cur.FabricHealthPeriod != new.FabricHealthPeriod },
+	},
+	{
+		name:    "telemetry_port",
+		changed: func(cur, new *Config) bool { return cur.TelemetryPort != new.TelemetryPort },
+	},
+	{
+		name:    "telemetry_enabled",
+		changed: func(cur, new *Config) bool { return cur.TelemetryEnabled != new.TelemetryEnabled },
+	},
+	{
+		name:    "telemetry_retain",
+		changed: func(cur, new *Config) bool { return cur.TelemetryRetain != new.TelemetryRetain },
+	},
+}
+
+// mergeConfig returns a copy of the running configuration updated with the
+// reloadable settings that have changed in the new configuration, along with
+// the names of the settings applied and those that require a restart.
+func mergeConfig(cur, new *Config) (merged *Config, applied, restartRequired []string) {
+	mergedCfg := *cur
+	for _, setting := range agentConfigSettings {
+		if !setting.changed(cur, new) {
+			continue
+		}
+		if setting.apply == nil {
+			restartRequired = append(restartRequired, setting.name)
+			continue
+		}
+		setting.apply(&mergedCfg, new)
+		applied = append(applied, setting.name)
+	}
+
+	return &mergedCfg, applied, restartRequired
+}
+
+// configReloader reloads the agent configuration and applies the settings that
+// can be changed without restarting the agent.
+type configReloader struct {
+	sync.Mutex
+	log        logging.Logger
+	cfg        *Config
+	loadConfig configLoaderFn
+	ctlInvoker control.Invoker
+	cache      *InfoCache
+	secMod     *SecurityModule
+}
+
+// reload reads the configuration again and applies any changes to reloadable
+// settings. If the new configuration is invalid, the running configuration is
+// left unchanged.
+func (r *configReloader) reload(ctx context.Context) (*mgmtpb.ReloadConfigResp, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.loadConfig == nil {
+		return nil, errors.New("configuration reload is not supported")
+	}
+
+	newCfg, err := r.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	merged, applied, restartRequired := mergeConfig(r.cfg, newCfg)
+	resp := &mgmtpb.ReloadConfigResp{
+		Applied:         applied,
+		RestartRequired: restartRequired,
+	}
+	if len(restartRequired) > 0 {
+		r.log.Noticef("configuration reload: changes to %s require an agent restart",
+			strings.Join(restartRequired, ", "))
+	}
+	if len(applied) == 0 {
+		r.log.Info("configuration reload: no changes to apply")
+		return resp, nil
+	}
+
+	for _, name := range applied {
+		switch name {
+		case "access_points", "port":
+			r.ctlInvoker.SetConfig(controlConfig(merged))
+		case "control_log_mask":
+			if ll, ok := r.log.(*logging.LeveledLogger); ok {
+				ll.SetLevel(logging.LogLevel(merged.LogLevel))
+			}
+		case "credential_config.client_user_map":
+			r.secMod.SetClientUserMap(merged.CredentialConfig.ClientUserMap)
+		}
+	}
+	r.cache.Reconfigure(ctx, merged)
+	r.cfg = merged
+
+	r.log.Noticef("configuration reload: applied changes to %s", strings.Join(applied, ", "))
+	return resp, nil
+}
+
+type agentConfigCmd struct {
+	Reload configReloadCmd `command:"reload" description:"Reload the configuration file of the running agent"`
+}
+
+type configReloadCmd struct {
+	agentAdminCmd
+}
+
+func (cmd *configReloadCmd) Execute(_ []string) error {
+	resp := new(mgmtpb.ReloadConfigResp)
+	err := cmd.invokeAgent(cmd.MustLogCtx(), drpc.MethodReloadConfig, new(mgmtpb.ReloadConfigReq), resp)
+	if err == nil && resp.Error != "" {
+		err = errors.Errorf("failed to reload configuration: %s", resp.Error)
+	}
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	printReloadResult(&out, resp)
+	cmd.Info(out.String())
+
+	return nil
+}
+
+func printReloadResult(out io.Writer, resp *mgmtpb.ReloadConfigResp) {
+	if len(resp.Applied) == 0 && len(resp.RestartRequired) == 0 {
+		fmt.Fprintln(out, "Configuration reloaded; no changes found")
+		return
+	}
+
+	fmt.Fprintln(out, "Configuration reloaded")
+	if len(resp.Applied) > 0 {
+		fmt.Fprintf(out, "  Applied: %s\n", strings.Join(resp.Applied, ", "))
+	}
+	if len(resp.RestartRequired) > 0 {
+		fmt.Fprintf(out, "  Not applied (restart required): %s\n", strings.Join(resp.RestartRequired, ", "))
+	}
+}
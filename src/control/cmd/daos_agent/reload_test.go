@@ -0,0 +1,329 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/cache"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestAgent_mergeConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		update             func(*Config)
+		expApplied         []string
+		expRestartRequired []string
+		checkMerged        func(*testing.T, *Config)
+	}{
+		"no changes": {
+			update: func(*Config) {},
+		},
+		"reloadable settings": {
+			update: func(cfg *Config) {
+				cfg.AccessPoints = []string{"host1:10001", "host2:10001"}
+				cfg.LogLevel = common.ControlLogLevelDebug
+				cfg.CacheExpiration = refreshMinutes(5 * time.Minute)
+				cfg.ExcludeFabricIfaces = common.NewStringSet("eth0")
+				cfg.FabricIfaceSelect = fabricSelectLeastConns
+				cfg.CredentialConfig = &security.CredentialConfig{
+					ClientUserMap: security.ClientUserMap{
+						1000: &security.MappedClientUser{User: "user"},
+					},
+				}
+			},
+			expApplied: []string{
+				"access_points",
+				"control_log_mask",
+				"cache_expiration",
+				"exclude_fabric_ifaces",
+				"fabric_iface_selection",
+				"credential_config.client_user_map",
+			},
+			checkMerged: func(t *testing.T, merged *Config) {
+				test.AssertEqual(t, 2, len(merged.AccessPoints), "")
+				test.AssertEqual(t, common.ControlLogLevelDebug, merged.LogLevel, "")
+				test.AssertEqual(t, fabricSelectLeastConns, merged.FabricIfaceSelect, "")
+				test.AssertEqual(t, "user", merged.CredentialConfig.ClientUserMap.Lookup(1000).User, "")
+			},
+		},
+		"restart required": {
+			update: func(cfg *Config) {
+				cfg.SystemName = "new_name"
+				cfg.RuntimeDir = "/new/dir"
+				cfg.TransportConfig = &security.TransportConfig{AllowInsecure: true}
+				cfg.CredentialConfig = &security.CredentialConfig{
+					CacheExpiration: time.Minute,
+					IdentityProviders: []*security.IdentityProviderConfig{
+						{Type: security.IdentityProviderExec, Path: "/usr/bin/id_helper"},
+					},
+					TokenProvider: &security.TokenProviderConfig{Path: "/usr/bin/token_helper"},
+				}
+				cfg.DisableAutoEvict = true
+				cfg.TelemetryPort = 9192
+			},
+			expRestartRequired: []string{
+				"name",
+				"runtime_dir",
+				"transport_config",
+				"credential_config.cache_expiration",
+				"credential_config.identity_providers",
+				"credential_config.token_provider",
+				"disable_auto_evict",
+				"telemetry_port",
+			},
+			checkMerged: func(t *testing.T, merged *Config) {
+				test.AssertEqual(t, DefaultConfig().SystemName, merged.SystemName, "")
+				test.AssertFalse(t, merged.TransportConfig.AllowInsecure, "")
+				test.AssertFalse(t, merged.DisableAutoEvict, "")
+			},
+		},
+		"certificate monitoring settings": {
+			update: func(cfg *Config) {
+				transport := *cfg.TransportConfig
+				transport.CertExpiryWarningDays = []uint{14}
+				cfg.TransportConfig = &transport
+			},
+			expRestartRequired: []string{"transport_config"},
+		},
+		"mixed": {
+			update: func(cfg *Config) {
+				cfg.ControlPort = 10002
+				cfg.LogFile = "/tmp/agent.log"
+			},
+			expApplied:         []string{"port"},
+			expRestartRequired: []string{"log_file"},
+			checkMerged: func(t *testing.T, merged *Config) {
+				test.AssertEqual(t, 10002, merged.ControlPort, "")
+				test.AssertEqual(t, "", merged.LogFile, "")
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cur := DefaultConfig()
+			newCfg := DefaultConfig()
+			tc.update(newCfg)
+
+			merged, applied, restartRequired := mergeConfig(cur, newCfg)
+
+			if diff := cmp.Diff(tc.expApplied, applied); diff != "" {
+				t.Fatalf("unexpected applied settings (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expRestartRequired, restartRequired); diff != "" {
+				t.Fatalf("unexpected restart required settings (-want, +got):\n%s\n", diff)
+			}
+			if tc.checkMerged != nil {
+				tc.checkMerged(t, merged)
+			}
+
+			// The running configuration must not be modified.
+			if diff := cmp.Diff(DefaultConfig(), cur, cmpopts.IgnoreUnexported(security.CertificateConfig{})); diff != "" {
+				t.Fatalf("current config was modified (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_configReloader_reload(t *testing.T) {
+	const baseCfg = `
+name: daos_server
+access_points: ["localhost"]
+port: 10001
+transport_config:
+  allow_insecure: true
+`
+
+	for name, tc := range map[string]struct {
+		cfgContent         string
+		noLoader           bool
+		expErr             error
+		expApplied         []string
+		expRestartRequired []string
+		expLogLevel        logging.LogLevel
+		expCacheFlushed    bool
+	}{
+		"no loader": {
+			noLoader: true,
+			expErr:   errors.New("not supported"),
+		},
+		"invalid config": {
+			cfgContent: baseCfg + "fabric_iface_selection: random\n",
+			expErr:     errors.New("invalid fabric_iface_selection"),
+		},
+		"no changes": {
+			cfgContent:  baseCfg,
+			expLogLevel: logging.LogLevelInfo,
+		},
+		"log level": {
+			cfgContent:      baseCfg + "control_log_mask: debug\n",
+			expApplied:      []string{"control_log_mask"},
+			expLogLevel:     logging.LogLevelDebug,
+			expCacheFlushed: true,
+		},
+		"access points and restart required": {
+			cfgContent: `
+name: new_system
+access_points: ["host1", "host2"]
+port: 10001
+transport_config:
+  allow_insecure: true
+`,
+			expApplied:         []string{"access_points"},
+			expRestartRequired: []string{"name"},
+			expLogLevel:        logging.LogLevelInfo,
+			expCacheFlushed:    true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			dir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			cfgPath := test.CreateTestFile(t, dir, baseCfg)
+			cur, err := loadConfig(log, &cliOptions{}, cfgPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			log.SetLevel(logging.LogLevel(cur.LogLevel))
+
+			if tc.cfgContent != "" {
+				if err := os.WriteFile(cfgPath, []byte(tc.cfgContent), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			ic := newTestInfoCache(t, log, testInfoCacheParams{
+				cachedItems: []cache.Item{
+					newCachedAttachInfo(0, "daos_server", nil, nil),
+				},
+			})
+			secMod := NewSecurityModule(log, defaultTestSecurityConfig())
+
+			r := &configReloader{
+				log:        log,
+				cfg:        cur,
+				ctlInvoker: control.NewMockInvoker(log, control.DefaultMockInvokerConfig()),
+				cache:      ic,
+				secMod:     secMod,
+			}
+			if !tc.noLoader {
+				r.loadConfig = func() (*Config, error) {
+					return loadConfig(log, &cliOptions{}, cfgPath)
+				}
+			}
+
+			resp, err := r.reload(test.Context(t))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				test.AssertEqual(t, cur, r.cfg, "config should not have been replaced")
+				return
+			}
+
+			if diff := cmp.Diff(tc.expApplied, resp.Applied); diff != "" {
+				t.Fatalf("unexpected applied settings (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expRestartRequired, resp.RestartRequired); diff != "" {
+				t.Fatalf("unexpected restart required settings (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expLogLevel, log.Level(), "")
+			test.AssertEqual(t, tc.expCacheFlushed, len(ic.cache.Keys()) == 0, "")
+		})
+	}
+}
+
+func TestAgent_InfoCache_Reconfigure(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ic := newTestInfoCache(t, log, testInfoCacheParams{
+		cachedItems: []cache.Item{
+			newCachedAttachInfo(0, "daos_server", nil, nil),
+		},
+	})
+
+	cfg := DefaultConfig()
+	cfg.CacheExpiration = refreshMinutes(10 * time.Minute)
+	cfg.ExcludeFabricIfaces = common.NewStringSet("eth0")
+	cfg.FabricInterfaces = []*NUMAFabricConfig{
+		{
+			NUMANode: 0,
+			Interfaces: []*FabricInterfaceConfig{
+				{Interface: "ib0", Domain: "mlx5_0"},
+			},
+		},
+	}
+	ic.Reconfigure(test.Context(t), cfg)
+
+	test.AssertEqual(t, 10*time.Minute, ic.attachInfoRefresh, "")
+	test.AssertTrue(t, ic.ignoreIfaces.Has("eth0"), "eth0 should be ignored")
+	if diff := cmp.Diff([]string{fabricKey}, ic.cache.Keys()); diff != "" {
+		t.Fatalf("unexpected cache keys (-want, +got):\n%s\n", diff)
+	}
+
+	nf, err := ic.getNUMAFabric(test.Context(t), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, nf.NumDevices(0), "")
+}
+
+func TestAgent_printReloadResult(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp   *mgmtpb.ReloadConfigResp
+		expOut string
+	}{
+		"no changes": {
+			resp: &mgmtpb.ReloadConfigResp{},
+			expOut: `
+Configuration reloaded; no changes found
+`,
+		},
+		"applied": {
+			resp: &mgmtpb.ReloadConfigResp{
+				Applied: []string{"access_points", "control_log_mask"},
+			},
+			expOut: `
+Configuration reloaded
+  Applied: access_points, control_log_mask
+`,
+		},
+		"restart required": {
+			resp: &mgmtpb.ReloadConfigResp{
+				Applied:         []string{"port"},
+				RestartRequired: []string{"name"},
+			},
+			expOut: `
+Configuration reloaded
+  Applied: port
+  Not applied (restart required): name
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out strings.Builder
+			printReloadResult(&out, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,10 +12,12 @@ import (
 	"fmt"
 	"net"
 	"os/user"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/lib/cache"
 	"github.com/daos-stack/daos/src/control/lib/daos"
@@ -27,6 +30,15 @@ type (
 	// credSignerFn defines the function signature for signing credentials.
 	credSignerFn func(context.Context, *auth.CredentialRequest) (*auth.Credential, error)
 
+	// bearerSignerFn defines the function signature for signing credentials
+	// carrying bearer tokens.
+	bearerSignerFn func(context.Context, *auth.CredentialRequest, string) (*auth.Credential, error)
+
+	// bearerCredFn defines the function signature for obtaining a signed
+	// credential carrying a bearer token, along with the token's expiration
+	// time.
+	bearerCredFn func(context.Context, *auth.CredentialRequest) (*auth.Credential, time.Time, error)
+
 	// credentialCache implements a cache for signed credentials.
 	credentialCache struct {
 		log          logging.Logger
@@ -43,35 +55,68 @@ type (
 		cred      *auth.Credential
 	}
 
+	// identitySource is an identity provider along with the lifetime of
+	// cached credentials for the identities it provides.
+	identitySource struct {
+		auth.IdentityProvider
+		credLifetime time.Duration
+	}
+
 	// securityConfig defines configuration parameters for SecurityModule.
 	securityConfig struct {
 		credentials *security.CredentialConfig
 		transport   *security.TransportConfig
+		identities  []*identitySource
+		tokens      auth.TokenProvider
 	}
 
 	// SecurityModule is the security drpc module struct
 	SecurityModule struct {
 		log            logging.Logger
 		signCredential credSignerFn
+		signIdentity   credSignerFn
+		signBearer     bearerSignerFn
 		credCache      *credentialCache
 
-		config *securityConfig
+		configLock sync.RWMutex
+		config     *securityConfig
 	}
 )
 
+const (
+	// bearerCacheKeyPrefix distinguishes cached credentials carrying bearer
+	// tokens from other credentials for the same client.
+	bearerCacheKeyPrefix = "bearer/"
+	// bearerRefreshMargin is how long before its token expires that a cached
+	// bearer credential is discarded, so that clients are not handed a token
+	// that expires while it is in use.
+	bearerRefreshMargin = time.Minute
+)
+
 var _ cache.ExpirableItem = (*cachedCredential)(nil)
 
 // NewSecurityModule creates a new module with the given initialized TransportConfig.
 func NewSecurityModule(log logging.Logger, cfg *securityConfig) *SecurityModule {
+	cacheIdentities := false
+	for _, src := range cfg.identities {
+		if src.credLifetime > 0 {
+			cacheIdentities = true
+			log.Noticef("credential cache enabled for identity provider %q (entry lifetime: %s)",
+				src.Name(), src.credLifetime)
+		}
+	}
+
 	var credCache *credentialCache
 	credSigner := auth.GetSignedCredential
-	if cfg.credentials.CacheExpiration > 0 {
+	if cfg.credentials.CacheExpiration > 0 || cacheIdentities || cfg.tokens != nil {
 		credCache = &credentialCache{
 			log:          log,
 			cache:        cache.NewItemCache(log),
 			credLifetime: cfg.credentials.CacheExpiration,
 			cacheMissFn:  auth.GetSignedCredential,
 		}
+	}
+	if cfg.credentials.CacheExpiration > 0 {
 		credSigner = credCache.getSignedCredential
 		log.Noticef("credential cache enabled (entry lifetime: %s)", cfg.credentials.CacheExpiration)
 	}
@@ -79,11 +124,41 @@ func NewSecurityModule(log logging.Logger, cfg *securityConfig) *SecurityModule
 	return &SecurityModule{
 		log:            log,
 		signCredential: credSigner,
+		signIdentity:   auth.GetSignedCredential,
+		signBearer:     auth.GetBearerCredential,
 		credCache:      credCache,
 		config:         cfg,
 	}
 }
 
+// newIdentitySources creates the identity providers in the credential
+// configuration. Credentials for their identities are cached for the provider's
+// cache_expiration, or the credential cache_expiration if it is not set.
+func newIdentitySources(cfg *security.CredentialConfig) ([]*identitySource, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	var sources []*identitySource
+	for _, ipc := range cfg.IdentityProviders {
+		prov, err := auth.NewIdentityProvider(ipc)
+		if err != nil {
+			return nil, err
+		}
+
+		lifetime := ipc.CacheExpiration
+		if lifetime == 0 {
+			lifetime = cfg.CacheExpiration
+		}
+		sources = append(sources, &identitySource{
+			IdentityProvider: prov,
+			credLifetime:     lifetime,
+		})
+	}
+
+	return sources, nil
+}
+
 func credReqKey(req *auth.CredentialRequest) string {
 	return fmt.Sprintf("%d:%d:%s", req.DomainInfo.Uid(), req.DomainInfo.Gid(), req.DomainInfo.Ctx())
 }
@@ -106,17 +181,71 @@ func (cred *cachedCredential) IsExpired() bool {
 	return time.Now().After(cred.expiredAt)
 }
 
+// newTokenProvider creates the token provider in the credential configuration,
+// if one is configured.
+func newTokenProvider(cfg *security.CredentialConfig) (auth.TokenProvider, error) {
+	if cfg == nil || cfg.TokenProvider == nil {
+		return nil, nil
+	}
+
+	return auth.NewExecTokenProvider(cfg.TokenProvider)
+}
+
 func (cc *credentialCache) getSignedCredential(ctx context.Context, req *auth.CredentialRequest) (*auth.Credential, error) {
-	key := credReqKey(req)
+	return cc.getCachedCredential(ctx, credReqKey(req), cc.credLifetime, func() (*auth.Credential, error) {
+		return cc.cacheMissFn(ctx, req)
+	})
+}
+
+// getIdentityCredential returns a cached credential for the identity that the
+// source provides for the client. Credentials are cached separately for each
+// source, with the source's cache lifetime.
+func (cc *credentialCache) getIdentityCredential(ctx context.Context, src *identitySource, req *auth.CredentialRequest) (*auth.Credential, error) {
+	key := src.Name() + "/" + credReqKey(req)
+	return cc.getCachedCredential(ctx, key, src.credLifetime, func() (*auth.Credential, error) {
+		return signIdentityCredential(ctx, src, req, cc.cacheMissFn)
+	})
+}
+
+// getBearerCredential returns a cached credential carrying a bearer token for
+// the client. The credential is cached until shortly before the token expires,
+// so that the token provider is not run for every request.
+func (cc *credentialCache) getBearerCredential(ctx context.Context, req *auth.CredentialRequest, signFn bearerCredFn) (*auth.Credential, error) {
+	if cc == nil {
+		cred, _, err := signFn(ctx, req)
+		return cred, err
+	}
+
+	key := bearerCacheKeyPrefix + credReqKey(req)
+	return cc.getOrCreateCredential(ctx, key, func() (*cachedCredential, error) {
+		cred, expires, err := signFn(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		// A token without a known expiration time is used only once.
+		return newCachedCredential(key, cred, time.Until(expires.Add(-bearerRefreshMargin)))
+	})
+}
+
+func (cc *credentialCache) getCachedCredential(ctx context.Context, key string, lifetime time.Duration, signFn func() (*auth.Credential, error)) (*auth.Credential, error) {
+	return cc.getOrCreateCredential(ctx, key, func() (*cachedCredential, error) {
+		cred, err := signFn()
+		if err != nil {
+			return nil, err
+		}
+		return newCachedCredential(key, cred, lifetime)
+	})
+}
 
+func (cc *credentialCache) getOrCreateCredential(ctx context.Context, key string, createFn func() (*cachedCredential, error)) (*auth.Credential, error) {
 	createItem := func() (cache.Item, error) {
 		cc.log.Tracef("cache miss for %s", key)
-		cred, err := cc.cacheMissFn(ctx, req)
+		cachedCred, err := createFn()
 		if err != nil {
 			return nil, err
 		}
 		cc.log.Tracef("getting credential for %s", key)
-		return newCachedCredential(key, cred, cc.credLifetime)
+		return cachedCred, nil
 	}
 
 	item, release, err := cc.cache.GetOrCreate(ctx, key, createItem)
@@ -133,6 +262,41 @@ func (cc *credentialCache) getSignedCredential(ctx context.Context, req *auth.Cr
 	return cachedCred.cred, nil
 }
 
+// flush discards all cached credentials.
+func (cc *credentialCache) flush() {
+	if cc == nil {
+		return
+	}
+
+	for _, key := range cc.cache.Keys() {
+		cc.cache.Delete(key)
+	}
+}
+
+// list returns the unexpired entries in the credential cache.
+func (cc *credentialCache) list(ctx context.Context) []*mgmtpb.CachedCredential {
+	if cc == nil {
+		return nil
+	}
+
+	var entries []*mgmtpb.CachedCredential
+	for _, key := range cc.cache.Keys() {
+		item, release, err := cc.cache.Get(ctx, key)
+		if err != nil {
+			continue
+		}
+		if cachedCred, ok := item.(*cachedCredential); ok {
+			entries = append(entries, &mgmtpb.CachedCredential{
+				Key:       key,
+				ExpiresAt: uint64(cachedCred.expiredAt.Unix()),
+			})
+		}
+		release()
+	}
+
+	return entries
+}
+
 func newCachedCredential(key string, cred *auth.Credential, lifetime time.Duration) (*cachedCredential, error) {
 	if cred == nil {
 		return nil, errors.New("credential is nil")
@@ -145,6 +309,26 @@ func newCachedCredential(key string, cred *auth.Credential, lifetime time.Durati
 	}, nil
 }
 
+func (m *SecurityModule) clientUserMap() security.ClientUserMap {
+	m.configLock.RLock()
+	defer m.configLock.RUnlock()
+
+	return m.config.credentials.ClientUserMap
+}
+
+// SetClientUserMap replaces the map used to look up credentials for client
+// users that are unknown on the agent's host. Cached credentials are discarded
+// so that they are regenerated with the new mapping.
+func (m *SecurityModule) SetClientUserMap(cum security.ClientUserMap) {
+	m.configLock.Lock()
+	credentials := *m.config.credentials
+	credentials.ClientUserMap = cum
+	m.config.credentials = &credentials
+	m.configLock.Unlock()
+
+	m.credCache.flush()
+}
+
 // HandleCall is the handler for calls to the SecurityModule
 func (m *SecurityModule) HandleCall(ctx context.Context, session *drpc.Session, method drpc.Method, body []byte) ([]byte, error) {
 	if method != drpc.MethodRequestCredentials {
@@ -180,14 +364,27 @@ func (m *SecurityModule) getCredential(ctx context.Context, session *drpc.Sessio
 	}
 
 	req := auth.NewCredentialRequest(info, signingKey)
-	cred, err := m.signCredential(ctx, req)
+	cred, err := m.bearerCredential(ctx, req)
+	if errors.Is(err, auth.ErrNoToken) {
+		cred, err = m.identityCredential(ctx, req)
+	}
+	switch {
+	case err == nil:
+		resp := &auth.GetCredResp{Cred: cred}
+		return drpc.Marshal(resp)
+	case !errors.Is(err, auth.ErrNoIdentity):
+		m.log.Errorf("%s: failed to get user credential: %s", info, err)
+		return m.credRespWithStatus(daos.MiscError)
+	}
+
+	cred, err = m.signCredential(ctx, req)
 	if err != nil {
 		if err := func() error {
 			if !errors.Is(err, user.UnknownUserIdError(info.Uid())) {
 				return err
 			}
 
-			mu := m.config.credentials.ClientUserMap.Lookup(info.Uid())
+			mu := m.clientUserMap().Lookup(info.Uid())
 			if mu == nil {
 				return user.UnknownUserIdError(info.Uid())
 			}
@@ -209,6 +406,81 @@ func (m *SecurityModule) getCredential(ctx context.Context, session *drpc.Sessio
 	return drpc.Marshal(resp)
 }
 
+// signIdentityCredential signs a credential for the identity that the source
+// provides for the client.
+func signIdentityCredential(ctx context.Context, src *identitySource, req *auth.CredentialRequest, sign credSignerFn) (*auth.Credential, error) {
+	id, err := src.GetIdentity(ctx, req.DomainInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	req.WithIdentity(id)
+	return sign(ctx, req)
+}
+
+// bearerCredential signs a credential carrying a bearer token obtained for the
+// client by the configured token provider. If there is no token provider, or it
+// has no token for the client, auth.ErrNoToken is returned.
+func (m *SecurityModule) bearerCredential(ctx context.Context, req *auth.CredentialRequest) (*auth.Credential, error) {
+	if m.config.tokens == nil {
+		return nil, auth.ErrNoToken
+	}
+
+	return m.credCache.getBearerCredential(ctx, req, m.signBearerCredential)
+}
+
+// signBearerCredential obtains a bearer token for the client from the token
+// provider and signs a credential carrying it. The expiration time of the token
+// is returned so that the credential can be cached until shortly before then.
+func (m *SecurityModule) signBearerCredential(ctx context.Context, req *auth.CredentialRequest) (*auth.Credential, time.Time, error) {
+	token, err := m.config.tokens.GetToken(ctx, req.DomainInfo)
+	if err != nil {
+		if errors.Is(err, auth.ErrNoToken) {
+			m.log.Tracef("%s: no bearer token from token provider", req.DomainInfo)
+		}
+		return nil, time.Time{}, err
+	}
+
+	m.log.Tracef("%s: bearer token from token provider", req.DomainInfo)
+	cred, err := m.signBearer(ctx, req, token)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	expires, err := auth.BearerTokenExpiry(token)
+	if err != nil {
+		m.log.Debugf("%s: not caching bearer token: %s", req.DomainInfo, err)
+	}
+	return cred, expires, nil
+}
+
+// identityCredential signs a credential for the first identity found for the
+// client by the configured identity providers. If none of them has an identity
+// for the client, auth.ErrNoIdentity is returned.
+func (m *SecurityModule) identityCredential(ctx context.Context, req *auth.CredentialRequest) (*auth.Credential, error) {
+	for _, src := range m.config.identities {
+		var cred *auth.Credential
+		var err error
+		if m.credCache != nil && src.credLifetime > 0 {
+			cred, err = m.credCache.getIdentityCredential(ctx, src, req)
+		} else {
+			cred, err = signIdentityCredential(ctx, src, req, m.signIdentity)
+		}
+		if errors.Is(err, auth.ErrNoIdentity) {
+			m.log.Tracef("%s: no identity from provider %q", req.DomainInfo, src.Name())
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		m.log.Tracef("%s: identity from provider %q", req.DomainInfo, src.Name())
+		return cred, nil
+	}
+
+	return nil, auth.ErrNoIdentity
+}
+
 func (m *SecurityModule) credRespWithStatus(status daos.Status) ([]byte, error) {
 	resp := &auth.GetCredResp{Status: int32(status)}
 	return drpc.Marshal(resp)
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,9 @@ package main
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"errors"
 	"net"
 	"os/user"
@@ -416,3 +420,368 @@ func TestAgent_SecurityCachedCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestAgent_SecurityModule_SetClientUserMap(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	cfg := defaultTestSecurityConfig()
+	cfg.credentials.CacheExpiration = time.Minute
+	origCredCfg := cfg.credentials
+	mod := NewSecurityModule(log, cfg)
+	mod.credCache.cacheMissFn = func(_ context.Context, req *auth.CredentialRequest) (*auth.Credential, error) {
+		return &auth.Credential{Origin: "test-origin"}, nil
+	}
+
+	req := &auth.CredentialRequest{
+		DomainInfo: security.InitDomainInfo(&syscall.Ucred{Uid: 1234, Gid: 5678}, ""),
+	}
+	if _, err := mod.credCache.getSignedCredential(test.Context(t), req); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, len(mod.credCache.list(test.Context(t))), "")
+
+	mod.SetClientUserMap(security.ClientUserMap{
+		1234: &security.MappedClientUser{User: "mapped"},
+	})
+
+	test.AssertEqual(t, 0, len(mod.credCache.list(test.Context(t))), "cache should have been flushed")
+	test.AssertEqual(t, "mapped", mod.clientUserMap().Lookup(1234).User, "")
+	test.AssertEqual(t, 0, len(origCredCfg.ClientUserMap), "original config should not be modified")
+	test.AssertEqual(t, time.Minute, mod.config.credentials.CacheExpiration, "")
+}
+
+type mockIdentityProvider struct {
+	name  string
+	id    *auth.Identity
+	err   error
+	calls int
+}
+
+func (p *mockIdentityProvider) Name() string {
+	return p.name
+}
+
+func (p *mockIdentityProvider) GetIdentity(_ context.Context, _ *security.DomainInfo) (*auth.Identity, error) {
+	p.calls++
+	return p.id, p.err
+}
+
+func TestAgent_SecurityModule_identityCredential(t *testing.T) {
+	for name, tc := range map[string]struct {
+		providers      []*mockIdentityProvider
+		lifetime       time.Duration
+		expErr         error
+		expUser        string
+		expGroups      []string
+		expCalls       []int
+		expCachedCalls []int
+	}{
+		"no providers": {
+			expErr: auth.ErrNoIdentity,
+		},
+		"first provider wins": {
+			providers: []*mockIdentityProvider{
+				{name: "p1", id: &auth.Identity{User: "alice", Group: "users", Groups: []string{"proj1"}}},
+				{name: "p2", id: &auth.Identity{User: "bob", Group: "users"}},
+			},
+			expUser:   "alice",
+			expGroups: []string{"proj1"},
+			expCalls:  []int{1, 0},
+		},
+		"falls through on no identity": {
+			providers: []*mockIdentityProvider{
+				{name: "p1", err: auth.ErrNoIdentity},
+				{name: "p2", id: &auth.Identity{User: "bob", Group: "users"}},
+			},
+			expUser:  "bob",
+			expCalls: []int{1, 1},
+		},
+		"no provider has identity": {
+			providers: []*mockIdentityProvider{
+				{name: "p1", err: auth.ErrNoIdentity},
+				{name: "p2", err: auth.ErrNoIdentity},
+			},
+			expErr:   auth.ErrNoIdentity,
+			expCalls: []int{1, 1},
+		},
+		"provider error stops lookup": {
+			providers: []*mockIdentityProvider{
+				{name: "p1", err: errors.New("helper failed")},
+				{name: "p2", id: &auth.Identity{User: "bob", Group: "users"}},
+			},
+			expErr:   errors.New("helper failed"),
+			expCalls: []int{1, 0},
+		},
+		"cached": {
+			providers: []*mockIdentityProvider{
+				{name: "p1", err: auth.ErrNoIdentity},
+				{name: "p2", id: &auth.Identity{User: "bob", Group: "users"}},
+			},
+			lifetime:       time.Minute,
+			expUser:        "bob",
+			expCalls:       []int{1, 1},
+			expCachedCalls: []int{2, 1},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := defaultTestSecurityConfig()
+			for _, prov := range tc.providers {
+				cfg.identities = append(cfg.identities, &identitySource{
+					IdentityProvider: prov,
+					credLifetime:     tc.lifetime,
+				})
+			}
+			mod := NewSecurityModule(log, cfg)
+
+			var signs int
+			signFn := func(ctx context.Context, req *auth.CredentialRequest) (*auth.Credential, error) {
+				signs++
+				return auth.GetSignedCredential(ctx, req)
+			}
+			mod.signIdentity = signFn
+			if mod.credCache != nil {
+				mod.credCache.cacheMissFn = signFn
+			}
+
+			getCred := func() (*auth.Credential, error) {
+				req := auth.NewCredentialRequest(security.InitDomainInfo(&syscall.Ucred{Uid: 1234, Gid: 5678}, ""), nil)
+				return mod.identityCredential(test.Context(t), req)
+			}
+			getCalls := func() []int {
+				var calls []int
+				for _, prov := range tc.providers {
+					calls = append(calls, prov.calls)
+				}
+				return calls
+			}
+
+			cred, err := getCred()
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expCalls, getCalls()); diff != "" {
+				t.Fatalf("unexpected provider calls (-want, +got):\n%s\n", diff)
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			sys, err := auth.AuthSysFromAuthToken(cred.Token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expUser+"@", sys.User, "")
+			test.AssertEqual(t, "users@", sys.Group, "")
+			var expGroups []string
+			for _, grp := range tc.expGroups {
+				expGroups = append(expGroups, grp+"@")
+			}
+			if diff := cmp.Diff(expGroups, sys.Groups); diff != "" {
+				t.Fatalf("unexpected groups (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.expCachedCalls == nil {
+				return
+			}
+
+			// Identities that were found are served from the cache.
+			if _, err := getCred(); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expCachedCalls, getCalls()); diff != "" {
+				t.Fatalf("unexpected provider calls (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, 1, signs, "credential should have been cached")
+		})
+	}
+}
+
+func TestAgent_SecurityModule_RequestCreds_IdentityProvider(t *testing.T) {
+	for name, tc := range map[string]struct {
+		provider   *mockIdentityProvider
+		expStatus  int32
+		expCred    bool
+		expSigners int
+	}{
+		"identity from provider": {
+			provider: &mockIdentityProvider{name: "p1", id: &auth.Identity{User: "alice", Group: "users"}},
+			expCred:  true,
+		},
+		"no identity falls back": {
+			provider:   &mockIdentityProvider{name: "p1", err: auth.ErrNoIdentity},
+			expCred:    true,
+			expSigners: 1,
+		},
+		"provider error": {
+			provider:  &mockIdentityProvider{name: "p1", err: errors.New("helper failed")},
+			expStatus: int32(daos.MiscError),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			conn, cleanup := setupTestUnixConn(t)
+			defer cleanup()
+
+			cfg := defaultTestSecurityConfig()
+			cfg.identities = []*identitySource{{IdentityProvider: tc.provider}}
+			mod := NewSecurityModule(log, cfg)
+			mod.signIdentity = func(_ context.Context, _ *auth.CredentialRequest) (*auth.Credential, error) {
+				return &auth.Credential{Origin: "identity"}, nil
+			}
+			var signers int
+			mod.signCredential = func(_ context.Context, _ *auth.CredentialRequest) (*auth.Credential, error) {
+				signers++
+				return &auth.Credential{Origin: "local"}, nil
+			}
+
+			respBytes, err := callRequestCreds(mod, t, log, conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectCredResp(t, respBytes, tc.expStatus, tc.expCred)
+			test.AssertEqual(t, tc.expSigners, signers, "")
+		})
+	}
+}
+
+type mockTokenProvider struct {
+	token string
+	err   error
+	calls int
+}
+
+func (p *mockTokenProvider) GetToken(_ context.Context, _ *security.DomainInfo) (string, error) {
+	p.calls++
+	return p.token, p.err
+}
+
+func TestAgent_SecurityModule_RequestCreds_TokenProvider(t *testing.T) {
+	for name, tc := range map[string]struct {
+		tokens    auth.TokenProvider
+		expStatus int32
+		expOrigin string
+		expToken  string
+	}{
+		"no token provider": {
+			expOrigin: "local",
+		},
+		"bearer token": {
+			tokens:    &mockTokenProvider{token: "header.claims.sig"},
+			expOrigin: "bearer",
+			expToken:  "header.claims.sig",
+		},
+		"no token falls back": {
+			tokens:    &mockTokenProvider{err: auth.ErrNoToken},
+			expOrigin: "local",
+		},
+		"token provider error": {
+			tokens:    &mockTokenProvider{err: errors.New("helper failed")},
+			expStatus: int32(daos.MiscError),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			conn, cleanup := setupTestUnixConn(t)
+			defer cleanup()
+
+			cfg := defaultTestSecurityConfig()
+			cfg.tokens = tc.tokens
+			mod := NewSecurityModule(log, cfg)
+			var gotToken string
+			mod.signBearer = func(_ context.Context, _ *auth.CredentialRequest, token string) (*auth.Credential, error) {
+				gotToken = token
+				return &auth.Credential{Origin: "bearer"}, nil
+			}
+			mod.signCredential = func(_ context.Context, _ *auth.CredentialRequest) (*auth.Credential, error) {
+				return &auth.Credential{Origin: "local"}, nil
+			}
+
+			respBytes, err := callRequestCreds(mod, t, log, conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp := &auth.GetCredResp{}
+			if err := proto.Unmarshal(respBytes, resp); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expStatus, resp.Status, "")
+			test.AssertEqual(t, tc.expOrigin, resp.GetCred().GetOrigin(), "")
+			test.AssertEqual(t, tc.expToken, gotToken, "")
+		})
+	}
+}
+
+func TestAgent_SecurityModule_RequestCreds_TokenCache(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newToken := func(t *testing.T, expires time.Duration) string {
+		t.Helper()
+
+		token, err := auth.NewBearerToken(key, &auth.BearerClaims{
+			Issuer:    "test",
+			Subject:   "alice",
+			ExpiresAt: time.Now().Add(expires).Unix(),
+			Group:     "users",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	for name, tc := range map[string]struct {
+		token    func(t *testing.T) string
+		expCalls int
+	}{
+		"cached until shortly before expiry": {
+			token:    func(t *testing.T) string { return newToken(t, time.Hour) },
+			expCalls: 1,
+		},
+		"expires too soon to cache": {
+			token:    func(t *testing.T) string { return newToken(t, bearerRefreshMargin/2) },
+			expCalls: 2,
+		},
+		"unknown expiry": {
+			token:    func(*testing.T) string { return "header.claims.sig" },
+			expCalls: 2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			conn, cleanup := setupTestUnixConn(t)
+			defer cleanup()
+
+			tokens := &mockTokenProvider{token: tc.token(t)}
+			cfg := defaultTestSecurityConfig()
+			cfg.tokens = tokens
+			mod := NewSecurityModule(log, cfg)
+			mod.signBearer = func(_ context.Context, _ *auth.CredentialRequest, token string) (*auth.Credential, error) {
+				return &auth.Credential{Origin: "bearer"}, nil
+			}
+
+			for i := 0; i < 2; i++ {
+				respBytes, err := callRequestCreds(mod, t, log, conn)
+				if err != nil {
+					t.Fatal(err)
+				}
+				resp := &auth.GetCredResp{}
+				if err := proto.Unmarshal(respBytes, resp); err != nil {
+					t.Fatal(err)
+				}
+				test.AssertEqual(t, "bearer", resp.GetCred().GetOrigin(), "")
+			}
+			test.AssertEqual(t, tc.expCalls, tokens.calls, "unexpected token provider calls")
+		})
+	}
+}
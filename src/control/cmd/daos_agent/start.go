@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -24,6 +25,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/hardware/hwloc"
 	"github.com/daos-stack/daos/src/control/lib/systemd"
 	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
+	"github.com/daos-stack/daos/src/control/security"
 )
 
 type ctxKey string
@@ -45,6 +47,11 @@ type startCmd struct {
 	cmdutil.LogCmd
 	configCmd
 	ctlInvokerCmd
+	loadConfig configLoaderFn
+}
+
+func (cmd *startCmd) setConfigLoader(fn configLoaderFn) {
+	cmd.loadConfig = fn
 }
 
 func (cmd *startCmd) Execute(_ []string) error {
@@ -88,9 +95,17 @@ func (cmd *startCmd) Execute(_ []string) error {
 
 	procmonStart := time.Now()
 	procmon := NewProcMon(cmd.Logger, cmd.ctlInvoker, cmd.cfg.SystemName)
+	procmon.SetInterfaceConnectFunc(cache.InterfaceConnected)
 	procmon.startMonitoring(ctx, cmd.cfg.EvictOnStart)
 	cmd.Debugf("started process monitor: %s", time.Since(procmonStart))
 
+	certMon := security.NewCertMonitor(cmd.Logger, cmd.cfg.TransportConfig)
+	certMon.Start(ctx)
+
+	cache.SetInterfaceLoadFunc(procmon.InterfaceLoad)
+	cache.MonitorFabricHealth(ctx, cmd.cfg.FabricHealthPeriod)
+	cache.MonitorAccessPoints(ctx, defaultAPProbeInterval)
+
 	var clientMetricSource *promexp.ClientSource
 	if cmd.cfg.TelemetryExportEnabled() {
 		if ctx, clientMetricSource, err = promexp.NewClientSource(ctx); err != nil {
@@ -106,11 +121,22 @@ func (cmd *startCmd) Execute(_ []string) error {
 	}
 
 	drpcRegStart := time.Now()
+	identities, err := newIdentitySources(cmd.cfg.CredentialConfig)
+	if err != nil {
+		return errors.Wrap(err, "unable to load identity providers")
+	}
+	tokens, err := newTokenProvider(cmd.cfg.CredentialConfig)
+	if err != nil {
+		return errors.Wrap(err, "unable to create token provider")
+	}
 	secCfg := &securityConfig{
 		transport:   cmd.cfg.TransportConfig,
 		credentials: cmd.cfg.CredentialConfig,
+		identities:  identities,
+		tokens:      tokens,
 	}
-	drpcServer.RegisterRPCModule(NewSecurityModule(cmd.Logger, secCfg))
+	secMod := NewSecurityModule(cmd.Logger, secCfg)
+	drpcServer.RegisterRPCModule(secMod)
 	mgmtMod := &mgmtModule{
 		log:           cmd.Logger,
 		sys:           cmd.cfg.SystemName,
@@ -122,6 +148,21 @@ func (cmd *startCmd) Execute(_ []string) error {
 		cliMetricsSrc: clientMetricSource,
 	}
 	drpcServer.RegisterRPCModule(mgmtMod)
+	reloader := &configReloader{
+		log:        cmd.Logger,
+		cfg:        cmd.cfg,
+		loadConfig: cmd.loadConfig,
+		ctlInvoker: cmd.ctlInvoker,
+		cache:      cache,
+		secMod:     secMod,
+	}
+	drpcServer.RegisterRPCModule(&adminModule{
+		log:       cmd.Logger,
+		monitor:   procmon,
+		credCache: secMod.credCache,
+		reloader:  reloader,
+		agentUid:  uint32(os.Getuid()),
+	})
 	cmd.Debugf("registered dRPC modules: %s", time.Since(drpcRegStart))
 
 	hwlocStart := time.Now()
@@ -151,7 +192,8 @@ func (cmd *startCmd) Execute(_ []string) error {
 	signals := make(chan os.Signal)
 	finish := make(chan struct{})
 
-	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGPIPE, syscall.SIGUSR1, syscall.SIGUSR2)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGPIPE, syscall.SIGUSR1, syscall.SIGUSR2,
+		syscall.SIGHUP)
 	// Anonymous goroutine to wait on the signals channel and tell the
 	// program to finish when it receives a signal. Since we notify on
 	// SIGINT and SIGTERM we should only catch these on a kill or ctrl+c
@@ -170,6 +212,12 @@ func (cmd *startCmd) Execute(_ []string) error {
 			case syscall.SIGUSR2:
 				cmd.Infof("Signal received. Caught %s; refreshing caches", sig)
 				mgmtMod.RefreshCache(ctx)
+			case syscall.SIGHUP:
+				cmd.Infof("Signal received. Caught %s; reloading configuration", sig)
+				if _, err := reloader.reload(ctx); err != nil {
+					cmd.Errorf("failed to reload configuration: %s", err)
+				}
+				certMon.Check()
 			default:
 				shutdownRcvd = time.Now()
 				cmd.Infof("Signal received.  Caught %s; shutting down", sig)
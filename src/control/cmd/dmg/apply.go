@@ -0,0 +1,540 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+)
+
+type (
+	// poolManifest describes the desired state of a single DAOS pool. Storage sizing, ranks
+	// and ownership are only used when the pool is created; properties and ACL entries are
+	// reconciled against existing pools.
+	poolManifest struct {
+		Label      string            `yaml:"label"`
+		Size       string            `yaml:"size,omitempty"`
+		TierRatio  string            `yaml:"tier_ratio,omitempty"`
+		NumRanks   uint32            `yaml:"nranks,omitempty"`
+		ScmSize    string            `yaml:"scm_size,omitempty"`
+		NVMeSize   string            `yaml:"nvme_size,omitempty"`
+		Ranks      string            `yaml:"ranks,omitempty"`
+		NumSvcReps uint32            `yaml:"nsvc,omitempty"`
+		User       string            `yaml:"user,omitempty"`
+		Group      string            `yaml:"group,omitempty"`
+		Properties map[string]string `yaml:"properties,omitempty"`
+		ACL        []string          `yaml:"acl,omitempty"`
+	}
+
+	// systemManifest describes the desired state of DAOS system properties.
+	systemManifest struct {
+		Properties map[string]string `yaml:"properties,omitempty"`
+	}
+
+	// clusterManifest describes the desired state of a DAOS system and its pools.
+	clusterManifest struct {
+		System systemManifest  `yaml:"system,omitempty"`
+		Pools  []*poolManifest `yaml:"pools,omitempty"`
+	}
+)
+
+// readClusterManifest reads and validates a cluster manifest from the supplied path.
+func readClusterManifest(path string) (*clusterManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading manifest")
+	}
+
+	return parseClusterManifest(data)
+}
+
+// parseClusterManifest parses and validates a YAML cluster manifest.
+func parseClusterManifest(data []byte) (*clusterManifest, error) {
+	cm := new(clusterManifest)
+	if err := yaml.UnmarshalStrict(data, cm); err != nil {
+		return nil, errors.Wrap(err, "parsing manifest")
+	}
+
+	labels := make(map[string]struct{})
+	for i, pm := range cm.Pools {
+		if pm == nil || pm.Label == "" {
+			return nil, errors.Errorf("pool %d in manifest has no label", i)
+		}
+		if _, exists := labels[pm.Label]; exists {
+			return nil, errors.Errorf("duplicate pool label %q in manifest", pm.Label)
+		}
+		labels[pm.Label] = struct{}{}
+	}
+
+	return cm, nil
+}
+
+// poolProps converts the manifest pool properties into a sorted list of DAOS pool properties.
+func (pm *poolManifest) poolProps() ([]*daos.PoolProperty, error) {
+	propHdlrs := daos.PoolProperties()
+	deprecated := daos.PoolDeprecatedProperties()
+
+	keys := make([]string, 0, len(pm.Properties))
+	for key := range pm.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	props := make([]*daos.PoolProperty, 0, len(keys))
+	for _, key := range keys {
+		name := key
+		if newName, found := deprecated[key]; found {
+			name = newName
+		}
+		if name == "label" {
+			return nil, errors.Errorf("pool %q: label must not be set in properties",
+				pm.Label)
+		}
+		hdlr, found := propHdlrs[name]
+		if !found {
+			return nil, errors.Errorf("pool %q: unknown property %q", pm.Label, key)
+		}
+		p := hdlr.GetProperty(name)
+		if err := p.SetValue(pm.Properties[key]); err != nil {
+			return nil, errors.Wrapf(err, "pool %q: property %q", pm.Label, key)
+		}
+		props = append(props, p)
+	}
+
+	return props, nil
+}
+
+// createReq builds a pool create request from the manifest using the same sizing rules as
+// the dmg pool create command.
+func (pm *poolManifest) createReq() (*control.PoolCreateReq, error) {
+	props, err := pm.poolProps()
+	if err != nil {
+		return nil, err
+	}
+	labelProp := daos.PoolProperties()["label"].GetProperty("label")
+	if err := labelProp.SetValue(pm.Label); err != nil {
+		return nil, errors.Wrapf(err, "pool %q", pm.Label)
+	}
+
+	req := &control.PoolCreateReq{
+		NumSvcReps: pm.NumSvcReps,
+		Properties: append([]*daos.PoolProperty{labelProp}, props...),
+	}
+
+	var user, group ui.ACLPrincipalFlag
+	if pm.User != "" {
+		if err := user.UnmarshalFlag(pm.User); err != nil {
+			return nil, errors.Wrapf(err, "pool %q", pm.Label)
+		}
+	}
+	if pm.Group != "" {
+		if err := group.UnmarshalFlag(pm.Group); err != nil {
+			return nil, errors.Wrapf(err, "pool %q", pm.Label)
+		}
+	}
+	req.User = user.String()
+	req.UserGroup = group.String()
+
+	if pm.Ranks != "" {
+		var ranks ui.RankSetFlag
+		if err := ranks.UnmarshalFlag(pm.Ranks); err != nil {
+			return nil, errors.Wrapf(err, "pool %q", pm.Label)
+		}
+		req.Ranks = ranks.Ranks()
+	}
+
+	if len(pm.ACL) > 0 {
+		req.ACL = &control.AccessControlList{Entries: pm.ACL}
+	}
+
+	manual := pm.ScmSize != "" || pm.NVMeSize != ""
+	switch {
+	case manual && pm.Size != "":
+		return nil, errors.Errorf("pool %q: size may not be mixed with scm_size or "+
+			"nvme_size", pm.Label)
+	case manual:
+		if pm.ScmSize == "" {
+			return nil, errors.Errorf("pool %q: nvme_size cannot be set without "+
+				"scm_size", pm.Label)
+		}
+		if pm.NumRanks > 0 || pm.TierRatio != "" {
+			return nil, errors.Errorf("pool %q: nranks and tier_ratio may not be "+
+				"mixed with scm_size", pm.Label)
+		}
+		var scm, nvme ui.ByteSizeFlag
+		if err := scm.UnmarshalFlag(pm.ScmSize); err != nil {
+			return nil, errors.Wrapf(err, "pool %q: scm_size", pm.Label)
+		}
+		if pm.NVMeSize != "" {
+			if err := nvme.UnmarshalFlag(pm.NVMeSize); err != nil {
+				return nil, errors.Wrapf(err, "pool %q: nvme_size", pm.Label)
+			}
+		}
+		req.TierBytes = []uint64{scm.Bytes, nvme.Bytes}
+	case pm.Size != "":
+		var size poolSizeFlag
+		if err := size.UnmarshalFlag(pm.Size); err != nil {
+			return nil, errors.Wrapf(err, "pool %q: size", pm.Label)
+		}
+		if size.IsRatio() {
+			if pm.NumRanks > 0 || pm.TierRatio != "" {
+				return nil, errors.Errorf("pool %q: nranks and tier_ratio may not "+
+					"be mixed with a percentage size", pm.Label)
+			}
+			availFrac := float64(size.availRatio) / 100.0
+			req.TierRatio = []float64{availFrac, availFrac}
+			break
+		}
+		if pm.NumRanks > 0 && len(req.Ranks) > 0 {
+			return nil, errors.Errorf("pool %q: nranks may not be mixed with ranks",
+				pm.Label)
+		}
+		var tierRatio tierRatioFlag
+		if pm.TierRatio != "" {
+			if err := tierRatio.UnmarshalFlag(pm.TierRatio); err != nil {
+				return nil, errors.Wrapf(err, "pool %q: tier_ratio", pm.Label)
+			}
+		}
+		req.NumRanks = pm.NumRanks
+		req.TierRatio = tierRatio.Ratios()
+		req.TotalBytes = size.Bytes
+	default:
+		return nil, errors.Errorf("pool %q: one of size or scm_size must be set", pm.Label)
+	}
+
+	return req, nil
+}
+
+// systemProps converts the manifest system properties into a map of DAOS system properties.
+func (sm *systemManifest) systemProps() (map[daos.SystemPropertyKey]daos.SystemPropertyValue, error) {
+	sysProps := daos.SystemProperties()
+	props := make(map[daos.SystemPropertyKey]daos.SystemPropertyValue)
+	for key, val := range sm.Properties {
+		prop, ok := sysProps.Get(key)
+		if !ok {
+			return nil, errors.Errorf("invalid system property key: %s", key)
+		}
+		if err := prop.Value.Handler(val); err != nil {
+			return nil, errors.Wrapf(err, "invalid system property value for %s", key)
+		}
+		props[prop.Key] = prop.Value
+	}
+
+	return props, nil
+}
+
+type (
+	// applyAction describes a single change required to bring the system to the state
+	// described in a cluster manifest.
+	applyAction struct {
+		Kind        string `json:"kind"`
+		Target      string `json:"target"`
+		Description string `json:"description"`
+
+		poolCreate *control.PoolCreateReq
+		poolProps  *control.PoolSetPropReq
+		poolACL    *control.PoolUpdateACLReq
+		sysProps   *control.SystemSetPropReq
+	}
+
+	// applyPlan is the ordered set of actions needed to apply a cluster manifest.
+	applyPlan struct {
+		Actions []*applyAction `json:"actions"`
+	}
+)
+
+const (
+	applyKindSetSystemProp = "set-system-prop"
+	applyKindCreatePool    = "create-pool"
+	applyKindSetPoolProp   = "set-pool-prop"
+	applyKindUpdatePoolACL = "update-pool-acl"
+)
+
+func (act *applyAction) String() string {
+	return fmt.Sprintf("%s %s: %s", act.Kind, act.Target, act.Description)
+}
+
+func (plan *applyPlan) String() string {
+	if len(plan.Actions) == 0 {
+		return "No changes required, system matches manifest\n"
+	}
+
+	var bld strings.Builder
+	fmt.Fprintf(&bld, "Plan: %d change(s)\n", len(plan.Actions))
+	for _, act := range plan.Actions {
+		fmt.Fprintf(&bld, "  %s\n", act)
+	}
+
+	return bld.String()
+}
+
+func propsString(props []*daos.PoolProperty) string {
+	strs := make([]string, 0, len(props))
+	for _, p := range props {
+		strs = append(strs, p.String())
+	}
+	return strings.Join(strs, ",")
+}
+
+// planSystemProps compares the desired system properties against the current values and
+// adds an action to the plan for any that differ.
+func planSystemProps(ctx context.Context, rpcClient control.UnaryInvoker, sm *systemManifest, plan *applyPlan) error {
+	if len(sm.Properties) == 0 {
+		return nil
+	}
+
+	desired, err := sm.systemProps()
+	if err != nil {
+		return err
+	}
+
+	getReq := new(control.SystemGetPropReq)
+	for key := range desired {
+		getReq.Keys = append(getReq.Keys, key)
+	}
+	getResp, err := control.SystemGetProp(ctx, rpcClient, getReq)
+	if err != nil {
+		return errors.Wrap(err, "system get-prop failed")
+	}
+	current := make(map[daos.SystemPropertyKey]string)
+	for _, prop := range getResp.Properties {
+		current[prop.Key] = prop.Value.String()
+	}
+
+	setReq := &control.SystemSetPropReq{
+		Properties: make(map[daos.SystemPropertyKey]daos.SystemPropertyValue),
+	}
+	var changes []string
+	for key, val := range desired {
+		if cur, found := current[key]; found && cur == val.String() {
+			continue
+		}
+		setReq.Properties[key] = val
+		changes = append(changes, fmt.Sprintf("%s:%s", key, val))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	sort.Strings(changes)
+
+	plan.Actions = append(plan.Actions, &applyAction{
+		Kind:        applyKindSetSystemProp,
+		Target:      "system",
+		Description: strings.Join(changes, ","),
+		sysProps:    setReq,
+	})
+
+	return nil
+}
+
+// planPool compares the desired state of a pool against the current state and adds actions
+// to the plan to create the pool or to update its properties and ACL.
+func planPool(ctx context.Context, rpcClient control.UnaryInvoker, pm *poolManifest, exists bool, plan *applyPlan) error {
+	if !exists {
+		req, err := pm.createReq()
+		if err != nil {
+			return err
+		}
+		desc := "new pool"
+		var details []string
+		if len(pm.Properties) > 0 {
+			details = append(details, "properties "+propsString(req.Properties[1:]))
+		}
+		if len(pm.ACL) > 0 {
+			details = append(details, fmt.Sprintf("%d ACL entries", len(pm.ACL)))
+		}
+		if len(details) > 0 {
+			desc += " with " + strings.Join(details, " and ")
+		}
+		plan.Actions = append(plan.Actions, &applyAction{
+			Kind:        applyKindCreatePool,
+			Target:      pm.Label,
+			Description: desc,
+			poolCreate:  req,
+		})
+		return nil
+	}
+
+	desired, err := pm.poolProps()
+	if err != nil {
+		return err
+	}
+	if len(desired) > 0 {
+		// PoolGetProp fills in the values of the properties it is given, so
+		// query with empty copies to keep the desired values intact.
+		propHdlrs := daos.PoolProperties()
+		toGet := make([]*daos.PoolProperty, 0, len(desired))
+		for _, p := range desired {
+			toGet = append(toGet, propHdlrs[p.Name].GetProperty(p.Name))
+		}
+		current, err := control.PoolGetProp(ctx, rpcClient, &control.PoolGetPropReq{
+			ID:         pm.Label,
+			Properties: toGet,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "pool %q get-prop failed", pm.Label)
+		}
+		curVals := make(map[string]string)
+		for _, p := range current {
+			curVals[p.Name] = p.StringValue()
+		}
+
+		var toSet []*daos.PoolProperty
+		for _, p := range desired {
+			if cur, found := curVals[p.Name]; found && cur == p.StringValue() {
+				continue
+			}
+			toSet = append(toSet, p)
+		}
+		if len(toSet) > 0 {
+			plan.Actions = append(plan.Actions, &applyAction{
+				Kind:        applyKindSetPoolProp,
+				Target:      pm.Label,
+				Description: propsString(toSet),
+				poolProps: &control.PoolSetPropReq{
+					ID:         pm.Label,
+					Properties: toSet,
+				},
+			})
+		}
+	}
+
+	if len(pm.ACL) > 0 {
+		aclResp, err := control.PoolGetACL(ctx, rpcClient, &control.PoolGetACLReq{
+			ID: pm.Label,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "pool %q get-acl failed", pm.Label)
+		}
+		diff := control.DiffACL(aclResp.ACL, &control.AccessControlList{Entries: pm.ACL})
+		if missing := diff.Updates(); len(missing) > 0 {
+			plan.Actions = append(plan.Actions, &applyAction{
+				Kind:        applyKindUpdatePoolACL,
+				Target:      pm.Label,
+				Description: strings.Join(missing, ","),
+				poolACL: &control.PoolUpdateACLReq{
+					ID:  pm.Label,
+					ACL: &control.AccessControlList{Entries: missing},
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+// buildApplyPlan diffs the cluster manifest against the current system state and returns
+// the ordered set of actions needed to reconcile them.
+func buildApplyPlan(ctx context.Context, rpcClient control.UnaryInvoker, cm *clusterManifest) (*applyPlan, error) {
+	plan := new(applyPlan)
+
+	if err := planSystemProps(ctx, rpcClient, &cm.System, plan); err != nil {
+		return nil, err
+	}
+
+	if len(cm.Pools) == 0 {
+		return plan, nil
+	}
+
+	listResp, err := control.ListPools(ctx, rpcClient, &control.ListPoolsReq{NoQuery: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pools failed")
+	}
+	existing := make(map[string]struct{})
+	for _, p := range listResp.Pools {
+		existing[p.Label] = struct{}{}
+	}
+
+	for _, pm := range cm.Pools {
+		_, exists := existing[pm.Label]
+		if err := planPool(ctx, rpcClient, pm, exists, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// apply performs a single planned action.
+func (act *applyAction) apply(ctx context.Context, rpcClient control.UnaryInvoker) error {
+	switch {
+	case act.sysProps != nil:
+		return control.SystemSetProp(ctx, rpcClient, act.sysProps)
+	case act.poolCreate != nil:
+		_, err := control.PoolCreate(ctx, rpcClient, act.poolCreate)
+		return err
+	case act.poolProps != nil:
+		return control.PoolSetProp(ctx, rpcClient, act.poolProps)
+	case act.poolACL != nil:
+		_, err := control.PoolUpdateACL(ctx, rpcClient, act.poolACL)
+		return err
+	default:
+		return errors.Errorf("no request for %s action on %s", act.Kind, act.Target)
+	}
+}
+
+// applyCmd is the struct representing the command to apply a cluster manifest.
+type applyCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	File   string `short:"f" long:"file" required:"1" description:"Path to YAML manifest describing the desired state of the system and its pools"`
+	DryRun bool   `short:"n" long:"dry-run" description:"Print the plan without applying it"`
+}
+
+// Execute is run when applyCmd activates.
+func (cmd *applyCmd) Execute(_ []string) error {
+	cm, err := readClusterManifest(cmd.File)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.MustLogCtx()
+	plan, err := buildApplyPlan(ctx, cmd.ctlInvoker, cm)
+	if err != nil {
+		return errors.Wrap(err, "building plan failed")
+	}
+
+	if !cmd.JSONOutputEnabled() {
+		cmd.Info(plan.String())
+	}
+
+	if !cmd.DryRun {
+		for _, act := range plan.Actions {
+			if err = act.apply(ctx, cmd.ctlInvoker); err != nil {
+				err = errors.Wrapf(err, "%s %s failed", act.Kind, act.Target)
+				break
+			}
+			cmd.Debugf("applied %s", act)
+		}
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(plan, err)
+	}
+
+	if err != nil {
+		return err
+	}
+	if !cmd.DryRun && len(plan.Actions) > 0 {
+		cmd.Info("Apply command succeeded")
+	}
+
+	return nil
+}
@@ -0,0 +1,376 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestDmg_parseClusterManifest(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input  string
+		expCM  *clusterManifest
+		expErr error
+	}{
+		"empty": {
+			expCM: &clusterManifest{},
+		},
+		"unknown field": {
+			input: `
+pools:
+- label: tank
+  sise: 10TB
+`,
+			expErr: errors.New("field sise not found"),
+		},
+		"missing label": {
+			input: `
+pools:
+- size: 10TB
+`,
+			expErr: errors.New("no label"),
+		},
+		"duplicate label": {
+			input: `
+pools:
+- label: tank
+  size: 10TB
+- label: tank
+  size: 20TB
+`,
+			expErr: errors.New("duplicate pool label"),
+		},
+		"valid": {
+			input: `
+system:
+  properties:
+    pool_scrub_mode: lazy
+pools:
+- label: tank
+  size: 10TB
+  ranks: 0-3
+  properties:
+    reclaim: lazy
+  acl:
+  - A::OWNER@:rw
+`,
+			expCM: &clusterManifest{
+				System: systemManifest{
+					Properties: map[string]string{"pool_scrub_mode": "lazy"},
+				},
+				Pools: []*poolManifest{
+					{
+						Label:      "tank",
+						Size:       "10TB",
+						Ranks:      "0-3",
+						Properties: map[string]string{"reclaim": "lazy"},
+						ACL:        []string{"A::OWNER@:rw"},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotCM, gotErr := parseClusterManifest([]byte(tc.input))
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expCM, gotCM); diff != "" {
+				t.Fatalf("unexpected manifest (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDmg_poolManifest_createReq(t *testing.T) {
+	for name, tc := range map[string]struct {
+		pm     *poolManifest
+		expReq *control.PoolCreateReq
+		expErr error
+	}{
+		"no size": {
+			pm:     &poolManifest{Label: "tank"},
+			expErr: errors.New("one of size or scm_size"),
+		},
+		"size and scm_size": {
+			pm:     &poolManifest{Label: "tank", Size: "10TB", ScmSize: "1TB"},
+			expErr: errors.New("may not be mixed"),
+		},
+		"nvme_size without scm_size": {
+			pm:     &poolManifest{Label: "tank", NVMeSize: "1TB"},
+			expErr: errors.New("cannot be set without"),
+		},
+		"percentage size with nranks": {
+			pm:     &poolManifest{Label: "tank", Size: "50%", NumRanks: 2},
+			expErr: errors.New("may not be mixed"),
+		},
+		"unknown property": {
+			pm: &poolManifest{
+				Label:      "tank",
+				Size:       "10TB",
+				Properties: map[string]string{"foo": "bar"},
+			},
+			expErr: errors.New("unknown property"),
+		},
+		"label property": {
+			pm: &poolManifest{
+				Label:      "tank",
+				Size:       "10TB",
+				Properties: map[string]string{"label": "bar"},
+			},
+			expErr: errors.New("label must not be set"),
+		},
+		"bad property value": {
+			pm: &poolManifest{
+				Label:      "tank",
+				Size:       "10TB",
+				Properties: map[string]string{"reclaim": "never"},
+			},
+			expErr: errors.New("invalid"),
+		},
+		"auto total size": {
+			pm: &poolManifest{
+				Label:      "tank",
+				Size:       "10TB",
+				NumRanks:   2,
+				NumSvcReps: 3,
+			},
+			expReq: &control.PoolCreateReq{
+				NumSvcReps: 3,
+				NumRanks:   2,
+				TotalBytes: 10 * humanize.TByte,
+				TierRatio:  defaultTierRatios,
+			},
+		},
+		"auto percentage size": {
+			pm: &poolManifest{
+				Label: "tank",
+				Size:  "50%",
+			},
+			expReq: &control.PoolCreateReq{
+				TierRatio: []float64{0.5, 0.5},
+			},
+		},
+		"manual size": {
+			pm: &poolManifest{
+				Label:    "tank",
+				ScmSize:  "1GiB",
+				NVMeSize: "10GiB",
+				Ranks:    "0-1",
+				User:     "bob",
+				Group:    "admins",
+				ACL:      []string{"A::OWNER@:rw"},
+			},
+			expReq: &control.PoolCreateReq{
+				User:      "bob@",
+				UserGroup: "admins@",
+				Ranks:     []ranklist.Rank{0, 1},
+				TierBytes: []uint64{humanize.GiByte, 10 * humanize.GiByte},
+				ACL:       &control.AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotReq, gotErr := tc.pm.createReq()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, "label:"+tc.pm.Label, gotReq.Properties[0].String(),
+				"unexpected label property")
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(control.PoolCreateReq{}),
+				cmpopts.IgnoreFields(control.PoolCreateReq{}, "Properties"),
+			}
+			if diff := cmp.Diff(tc.expReq, gotReq, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected request (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDmg_buildApplyPlan(t *testing.T) {
+	existingPool := &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{Uuid: test.MockUUID(), Label: "tank"},
+		},
+	}
+	reclaimLazy := &mgmtpb.PoolGetPropResp{
+		Properties: []*mgmtpb.PoolProperty{
+			{
+				Number: daos.PoolPropertySpaceReclaim,
+				Value:  &mgmtpb.PoolProperty_Numval{Numval: daos.PoolSpaceReclaimLazy},
+			},
+		},
+	}
+	ownerACL := &mgmtpb.ACLResp{
+		Acl: &mgmtpb.AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+	}
+
+	for name, tc := range map[string]struct {
+		cm         *clusterManifest
+		responses  []*control.UnaryResponse
+		expActions []*applyAction
+		expErr     error
+	}{
+		"empty manifest": {
+			cm: &clusterManifest{},
+		},
+		"system property unchanged": {
+			cm: &clusterManifest{
+				System: systemManifest{
+					Properties: map[string]string{"pool_scrub_mode": "off"},
+				},
+			},
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, &mgmtpb.SystemGetPropResp{
+					Properties: map[string]string{"pool_scrub_mode": "off"},
+				}),
+			},
+		},
+		"system property changed": {
+			cm: &clusterManifest{
+				System: systemManifest{
+					Properties: map[string]string{"pool_scrub_mode": "lazy"},
+				},
+			},
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, &mgmtpb.SystemGetPropResp{
+					Properties: map[string]string{"pool_scrub_mode": "off"},
+				}),
+			},
+			expActions: []*applyAction{
+				{
+					Kind:        applyKindSetSystemProp,
+					Target:      "system",
+					Description: "pool_scrub_mode:lazy",
+				},
+			},
+		},
+		"list pools fails": {
+			cm: &clusterManifest{
+				Pools: []*poolManifest{{Label: "tank", Size: "10TB"}},
+			},
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"new pool": {
+			cm: &clusterManifest{
+				Pools: []*poolManifest{
+					{
+						Label:      "new",
+						Size:       "10TB",
+						Properties: map[string]string{"reclaim": "time"},
+						ACL:        []string{"A::bob@:r"},
+					},
+				},
+			},
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, existingPool),
+			},
+			expActions: []*applyAction{
+				{
+					Kind:        applyKindCreatePool,
+					Target:      "new",
+					Description: "new pool with properties reclaim:time and 1 ACL entries",
+				},
+			},
+		},
+		"existing pool unchanged": {
+			cm: &clusterManifest{
+				Pools: []*poolManifest{
+					{
+						Label:      "tank",
+						Size:       "10TB",
+						Properties: map[string]string{"reclaim": "lazy"},
+						ACL:        []string{"A::OWNER@:rw"},
+					},
+				},
+			},
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, existingPool),
+				control.MockMSResponse("host1", nil, reclaimLazy),
+				control.MockMSResponse("host1", nil, ownerACL),
+			},
+		},
+		"existing pool changed": {
+			cm: &clusterManifest{
+				Pools: []*poolManifest{
+					{
+						Label:      "tank",
+						Size:       "10TB",
+						Properties: map[string]string{"reclaim": "time"},
+						ACL:        []string{"A::OWNER@:rw", "A::bob@:r"},
+					},
+				},
+			},
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, existingPool),
+				control.MockMSResponse("host1", nil, reclaimLazy),
+				control.MockMSResponse("host1", nil, ownerACL),
+			},
+			expActions: []*applyAction{
+				{
+					Kind:        applyKindSetPoolProp,
+					Target:      "tank",
+					Description: "reclaim:time",
+				},
+				{
+					Kind:        applyKindUpdatePoolACL,
+					Target:      "tank",
+					Description: "A::bob@:r",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponseSet: tc.responses,
+			})
+
+			gotPlan, gotErr := buildApplyPlan(test.Context(t), mi, tc.cm)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(applyAction{}),
+			}
+			if diff := cmp.Diff(tc.expActions, gotPlan.Actions, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected plan (-want, +got):\n%s\n", diff)
+			}
+			for _, act := range gotPlan.Actions {
+				if act.poolCreate == nil && act.poolProps == nil && act.poolACL == nil &&
+					act.sysProps == nil {
+					t.Fatalf("no request for action %s", act)
+				}
+			}
+		})
+	}
+}
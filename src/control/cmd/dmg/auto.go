@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -30,7 +31,9 @@ var confGenRemoteCall confGenRemoteFn = control.ConfGenerateRemote
 
 // configCmd is the struct representing the top-level config subcommand.
 type configCmd struct {
-	Generate configGenCmd `command:"generate" alias:"gen" description:"Generate DAOS server configuration file based on discoverable hardware devices"`
+	Generate    configGenCmd         `command:"generate" alias:"gen" description:"Generate DAOS server configuration file based on discoverable hardware devices"`
+	UseSystem   configUseSystemCmd   `command:"use-system" description:"Select the system from the system profiles file that dmg uses by default"`
+	ListSystems configListSystemsCmd `command:"list-systems" description:"List the systems in the system profiles file"`
 }
 
 type configGenCmd struct {
@@ -0,0 +1,167 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+// certsCmd is the struct representing the top-level certs subcommand. The
+// certs subcommands operate on a local certificate authority and do not
+// connect to the DAOS system.
+type certsCmd struct {
+	InitCA certsInitCACmd `command:"init-ca" description:"Create a certificate authority for DAOS components"`
+	Issue  certsIssueCmd  `command:"issue" description:"Issue a certificate for a DAOS component"`
+	Revoke certsRevokeCmd `command:"revoke" description:"Revoke a certificate issued by the DAOS certificate authority"`
+}
+
+type certsCACmd struct {
+	baseCmd
+	cmdutil.JSONOutputCmd
+	CADir string `long:"ca-dir" default:"./daosCA" description:"Directory containing the certificate authority"`
+}
+
+func daysToDuration(days uint) time.Duration {
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// certsInitCACmd is the struct representing the command to create a
+// certificate authority.
+type certsInitCACmd struct {
+	certsCACmd
+	Days uint `long:"days" default:"1095" description:"Number of days the CA certificate is valid for"`
+}
+
+// Execute is run when certsInitCACmd activates.
+func (cmd *certsInitCACmd) Execute(_ []string) error {
+	ca, err := security.InitCA(cmd.CADir, daysToDuration(cmd.Days))
+	if err != nil {
+		return errors.Wrap(err, "unable to create certificate authority")
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(struct {
+			CACert string `json:"ca_cert"`
+			CRL    string `json:"crl"`
+		}{
+			CACert: ca.CACertPath(),
+			CRL:    ca.CRLPath(),
+		}, nil)
+	}
+
+	var bld strings.Builder
+	fmt.Fprintf(&bld, "Created DAOS certificate authority in %s\n", cmd.CADir)
+	fmt.Fprintf(&bld, "  CA certificate: %s\n", ca.CACertPath())
+	fmt.Fprintf(&bld, "  Certificate revocation list: %s\n", ca.CRLPath())
+	fmt.Fprintf(&bld, "Distribute the CA certificate to all DAOS hosts, and keep the CA private key secure.")
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// certsIssueCmd is the struct representing the command to issue a certificate
+// for a DAOS component.
+type certsIssueCmd struct {
+	certsCACmd
+	Component string   `long:"component" required:"1" choice:"server" choice:"agent" choice:"admin" description:"DAOS component the certificate is for"`
+	Hosts     []string `long:"host" description:"Hostname or IP address to include in the certificate (may be repeated)"`
+	Roles     []string `long:"role" description:"Access policy role to assign to an admin certificate (may be repeated)"`
+	OutDir    string   `long:"out-dir" description:"Directory to write the certificate and key to (default: per-host directory in the certs directory of the CA)"`
+	Days      uint     `long:"days" default:"1095" description:"Number of days the certificate is valid for"`
+	Force     bool     `long:"force" description:"Overwrite an existing certificate and key"`
+}
+
+// Execute is run when certsIssueCmd activates.
+func (cmd *certsIssueCmd) Execute(_ []string) error {
+	comp := security.CommonNameToComponent(cmd.Component)
+	if comp == security.ComponentUndefined {
+		return errors.Errorf("invalid component %q", cmd.Component)
+	}
+
+	ca, err := security.LoadCA(cmd.CADir)
+	if err != nil {
+		return err
+	}
+
+	ic, err := ca.Issue(comp, cmd.Hosts, cmd.Roles, cmd.OutDir, daysToDuration(cmd.Days), cmd.Force)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			err = errors.Wrap(err, "use --force to overwrite")
+		}
+		return errors.Wrapf(err, "unable to issue %s certificate", cmd.Component)
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(ic, nil)
+	}
+
+	var bld strings.Builder
+	fmt.Fprintf(&bld, "Issued %s certificate with serial %s, valid until %s\n", ic.Component, ic.Serial,
+		ic.NotAfter.UTC().Format(time.RFC3339))
+	if len(ic.Roles) > 0 {
+		fmt.Fprintf(&bld, "  Roles: %s\n", strings.Join(ic.Roles, ", "))
+	}
+	fmt.Fprintf(&bld, "  Certificate: %s\n", ic.CertPath)
+	fmt.Fprintf(&bld, "  Key: %s\n", ic.KeyPath)
+	fmt.Fprintf(&bld, "  CA certificate: %s", ca.CACertPath())
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// certsRevokeCmd is the struct representing the command to revoke a
+// certificate.
+type certsRevokeCmd struct {
+	certsCACmd
+	Serial   string `long:"serial" description:"Serial number of the certificate to revoke"`
+	CertPath string `long:"cert" description:"Path of the certificate to revoke"`
+}
+
+// Execute is run when certsRevokeCmd activates.
+func (cmd *certsRevokeCmd) Execute(_ []string) error {
+	if (cmd.Serial == "") == (cmd.CertPath == "") {
+		return errors.New("exactly one of --serial or --cert must be supplied")
+	}
+
+	serial := cmd.Serial
+	if cmd.CertPath != "" {
+		cert, err := security.LoadCertificate(cmd.CertPath)
+		if err != nil {
+			return errors.Wrapf(err, "unable to load certificate %s", cmd.CertPath)
+		}
+		serial = cert.SerialNumber.String()
+	}
+
+	ca, err := security.LoadCA(cmd.CADir)
+	if err != nil {
+		return err
+	}
+
+	ic, err := ca.Revoke(serial)
+	if err != nil {
+		return errors.Wrap(err, "unable to revoke certificate")
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(ic, nil)
+	}
+
+	var bld strings.Builder
+	fmt.Fprintf(&bld, "Revoked %s certificate with serial %s\n", ic.Component, ic.Serial)
+	fmt.Fprintf(&bld, "Distribute %s to all DAOS hosts configured with a certificate revocation list.", ca.CRLPath())
+	cmd.Info(bld.String())
+
+	return nil
+}
@@ -0,0 +1,107 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestDmg_CertsCmd(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	caDir := filepath.Join(dir, "daosCA")
+	outDir := filepath.Join(dir, "admin")
+
+	// The commands build on each other, so they are run in order.
+	for _, tc := range []struct {
+		desc   string
+		cmd    string
+		expErr error
+	}{
+		{
+			desc:   "issue without CA",
+			cmd:    fmt.Sprintf("certs issue --ca-dir %s --component admin", caDir),
+			expErr: errors.New("loading CA certificate"),
+		},
+		{
+			desc: "init CA",
+			cmd:  fmt.Sprintf("certs init-ca --ca-dir %s", caDir),
+		},
+		{
+			desc:   "init CA again",
+			cmd:    fmt.Sprintf("certs init-ca --ca-dir %s", caDir),
+			expErr: errors.New("already initialized"),
+		},
+		{
+			desc:   "issue invalid component",
+			cmd:    fmt.Sprintf("certs issue --ca-dir %s --component engine", caDir),
+			expErr: errors.New("Invalid value"),
+		},
+		{
+			desc: "issue admin certificate",
+			cmd:  fmt.Sprintf("certs issue --ca-dir %s --component admin --host admin-1 --role read-only --out-dir %s", caDir, outDir),
+		},
+		{
+			desc:   "issue admin certificate again",
+			cmd:    fmt.Sprintf("certs issue --ca-dir %s --component admin --out-dir %s", caDir, outDir),
+			expErr: errors.New("use --force to overwrite"),
+		},
+		{
+			desc: "reissue admin certificate",
+			cmd:  fmt.Sprintf("certs issue --ca-dir %s --component admin --host admin-1 --role read-only --out-dir %s --force", caDir, outDir),
+		},
+		{
+			desc:   "revoke without certificate",
+			cmd:    fmt.Sprintf("certs revoke --ca-dir %s", caDir),
+			expErr: errors.New("exactly one of"),
+		},
+		{
+			desc: "revoke admin certificate",
+			cmd:  fmt.Sprintf("certs revoke --ca-dir %s --cert %s", caDir, filepath.Join(outDir, "admin.crt")),
+		},
+		{
+			desc:   "revoke admin certificate again",
+			cmd:    fmt.Sprintf("certs revoke --ca-dir %s --cert %s", caDir, filepath.Join(outDir, "admin.crt")),
+			expErr: errors.New("already revoked"),
+		},
+	} {
+		log, buf := logging.NewTestLogger(t.Name())
+		err := runCmd(t, tc.cmd, log, control.DefaultMockInvoker(log))
+		if tc.expErr == nil && err != nil {
+			t.Log(buf.String())
+		}
+		test.CmpErr(t, tc.expErr, err)
+	}
+
+	ca, err := security.LoadCA(caDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certs, err := ca.Certificates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(certs) != 2 {
+		t.Fatalf("expected 2 issued certificates, got %d", len(certs))
+	}
+	// Only the reissued certificate, which replaced the first, was revoked.
+	test.AssertEqual(t, "admin", certs[1].Component, "")
+	test.AssertEqual(t, "read-only", strings.Join(certs[1].Roles, ","), "")
+	test.AssertFalse(t, certs[0].IsRevoked(), "expected replaced certificate not to be revoked")
+	test.AssertTrue(t, certs[1].IsRevoked(), "expected certificate to be revoked")
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -140,6 +141,7 @@ func (f *setRepPolFlag) Complete(match string) []flags.Completion {
 
 type checkStartCmd struct {
 	checkPoolCmdBase
+	asyncCmd
 
 	DryRun      bool           `short:"n" long:"dry-run" description:"Scan only; do not initiate repairs."`
 	Reset       bool           `short:"r" long:"reset" description:"Reset the system check state."`
@@ -180,6 +182,11 @@ func (cmd *checkStartCmd) Execute(_ []string) error {
 	}
 	req.Policies = cmd.Policies.SetPolicies
 
+	if cmd.Async {
+		op, err := control.SystemCheckStartAsync(ctx, cmd.ctlInvoker, req)
+		return outputOperationStarted(cmd, op, err)
+	}
+
 	if err := control.SystemCheckStart(ctx, cmd.ctlInvoker, req); err != nil {
 		return err
 	}
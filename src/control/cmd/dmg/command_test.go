@@ -192,6 +192,24 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
 	case *control.SystemGetPropReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemGetPropResp{})
+	case *control.OperationListReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.OperationListResp{})
+	case *control.OperationGetReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.OperationGetResp{
+			Operation: &mgmtpb.Operation{
+				Id:     req.ID,
+				Method: mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+				State:  "succeeded",
+			},
+		})
+	case *control.OperationCancelReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.OperationCancelResp{
+			Operation: &mgmtpb.Operation{
+				Id:     req.ID,
+				Method: mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+				State:  "running",
+			},
+		})
 	case *control.GetAttachInfoReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.GetAttachInfoResp{})
 	case *control.NetworkScanReq:
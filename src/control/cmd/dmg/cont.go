@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -38,6 +39,7 @@ type ContSetOwnerCmd struct {
 	contCmd
 	GroupName ui.ACLPrincipalFlag `short:"g" long:"group" description:"New owner-group for the container, format name@domain"`
 	UserName  ui.ACLPrincipalFlag `short:"u" long:"user" description:"New owner-user for the container, format name@domain"`
+	IdemKey   string              `long:"idempotency-key" description:"Key identifying retries of this request; a repeated key returns the original result"`
 }
 
 // Execute runs the container set-owner command
@@ -50,10 +52,11 @@ func (cmd *ContSetOwnerCmd) Execute(args []string) error {
 	}
 	msg := "SUCCEEDED"
 	req := &control.ContSetOwnerReq{
-		ContID: cmd.Args.Cont.String(),
-		PoolID: cmd.poolCmd.Args.Pool.String(),
-		User:   cmd.UserName.String(),
-		Group:  cmd.GroupName.String(),
+		ContID:         cmd.Args.Cont.String(),
+		PoolID:         cmd.poolCmd.Args.Pool.String(),
+		User:           cmd.UserName.String(),
+		Group:          cmd.GroupName.String(),
+		IdempotencyKey: cmd.IdemKey,
 	}
 
 	ctx := cmd.MustLogCtx()
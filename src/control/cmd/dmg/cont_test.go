@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -71,6 +72,20 @@ func TestContSetOwnerCommand(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Set owner with idempotency key",
+			fmt.Sprintf("cont set-owner --user=%s --idempotency-key=key1 %s %s", testUser,
+				testPoolUUID, testContUUID),
+			strings.Join([]string{
+				printRequest(t, &control.ContSetOwnerReq{
+					PoolID:         testPoolUUID.String(),
+					ContID:         testContUUID.String(),
+					User:           testUser,
+					IdempotencyKey: "key1",
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Bad owner principal",
 			fmt.Sprintf("cont set-owner --user=%s --group=%s %s %s", "bad@@", testGroup,
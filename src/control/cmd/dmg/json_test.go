@@ -10,12 +10,16 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -68,7 +72,7 @@ func TestDmg_JsonOutput(t *testing.T) {
 			testArgs := append([]string{"-i", "--json"}, args...)
 			switch strings.Join(args, " ") {
 			case "version", "telemetry config", "telemetry run", "config generate",
-				"manpage", "system set-prop", "support collect-log", "check repair":
+				"manpage", "system set-prop", "support collect-log", "check repair", "shell":
 				return
 			case "storage nvme-rebind":
 				testArgs = append(testArgs, "-l", "foo.com", "-a",
@@ -106,6 +110,8 @@ func TestDmg_JsonOutput(t *testing.T) {
 				testArgs = append(testArgs, "--user", "foo", test.MockUUID(), test.MockUUID())
 			case "telemetry metrics list", "telemetry metrics query":
 				return // These commands query via http directly
+			case "operation get", "operation wait", "operation cancel":
+				testArgs = append(testArgs, "op1")
 			case "system cleanup":
 				testArgs = append(testArgs, "hostname")
 			case "check set-policy":
@@ -157,3 +163,76 @@ func TestDmg_JsonOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestDmg_OutputFormat(t *testing.T) {
+	for name, tc := range map[string]struct {
+		args      []string
+		expOutput string
+		expErr    error
+	}{
+		"yaml": {
+			args:      []string{"--output", "yaml"},
+			expOutput: "response:\n",
+		},
+		"json": {
+			args:      []string{"--output", "json"},
+			expOutput: "\"response\": {",
+		},
+		"jsonpath": {
+			args:      []string{"--output", "jsonpath={.pools[*].label}"},
+			expOutput: "tank\n",
+		},
+		"template": {
+			args:      []string{"--output", "template={{range .pools}}{{.label}}/{{.uuid}}{{end}}"},
+			expOutput: "tank/" + test.MockUUID(1),
+		},
+		"unknown format": {
+			args:   []string{"--output", "xml"},
+			expErr: errors.New("unknown output format"),
+		},
+		"json and output": {
+			args:   []string{"--json", "--output", "yaml"},
+			expErr: errors.New("may not be used together"),
+		},
+		"all systems": {
+			args:   []string{"--all-systems", "--output", "csv"},
+			expErr: errors.New("only supports JSON output"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var result bytes.Buffer
+			r, w, _ := os.Pipe()
+			done := make(chan struct{})
+			go func() {
+				_, _ = io.Copy(&result, r)
+				close(done)
+			}()
+			stdout := os.Stdout
+			defer func() {
+				os.Stdout = stdout
+			}()
+			os.Stdout = w
+
+			log := logging.NewCommandLineLogger()
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+					Pools: []*mgmtpb.ListPoolsResp_Pool{
+						{Uuid: test.MockUUID(1), Label: "tank"},
+					},
+				}),
+			})
+
+			args := append([]string{"-i"}, tc.args...)
+			err := parseOpts(append(args, "pool", "list", "--no-query"), &cliOptions{}, mi, log)
+			w.Close()
+			<-done
+
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertTrue(t, strings.Contains(result.String(), tc.expOutput),
+				fmt.Sprintf("expected %q in output:\n%s", tc.expOutput, result.String()))
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,11 +12,13 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 
 	flags "github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/fault"
@@ -24,6 +27,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ui"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
 )
 
 type (
@@ -56,6 +60,17 @@ type (
 		setLog(*logging.LeveledLogger)
 	}
 
+	// tracerSetter is an interface for invokers that can trace the requests they make
+	tracerSetter interface {
+		SetTracer(*control.Tracer)
+	}
+
+	// circuitBreakerSetter is an interface for invokers that can fail fast the
+	// requests to hosts that recently failed to respond
+	circuitBreakerSetter interface {
+		SetCircuitBreaker(*control.CircuitBreaker)
+	}
+
 	// cmdConfigSetter is an interface for setting the control config on a command
 	cmdConfigSetter interface {
 		setConfig(*control.Config)
@@ -99,7 +114,14 @@ type cliOptions struct {
 	LogFile        string           `long:"log-file" description:"Log command output to the specified file"`
 	JSON           bool             `short:"j" long:"json" description:"Enable JSON output"`
 	JSONLogs       bool             `short:"J" long:"json-logging" description:"Enable JSON-formatted log output"`
+	Output         string           `long:"output" description:"Output format: json, yaml, csv, template=<go-template> or jsonpath=<expr>"`
+	Trace          bool             `long:"trace" description:"Print a summary of the RPCs made by the command with their timings, retries and redirects"`
+	TraceExport    string           `long:"trace-export" description:"Append a trace of the RPCs made by the command to the specified file as OpenTelemetry (OTLP JSON) spans"`
+	NoBreaker      bool             `long:"no-circuit-breaker" description:"Send requests to all hosts, including hosts that recently failed to respond"`
 	ConfigPath     string           `short:"o" long:"config-path" description:"Client config file path"`
+	SystemProfile  string           `long:"system" description:"Use the named system from the system profiles file"`
+	AllSystems     bool             `long:"all-systems" description:"Run a query command against every system in the system profiles file"`
+	ProfilesPath   string           `long:"profiles-path" description:"System profiles file path (default: ~/.daos_control_profiles.yml)"`
 	Server         serverCmd        `command:"server" alias:"srv" description:"Perform tasks related to remote servers"`
 	Storage        storageCmd       `command:"storage" alias:"sto" description:"Perform tasks related to storage attached to remote servers"`
 	Config         configCmd        `command:"config" alias:"cfg" description:"Perform tasks related to configuration of hardware on remote servers"`
@@ -108,13 +130,19 @@ type cliOptions struct {
 	Support        supportCmd       `command:"support" alias:"supp" description:"Perform debug tasks to help support team"`
 	Pool           PoolCmd          `command:"pool" description:"Perform tasks related to DAOS pools"`
 	Cont           ContCmd          `command:"container" alias:"cont" description:"Perform tasks related to DAOS containers"`
+	Apply          applyCmd         `command:"apply" description:"Apply a manifest describing the desired state of the DAOS system and its pools"`
 	Version        versionCmd       `command:"version" description:"Print dmg version"`
 	ServerVersion  serverVersionCmd `command:"server-version" description:"Print server version"`
 	Telemetry      telemCmd         `command:"telemetry" alias:"telem" description:"Perform telemetry operations"`
 	Check          checkCmdRoot     `command:"check" description:"Check system health"`
+	Operation      operationCmd     `command:"operation" alias:"op" description:"Manage long-running operations on the management service"`
+	Certs          certsCmd         `command:"certs" description:"Manage the certificate authority for DAOS components"`
+	Shell          shellCmd         `command:"shell" description:"Start an interactive shell for running dmg commands"`
 	ManPage        cmdutil.ManCmd   `command:"manpage" hidden:"true"`
 	faultsCmdRoot                   // compiled out for release builds
 	firmwareOption                  // build with tag "firmware" to enable
+
+	shellConfig *control.Config // set when running a command in the shell
 }
 
 type versionCmd struct {
@@ -131,7 +159,6 @@ func (cmd *versionCmd) Execute(_ []string) error {
 	}
 
 	fmt.Println(build.String(build.AdminUtilName))
-	os.Exit(0)
 	return nil
 }
 
@@ -168,17 +195,20 @@ func (cmd *serverVersionCmd) Execute(_ []string) error {
 	return err
 }
 
-func exitWithError(log logging.Logger, err error) {
+func logCmdError(log logging.Logger, err error) {
 	cmdName := path.Base(os.Args[0])
 	log.Errorf("%s: %v", cmdName, err)
 	if fault.HasResolution(err) {
 		log.Errorf("%s: %s", cmdName, fault.ShowResolutionFor(err))
 	}
+}
+
+func exitWithError(log logging.Logger, err error) {
+	logCmdError(log, err)
 	os.Exit(1)
 }
 
-func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *logging.LeveledLogger) error {
-	var wroteJSON atm.Bool
+func newParser(opts *cliOptions) *flags.Parser {
 	p := flags.NewParser(opts, flags.Default)
 	p.Name = "dmg"
 	p.ShortDescription = "Administrative tool for managing DAOS clusters"
@@ -188,6 +218,62 @@ provided as a means for allowing administrators to securely discover and
 administer DAOS components such as storage allocations, network configuration,
 and access control settings, along with system wide operations.`
 	p.Options ^= flags.PrintErrors // Don't allow the library to print errors
+
+	return p
+}
+
+// activeCommandName returns the full name of the command selected by the
+// parsed arguments, e.g. "dmg pool list".
+func activeCommandName(p *flags.Parser) string {
+	name := []string{p.Name}
+	for cmd := p.Active; cmd != nil; cmd = cmd.Active {
+		name = append(name, cmd.Name)
+	}
+	return strings.Join(name, " ")
+}
+
+// loadCircuitBreaker loads the health of the hosts recorded by previous
+// invocations. Failure to load it is not fatal as it only serves to avoid
+// waiting for hosts that are known to be unresponsive.
+func loadCircuitBreaker(log logging.Logger) *control.CircuitBreaker {
+	path, err := control.DefaultCircuitBreakerPath()
+	if err != nil {
+		log.Debugf("circuit breaker disabled: %s", err)
+		return nil
+	}
+
+	cb, err := control.LoadCircuitBreaker(path)
+	if err != nil {
+		log.Debugf("failed to load host health: %s", err)
+	}
+	return cb
+}
+
+// writeTrace prints a summary of the trace to stderr, so as not to interfere
+// with the command output, and appends the trace to the export file if one
+// was specified.
+func writeTrace(tracer *control.Tracer, opts *cliOptions) error {
+	if opts.Trace {
+		pretty.PrintTrace(os.Stderr, tracer)
+	}
+
+	if opts.TraceExport == "" {
+		return nil
+	}
+
+	f, err := common.AppendFile(opts.TraceExport)
+	if err != nil {
+		return errors.Wrap(err, "open trace export file")
+	}
+	defer f.Close()
+
+	return tracer.WriteOTLP(f, build.AdminUtilName)
+}
+
+func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *logging.LeveledLogger) error {
+	var wroteJSON atm.Bool
+	var outFmt *cmdutil.OutputFormat
+	p := newParser(opts)
 	p.CommandHandler = func(cmd flags.Commander, args []string) error {
 		if cmd == nil {
 			return nil
@@ -232,8 +318,26 @@ and access control settings, along with system wide operations.`
 			log.WithJSONOutput()
 		}
 
-		if jsonCmd, ok := cmd.(cmdutil.JSONOutputter); ok && opts.JSON {
+		if opts.Output != "" {
+			if opts.JSON {
+				return errors.New("--json and --output may not be used together")
+			}
+			var err error
+			if outFmt, err = cmdutil.ParseOutputFormat(opts.Output); err != nil {
+				return err
+			}
+			// JSON output is handled in the same way as --json
+			if outFmt.IsJSON() {
+				opts.JSON = true
+				outFmt = nil
+			}
+		}
+
+		if jsonCmd, ok := cmd.(cmdutil.JSONOutputter); ok && (opts.JSON || outFmt != nil) {
 			jsonCmd.EnableJSONOutput(os.Stdout, &wroteJSON)
+			if fmtCmd, ok := cmd.(cmdutil.OutputFormatter); ok && outFmt != nil {
+				fmtCmd.SetOutputFormat(outFmt)
+			}
 			// disable output on stdout other than JSON
 			log.ClearLevel(logging.LogLevelInfo)
 		}
@@ -242,76 +346,127 @@ and access control settings, along with system wide operations.`
 			logCmd.SetLog(log)
 		}
 
+		if psCmd, ok := cmd.(profilesPathSetter); ok {
+			psCmd.setProfilesPath(opts.ProfilesPath)
+		}
+
+		if ts, ok := invoker.(tracerSetter); ok && (opts.Trace || opts.TraceExport != "") {
+			tracer := control.NewTracer(activeCommandName(p))
+			ts.SetTracer(tracer)
+			defer func() {
+				ts.SetTracer(nil)
+				tracer.Finish()
+				if err := writeTrace(tracer, opts); err != nil {
+					log.Errorf("failed to write trace: %s", err)
+				}
+			}()
+		}
+
 		switch cmd.(type) {
-		case *versionCmd:
-			// this command don't need the rest of the setup
+		case *versionCmd, *certsInitCACmd, *certsIssueCmd, *certsRevokeCmd,
+			*configUseSystemCmd, *configListSystemsCmd:
+			// these commands don't need the rest of the setup
 			return cmd.Execute(args)
 		}
 
-		ctlCfg, err := control.LoadConfig(opts.ConfigPath)
-		if err != nil {
-			if errors.Cause(err) != control.ErrNoConfigFile {
-				return errors.Wrap(err, "failed to load control configuration")
+		runWithConfig := func(ctlCfg *control.Config) error {
+			if ctlCfg.Path != "" {
+				log.Debugf("control config loaded from %s", ctlCfg.Path)
 			}
-			// Use the default config if no config file was found.
-			ctlCfg = control.DefaultConfig()
-		}
-		if ctlCfg.Path != "" {
-			log.Debugf("control config loaded from %s", ctlCfg.Path)
-		}
 
-		if opts.Insecure {
-			ctlCfg.TransportConfig.AllowInsecure = true
-		}
-		if err := ctlCfg.TransportConfig.PreLoadCertData(); err != nil {
-			return errors.Wrap(err, "Unable to load Certificate Data")
-		}
+			if opts.Insecure {
+				ctlCfg.TransportConfig.AllowInsecure = true
+			}
+			if err := ctlCfg.TransportConfig.PreLoadCertData(); err != nil {
+				return errors.Wrap(err, "Unable to load Certificate Data")
+			}
+			// Warn if the admin certificate is due to expire soon. In the
+			// shell this was done when the shell started.
+			if opts.shellConfig == nil {
+				security.NewCertMonitor(log, ctlCfg.TransportConfig).Check()
+			}
 
-		invoker.SetConfig(ctlCfg)
-		if ctlCmd, ok := cmd.(ctlInvoker); ok {
-			ctlCmd.setInvoker(invoker)
-		}
+			invoker.SetConfig(ctlCfg)
+			if cbSetter, ok := invoker.(circuitBreakerSetter); ok {
+				var cb *control.CircuitBreaker
+				if !opts.NoBreaker {
+					cb = loadCircuitBreaker(log)
+					defer func() {
+						if err := cb.Save(); err != nil {
+							log.Debugf("failed to save host health: %s", err)
+						}
+					}()
+				}
+				cbSetter.SetCircuitBreaker(cb)
+			}
+			if ctlCmd, ok := cmd.(ctlInvoker); ok {
+				ctlCmd.setInvoker(invoker)
+			}
 
-		// Handle the deprecated global hostlist flag
-		if !opts.HostList.Empty() {
-			if hlCmd, ok := cmd.(hostListSetter); ok {
-				hlCmd.setHostList(&opts.HostList.HostSet)
-			} else {
-				return &flags.Error{
-					Type:    flags.ErrUnknownFlag,
-					Message: "unknown flag `l'/`host-list'",
+			// Handle the deprecated global hostlist flag
+			if !opts.HostList.Empty() {
+				if hlCmd, ok := cmd.(hostListSetter); ok {
+					hlCmd.setHostList(&opts.HostList.HostSet)
+				} else {
+					return &flags.Error{
+						Type:    flags.ErrUnknownFlag,
+						Message: "unknown flag `l'/`host-list'",
+					}
 				}
 			}
-		}
 
-		if hlCmd, ok := cmd.(hostListGetter); ok {
-			hl := hlCmd.getHostList()
-			if len(hl) > 0 {
-				ctlCfg.HostList = hl
+			if hlCmd, ok := cmd.(hostListGetter); ok {
+				hl := hlCmd.getHostList()
+				if len(hl) > 0 {
+					ctlCfg.HostList = hl
+				}
+			}
+
+			if cfgCmd, ok := cmd.(cmdConfigSetter); ok {
+				cfgCmd.setConfig(ctlCfg)
 			}
+
+			if argsCmd, ok := cmd.(cmdutil.ArgsHandler); ok {
+				if err := argsCmd.CheckArgs(args); err != nil {
+					return err
+				}
+			}
+
+			return cmd.Execute(args)
 		}
 
-		if cfgCmd, ok := cmd.(cmdConfigSetter); ok {
-			cfgCmd.setConfig(ctlCfg)
+		if opts.shellConfig != nil {
+			if _, ok := cmd.(*shellCmd); ok {
+				return errors.New("already running in the dmg shell")
+			}
+			if opts.SystemProfile != "" || opts.ConfigPath != "" || opts.AllSystems || opts.Insecure {
+				return errors.New("--system, --config-path, --all-systems and --insecure may not be used in the dmg shell")
+			}
+			return runWithConfig(copyShellConfig(opts.shellConfig))
 		}
 
-		if argsCmd, ok := cmd.(cmdutil.ArgsHandler); ok {
-			if err := argsCmd.CheckArgs(args); err != nil {
-				return err
+		if opts.AllSystems {
+			if outFmt != nil {
+				return errors.New("--all-systems only supports JSON output")
 			}
+			return runAllSystems(log, opts, cmd, &wroteJSON, runWithConfig)
 		}
 
-		if err := cmd.Execute(args); err != nil {
+		ctlCfg, err := loadControlConfig(opts)
+		if err != nil {
 			return err
 		}
 
-		return nil
+		return runWithConfig(ctlCfg)
 	}
 
 	_, err := p.ParseArgs(args)
 	if opts.JSON && wroteJSON.IsFalse() {
 		return cmdutil.OutputJSON(os.Stdout, nil, err)
 	}
+	if outFmt != nil && wroteJSON.IsFalse() {
+		return outFmt.Write(os.Stdout, nil, err)
+	}
 	return err
 }
 
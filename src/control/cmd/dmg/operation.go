@@ -0,0 +1,182 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// operationCmd is the struct representing the top-level operation subcommand.
+type operationCmd struct {
+	List   operationListCmd   `command:"list" alias:"ls" description:"List long-running operations"`
+	Get    operationGetCmd    `command:"get" description:"Show a long-running operation and its result"`
+	Wait   operationWaitCmd   `command:"wait" description:"Wait for a long-running operation to finish"`
+	Cancel operationCancelCmd `command:"cancel" description:"Cancel a running long-running operation"`
+}
+
+// asyncCmd may be embedded in commands that can be run as long-running
+// operations on the MS leader.
+type asyncCmd struct {
+	Async bool `long:"async" description:"Start the request as a long-running operation and return its ID without waiting for it to finish"`
+}
+
+type operationOutputter interface {
+	cmdutil.JSONOutputter
+	Info(string)
+}
+
+// outputOperationStarted prints the operation that was started by a command
+// run with --async.
+func outputOperationStarted(cmd operationOutputter, op *control.Operation, err error) error {
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(op, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	pretty.PrintOperationStarted(&bld, op)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+type operationIDCmd struct {
+	baseCtlCmd
+
+	Args struct {
+		ID string `positional-arg-name:"<operation ID>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// operationListCmd is the struct representing the command to list operations.
+type operationListCmd struct {
+	baseCtlCmd
+	State string `long:"state" description:"Only list operations in the given state" choice:"running" choice:"succeeded" choice:"failed" choice:"canceled"`
+}
+
+// Execute is run when operationListCmd activates.
+func (cmd *operationListCmd) Execute(_ []string) error {
+	req := &control.OperationListReq{
+		State: system.OperationState(cmd.State),
+	}
+
+	resp, err := control.OperationList(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	pretty.PrintOperationListResp(&bld, resp)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// operationGetCmd is the struct representing the command to get an operation.
+type operationGetCmd struct {
+	operationIDCmd
+}
+
+// Execute is run when operationGetCmd activates.
+func (cmd *operationGetCmd) Execute(_ []string) error {
+	req := &control.OperationGetReq{ID: cmd.Args.ID}
+
+	op, err := control.OperationGet(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(op, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	pretty.PrintOperation(&bld, op)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// operationWaitCmd is the struct representing the command to wait for an
+// operation to finish.
+type operationWaitCmd struct {
+	operationIDCmd
+	Interval uint `long:"interval" default:"2" description:"Number of seconds between checks of the operation state"`
+	Timeout  uint `long:"timeout" description:"Maximum number of seconds to wait (default: wait until finished)"`
+}
+
+// Execute is run when operationWaitCmd activates. An error is returned if
+// the operation did not succeed.
+func (cmd *operationWaitCmd) Execute(_ []string) error {
+	ctx := cmd.MustLogCtx()
+	if cmd.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(cmd.Timeout)*time.Second)
+		defer cancel()
+	}
+
+	req := &control.OperationWaitReq{
+		OperationGetReq: control.OperationGetReq{ID: cmd.Args.ID},
+		Interval:        time.Duration(cmd.Interval) * time.Second,
+	}
+
+	op, err := control.OperationWait(ctx, cmd.ctlInvoker, req)
+	if err == nil && op.State != system.OperationStateSucceeded {
+		err = errors.Errorf("operation %s %s", op.ID, op.State)
+		if op.Error != "" {
+			err = errors.Errorf("%s: %s", err, op.Error)
+		}
+	}
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(op, err)
+	}
+	if op == nil {
+		return err
+	}
+
+	var bld strings.Builder
+	pretty.PrintOperation(&bld, op)
+	cmd.Info(bld.String())
+
+	return err
+}
+
+// operationCancelCmd is the struct representing the command to cancel an
+// operation.
+type operationCancelCmd struct {
+	operationIDCmd
+}
+
+// Execute is run when operationCancelCmd activates.
+func (cmd *operationCancelCmd) Execute(_ []string) error {
+	req := &control.OperationCancelReq{ID: cmd.Args.ID}
+
+	op, err := control.OperationCancel(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(op, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("Cancellation of %s operation %s requested\n", op.Name(), op.ID)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestDmg_OperationCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"List operations",
+			"operation list",
+			printRequest(t, &control.OperationListReq{}),
+			nil,
+		},
+		{
+			"List running operations",
+			"operation list --state running",
+			printRequest(t, &control.OperationListReq{
+				State: system.OperationStateRunning,
+			}),
+			nil,
+		},
+		{
+			"List operations with invalid state",
+			"operation list --state garbage",
+			"",
+			errors.New("Invalid value"),
+		},
+		{
+			"Get operation",
+			"operation get op1",
+			printRequest(t, &control.OperationGetReq{ID: "op1"}),
+			nil,
+		},
+		{
+			"Get operation without ID",
+			"operation get",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"Wait for operation",
+			"operation wait op1",
+			printRequest(t, &control.OperationGetReq{ID: "op1"}),
+			nil,
+		},
+		{
+			"Cancel operation",
+			"operation cancel op1",
+			printRequest(t, &control.OperationCancelReq{ID: "op1"}),
+			nil,
+		},
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"strings"
 
 	"github.com/dustin/go-humanize"
+	flags "github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
@@ -45,6 +46,8 @@ type PoolCmd struct {
 	OverwriteACL poolOverwriteACLCmd `command:"overwrite-acl" description:"Overwrite a DAOS pool's Access Control List"`
 	UpdateACL    poolUpdateACLCmd    `command:"update-acl" description:"Update entries in a DAOS pool's Access Control List"`
 	DeleteACL    poolDeleteACLCmd    `command:"delete-acl" description:"Delete an entry from a DAOS pool's Access Control List"`
+	ACL          poolACLCmd          `command:"acl" description:"Manage and apply named Access Control List templates"`
+	Rebuild      poolRebuildCmd      `command:"rebuild" description:"Throttle, prioritize, pause or resume pool rebuild"`
 	SetProp      poolSetPropCmd      `command:"set-prop" description:"Set pool property"`
 	GetProp      poolGetPropCmd      `command:"get-prop" description:"Get pool properties"`
 	Upgrade      poolUpgradeCmd      `command:"upgrade" description:"Upgrade pool to latest format"`
@@ -169,10 +172,12 @@ type poolCreateCmd struct {
 	cfgCmd
 	ctlInvokerCmd
 	cmdutil.JSONOutputCmd
+	asyncCmd
 	GroupName  ui.ACLPrincipalFlag `short:"g" long:"group" description:"DAOS pool to be owned by given group, format name@domain"`
 	UserName   ui.ACLPrincipalFlag `short:"u" long:"user" description:"DAOS pool to be owned by given user, format name@domain"`
 	Properties PoolSetPropsFlag    `short:"P" long:"properties" description:"Pool properties to be set"`
 	ACLFile    string              `short:"a" long:"acl-file" description:"Access Control List file path for DAOS pool"`
+	ACLTmpl    string              `long:"acl-template" description:"Name of a stored Access Control List template to use for DAOS pool"`
 	Size       poolSizeFlag        `short:"z" long:"size" description:"Total size of DAOS pool or its percentage ratio (auto)"`
 	TierRatio  tierRatioFlag       `short:"t" long:"tier-ratio" description:"Percentage of storage tiers for pool storage (auto; default: 6,94)"`
 	NumRanks   uint32              `short:"k" long:"nranks" description:"Number of ranks to use (auto)"`
@@ -183,6 +188,7 @@ type poolCreateCmd struct {
 	DataSize   ui.ByteSizeFlag     `long:"data-size" description:"Per-engine Data-on-SSD allocation for DAOS pool (manual). Only valid in MD-on-SSD mode"`
 	MemRatio   tierRatioFlag       `long:"mem-ratio" description:"Percentage of the pool metadata storage size (on SSD) that should be used as the memory file size (on ram-disk). Default value is 100% and only valid in MD-on-SSD mode"`
 	RankList   ui.RankSetFlag      `short:"r" long:"ranks" description:"Storage engine unique identifiers (ranks) for DAOS pool"`
+	IdemKey    string              `long:"idempotency-key" description:"Key identifying retries of this request; a repeated key returns the original result"`
 
 	Args struct {
 		PoolLabel string `positional-arg-name:"<pool label>" required:"1"`
@@ -332,11 +338,16 @@ func (cmd *poolCreateCmd) Execute(args []string) error {
 
 	ctx := cmd.MustLogCtx()
 	req := &control.PoolCreateReq{
-		User:       cmd.UserName.String(),
-		UserGroup:  cmd.GroupName.String(),
-		NumSvcReps: cmd.NumSvcReps,
-		Properties: cmd.Properties.ToSet,
-		Ranks:      cmd.RankList.Ranks(),
+		User:           cmd.UserName.String(),
+		UserGroup:      cmd.GroupName.String(),
+		NumSvcReps:     cmd.NumSvcReps,
+		Properties:     cmd.Properties.ToSet,
+		Ranks:          cmd.RankList.Ranks(),
+		IdempotencyKey: cmd.IdemKey,
+	}
+
+	if cmd.ACLFile != "" && cmd.ACLTmpl != "" {
+		return errors.New("--acl-file may not be mixed with --acl-template")
 	}
 
 	if cmd.ACLFile != "" {
@@ -347,6 +358,14 @@ func (cmd *poolCreateCmd) Execute(args []string) error {
 		}
 	}
 
+	if cmd.ACLTmpl != "" {
+		var err error
+		req.ACL, err = control.GetACLTemplate(ctx, cmd.ctlInvoker, cmd.ACLTmpl)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Refuse unsupported input value combinations.
 
 	pmemParams := cmd.ScmSize.IsSet() || cmd.NVMeSize.IsSet()
@@ -383,6 +402,14 @@ func (cmd *poolCreateCmd) Execute(args []string) error {
 		}
 	}
 
+	if cmd.Async {
+		op, err := control.PoolCreateAsync(ctx, cmd.ctlInvoker, req)
+		if err == nil && !cmd.JSONOutputEnabled() {
+			cmd.Infof("Creating pool %s (UUID %s)\n", cmd.Args.PoolLabel, req.UUID)
+		}
+		return outputOperationStarted(cmd, op, err)
+	}
+
 	resp, err := control.PoolCreate(ctx, cmd.ctlInvoker, req)
 
 	if cmd.JSONOutputEnabled() {
@@ -410,7 +437,8 @@ type poolListCmd struct {
 	cmdutil.JSONOutputCmd
 	Verbose     bool `short:"v" long:"verbose" description:"Add pool UUIDs and service replica lists to display"`
 	NoQuery     bool `short:"n" long:"no-query" description:"Disable query of listed pools"`
-	RebuildOnly bool `short:"r" long:"rebuild-only" description:"List only pools which rebuild stats is not idle"`
+	RebuildOnly bool `short:"r" long:"rebuild-only" description:"List only pools which rebuild stats is not idle, with rebuild rate and ETA"`
+	Aliases     bool `short:"a" long:"aliases" description:"Display previous pool labels that still resolve to each pool"`
 }
 
 // Execute is run when PoolListCmd activates
@@ -455,6 +483,14 @@ func (cmd *poolListCmd) Execute(_ []string) (errOut error) {
 	if outErr.String() != "" {
 		cmd.Error(outErr.String())
 	}
+	if cmd.RebuildOnly && !cmd.NoQuery && len(resp.Pools) > 0 {
+		out.WriteString("\n")
+		pretty.PrintPoolRebuildProgress(&out, resp.Pools)
+	}
+	if cmd.Aliases {
+		out.WriteString("\n")
+		pretty.PrintPoolLabelAliases(&out, resp.Pools)
+	}
 	// Infof prints raw string and doesn't try to expand "%"
 	// preserving column formatting in txtfmt table
 	cmd.Infof("%s", out.String())
@@ -466,6 +502,12 @@ type PoolID struct {
 	ui.LabelOrUUIDFlag
 }
 
+// Complete implements the go-flags Completer interface to offer the labels of
+// the pools in the system when completing a command line in the shell.
+func (p *PoolID) Complete(match string) []flags.Completion {
+	return liveCompletions.getItems(poolCompletion, match)
+}
+
 // poolCmd is the base struct for all pool commands that work with existing pools.
 type poolCmd struct {
 	baseCmd
@@ -485,8 +527,10 @@ func (cmd *poolCmd) PoolID() *PoolID {
 // poolDestroyCmd is the struct representing the command to destroy a DAOS pool.
 type poolDestroyCmd struct {
 	poolCmd
-	Recursive bool `short:"r" long:"recursive" description:"Remove pool with existing containers"`
-	Force     bool `short:"f" long:"force" description:"Forcibly remove pool with active client connections"`
+	asyncCmd
+	Recursive bool   `short:"r" long:"recursive" description:"Remove pool with existing containers"`
+	Force     bool   `short:"f" long:"force" description:"Forcibly remove pool with active client connections"`
+	IdemKey   string `long:"idempotency-key" description:"Key identifying retries of this request; a repeated key returns the original result"`
 }
 
 // Execute is run when PoolDestroyCmd subcommand is activated
@@ -494,9 +538,15 @@ func (cmd *poolDestroyCmd) Execute(args []string) error {
 	msg := "succeeded"
 
 	req := &control.PoolDestroyReq{
-		ID:        cmd.PoolID().String(),
-		Force:     cmd.Force,
-		Recursive: cmd.Recursive,
+		ID:             cmd.PoolID().String(),
+		Force:          cmd.Force,
+		Recursive:      cmd.Recursive,
+		IdempotencyKey: cmd.IdemKey,
+	}
+
+	if cmd.Async {
+		op, err := control.PoolDestroyAsync(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+		return outputOperationStarted(cmd, op, err)
 	}
 
 	err := control.PoolDestroy(cmd.MustLogCtx(), cmd.ctlInvoker, req)
@@ -674,6 +724,9 @@ func (cmd *poolQueryCmd) Execute(args []string) error {
 	req.QueryMask.SetOptions(daos.PoolQueryOptionDisabledEngines)
 
 	resp, err := control.PoolQuery(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if resp != nil && resp.LabelWarning != "" {
+		cmd.Notice(resp.LabelWarning)
+	}
 	if cmd.JSONOutputEnabled() {
 		var poolInfo *daos.PoolInfo
 		if resp != nil {
@@ -0,0 +1,312 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// poolACLCmd is the struct representing the pool ACL template subcommands.
+type poolACLCmd struct {
+	SetTemplate    poolACLSetTemplateCmd    `command:"set-template" description:"Store a named Access Control List template"`
+	GetTemplate    poolACLGetTemplateCmd    `command:"get-template" description:"Display a named Access Control List template"`
+	ListTemplates  poolACLListTemplatesCmd  `command:"list-templates" description:"List the stored Access Control List templates"`
+	DeleteTemplate poolACLDeleteTemplateCmd `command:"delete-template" description:"Delete a named Access Control List template"`
+	ApplyTemplate  poolACLApplyTemplateCmd  `command:"apply-template" description:"Apply a named Access Control List template to one or more pools"`
+}
+
+type aclTemplateArgs struct {
+	Args struct {
+		Name string `positional-arg-name:"<template name>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// poolACLSetTemplateCmd represents the command to store an ACL template.
+type poolACLSetTemplateCmd struct {
+	baseCtlCmd
+	aclTemplateArgs
+	ACLFile string `short:"a" long:"acl-file" required:"1" description:"Path for Access Control List file to store as template"`
+}
+
+// Execute is run when the poolACLSetTemplateCmd subcommand is activated.
+func (cmd *poolACLSetTemplateCmd) Execute(_ []string) error {
+	acl, err := control.ReadACLFile(cmd.ACLFile)
+	if err != nil {
+		return err
+	}
+
+	req := &control.ACLTemplateSetReq{
+		Name: cmd.Args.Name,
+		ACL:  acl,
+	}
+
+	err = control.SetACLTemplate(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "Pool-ACL-set-template command failed")
+	}
+
+	cmd.Infof("Pool-ACL-set-template command succeeded, template: %s\n", cmd.Args.Name)
+
+	return nil
+}
+
+// poolACLGetTemplateCmd represents the command to display an ACL template.
+type poolACLGetTemplateCmd struct {
+	baseCtlCmd
+	aclTemplateArgs
+	File    string `short:"o" long:"outfile" required:"0" description:"Output ACL template to file"`
+	Force   bool   `short:"f" long:"force" required:"0" description:"Allow to clobber output file"`
+	Verbose bool   `short:"v" long:"verbose" required:"0" description:"Add descriptive comments to ACL entries"`
+}
+
+// Execute is run when the poolACLGetTemplateCmd subcommand is activated.
+func (cmd *poolACLGetTemplateCmd) Execute(_ []string) error {
+	acl, err := control.GetACLTemplate(cmd.MustLogCtx(), cmd.ctlInvoker, cmd.Args.Name)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(acl, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "Pool-ACL-get-template command failed")
+	}
+
+	out := control.FormatACL(acl, cmd.Verbose)
+	if cmd.File == "" {
+		cmd.Info(out)
+		return nil
+	}
+
+	getACLCmd := &poolGetACLCmd{File: cmd.File, Force: cmd.Force}
+	getACLCmd.Logger = cmd.Logger
+	if err := getACLCmd.writeACLToFile(out); err != nil {
+		return err
+	}
+	cmd.Infof("Wrote ACL template to output file: %s", cmd.File)
+
+	return nil
+}
+
+// poolACLListTemplatesCmd represents the command to list the stored ACL templates.
+type poolACLListTemplatesCmd struct {
+	baseCtlCmd
+	Verbose bool `short:"v" long:"verbose" required:"0" description:"Display the entries of each template"`
+}
+
+// Execute is run when the poolACLListTemplatesCmd subcommand is activated.
+func (cmd *poolACLListTemplatesCmd) Execute(_ []string) error {
+	resp, err := control.GetACLTemplates(cmd.MustLogCtx(), cmd.ctlInvoker,
+		new(control.ACLTemplateGetReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "Pool-ACL-list-templates command failed")
+	}
+
+	if len(resp.Templates) == 0 {
+		cmd.Info("No ACL templates found\n")
+		return nil
+	}
+
+	names := make([]string, 0, len(resp.Templates))
+	for name := range resp.Templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var bld strings.Builder
+	for _, name := range names {
+		if !cmd.Verbose {
+			fmt.Fprintf(&bld, "%s\n", name)
+			continue
+		}
+		fmt.Fprintf(&bld, "# Template: %s\n%s\n", name,
+			control.FormatACLDefault(resp.Templates[name]))
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// poolACLDeleteTemplateCmd represents the command to delete an ACL template.
+type poolACLDeleteTemplateCmd struct {
+	baseCtlCmd
+	aclTemplateArgs
+}
+
+// Execute is run when the poolACLDeleteTemplateCmd subcommand is activated.
+func (cmd *poolACLDeleteTemplateCmd) Execute(_ []string) error {
+	req := &control.ACLTemplateDeleteReq{
+		Name: cmd.Args.Name,
+	}
+
+	err := control.DeleteACLTemplate(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "Pool-ACL-delete-template command failed")
+	}
+
+	cmd.Infof("Pool-ACL-delete-template command succeeded, template: %s\n", cmd.Args.Name)
+
+	return nil
+}
+
+// poolACLApplyResult contains the outcome of applying an ACL template to a pool.
+type poolACLApplyResult struct {
+	Pool  string           `json:"pool"`
+	Diff  *control.ACLDiff `json:"diff"`
+	Error string           `json:"error,omitempty"`
+}
+
+// poolACLApplyTemplateCmd represents the command to apply an ACL template to one or
+// more pools.
+type poolACLApplyTemplateCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	All       bool `short:"a" long:"all" description:"Apply the template to all pools in the system"`
+	Overwrite bool `short:"O" long:"overwrite" description:"Replace each pool's ACL with the template rather than adding and updating entries"`
+	DryRun    bool `short:"n" long:"dry-run" description:"Display the changes without applying them"`
+
+	Args struct {
+		Name  string   `positional-arg-name:"<template name>" required:"1"`
+		Pools []string `positional-arg-name:"<pool label or UUID>"`
+	} `positional-args:"yes"`
+}
+
+func (cmd *poolACLApplyTemplateCmd) getPoolIDs(ctx context.Context) ([]string, error) {
+	if !cmd.All {
+		return cmd.Args.Pools, nil
+	}
+
+	resp, err := control.ListPools(ctx, cmd.ctlInvoker, &control.ListPoolsReq{NoQuery: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "list pools failed")
+	}
+
+	ids := make([]string, 0, len(resp.Pools))
+	for _, p := range resp.Pools {
+		if p.Label != "" {
+			ids = append(ids, p.Label)
+			continue
+		}
+		ids = append(ids, p.UUID.String())
+	}
+
+	return ids, nil
+}
+
+func (cmd *poolACLApplyTemplateCmd) applyToPool(ctx context.Context, poolID string, tmpl *control.AccessControlList) (*control.ACLDiff, error) {
+	getResp, err := control.PoolGetACL(ctx, cmd.ctlInvoker, &control.PoolGetACLReq{ID: poolID})
+	if err != nil {
+		return nil, err
+	}
+
+	diff := control.DiffACL(getResp.ACL, tmpl)
+	if cmd.DryRun || !diff.HasChanges(cmd.Overwrite) {
+		return diff, nil
+	}
+
+	if cmd.Overwrite {
+		_, err = control.PoolOverwriteACL(ctx, cmd.ctlInvoker, &control.PoolOverwriteACLReq{
+			ID:  poolID,
+			ACL: tmpl,
+		})
+		return diff, err
+	}
+
+	_, err = control.PoolUpdateACL(ctx, cmd.ctlInvoker, &control.PoolUpdateACLReq{
+		ID:  poolID,
+		ACL: &control.AccessControlList{Entries: diff.Updates()},
+	})
+	return diff, err
+}
+
+// Execute is run when the poolACLApplyTemplateCmd subcommand is activated.
+func (cmd *poolACLApplyTemplateCmd) Execute(_ []string) error {
+	switch {
+	case cmd.All && len(cmd.Args.Pools) > 0:
+		return errors.New("--all may not be mixed with a list of pools")
+	case !cmd.All && len(cmd.Args.Pools) == 0:
+		return errors.New("either --all or a list of pools is required")
+	}
+
+	ctx := cmd.MustLogCtx()
+
+	tmpl, err := control.GetACLTemplate(ctx, cmd.ctlInvoker, cmd.Args.Name)
+	if err != nil {
+		return errors.Wrap(err, "Pool-ACL-apply-template command failed")
+	}
+
+	poolIDs, err := cmd.getPoolIDs(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Pool-ACL-apply-template command failed")
+	}
+
+	var results []*poolACLApplyResult
+	var nrFailed int
+	for _, poolID := range poolIDs {
+		diff, err := cmd.applyToPool(ctx, poolID, tmpl)
+		result := &poolACLApplyResult{Pool: poolID, Diff: diff}
+		if err != nil {
+			result.Error = err.Error()
+			nrFailed++
+		}
+		results = append(results, result)
+	}
+
+	err = nil
+	if nrFailed > 0 {
+		err = errors.Errorf("Pool-ACL-apply-template command failed on %d of %d pools",
+			nrFailed, len(poolIDs))
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(results, err)
+	}
+
+	var bld strings.Builder
+	for _, result := range results {
+		fmt.Fprintf(&bld, "Pool %s:\n", result.Pool)
+		if result.Diff != nil {
+			bld.WriteString(control.FormatACLDiff(result.Diff, cmd.Overwrite))
+		}
+		if result.Error != "" {
+			fmt.Fprintf(&bld, "# Error: %s\n", result.Error)
+		}
+	}
+	cmd.Info(bld.String())
+
+	if err != nil {
+		return err
+	}
+
+	if cmd.DryRun {
+		cmd.Info("Dry run, no changes applied\n")
+		return nil
+	}
+	cmd.Infof("Pool-ACL-apply-template command succeeded, template: %s\n", cmd.Args.Name)
+
+	return nil
+}
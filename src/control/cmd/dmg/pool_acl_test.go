@@ -0,0 +1,111 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestDmg_poolACLApplyTemplateCmd_applyToPool(t *testing.T) {
+	tmpl := &control.AccessControlList{
+		Entries: []string{"A::OWNER@:rw", "A:G:readers@:r"},
+	}
+	curACL := &mgmtpb.ACLResp{
+		Acl: &mgmtpb.AccessControlList{
+			Entries: []string{"A::OWNER@:r", "A::bob@:rw"},
+		},
+	}
+	expDiff := &control.ACLDiff{
+		Added:   []string{"A:G:readers@:r"},
+		Changed: []string{"A::OWNER@:rw"},
+		Removed: []string{"A::bob@:rw"},
+	}
+
+	for name, tc := range map[string]struct {
+		overwrite bool
+		dryRun    bool
+		responses []*control.UnaryResponse
+		expDiff   *control.ACLDiff
+		expErr    error
+	}{
+		"get ACL fails": {
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"no changes": {
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, &mgmtpb.ACLResp{
+					Acl: &mgmtpb.AccessControlList{Entries: tmpl.Entries},
+				}),
+				control.MockMSResponse("host1", errors.New("unexpected update"), nil),
+			},
+			expDiff: &control.ACLDiff{Unchanged: tmpl.Entries},
+		},
+		"dry run": {
+			dryRun: true,
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, curACL),
+				control.MockMSResponse("host1", errors.New("unexpected update"), nil),
+			},
+			expDiff: expDiff,
+		},
+		"update fails": {
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, curACL),
+				control.MockMSResponse("host1", errors.New("update failed"), nil),
+			},
+			expDiff: expDiff,
+			expErr:  errors.New("update failed"),
+		},
+		"update": {
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, curACL),
+				control.MockMSResponse("host1", nil, &mgmtpb.ACLResp{}),
+			},
+			expDiff: expDiff,
+		},
+		"overwrite": {
+			overwrite: true,
+			responses: []*control.UnaryResponse{
+				control.MockMSResponse("host1", nil, curACL),
+				control.MockMSResponse("host1", nil, &mgmtpb.ACLResp{}),
+			},
+			expDiff: expDiff,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cmd := &poolACLApplyTemplateCmd{
+				Overwrite: tc.overwrite,
+				DryRun:    tc.dryRun,
+			}
+			cmd.Logger = log
+			cmd.ctlInvoker = control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponseSet: tc.responses,
+			})
+
+			gotDiff, gotErr := cmd.applyToPool(test.Context(t), "tank", tmpl)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if diff := cmp.Diff(tc.expDiff, gotDiff); diff != "" {
+				t.Fatalf("unexpected diff (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
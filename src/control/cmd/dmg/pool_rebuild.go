@@ -0,0 +1,128 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+// poolRebuildCmd is the struct representing the pool rebuild control subcommands.
+type poolRebuildCmd struct {
+	Throttle poolRebuildThrottleCmd `command:"throttle" description:"Limit the share of the data migration budget used by pool rebuild"`
+	Priority poolRebuildPriorityCmd `command:"priority" description:"Set the share of engine scheduling given to pool rebuild over user I/O"`
+	Pause    poolRebuildPauseCmd    `command:"pause" description:"Pause pool rebuild data movement"`
+	Resume   poolRebuildResumeCmd   `command:"resume" description:"Resume paused pool rebuild data movement"`
+}
+
+// setRebuildProp validates and sets a single rebuild-related property on the pool.
+func (cmd *poolCmd) setRebuildProp(name, value string) error {
+	prop, err := daos.PoolProperties().GetProperty(name)
+	if err != nil {
+		return err
+	}
+	if err := prop.SetValue(value); err != nil {
+		return err
+	}
+
+	req := &control.PoolSetPropReq{
+		ID:         cmd.PoolID().String(),
+		Properties: []*daos.PoolProperty{prop},
+	}
+
+	return control.PoolSetProp(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+}
+
+// poolRebuildThrottleCmd represents the command to throttle pool rebuild.
+type poolRebuildThrottleCmd struct {
+	poolCmd
+
+	Args struct {
+		Percent string `positional-arg-name:"<percent>" required:"1" description:"Percentage of the migration budget (1-100)"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when poolRebuildThrottleCmd subcommand is activated.
+func (cmd *poolRebuildThrottleCmd) Execute(_ []string) error {
+	err := cmd.setRebuildProp("rebuild_throttle", cmd.Args.Percent)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool rebuild throttle failed")
+	}
+	cmd.Infof("pool rebuild throttle succeeded, rebuild limited to %s", cmd.Args.Percent)
+
+	return nil
+}
+
+// poolRebuildPriorityCmd represents the command to set the pool rebuild priority.
+type poolRebuildPriorityCmd struct {
+	poolCmd
+
+	Args struct {
+		Priority string `positional-arg-name:"<low|normal|high>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when poolRebuildPriorityCmd subcommand is activated.
+func (cmd *poolRebuildPriorityCmd) Execute(_ []string) error {
+	err := cmd.setRebuildProp("rebuild_priority", cmd.Args.Priority)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool rebuild priority failed")
+	}
+	cmd.Infof("pool rebuild priority succeeded, priority set to %s", cmd.Args.Priority)
+
+	return nil
+}
+
+// poolRebuildPauseCmd represents the command to pause pool rebuild.
+type poolRebuildPauseCmd struct {
+	poolCmd
+}
+
+// Execute is run when poolRebuildPauseCmd subcommand is activated.
+func (cmd *poolRebuildPauseCmd) Execute(_ []string) error {
+	err := cmd.setRebuildProp("rebuild_pause", "on")
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool rebuild pause failed")
+	}
+	cmd.Info("pool rebuild pause succeeded")
+
+	return nil
+}
+
+// poolRebuildResumeCmd represents the command to resume paused pool rebuild.
+type poolRebuildResumeCmd struct {
+	poolCmd
+}
+
+// Execute is run when poolRebuildResumeCmd subcommand is activated.
+func (cmd *poolRebuildResumeCmd) Execute(_ []string) error {
+	err := cmd.setRebuildProp("rebuild_pause", "off")
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool rebuild resume failed")
+	}
+	cmd.Info("pool rebuild resume succeeded")
+
+	return nil
+}
@@ -596,6 +596,22 @@ func TestPoolCommands(t *testing.T) {
 			"",
 			dmgTestErr(fmt.Sprintf("ACL file '%s' contains no entries", testEmptyFile)),
 		},
+		{
+			"Create pool with ACL file and template",
+			fmt.Sprintf("pool create label --scm-size %s --acl-file %s --acl-template ro", testSizeStr, testACLFile),
+			"",
+			errors.New("may not be mixed"),
+		},
+		{
+			"Create pool with missing ACL template",
+			fmt.Sprintf("pool create label --scm-size %s --acl-template ro", testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetAttrReq{
+					Keys: []string{control.ACLTemplateAttrPrefix + "ro"},
+				}),
+			}, " "),
+			errors.New("ACL template \"ro\" not found"),
+		},
 		{
 			"Create pool with scrubbing",
 			fmt.Sprintf("pool create label --scm-size %s --properties=scrub:timed,scrub_freq:1", testSizeStr),
@@ -776,6 +792,17 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Destroy pool with idempotency key",
+			"pool destroy 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --idempotency-key=key1",
+			strings.Join([]string{
+				printRequest(t, &control.PoolDestroyReq{
+					ID:             "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					IdempotencyKey: "key1",
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Evict pool",
 			"pool evict 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
@@ -802,6 +829,14 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"List pools with aliases flag",
+			"pool list --aliases",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{}),
+			}, " "),
+			nil,
+		},
 		{
 			"Set pool properties",
 			"pool set-prop 031bcaf8-f0f5-42ef-b3c5-ee048676dceb label:foo,space_rb:42",
@@ -830,6 +865,70 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Throttle pool rebuild",
+			"pool rebuild throttle 031bcaf8-f0f5-42ef-b3c5-ee048676dceb 25%",
+			strings.Join([]string{
+				printRequest(t, &control.PoolSetPropReq{
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Properties: []*daos.PoolProperty{
+						propWithVal("rebuild_throttle", "25"),
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Throttle pool rebuild out of range",
+			"pool rebuild throttle 031bcaf8-f0f5-42ef-b3c5-ee048676dceb 0",
+			"",
+			errors.New("invalid rebuild_throttle value 0"),
+		},
+		{
+			"Set pool rebuild priority",
+			"pool rebuild priority 031bcaf8-f0f5-42ef-b3c5-ee048676dceb low",
+			strings.Join([]string{
+				printRequest(t, &control.PoolSetPropReq{
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Properties: []*daos.PoolProperty{
+						propWithVal("rebuild_priority", "low"),
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set pool rebuild priority invalid",
+			"pool rebuild priority 031bcaf8-f0f5-42ef-b3c5-ee048676dceb urgent",
+			"",
+			errors.New("invalid value"),
+		},
+		{
+			"Pause pool rebuild",
+			"pool rebuild pause 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			strings.Join([]string{
+				printRequest(t, &control.PoolSetPropReq{
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Properties: []*daos.PoolProperty{
+						propWithVal("rebuild_pause", "on"),
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Resume pool rebuild",
+			"pool rebuild resume 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			strings.Join([]string{
+				printRequest(t, &control.PoolSetPropReq{
+					ID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+					Properties: []*daos.PoolProperty{
+						propWithVal("rebuild_pause", "off"),
+					},
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Set pool property invalid property",
 			"pool set-prop 031bcaf8-f0f5-42ef-b3c5-ee048676dceb whoops:foo",
@@ -961,6 +1060,66 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			errors.New(fmt.Sprintf("open %s: permission denied", filepath.Join(testNoPermDir, "out.txt"))),
 		},
+		{
+			"Set pool ACL template",
+			fmt.Sprintf("pool acl set-template ro --acl-file %s", testACLFile),
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetAttrReq{
+					Attributes: map[string]string{
+						control.ACLTemplateAttrPrefix + "ro": strings.Join(testACL.Entries, ","),
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set pool ACL template with invalid name",
+			fmt.Sprintf("pool acl set-template r:o --acl-file %s", testACLFile),
+			"",
+			errors.New("invalid ACL template name"),
+		},
+		{
+			"Get missing pool ACL template",
+			"pool acl get-template ro",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetAttrReq{
+					Keys: []string{control.ACLTemplateAttrPrefix + "ro"},
+				}),
+			}, " "),
+			errors.New("ACL template \"ro\" not found"),
+		},
+		{
+			"List pool ACL templates",
+			"pool acl list-templates",
+			strings.Join([]string{
+				printRequest(t, &control.SystemGetAttrReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"Delete pool ACL template",
+			"pool acl delete-template ro",
+			strings.Join([]string{
+				printRequest(t, &control.SystemSetAttrReq{
+					Attributes: map[string]string{
+						control.ACLTemplateAttrPrefix + "ro": "",
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Apply pool ACL template without pools",
+			"pool acl apply-template ro",
+			"",
+			errors.New("either --all or a list of pools is required"),
+		},
+		{
+			"Apply pool ACL template with pools and --all",
+			"pool acl apply-template ro --all tank",
+			"",
+			errors.New("may not be mixed"),
+		},
 		{
 			"Overwrite pool ACL with invalid ACL file",
 			"pool overwrite-acl 12345678-1234-1234-1234-1234567890ab --acl-file /not/a/real/file",
@@ -0,0 +1,101 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/audit"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+type hostAuditEntry struct {
+	host string
+	*audit.Entry
+}
+
+// PrintAuditListResp generates a human-readable table of the entries retrieved
+// from the audit logs of all hosts, ordered by time, and writes it to the
+// supplied io.Writer. Hosts with audit logs that failed verification are
+// reported before the table.
+func PrintAuditListResp(out io.Writer, resp *control.AuditListResp, verbose bool) {
+	timeTitle := "Time"
+	hostTitle := "Host"
+	callerTitle := "Caller"
+	methodTitle := "Operation"
+	resultTitle := "Result"
+	durationTitle := "Duration"
+	peerTitle := "Peer"
+	requestTitle := "Request"
+
+	hosts := make([]string, 0, len(resp.HostLogs))
+	for host := range resp.HostLogs {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	var disabled []string
+	var entries []hostAuditEntry
+	for _, host := range hosts {
+		hl := resp.HostLogs[host]
+		if !hl.Enabled {
+			disabled = append(disabled, host)
+			continue
+		}
+		if hl.VerifyError != "" {
+			fmt.Fprintf(out, "WARNING: audit log on %s failed verification: %s\n", host, hl.VerifyError)
+		}
+		for _, e := range hl.Entries {
+			entries = append(entries, hostAuditEntry{host: host, Entry: e})
+		}
+	}
+	if len(disabled) > 0 {
+		fmt.Fprintf(out, "Audit logging is not enabled on: %s\n", strings.Join(disabled, ", "))
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintln(out, "No audit log entries found")
+		return
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+
+	titles := []string{timeTitle, hostTitle, callerTitle, methodTitle, resultTitle, durationTitle}
+	if verbose {
+		titles = append(titles, peerTitle, requestTitle)
+	}
+
+	var table []txtfmt.TableRow
+	for _, e := range entries {
+		caller := e.Caller
+		if e.OnBehalfOf != "" {
+			caller = fmt.Sprintf("%s (for %s)", e.Caller, e.OnBehalfOf)
+		}
+		table = append(table, txtfmt.TableRow{
+			timeTitle:     e.Time.UTC().Format(time.RFC3339),
+			hostTitle:     e.host,
+			callerTitle:   caller,
+			methodTitle:   path.Base(e.Method),
+			resultTitle:   e.Result,
+			durationTitle: e.Duration.Round(time.Millisecond).String(),
+			peerTitle:     e.PeerAddr,
+			requestTitle:  e.Request,
+		})
+	}
+
+	tf := txtfmt.NewTableFormatter(titles...)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
@@ -0,0 +1,102 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/audit"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintAuditListResp(t *testing.T) {
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	resp := &control.AuditListResp{
+		HostLogs: map[string]*control.HostAuditLog{
+			"host1": {
+				Enabled: true,
+				Entries: []*audit.Entry{
+					{
+						Time:       start.Add(time.Hour),
+						Caller:     "CN=admin",
+						OnBehalfOf: "token:alice",
+						Method:     "/mgmt.MgmtSvc/PoolCreate",
+						Result:     audit.ResultOK,
+						Duration:   1500 * time.Millisecond,
+						PeerAddr:   "10.0.0.1:4242",
+						Request:    "req1",
+					},
+				},
+			},
+			"host2": {
+				Enabled:     true,
+				VerifyError: "broken",
+				Entries: []*audit.Entry{
+					{
+						Time:     start,
+						Caller:   "CN=admin",
+						Method:   "/mgmt.MgmtSvc/SystemStop",
+						Result:   "denied",
+						Duration: 2 * time.Millisecond,
+						PeerAddr: "10.0.0.2:4242",
+						Request:  "req2",
+					},
+				},
+			},
+			"host3": {},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.AuditListResp
+		verbose     bool
+		expPrintStr string
+	}{
+		"no hosts": {
+			resp: new(control.AuditListResp),
+			expPrintStr: `
+No audit log entries found
+`,
+		},
+		"entries": {
+			resp: resp,
+			expPrintStr: `
+WARNING: audit log on host2 failed verification: broken
+Audit logging is not enabled on: host3
+Time                 Host  Caller                     Operation  Result Duration 
+----                 ----  ------                     ---------  ------ -------- 
+2025-03-01T12:00:00Z host2 CN=admin                   SystemStop denied 2ms      
+2025-03-01T13:00:00Z host1 CN=admin (for token:alice) PoolCreate ok     1.5s     
+`,
+		},
+		"verbose": {
+			resp:    resp,
+			verbose: true,
+			expPrintStr: `
+WARNING: audit log on host2 failed verification: broken
+Audit logging is not enabled on: host3
+Time                 Host  Caller                     Operation  Result Duration Peer          Request 
+----                 ----  ------                     ---------  ------ -------- ----          ------- 
+2025-03-01T12:00:00Z host2 CN=admin                   SystemStop denied 2ms      10.0.0.2:4242 req2    
+2025-03-01T13:00:00Z host1 CN=admin (for token:alice) PoolCreate ok     1.5s     10.0.0.1:4242 req1    
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintAuditListResp(&bld, tc.resp, tc.verbose)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// PrintCertCheckResp generates a human-readable table of the TLS certificates
+// in use on each host, flagging those that expire within warnDays of now, and
+// writes it to the supplied io.Writer.
+func PrintCertCheckResp(out io.Writer, resp *control.CertCheckResp, now time.Time, warnDays uint) {
+	hostTitle := "Host"
+	certTitle := "Certificate"
+	subjectTitle := "Subject"
+	expiresTitle := "Expires"
+	daysTitle := "Days Left"
+	statusTitle := "Status"
+
+	hosts := make([]string, 0, len(resp.HostCerts))
+	for host := range resp.HostCerts {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	var table []txtfmt.TableRow
+	for _, host := range hosts {
+		hc := resp.HostCerts[host]
+		if hc.Insecure {
+			table = append(table, txtfmt.TableRow{
+				hostTitle:    host,
+				certTitle:    "-",
+				subjectTitle: "-",
+				expiresTitle: "-",
+				daysTitle:    "-",
+				statusTitle:  "insecure",
+			})
+			continue
+		}
+
+		for _, cert := range hc.Certs {
+			remaining := cert.ExpiresIn(now)
+			status := "ok"
+			switch {
+			case remaining <= 0:
+				status = "expired"
+			case remaining <= time.Duration(warnDays)*24*time.Hour:
+				status = "expiring"
+			}
+
+			table = append(table, txtfmt.TableRow{
+				hostTitle:    host,
+				certTitle:    cert.Path,
+				subjectTitle: cert.Subject,
+				expiresTitle: cert.NotAfter.UTC().Format(time.RFC3339),
+				daysTitle:    fmt.Sprintf("%d", int(remaining/(24*time.Hour))),
+				statusTitle:  status,
+			})
+		}
+	}
+
+	if len(table) == 0 {
+		fmt.Fprintln(out, "No certificates found")
+		return
+	}
+
+	tf := txtfmt.NewTableFormatter(hostTitle, certTitle, subjectTitle, expiresTitle, daysTitle, statusTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
@@ -0,0 +1,74 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestPretty_PrintCertCheckResp(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	mockCert := func(path string, notAfter time.Time) *security.CertificateInfo {
+		return &security.CertificateInfo{
+			Path:     path,
+			Subject:  "CN=server",
+			NotAfter: notAfter,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.CertCheckResp
+		expPrintStr string
+	}{
+		"no hosts": {
+			resp: new(control.CertCheckResp),
+			expPrintStr: `
+No certificates found
+`,
+		},
+		"certificates": {
+			resp: &control.CertCheckResp{
+				HostCerts: map[string]*control.HostCerts{
+					"host2": {Insecure: true},
+					"host1": {
+						Certs: []*security.CertificateInfo{
+							mockCert("/a.crt", now.Add(100*day)),
+							mockCert("/b.crt", now.Add(10*day)),
+							mockCert("/c.crt", now.Add(-day)),
+						},
+					},
+				},
+			},
+			expPrintStr: `
+Host  Certificate Subject   Expires              Days Left Status   
+----  ----------- -------   -------              --------- ------   
+host1 /a.crt      CN=server 2025-06-09T12:00:00Z 100       ok       
+host1 /b.crt      CN=server 2025-03-11T12:00:00Z 10        expiring 
+host1 /c.crt      CN=server 2025-02-28T12:00:00Z -1        expired  
+host2 -           -         -                    -         insecure 
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintCertCheckResp(&bld, tc.resp, now, 30)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
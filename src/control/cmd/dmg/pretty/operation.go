@@ -0,0 +1,79 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+func formatOperationTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// PrintOperationStarted writes the ID of a newly started operation, and how to
+// follow it, to the supplied io.Writer.
+func PrintOperationStarted(out io.Writer, op *control.Operation) {
+	fmt.Fprintf(out, "%s operation %s started\n", op.Name(), op.ID)
+	fmt.Fprintf(out, "Run \"dmg operation wait %s\" to wait for the result\n", op.ID)
+}
+
+// PrintOperation generates a human-readable representation of the supplied
+// operation and writes it to the supplied io.Writer.
+func PrintOperation(out io.Writer, op *control.Operation) {
+	rows := []txtfmt.TableRow{
+		{"Operation": op.Name()},
+		{"State": string(op.State)},
+		{"Caller": op.Caller},
+		{"Created": formatOperationTime(op.Created)},
+		{"Updated": formatOperationTime(op.Updated)},
+	}
+	if op.Error != "" {
+		rows = append(rows, txtfmt.TableRow{"Error": op.Error})
+	}
+	if len(op.Response) > 0 {
+		rows = append(rows, txtfmt.TableRow{"Response": string(op.Response)})
+	}
+
+	fmt.Fprint(out, txtfmt.FormatEntity(fmt.Sprintf("Operation %s", op.ID), rows))
+}
+
+// PrintOperationListResp generates a human-readable table of the supplied
+// operations and writes it to the supplied io.Writer.
+func PrintOperationListResp(out io.Writer, resp *control.OperationListResp) {
+	if len(resp.Operations) == 0 {
+		fmt.Fprintln(out, "No operations found")
+		return
+	}
+
+	idTitle := "ID"
+	methodTitle := "Operation"
+	stateTitle := "State"
+	callerTitle := "Caller"
+	createdTitle := "Created"
+	updatedTitle := "Updated"
+
+	var table []txtfmt.TableRow
+	for _, op := range resp.Operations {
+		table = append(table, txtfmt.TableRow{
+			idTitle:      op.ID,
+			methodTitle:  op.Name(),
+			stateTitle:   string(op.State),
+			callerTitle:  op.Caller,
+			createdTitle: formatOperationTime(op.Created),
+			updatedTitle: formatOperationTime(op.Updated),
+		})
+	}
+
+	tf := txtfmt.NewTableFormatter(idTitle, methodTitle, stateTitle, callerTitle, createdTitle, updatedTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
@@ -0,0 +1,128 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockOperation(id, method string, state system.OperationState) *control.Operation {
+	created := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	return &control.Operation{
+		ID:      id,
+		Method:  "/mgmt.MgmtSvc/" + method,
+		State:   state,
+		Caller:  "CN=admin",
+		Created: created,
+		Updated: created.Add(5 * time.Minute),
+	}
+}
+
+func TestPretty_PrintOperation(t *testing.T) {
+	succeeded := mockOperation("op1", "PoolCreate", system.OperationStateSucceeded)
+	succeeded.Response = json.RawMessage(`{"svc_reps":[0]}`)
+	failed := mockOperation("op2", "PoolDestroy", system.OperationStateFailed)
+	failed.Error = "whoops"
+
+	for name, tc := range map[string]struct {
+		op          *control.Operation
+		expPrintStr string
+	}{
+		"succeeded": {
+			op: succeeded,
+			expPrintStr: `
+Operation op1
+-------------
+  Operation : PoolCreate          
+  State     : succeeded           
+  Caller    : CN=admin            
+  Created   : 2025-03-01T12:00:00Z
+  Updated   : 2025-03-01T12:05:00Z
+  Response  : {"svc_reps":[0]}    
+`,
+		},
+		"failed": {
+			op: failed,
+			expPrintStr: `
+Operation op2
+-------------
+  Operation : PoolDestroy         
+  State     : failed              
+  Caller    : CN=admin            
+  Created   : 2025-03-01T12:00:00Z
+  Updated   : 2025-03-01T12:05:00Z
+  Error     : whoops              
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintOperation(&bld, tc.op)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintOperationListResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.OperationListResp
+		expPrintStr string
+	}{
+		"no operations": {
+			resp: new(control.OperationListResp),
+			expPrintStr: `
+No operations found
+`,
+		},
+		"operations": {
+			resp: &control.OperationListResp{
+				Operations: []*control.Operation{
+					mockOperation("op1", "PoolCreate", system.OperationStateSucceeded),
+					mockOperation("op2", "PoolDestroy", system.OperationStateFailed),
+				},
+			},
+			expPrintStr: `
+ID  Operation   State     Caller   Created              Updated              
+--  ---------   -----     ------   -------              -------              
+op1 PoolCreate  succeeded CN=admin 2025-03-01T12:00:00Z 2025-03-01T12:05:00Z 
+op2 PoolDestroy failed    CN=admin 2025-03-01T12:00:00Z 2025-03-01T12:05:00Z 
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintOperationListResp(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintOperationStarted(t *testing.T) {
+	var bld strings.Builder
+	PrintOperationStarted(&bld, mockOperation("op1", "PoolCreate", system.OperationStateRunning))
+
+	exp := `PoolCreate operation op1 started
+Run "dmg operation wait op1" to wait for the result
+`
+	if diff := cmp.Diff(exp, bld.String()); diff != "" {
+		t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+	}
+}
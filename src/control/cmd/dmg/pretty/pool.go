@@ -10,6 +10,7 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -174,6 +175,85 @@ func PrintListPoolsResponse(out, outErr io.Writer, resp *control.ListPoolsResp,
 	return pretty.PrintPoolList(queriedPools, out, verbose)
 }
 
+// PrintPoolLabelAliases generates a human-readable table of the previous labels
+// that still resolve to each pool and writes it to the supplied io.Writer.
+func PrintPoolLabelAliases(out io.Writer, pools []*daos.PoolInfo) {
+	labelTitle := "Pool"
+	aliasTitle := "Alias"
+	expiresTitle := "Expires"
+
+	var table []txtfmt.TableRow
+	for _, pool := range pools {
+		for _, alias := range pool.LabelAliases {
+			table = append(table, txtfmt.TableRow{
+				labelTitle:   pool.Name(),
+				aliasTitle:   alias.Label,
+				expiresTitle: alias.Expires.UTC().Format(time.RFC3339),
+			})
+		}
+	}
+
+	if len(table) == 0 {
+		fmt.Fprintln(out, "No pool label aliases")
+		return
+	}
+
+	tf := txtfmt.NewTableFormatter(labelTitle, aliasTitle, expiresTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
+
+// PrintPoolRebuildProgress generates a human-readable table of the rebuild progress,
+// rate and estimated time to completion of each pool and writes it to the supplied
+// io.Writer.
+func PrintPoolRebuildProgress(out io.Writer, pools []*daos.PoolInfo) {
+	poolTitle := "Pool"
+	stateTitle := "State"
+	objectsTitle := "Objects"
+	recordsTitle := "Records"
+	rateTitle := "Rate"
+	etaTitle := "ETA"
+
+	var table []txtfmt.TableRow
+	for _, pool := range pools {
+		rs := pool.Rebuild
+		if rs == nil {
+			continue
+		}
+
+		objects := fmt.Sprintf("%d", rs.Objects)
+		if rs.TotalObjects != 0 {
+			objects = fmt.Sprintf("%d/%d", rs.Objects, rs.TotalObjects)
+		}
+		rate := "-"
+		if rs.ObjectRate > 0 || rs.RecordRate > 0 {
+			rate = fmt.Sprintf("%.1f obj/s, %.1f rec/s", rs.ObjectRate, rs.RecordRate)
+		}
+		eta := "-"
+		if rs.ETASeconds > 0 {
+			eta = (time.Duration(rs.ETASeconds) * time.Second).String()
+		}
+
+		table = append(table, txtfmt.TableRow{
+			poolTitle:    pool.Name(),
+			stateTitle:   pool.RebuildState(),
+			objectsTitle: objects,
+			recordsTitle: fmt.Sprintf("%d", rs.Records),
+			rateTitle:    rate,
+			etaTitle:     eta,
+		})
+	}
+
+	if len(table) == 0 {
+		fmt.Fprintln(out, "No pool rebuild progress")
+		return
+	}
+
+	tf := txtfmt.NewTableFormatter(poolTitle, stateTitle, objectsTitle, recordsTitle, rateTitle, etaTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
+
 // PrintPoolProperties displays a two-column table of pool property names and values.
 func PrintPoolProperties(poolID string, out io.Writer, properties ...*daos.PoolProperty) {
 	fmt.Fprintf(out, "Pool %s properties:\n", poolID)
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -634,3 +635,116 @@ Label UUID                                 State SvcReps SCM Size SCM Used SCM I
 		})
 	}
 }
+
+func TestPretty_PrintPoolLabelAliases(t *testing.T) {
+	expires := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		pools       []*daos.PoolInfo
+		expPrintStr string
+	}{
+		"no pools": {
+			expPrintStr: `
+No pool label aliases
+`,
+		},
+		"no aliases": {
+			pools: []*daos.PoolInfo{
+				{UUID: test.MockPoolUUID(1), Label: "one"},
+			},
+			expPrintStr: `
+No pool label aliases
+`,
+		},
+		"aliases": {
+			pools: []*daos.PoolInfo{
+				{
+					UUID:  test.MockPoolUUID(1),
+					Label: "one",
+					LabelAliases: []*daos.PoolLabelAlias{
+						{Label: "first", Expires: expires},
+						{Label: "uno", Expires: expires.Add(time.Hour)},
+					},
+				},
+				{UUID: test.MockPoolUUID(2), Label: "two"},
+			},
+			expPrintStr: `
+Pool Alias Expires              
+---- ----- -------              
+one  first 2025-03-01T12:00:00Z 
+one  uno   2025-03-01T13:00:00Z 
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolLabelAliases(&bld, tc.pools)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolRebuildProgress(t *testing.T) {
+	for name, tc := range map[string]struct {
+		pools       []*daos.PoolInfo
+		expPrintStr string
+	}{
+		"no pools": {
+			expPrintStr: `
+No pool rebuild progress
+`,
+		},
+		"no rebuild status": {
+			pools: []*daos.PoolInfo{
+				{UUID: test.MockPoolUUID(1), Label: "one"},
+			},
+			expPrintStr: `
+No pool rebuild progress
+`,
+		},
+		"rebuilding pools": {
+			pools: []*daos.PoolInfo{
+				{
+					UUID:  test.MockPoolUUID(1),
+					Label: "one",
+					Rebuild: &daos.PoolRebuildStatus{
+						State:        daos.PoolRebuildStateBusy,
+						Objects:      200,
+						Records:      3000,
+						TotalObjects: 1000,
+						ObjectRate:   10,
+						RecordRate:   200,
+						ETASeconds:   80,
+					},
+				},
+				{
+					UUID:  test.MockPoolUUID(2),
+					Label: "two",
+					Rebuild: &daos.PoolRebuildStatus{
+						State:   daos.PoolRebuildStateBusy,
+						Objects: 5,
+						Records: 50,
+					},
+				},
+			},
+			expPrintStr: `
+Pool State Objects  Records Rate                    ETA   
+---- ----- -------  ------- ----                    ---   
+one  busy  200/1000 3000    10.0 obj/s, 200.0 rec/s 1m20s 
+two  busy  5        50      -                       -     
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolRebuildProgress(&bld, tc.pools)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+func traceDuration(d time.Duration) string {
+	return d.Round(time.Microsecond).String()
+}
+
+// PrintTrace generates a human-readable summary of the requests recorded by
+// the tracer and writes it to the supplied io.Writer. Each request is listed
+// with its retries, timeouts and redirects, followed by the dial time and
+// latency of each RPC made to a host.
+func PrintTrace(out io.Writer, t *control.Tracer) {
+	requestTitle := "Request"
+	durationTitle := "Duration"
+	retriesTitle := "Retries"
+	timeoutsTitle := "Timeouts"
+	redirectsTitle := "Redirects"
+	resultTitle := "Result"
+	hostTitle := "Host"
+	tryTitle := "Try"
+	dialTitle := "Dial"
+	rpcTitle := "RPC"
+
+	fmt.Fprintf(out, "Trace %s: %d %s in %s\n", t.TraceID, len(t.Requests),
+		common.Pluralise("request", len(t.Requests)), traceDuration(t.Duration))
+	if len(t.Requests) == 0 {
+		return
+	}
+	fmt.Fprintln(out)
+
+	var reqTable, rpcTable []txtfmt.TableRow
+	for _, rt := range t.Requests {
+		var redirects []string
+		for _, rd := range rt.Redirects {
+			redirects = append(redirects, fmt.Sprintf("%s -> %s", rd.Reason, strings.Join(rd.Hosts, ",")))
+		}
+		result := "OK"
+		if rt.Error != "" {
+			result = rt.Error
+		}
+		reqTable = append(reqTable, txtfmt.TableRow{
+			requestTitle:   rt.Name,
+			durationTitle:  traceDuration(rt.Duration),
+			retriesTitle:   fmt.Sprint(rt.Retries),
+			timeoutsTitle:  fmt.Sprint(rt.Timeouts),
+			redirectsTitle: strings.Join(redirects, "; "),
+			resultTitle:    result,
+		})
+
+		for _, rpc := range rt.RPCs {
+			result := "OK"
+			switch {
+			case rpc.TimedOut:
+				result = "timed out"
+			case rpc.Error != "":
+				result = rpc.Error
+			}
+			rpcTable = append(rpcTable, txtfmt.TableRow{
+				requestTitle: rt.Name,
+				hostTitle:    rpc.Host,
+				tryTitle:     fmt.Sprint(rpc.Try),
+				dialTitle:    traceDuration(rpc.Dial),
+				rpcTitle:     traceDuration(rpc.Duration),
+				resultTitle:  result,
+			})
+		}
+	}
+
+	tf := txtfmt.NewTableFormatter(requestTitle, durationTitle, retriesTitle, timeoutsTitle,
+		redirectsTitle, resultTitle)
+	tf.InitWriter(out)
+	tf.Format(reqTable)
+
+	if len(rpcTable) == 0 {
+		return
+	}
+	fmt.Fprintln(out)
+
+	tf = txtfmt.NewTableFormatter(requestTitle, hostTitle, tryTitle, dialTitle, rpcTitle, resultTitle)
+	tf.InitWriter(out)
+	tf.Format(rpcTable)
+}
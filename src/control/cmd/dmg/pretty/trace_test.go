@@ -0,0 +1,92 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintTrace(t *testing.T) {
+	for name, tc := range map[string]struct {
+		tracer      *control.Tracer
+		expPrintStr string
+	}{
+		"no requests": {
+			tracer: &control.Tracer{
+				TraceID:  "4bf92f3577b34da6a3ce929d0e0e4736",
+				Duration: 1500 * time.Microsecond,
+			},
+			expPrintStr: `
+Trace 4bf92f3577b34da6a3ce929d0e0e4736: 0 requests in 1.5ms
+`,
+		},
+		"retried request": {
+			tracer: &control.Tracer{
+				TraceID:  "4bf92f3577b34da6a3ce929d0e0e4736",
+				Duration: 2 * time.Second,
+				Requests: []*control.RequestTrace{
+					{
+						Name:     "SystemQueryReq",
+						Duration: 1900 * time.Millisecond,
+						Retries:  1,
+						Timeouts: 1,
+						Redirects: []*control.RequestRedirect{
+							{Reason: "not leader", Hosts: []string{"host2:10001"}},
+						},
+						RPCs: []*control.RPCTrace{
+							{
+								Host:     "host1:10001",
+								Dial:     2 * time.Millisecond,
+								Duration: 3 * time.Millisecond,
+								Error:    "not leader",
+							},
+							{
+								Host:     "host3:10001",
+								Dial:     time.Second,
+								TimedOut: true,
+							},
+							{
+								Host:     "host2:10001",
+								Try:      1,
+								Dial:     1500 * time.Microsecond,
+								Duration: 40 * time.Millisecond,
+							},
+						},
+					},
+				},
+			},
+			expPrintStr: `
+Trace 4bf92f3577b34da6a3ce929d0e0e4736: 1 request in 2s
+
+Request        Duration Retries Timeouts Redirects                 Result 
+-------        -------- ------- -------- ---------                 ------ 
+SystemQueryReq 1.9s     1       1        not leader -> host2:10001 OK     
+
+Request        Host        Try Dial  RPC  Result     
+-------        ----        --- ----  ---  ------     
+SystemQueryReq host1:10001 0   2ms   3ms  not leader 
+SystemQueryReq host3:10001 0   1s    0s   timed out  
+SystemQueryReq host2:10001 1   1.5ms 40ms OK         
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintTrace(&bld, tc.tracer)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
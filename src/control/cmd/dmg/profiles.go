@@ -0,0 +1,254 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type (
+	// profilesPathSetter is an interface for setting the path of the
+	// system profiles file on a command.
+	profilesPathSetter interface {
+		setProfilesPath(string)
+	}
+
+	// profilesCmd is a structure that can be used by commands that
+	// operate on the system profiles file.
+	profilesCmd struct {
+		profilesPath string
+	}
+)
+
+func (cmd *profilesCmd) setProfilesPath(path string) {
+	cmd.profilesPath = path
+}
+
+// configUseSystemCmd is the struct representing the command to select the
+// system profile used by default.
+type configUseSystemCmd struct {
+	cmdutil.LogCmd
+	profilesCmd
+	Args struct {
+		System string `positional-arg-name:"<system>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when configUseSystemCmd activates.
+func (cmd *configUseSystemCmd) Execute(_ []string) error {
+	sp, err := control.LoadSystemProfiles(cmd.profilesPath)
+	if err != nil {
+		return errors.Wrap(err, "unable to load system profiles")
+	}
+
+	if err := sp.SetCurrentSystem(cmd.Args.System); err != nil {
+		return err
+	}
+	cmd.Infof("Now using system %q from %s", cmd.Args.System, sp.Path)
+
+	return nil
+}
+
+// configListSystemsCmd is the struct representing the command to list the
+// systems defined in the system profiles file.
+type configListSystemsCmd struct {
+	cmdutil.LogCmd
+	cmdutil.JSONOutputCmd
+	profilesCmd
+}
+
+type systemProfileInfo struct {
+	Profile    string   `json:"profile"`
+	Current    bool     `json:"current"`
+	SystemName string   `json:"system_name"`
+	HostList   []string `json:"hostlist"`
+}
+
+// Execute is run when configListSystemsCmd activates.
+func (cmd *configListSystemsCmd) Execute(_ []string) error {
+	sp, err := control.LoadSystemProfiles(cmd.profilesPath)
+	if err != nil {
+		return errors.Wrap(err, "unable to load system profiles")
+	}
+
+	var infos []*systemProfileInfo
+	for _, name := range sp.Names() {
+		cfg, err := sp.Config(name)
+		if err != nil {
+			return err
+		}
+		infos = append(infos, &systemProfileInfo{
+			Profile:    name,
+			Current:    name == sp.CurrentSystem,
+			SystemName: cfg.SystemName,
+			HostList:   cfg.HostList,
+		})
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(infos, nil)
+	}
+
+	currentTitle := "Current"
+	profileTitle := "Profile"
+	nameTitle := "System Name"
+	hostsTitle := "Hosts"
+
+	var table []txtfmt.TableRow
+	for _, info := range infos {
+		current := ""
+		if info.Current {
+			current = "*"
+		}
+		table = append(table, txtfmt.TableRow{
+			currentTitle: current,
+			profileTitle: info.Profile,
+			nameTitle:    info.SystemName,
+			hostsTitle:   strings.Join(info.HostList, ","),
+		})
+	}
+
+	var bld strings.Builder
+	tf := txtfmt.NewTableFormatter(currentTitle, profileTitle, nameTitle, hostsTitle)
+	tf.InitWriter(&bld)
+	tf.Format(table)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// loadControlConfig determines the control configuration to use. A system
+// profile is used if one is selected with --system, or if no config file is
+// supplied with --config-path and a current system is set in the system
+// profiles file. Otherwise the control config file is loaded as usual.
+func loadControlConfig(opts *cliOptions) (*control.Config, error) {
+	if opts.SystemProfile != "" {
+		if opts.ConfigPath != "" {
+			return nil, errors.New("--system and --config-path may not be used together")
+		}
+		sp, err := control.LoadSystemProfiles(opts.ProfilesPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to load system profiles")
+		}
+		return sp.Config(opts.SystemProfile)
+	}
+
+	if opts.ConfigPath == "" {
+		sp, err := control.LoadSystemProfiles(opts.ProfilesPath)
+		switch {
+		case err == nil && sp.CurrentSystem != "":
+			return sp.Config(sp.CurrentSystem)
+		case err != nil && errors.Cause(err) != control.ErrNoProfilesFile:
+			return nil, errors.Wrap(err, "unable to load system profiles")
+		}
+	}
+
+	ctlCfg, err := control.LoadConfig(opts.ConfigPath)
+	if err != nil {
+		if errors.Cause(err) != control.ErrNoConfigFile {
+			return nil, errors.Wrap(err, "failed to load control configuration")
+		}
+		// Use the default config if no config file was found.
+		ctlCfg = control.DefaultConfig()
+	}
+
+	return ctlCfg, nil
+}
+
+// allSystemsAllowed indicates whether the command only reads the state of a
+// system, and so may be run against all systems at once.
+func allSystemsAllowed(cmd interface{}) bool {
+	switch cmd.(type) {
+	case *leaderQueryCmd, *systemQueryCmd, *systemGetAttrCmd, *systemGetPropCmd,
+		*systemCertsCheckCmd, *poolListCmd, *usageQueryCmd, *serverVersionCmd:
+		return true
+	default:
+		return false
+	}
+}
+
+// runAllSystems runs the command once for each system in the system profiles
+// file. Failures on one system do not prevent the command being run on the
+// others. With JSON output enabled, the output for each system is collected
+// into a single JSON object keyed by profile name.
+func runAllSystems(log logging.Logger, opts *cliOptions, cmd interface{}, wroteJSON *atm.Bool, run func(*control.Config) error) error {
+	if opts.SystemProfile != "" || opts.ConfigPath != "" {
+		return errors.New("--all-systems may not be used with --system or --config-path")
+	}
+	if !opts.HostList.Empty() {
+		return errors.New("--all-systems may not be used with --host-list")
+	}
+	if hlCmd, ok := cmd.(hostListGetter); ok && len(hlCmd.getHostList()) > 0 {
+		return errors.New("--all-systems may not be used with --host-list")
+	}
+	if !allSystemsAllowed(cmd) {
+		return errors.New("--all-systems may only be used with commands that query the system")
+	}
+
+	sp, err := control.LoadSystemProfiles(opts.ProfilesPath)
+	if err != nil {
+		return errors.Wrap(err, "unable to load system profiles")
+	}
+
+	jsonCmd, jsonOut := cmd.(cmdutil.JSONOutputter)
+	jsonOut = jsonOut && opts.JSON
+	jsonResults := make(map[string]json.RawMessage)
+
+	var failed []string
+	for _, name := range sp.Names() {
+		ctlCfg, err := sp.Config(name)
+		if err != nil {
+			return err
+		}
+
+		var buf bytes.Buffer
+		if jsonOut {
+			jsonCmd.EnableJSONOutput(&buf, atm.NewBoolRef(false))
+		} else {
+			log.Infof("System %s (%s):\n", name, ctlCfg.SystemName)
+		}
+
+		if err := run(ctlCfg); err != nil {
+			failed = append(failed, name)
+			if !jsonOut {
+				log.Errorf("%s: %s", name, err)
+			} else if buf.Len() == 0 {
+				if err := cmdutil.OutputJSON(&buf, nil, err); err != nil {
+					return err
+				}
+			}
+		}
+		if jsonOut {
+			jsonResults[name] = json.RawMessage(buf.Bytes())
+		}
+	}
+
+	var errOut error
+	if len(failed) > 0 {
+		errOut = errors.Errorf("command failed on %d of %d systems: %s", len(failed), len(sp.Systems),
+			strings.Join(failed, ", "))
+	}
+	if jsonOut {
+		wroteJSON.SetTrue()
+		if err := cmdutil.OutputJSON(os.Stdout, jsonResults, errOut); err != nil {
+			return err
+		}
+	}
+
+	return errOut
+}
@@ -0,0 +1,211 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const testSystemProfiles = `current_system: prod
+systems:
+  prod:
+    name: daos_prod
+    hostlist: ['prod-1']
+    transport_config:
+      allow_insecure: true
+  test:
+    name: daos_test
+    hostlist: ['test-1']
+    transport_config:
+      allow_insecure: true
+`
+
+func writeTestFile(t *testing.T, path, content string) string {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestDmg_loadControlConfig(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	profilesPath := writeTestFile(t, filepath.Join(dir, "profiles.yml"), testSystemProfiles)
+	noCurrentPath := writeTestFile(t, filepath.Join(dir, "no_current.yml"),
+		strings.Replace(testSystemProfiles, "current_system: prod\n", "", 1))
+	badPath := writeTestFile(t, filepath.Join(dir, "bad.yml"), "systems: {}\n")
+	cfgPath := writeTestFile(t, filepath.Join(dir, "daos_control.yml"),
+		"name: daos_cfg\nhostlist: ['cfg-1']\ntransport_config:\n  allow_insecure: true\n")
+	missingPath := filepath.Join(dir, "missing.yml")
+
+	for name, tc := range map[string]struct {
+		opts       cliOptions
+		expSysName string
+		expErr     error
+	}{
+		"selected system": {
+			opts:       cliOptions{SystemProfile: "test", ProfilesPath: profilesPath},
+			expSysName: "daos_test",
+		},
+		"unknown system": {
+			opts:   cliOptions{SystemProfile: "dev", ProfilesPath: profilesPath},
+			expErr: errors.New(`unknown system "dev"`),
+		},
+		"system and config path": {
+			opts:   cliOptions{SystemProfile: "test", ProfilesPath: profilesPath, ConfigPath: cfgPath},
+			expErr: errors.New("may not be used together"),
+		},
+		"system without profiles": {
+			opts:   cliOptions{SystemProfile: "test", ProfilesPath: missingPath},
+			expErr: control.ErrNoProfilesFile,
+		},
+		"current system": {
+			opts:       cliOptions{ProfilesPath: profilesPath},
+			expSysName: "daos_prod",
+		},
+		"invalid profiles": {
+			opts:   cliOptions{ProfilesPath: badPath},
+			expErr: errors.New("no systems defined"),
+		},
+		"config path overrides current system": {
+			opts:       cliOptions{ProfilesPath: profilesPath, ConfigPath: cfgPath},
+			expSysName: "daos_cfg",
+		},
+		"no current system": {
+			opts:       cliOptions{ProfilesPath: noCurrentPath, ConfigPath: cfgPath},
+			expSysName: "daos_cfg",
+		},
+		"no profiles": {
+			opts:       cliOptions{ProfilesPath: missingPath, ConfigPath: cfgPath},
+			expSysName: "daos_cfg",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := loadControlConfig(&tc.opts)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expSysName, cfg.SystemName, "")
+		})
+	}
+}
+
+func TestDmg_ConfigSystemsCmds(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	profilesPath := writeTestFile(t, filepath.Join(dir, "profiles.yml"), testSystemProfiles)
+
+	for _, tc := range []struct {
+		cmd    string
+		expErr error
+	}{
+		{
+			cmd: "config list-systems",
+		},
+		{
+			cmd:    "config use-system dev",
+			expErr: errors.New(`unknown system "dev"`),
+		},
+		{
+			cmd: "config use-system test",
+		},
+	} {
+		log, buf := logging.NewTestLogger(t.Name())
+		err := runCmd(t, fmt.Sprintf("--profiles-path %s %s", profilesPath, tc.cmd), log,
+			control.DefaultMockInvoker(log))
+		if tc.expErr == nil && err != nil {
+			t.Log(buf.String())
+		}
+		test.CmpErr(t, tc.expErr, err)
+	}
+
+	sp, err := control.LoadSystemProfiles(profilesPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "test", sp.CurrentSystem, "")
+}
+
+func TestDmg_runAllSystems(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	profilesPath := writeTestFile(t, filepath.Join(dir, "profiles.yml"), testSystemProfiles)
+
+	for name, tc := range map[string]struct {
+		opts       cliOptions
+		cmd        interface{}
+		failSystem string
+		expSystems []string
+		expErr     error
+	}{
+		"mutating command": {
+			opts:   cliOptions{ProfilesPath: profilesPath},
+			cmd:    new(poolCreateCmd),
+			expErr: errors.New("may only be used with commands that query"),
+		},
+		"with system": {
+			opts:   cliOptions{ProfilesPath: profilesPath, SystemProfile: "prod"},
+			cmd:    new(systemQueryCmd),
+			expErr: errors.New("may not be used with --system"),
+		},
+		"no profiles": {
+			opts:   cliOptions{ProfilesPath: filepath.Join(dir, "missing.yml")},
+			cmd:    new(systemQueryCmd),
+			expErr: control.ErrNoProfilesFile,
+		},
+		"all systems": {
+			opts:       cliOptions{ProfilesPath: profilesPath},
+			cmd:        new(systemQueryCmd),
+			expSystems: []string{"daos_prod", "daos_test"},
+		},
+		"one system fails": {
+			opts:       cliOptions{ProfilesPath: profilesPath},
+			cmd:        new(poolListCmd),
+			failSystem: "daos_prod",
+			expSystems: []string{"daos_prod", "daos_test"},
+			expErr:     errors.New("command failed on 1 of 2 systems: prod"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var gotSystems []string
+			run := func(cfg *control.Config) error {
+				gotSystems = append(gotSystems, cfg.SystemName)
+				if cfg.SystemName == tc.failSystem {
+					return errors.New("failed")
+				}
+				return nil
+			}
+
+			err := runAllSystems(log, &tc.opts, tc.cmd, atm.NewBoolRef(false), run)
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expSystems, gotSystems); diff != "" {
+				t.Fatalf("unexpected systems (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
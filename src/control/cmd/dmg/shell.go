@@ -0,0 +1,420 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/desertbit/grumble"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	shellHistoryFile      = ".dmg_history"
+	defaultWatchInterval  = 2 * time.Second
+	completionRefreshTime = 30 * time.Second
+	completionTimeout     = 5 * time.Second
+)
+
+type completionKind int
+
+const (
+	poolCompletion completionKind = iota
+	rankCompletion
+	hostCompletion
+)
+
+// completionOptions maps the options whose values can be completed from the
+// state of the system to the kind of value they take.
+var completionOptions = map[string]completionKind{
+	"--rank":      rankCompletion,
+	"--ranks":     rankCompletion,
+	"--host":      hostCompletion,
+	"--host-list": hostCompletion,
+	"-l":          hostCompletion,
+}
+
+// liveCompletions supplies values fetched from the system to the completion
+// handlers of command arguments. It is only set while the shell is
+// completing a command line.
+var liveCompletions *shellCompletions
+
+// shellCompletions holds the pool labels, ranks and hosts of the system for
+// use in completion. The values are fetched when first needed and refreshed
+// if they are older than completionRefreshTime.
+type shellCompletions struct {
+	sync.Mutex
+	log     logging.Logger
+	config  *control.Config
+	invoker control.Invoker
+	updated time.Time
+	values  map[completionKind][]string
+}
+
+func (sc *shellCompletions) refresh() {
+	if time.Since(sc.updated) < completionRefreshTime {
+		return
+	}
+	sc.updated = time.Now()
+	sc.values = make(map[completionKind][]string)
+
+	ctx, err := logging.ToContext(context.Background(), sc.log)
+	if err != nil {
+		return
+	}
+	sc.invoker.SetConfig(copyShellConfig(sc.config))
+
+	poolReq := &control.ListPoolsReq{NoQuery: true}
+	poolReq.SetTimeout(completionTimeout)
+	if resp, err := control.ListPools(ctx, sc.invoker, poolReq); err != nil {
+		sc.log.Debugf("pool completion: %s", err)
+	} else {
+		for _, pool := range resp.Pools {
+			label := pool.Label
+			if label == "" {
+				label = pool.UUID.String()
+			}
+			sc.values[poolCompletion] = append(sc.values[poolCompletion], label)
+		}
+	}
+
+	hosts := make(map[string]struct{})
+	for _, addr := range sc.config.HostList {
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+		hosts[addr] = struct{}{}
+	}
+
+	sysReq := &control.SystemQueryReq{FailOnUnavailable: true}
+	sysReq.SetTimeout(completionTimeout)
+	if resp, err := control.SystemQuery(ctx, sc.invoker, sysReq); err != nil {
+		sc.log.Debugf("rank completion: %s", err)
+	} else {
+		members := resp.Members
+		sort.Slice(members, func(i, j int) bool { return members[i].Rank < members[j].Rank })
+		for _, m := range members {
+			sc.values[rankCompletion] = append(sc.values[rankCompletion], m.Rank.String())
+			if m.Addr != nil {
+				hosts[m.Addr.IP.String()] = struct{}{}
+			}
+		}
+	}
+
+	for host := range hosts {
+		sc.values[hostCompletion] = append(sc.values[hostCompletion], host)
+	}
+	sort.Strings(sc.values[poolCompletion])
+	sort.Strings(sc.values[hostCompletion])
+}
+
+// get returns the values of the given kind that start with the supplied
+// prefix.
+func (sc *shellCompletions) get(kind completionKind, prefix string) []string {
+	if sc == nil {
+		return nil
+	}
+
+	sc.Lock()
+	defer sc.Unlock()
+
+	sc.refresh()
+
+	var matches []string
+	for _, val := range sc.values[kind] {
+		if strings.HasPrefix(val, prefix) {
+			matches = append(matches, val)
+		}
+	}
+	return matches
+}
+
+// getList completes the last element of a comma-separated list of values.
+func (sc *shellCompletions) getList(kind completionKind, prefix string) []string {
+	head := ""
+	if i := strings.LastIndex(prefix, ","); i >= 0 {
+		head, prefix = prefix[:i+1], prefix[i+1:]
+	}
+
+	var items []string
+	for _, val := range sc.get(kind, prefix) {
+		items = append(items, head+val)
+	}
+	return items
+}
+
+// getItems returns the values of the given kind that start with the supplied
+// prefix as go-flags completion items.
+func (sc *shellCompletions) getItems(kind completionKind, prefix string) []flags.Completion {
+	var items []flags.Completion
+	for _, val := range sc.get(kind, prefix) {
+		items = append(items, flags.Completion{Item: val})
+	}
+	return items
+}
+
+// copyShellConfig returns a copy of the shell's control config for use by a
+// single command, so that changes made by the command do not persist.
+func copyShellConfig(cfg *control.Config) *control.Config {
+	cfgCopy := *cfg
+	cfgCopy.HostList = append([]string{}, cfg.HostList...)
+
+	return &cfgCopy
+}
+
+type (
+	// connPersister is an interface implemented by invokers that can
+	// reuse connections across requests.
+	connPersister interface {
+		PersistConnections()
+		Close() error
+	}
+
+	// dmgShell runs dmg commands interactively using a single control
+	// config and invoker.
+	dmgShell struct {
+		log         logging.Logger
+		config      *control.Config
+		invoker     control.Invoker
+		newLog      func() *logging.LeveledLogger
+		completions *shellCompletions
+		interrupt   chan os.Signal
+	}
+)
+
+func newShell(log logging.Logger, cfg *control.Config, invoker control.Invoker, newLog func() *logging.LeveledLogger) *dmgShell {
+	return &dmgShell{
+		log:     log,
+		config:  cfg,
+		invoker: invoker,
+		newLog:  newLog,
+		completions: &shellCompletions{
+			log:     log,
+			config:  cfg,
+			invoker: invoker,
+		},
+		interrupt: make(chan os.Signal, 1),
+	}
+}
+
+// runLine runs a single dmg command with a fresh set of options and logger.
+func (sh *dmgShell) runLine(args []string) error {
+	opts := cliOptions{shellConfig: sh.config}
+	log := sh.newLog()
+
+	err := parseOpts(args, &opts, sh.invoker, log)
+	if fe, ok := errors.Cause(err).(*flags.Error); ok && fe.Type == flags.ErrHelp {
+		log.Info(fe.Error())
+		return nil
+	}
+	return err
+}
+
+// run runs a single dmg command, reporting any error in the same way as a
+// dmg invocation would.
+func (sh *dmgShell) run(args []string) error {
+	if err := sh.runLine(args); err != nil {
+		logCmdError(sh.log, err)
+	}
+	return nil
+}
+
+// watch runs the command repeatedly until interrupted.
+func (sh *dmgShell) watch(interval time.Duration, args []string) error {
+	if interval <= 0 {
+		return errors.New("watch interval must be greater than zero")
+	}
+	if args[0] == "watch" {
+		return errors.New("watch may not be nested")
+	}
+
+	// Discard any interrupt received before the watch started.
+	select {
+	case <-sh.interrupt:
+	default:
+	}
+
+	for {
+		sh.log.Infof("Every %s: %s    %s\n", interval, strings.Join(args, " "),
+			time.Now().Format(time.RFC1123))
+		sh.run(args)
+
+		select {
+		case <-sh.interrupt:
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// complete returns the completions for the partial word at the end of a
+// command line. Subcommands and options are completed by go-flags, with
+// pool labels, ranks and hosts taken from the system.
+func (sh *dmgShell) complete(words []string, prefix string) []string {
+	if len(words) > 0 {
+		if kind, found := completionOptions[words[len(words)-1]]; found {
+			return sh.completions.getList(kind, prefix)
+		}
+	}
+	if opt, val, found := strings.Cut(prefix, "="); found {
+		if kind, found := completionOptions[opt]; found {
+			var items []string
+			for _, item := range sh.completions.getList(kind, val) {
+				items = append(items, opt+"="+item)
+			}
+			return items
+		}
+	}
+
+	liveCompletions = sh.completions
+	defer func() { liveCompletions = nil }()
+
+	os.Setenv("GO_FLAGS_COMPLETION", "1")
+	defer os.Unsetenv("GO_FLAGS_COMPLETION")
+
+	var completions []flags.Completion
+	p := newParser(new(cliOptions))
+	p.CompletionHandler = func(items []flags.Completion) {
+		completions = items
+	}
+	p.ParseArgs(append(append([]string{}, words...), prefix))
+
+	var items []string
+	for _, c := range completions {
+		if c.Item == "shell" {
+			continue
+		}
+		items = append(items, c.Item)
+	}
+	return items
+}
+
+// completeWatch completes the command line of a watched command.
+func (sh *dmgShell) completeWatch(prefix string, args []string) []string {
+	if len(args) > 0 && (args[0] == "-n" || args[0] == "--interval") {
+		if len(args) == 1 {
+			return nil
+		}
+		args = args[2:]
+	} else if len(args) > 0 && strings.HasPrefix(args[0], "-") {
+		args = args[1:]
+	}
+
+	return sh.complete(args, prefix)
+}
+
+func (sh *dmgShell) createApp() *grumble.App {
+	homedir, err := os.UserHomeDir()
+	if err != nil {
+		homedir = "/tmp"
+	}
+	app := grumble.New(&grumble.Config{
+		Name:        "dmg",
+		HistoryFile: filepath.Join(homedir, shellHistoryFile),
+		Prompt:      fmt.Sprintf("dmg(%s)> ", sh.config.SystemName),
+	})
+
+	for _, cmd := range newParser(new(cliOptions)).Commands() {
+		if cmd.Hidden || cmd.Name == "shell" {
+			continue
+		}
+
+		name := cmd.Name
+		app.AddCommand(&grumble.Command{
+			Name:     name,
+			Aliases:  cmd.Aliases,
+			Help:     cmd.ShortDescription,
+			LongHelp: fmt.Sprintf("%s\n\nRun '%s --help' for details of the options and subcommands.", cmd.ShortDescription, name),
+			Args: func(a *grumble.Args) {
+				a.StringList("args", "subcommands, options and arguments", grumble.Default([]string{}))
+			},
+			Run: func(c *grumble.Context) error {
+				return sh.run(append([]string{name}, c.Args.StringList("args")...))
+			},
+			Completer: func(prefix string, args []string) []string {
+				return sh.complete(append([]string{name}, args...), prefix)
+			},
+		})
+	}
+
+	app.AddCommand(&grumble.Command{
+		Name:     "watch",
+		Help:     "run a command periodically until interrupted",
+		LongHelp: "Run a dmg command periodically until interrupted with Ctrl-C.",
+		Usage:    "watch [-n <interval>] <command>",
+		Flags: func(f *grumble.Flags) {
+			f.Duration("n", "interval", defaultWatchInterval, "time between runs of the command")
+		},
+		Args: func(a *grumble.Args) {
+			a.StringList("command", "the command to run", grumble.Min(1))
+		},
+		Run: func(c *grumble.Context) error {
+			return sh.watch(c.Flags.Duration("interval"), c.Args.StringList("command"))
+		},
+		Completer: sh.completeWatch,
+	})
+
+	app.AddCommand(&grumble.Command{
+		Name:    "quit",
+		Aliases: []string{"q"},
+		Help:    "exit the shell",
+		Run: func(c *grumble.Context) error {
+			c.Stop()
+			return nil
+		},
+	})
+
+	return app
+}
+
+// shellCmd is the struct representing the command to start an interactive
+// shell in which dmg commands share a control config and connections.
+type shellCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	hostListCmd
+}
+
+// Execute is run when shellCmd activates.
+func (cmd *shellCmd) Execute(_ []string) error {
+	if p, ok := cmd.ctlInvoker.(connPersister); ok {
+		p.PersistConnections()
+		defer p.Close()
+	}
+
+	level := logging.LogLevelInfo
+	if ll, ok := cmd.Logger.(*logging.LeveledLogger); ok {
+		level = ll.Level()
+	}
+	newLog := func() *logging.LeveledLogger {
+		return logging.NewCommandLineLogger().WithLogLevel(level)
+	}
+	sh := newShell(cmd.Logger, cmd.config, cmd.ctlInvoker, newLog)
+
+	// Interrupts while a command runs should not end the shell.
+	signal.Notify(sh.interrupt, os.Interrupt)
+	defer signal.Stop(sh.interrupt)
+
+	app := sh.createApp()
+	// app.Run() uses the os.Args so need to clear them before running
+	os.Args = os.Args[:1]
+	return app.Run()
+}
@@ -0,0 +1,220 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func newTestShell(t *testing.T, log logging.Logger, mi control.Invoker) *dmgShell {
+	t.Helper()
+
+	cfg := control.DefaultConfig()
+	cfg.TransportConfig.AllowInsecure = true
+
+	return newShell(log, cfg, mi, func() *logging.LeveledLogger {
+		lineLog, _ := logging.NewTestLogger(t.Name())
+		return lineLog
+	})
+}
+
+func TestDmg_shellRunLine(t *testing.T) {
+	for name, tc := range map[string]struct {
+		line   string
+		expErr error
+	}{
+		"command": {
+			line: "pool list",
+		},
+		"help": {
+			line: "pool list --help",
+		},
+		"local command": {
+			line: "version",
+		},
+		"nested shell": {
+			line:   "shell",
+			expErr: errors.New("already running in the dmg shell"),
+		},
+		"config path": {
+			line:   "pool list --config-path /etc/daos/daos_control.yml",
+			expErr: errors.New("may not be used in the dmg shell"),
+		},
+		"all systems": {
+			line:   "pool list --all-systems",
+			expErr: errors.New("may not be used in the dmg shell"),
+		},
+		"unknown command": {
+			line:   "pool foo",
+			expErr: errors.New("Unknown command"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{}),
+			})
+			sh := newTestShell(t, log, mi)
+
+			err := sh.runLine(strings.Split(tc.line, " "))
+			test.CmpErr(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestDmg_shellComplete(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+		UnaryResponseSet: []*control.UnaryResponse{
+			control.MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+				Pools: []*mgmtpb.ListPoolsResp_Pool{
+					{Uuid: test.MockUUID(1), Label: "tank"},
+					{Uuid: test.MockUUID(2), Label: "scratch"},
+					{Uuid: test.MockUUID(3), Label: "test"},
+				},
+			}),
+			control.MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+				Members: []*mgmtpb.SystemMember{
+					{
+						Rank:  1,
+						Uuid:  test.MockUUID(1),
+						State: system.MemberStateJoined.String(),
+						Addr:  "10.0.0.2:10001",
+					},
+					{
+						Rank:  0,
+						Uuid:  test.MockUUID(0),
+						State: system.MemberStateJoined.String(),
+						Addr:  "10.0.0.1:10001",
+					},
+				},
+			}),
+		},
+	})
+	sh := newTestShell(t, log, mi)
+
+	for _, tc := range []struct {
+		words  []string
+		prefix string
+		exp    []string
+	}{
+		{
+			words:  []string{"pool"},
+			prefix: "quer",
+			exp:    []string{"query", "query-targets"},
+		},
+		{
+			words:  []string{"pool", "query"},
+			prefix: "t",
+			exp:    []string{"tank", "test"},
+		},
+		{
+			words:  []string{"system", "stop", "--ranks"},
+			prefix: "",
+			exp:    []string{"0", "1"},
+		},
+		{
+			words:  []string{"system", "stop", "--ranks"},
+			prefix: "0,",
+			exp:    []string{"0,0", "0,1"},
+		},
+		{
+			words:  []string{"system", "query"},
+			prefix: "--ranks=1",
+			exp:    []string{"--ranks=1"},
+		},
+		{
+			words:  []string{"system", "query", "-l"},
+			prefix: "",
+			exp:    []string{"10.0.0.1", "10.0.0.2", "localhost"},
+		},
+		{
+			words:  []string{},
+			prefix: "she",
+		},
+	} {
+		t.Run(strings.Join(append(tc.words, tc.prefix), " "), func(t *testing.T) {
+			got := sh.complete(tc.words, tc.prefix)
+			if diff := cmp.Diff(tc.exp, got); diff != "" {
+				t.Fatalf("unexpected completions (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+
+	// The values are only fetched once.
+	test.AssertEqual(t, 2, mi.GetInvokeCount(), "")
+	test.AssertTrue(t, liveCompletions == nil, "live completions left set")
+}
+
+func TestDmg_shellWatch(t *testing.T) {
+	for name, tc := range map[string]struct {
+		interval time.Duration
+		args     []string
+		expErr   error
+	}{
+		"bad interval": {
+			args:   []string{"pool", "list"},
+			expErr: errors.New("greater than zero"),
+		},
+		"nested watch": {
+			interval: time.Millisecond,
+			args:     []string{"watch", "pool", "list"},
+			expErr:   errors.New("may not be nested"),
+		},
+		"interrupted": {
+			interval: time.Millisecond,
+			args:     []string{"pool", "list"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{}),
+			})
+			sh := newTestShell(t, log, mi)
+
+			if tc.expErr == nil {
+				// A stale interrupt should not stop the watch.
+				sh.interrupt <- os.Interrupt
+				go func() {
+					for mi.GetInvokeCount() < 3 {
+						time.Sleep(time.Millisecond)
+					}
+					sh.interrupt <- os.Interrupt
+				}()
+			}
+
+			err := sh.watch(tc.interval, tc.args)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertTrue(t, mi.GetInvokeCount() >= 3, "command not run repeatedly")
+			test.AssertTrue(t, strings.Contains(buf.String(), "Every 1ms: pool list"),
+				"missing watch header")
+		})
+	}
+}
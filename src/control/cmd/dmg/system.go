@@ -8,9 +8,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
@@ -44,6 +47,8 @@ type SystemCmd struct {
 	DelAttr      systemDelAttrCmd      `command:"del-attr" description:"Delete system attributes"`
 	SetProp      systemSetPropCmd      `command:"set-prop" description:"Set system properties"`
 	GetProp      systemGetPropCmd      `command:"get-prop" description:"Get system properties"`
+	Certs        systemCertsCmd        `command:"certs" description:"Inspect the TLS certificates in use by the DAOS system"`
+	Audit        systemAuditCmd        `command:"audit" description:"Inspect the admin audit log of the DAOS system"`
 }
 
 type baseCtlCmd struct {
@@ -308,6 +313,7 @@ func (cmd *systemClearExcludeCmd) Execute(_ []string) error {
 
 type systemDrainCmd struct {
 	baseRankListCmd
+	asyncCmd
 }
 
 func (cmd *systemDrainCmd) execute(reint bool) (errOut error) {
@@ -332,6 +338,11 @@ func (cmd *systemDrainCmd) execute(reint bool) (errOut error) {
 	req.Ranks.Replace(&cmd.Ranks.RankSet)
 	req.Reint = reint
 
+	if cmd.Async {
+		op, err := control.SystemDrainAsync(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+		return outputOperationStarted(cmd, op, err)
+	}
+
 	resp, err := control.SystemDrain(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
 		return err // control api returned an error, disregard response
@@ -669,3 +680,165 @@ func (cmd *systemGetPropCmd) Execute(_ []string) error {
 
 	return nil
 }
+
+type systemCertsCmd struct {
+	Check systemCertsCheckCmd `command:"check" description:"Report the expiry of the TLS certificates in use on each server"`
+}
+
+// systemCertsCheckCmd is the struct representing the command to check the TLS
+// certificates in use by DAOS servers.
+type systemCertsCheckCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+	WarnDays uint `long:"warn-days" default:"30" description:"Flag certificates that expire within this many days"`
+}
+
+// systemHostAddrs returns the control-plane addresses of all system members.
+func systemHostAddrs(ctx context.Context, invoker control.Invoker) ([]string, error) {
+	resp, err := control.SystemQuery(ctx, invoker, new(control.SystemQueryReq))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to determine system hosts (use --host-list)")
+	}
+
+	seen := make(map[string]struct{})
+	var addrs []string
+	for _, m := range resp.Members {
+		addr := m.Addr.String()
+		if _, found := seen[addr]; found {
+			continue
+		}
+		seen[addr] = struct{}{}
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	return addrs, nil
+}
+
+// Execute is run when systemCertsCheckCmd activates.
+func (cmd *systemCertsCheckCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system certs check failed")
+	}()
+
+	ctx := cmd.MustLogCtx()
+	hosts := cmd.getHostList()
+	if len(hosts) == 0 {
+		var err error
+		if hosts, err = systemHostAddrs(ctx, cmd.ctlInvoker); err != nil {
+			return err
+		}
+	}
+
+	req := new(control.CertCheckReq)
+	req.SetHostList(hosts)
+
+	resp, err := control.CertCheck(ctx, cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &bld); err != nil {
+		return err
+	}
+	pretty.PrintCertCheckResp(&bld, resp, time.Now(), cmd.WarnDays)
+	cmd.Info(bld.String())
+
+	return resp.Errors()
+}
+
+type systemAuditCmd struct {
+	List systemAuditListCmd `command:"list" description:"List mutating operations recorded in the admin audit log"`
+}
+
+// systemAuditListCmd is the struct representing the command to list entries
+// from the admin audit logs of the MS replicas.
+type systemAuditListCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+	Since   string `long:"since" description:"Only list operations since the given time (RFC3339) or duration ago (e.g. 24h)"`
+	Method  string `long:"method" description:"Only list operations with names containing the given string (e.g. PoolDestroy)"`
+	Limit   uint   `long:"limit" description:"Maximum number of most recent operations to list from each host"`
+	Verbose bool   `short:"v" long:"verbose" description:"Include the peer address and request details"`
+}
+
+// parseSince converts a time or a duration before now into a time.
+func parseSince(since string, now time.Time) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(since); err == nil {
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid --since value %q (expected RFC3339 time or duration)", since)
+	}
+	return t, nil
+}
+
+// msReplicaAddrs returns the control-plane addresses of the MS replicas.
+func msReplicaAddrs(ctx context.Context, invoker control.Invoker) ([]string, error) {
+	resp, err := control.LeaderQuery(ctx, invoker, new(control.LeaderQueryReq))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to determine MS replicas (use --host-list)")
+	}
+
+	return resp.Replicas, nil
+}
+
+// Execute is run when systemAuditListCmd activates.
+func (cmd *systemAuditListCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system audit list failed")
+	}()
+
+	since, err := parseSince(cmd.Since, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.MustLogCtx()
+	// Operations are recorded by whichever replica was the MS leader at
+	// the time, so query all of them by default.
+	hosts := cmd.getHostList()
+	if len(hosts) == 0 {
+		if hosts, err = msReplicaAddrs(ctx, cmd.ctlInvoker); err != nil {
+			return err
+		}
+	}
+
+	req := &control.AuditListReq{
+		Since:  since,
+		Method: cmd.Method,
+		Limit:  int(cmd.Limit),
+	}
+	req.SetHostList(hosts)
+
+	resp, err := control.AuditList(ctx, cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &bld); err != nil {
+		return err
+	}
+	pretty.PrintAuditListResp(&bld, resp, cmd.Verbose)
+	cmd.Info(bld.String())
+
+	return resp.Errors()
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -393,6 +394,36 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system certs check",
+			"system certs check --host-list foo1,foo2",
+			printRequest(t, func() *control.CertCheckReq {
+				req := new(control.CertCheckReq)
+				req.SetHostList([]string{"foo1", "foo2"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"system audit list",
+			"system audit list --host-list foo1,foo2 --since 2025-01-01T00:00:00Z --method Pool --limit 10",
+			printRequest(t, func() *control.AuditListReq {
+				req := &control.AuditListReq{
+					Since:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					Method: "Pool",
+					Limit:  10,
+				}
+				req.SetHostList([]string{"foo1", "foo2"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"system audit list bad since",
+			"system audit list --host-list foo1 --since yesterday",
+			"",
+			errors.New("invalid --since"),
+		},
 		{
 			"Non-existent subcommand",
 			"system quack",
@@ -495,3 +526,33 @@ func TestDmg_systemStartCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestDmg_parseSince(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		since  string
+		expErr error
+		expT   time.Time
+	}{
+		"empty": {},
+		"duration": {
+			since: "24h",
+			expT:  now.Add(-24 * time.Hour),
+		},
+		"time": {
+			since: "2025-01-01T00:00:00Z",
+			expT:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"invalid": {
+			since:  "yesterday",
+			expErr: errors.New("invalid --since"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseSince(tc.since, now)
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertTrue(t, tc.expT.Equal(got), "unexpected time "+got.String())
+		})
+	}
+}
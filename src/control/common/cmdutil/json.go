@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -27,18 +28,23 @@ type (
 	}
 )
 
+// errorStatus returns the status and message to be reported for the given
+// error.
+func errorStatus(inErr error) (int, *string) {
+	if inErr == nil {
+		return 0, nil
+	}
+
+	errStr := inErr.Error()
+	if s, ok := errors.Cause(inErr).(daos.Status); ok {
+		return int(s), &errStr
+	}
+	return int(daos.MiscError), &errStr
+}
+
 // OutputJSON writes the given data or error to the given writer as JSON.
 func OutputJSON(writer io.Writer, in interface{}, inErr error) error {
-	status := 0
-	var errStr *string
-	if inErr != nil {
-		errStr = func() *string { str := inErr.Error(); return &str }()
-		if s, ok := errors.Cause(inErr).(daos.Status); ok {
-			status = int(s)
-		} else {
-			status = int(daos.MiscError)
-		}
-	}
+	status, errStr := errorStatus(inErr)
 
 	data, err := json.MarshalIndent(struct {
 		Response interface{} `json:"response"`
@@ -62,6 +68,7 @@ type JSONOutputCmd struct {
 	writer      io.Writer
 	jsonEnabled atm.Bool
 	wroteJSON   *atm.Bool
+	format      *OutputFormat
 }
 
 // EnableJSONOutput enables JSON output to the given writer. The
@@ -81,10 +88,20 @@ func (cmd *JSONOutputCmd) JSONOutputEnabled() bool {
 	return cmd.jsonEnabled.IsTrue()
 }
 
-// OutputJSON writes the given data or error to the command's writer as JSON.
+// SetOutputFormat sets the format in which output is written when output is
+// enabled. JSON is written if no format is set.
+func (cmd *JSONOutputCmd) SetOutputFormat(format *OutputFormat) {
+	cmd.format = format
+}
+
+// OutputJSON writes the given data or error to the command's writer as JSON,
+// or in the command's output format if one has been set.
 func (cmd *JSONOutputCmd) OutputJSON(in interface{}, err error) error {
 	if cmd.JSONOutputEnabled() && cmd.wroteJSON.IsFalse() {
 		cmd.wroteJSON.SetTrue()
+		if cmd.format != nil {
+			return cmd.format.Write(cmd.writer, in, err)
+		}
 		return OutputJSON(cmd.writer, in, err)
 	}
 
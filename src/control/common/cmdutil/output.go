@@ -0,0 +1,452 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package cmdutil
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// OutputFormatDescription describes the values accepted for an output format
+// option.
+const OutputFormatDescription = "Output format: json, yaml, csv, template=<go-template> or jsonpath=<expr>"
+
+const (
+	outputJSON     = "json"
+	outputYAML     = "yaml"
+	outputCSV      = "csv"
+	outputTemplate = "template"
+	outputJSONPath = "jsonpath"
+)
+
+var _ OutputFormatter = (*JSONOutputCmd)(nil)
+
+type (
+	// OutputFormatter is an interface for commands that can write their
+	// output in a format other than JSON.
+	OutputFormatter interface {
+		SetOutputFormat(*OutputFormat)
+	}
+
+	// OutputFormat describes a format in which the response of a command
+	// is written. The response is first converted to its JSON form, so
+	// that field names are the same in every format.
+	OutputFormat struct {
+		name string
+		expr string
+		tmpl *template.Template
+		path []pathStep
+	}
+
+	// pathStep is a single step of a JSONPath expression. A step selects
+	// a named field, an element of an array, or with wildcard set, all
+	// fields or elements.
+	pathStep struct {
+		field    string
+		index    *int
+		wildcard bool
+	}
+)
+
+// pathStepRegexp matches a single step of a JSONPath expression.
+var pathStepRegexp = regexp.MustCompile(`^(?:\.([A-Za-z0-9_-]+)|\.\*|\[(-?[0-9]+|\*)\]|\['([^']+)'\])`)
+
+// ParseOutputFormat parses an output format specification of the form
+// json, yaml, csv, template=<go-template> or jsonpath=<expr>. A nil format is
+// returned for an empty specification.
+func ParseOutputFormat(spec string) (*OutputFormat, error) {
+	if spec == "" {
+		return nil, nil
+	}
+
+	name, expr, hasExpr := strings.Cut(spec, "=")
+	of := &OutputFormat{name: name, expr: expr}
+
+	switch name {
+	case outputJSON, outputYAML, outputCSV:
+		if hasExpr {
+			return nil, errors.Errorf("output format %q does not take an expression", name)
+		}
+	case outputTemplate:
+		tmpl, err := template.New("output").Funcs(template.FuncMap{
+			"json": func(v interface{}) (string, error) {
+				data, err := json.Marshal(v)
+				return string(data), err
+			},
+			"join": func(sep string, v interface{}) string {
+				list, _ := v.([]interface{})
+				strs := make([]string, 0, len(list))
+				for _, elem := range list {
+					strs = append(strs, csvValue(elem))
+				}
+				return strings.Join(strs, sep)
+			},
+		}).Parse(expr)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid output template")
+		}
+		of.tmpl = tmpl
+	case outputJSONPath:
+		path, err := parseJSONPath(expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid jsonpath expression %q", expr)
+		}
+		of.path = path
+	default:
+		return nil, errors.Errorf("unknown output format %q (%s)", spec,
+			strings.TrimPrefix(OutputFormatDescription, "Output format: "))
+	}
+	if (name == outputTemplate || name == outputJSONPath) && expr == "" {
+		return nil, errors.Errorf("output format %q requires an expression", name)
+	}
+
+	return of, nil
+}
+
+// IsJSON returns true if the format is JSON.
+func (of *OutputFormat) IsJSON() bool {
+	return of != nil && of.name == outputJSON
+}
+
+func (of *OutputFormat) String() string {
+	if of.expr == "" {
+		return of.name
+	}
+	return of.name + "=" + of.expr
+}
+
+// Write writes the given data or error to the given writer in the output
+// format. JSON and YAML output wrap the data in the same response, error and
+// status fields. The other formats write only the data, leaving the error to
+// be reported by the caller. The input error is returned.
+func (of *OutputFormat) Write(writer io.Writer, in interface{}, inErr error) error {
+	switch of.name {
+	case outputJSON:
+		return OutputJSON(writer, in, inErr)
+	case outputYAML:
+		return outputYAMLEnvelope(writer, in, inErr)
+	}
+
+	if in == nil {
+		return inErr
+	}
+
+	data, err := toGeneric(in)
+	if err != nil {
+		return err
+	}
+
+	switch of.name {
+	case outputCSV:
+		err = writeCSV(writer, data)
+	case outputTemplate:
+		err = of.tmpl.Execute(writer, data)
+	case outputJSONPath:
+		err = writeJSONPath(writer, of.path, data)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "writing %s output", of.name)
+	}
+
+	return inErr
+}
+
+func outputYAMLEnvelope(writer io.Writer, in interface{}, inErr error) error {
+	status, errStr := errorStatus(inErr)
+
+	resp, err := toGeneric(in)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(yaml.MapSlice{
+		{Key: "response", Value: resp},
+		{Key: "error", Value: errStr},
+		{Key: "status", Value: status},
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err = writer.Write(data); err != nil {
+		return err
+	}
+
+	return inErr
+}
+
+// toGeneric converts the input to the maps, slices and scalars of its JSON
+// form. Numbers are converted to int64 where possible so that they are not
+// written in floating point notation.
+func toGeneric(in interface{}) (interface{}, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var out interface{}
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return convertNumbers(out), nil
+}
+
+func convertNumbers(in interface{}) interface{} {
+	switch v := in.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			v[key] = convertNumbers(val)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = convertNumbers(val)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if u, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+			return u
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	}
+
+	return in
+}
+
+// csvRows determines the rows to be written as CSV. An array is written as
+// one row per element. An object with a single non-empty array of objects,
+// such as a list of pools or system members, is written as one row per element
+// of that array, otherwise the object is written as a single row.
+func csvRows(data interface{}) []interface{} {
+	switch v := data.(type) {
+	case []interface{}:
+		return v
+	case map[string]interface{}:
+		var candidates, nonEmpty [][]interface{}
+		for _, val := range v {
+			arr, ok := val.([]interface{})
+			if !ok {
+				continue
+			}
+			allObjects := true
+			for _, elem := range arr {
+				if _, ok := elem.(map[string]interface{}); !ok {
+					allObjects = false
+					break
+				}
+			}
+			if !allObjects {
+				continue
+			}
+			candidates = append(candidates, arr)
+			if len(arr) > 0 {
+				nonEmpty = append(nonEmpty, arr)
+			}
+		}
+
+		switch {
+		case len(nonEmpty) == 1:
+			return nonEmpty[0]
+		case len(nonEmpty) == 0 && len(candidates) > 0:
+			return nil
+		}
+	}
+
+	return []interface{}{data}
+}
+
+func csvValue(in interface{}) string {
+	switch v := in.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// writeCSV writes the data as CSV with a header row. Columns are the sorted
+// union of the fields of each row. Nested values are written as JSON.
+func writeCSV(writer io.Writer, data interface{}) error {
+	rows := csvRows(data)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	colSet := make(map[string]struct{})
+	for _, row := range rows {
+		if obj, ok := row.(map[string]interface{}); ok {
+			for key := range obj {
+				colSet[key] = struct{}{}
+			}
+		}
+	}
+
+	cw := csv.NewWriter(writer)
+	if len(colSet) == 0 {
+		if err := cw.Write([]string{"value"}); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := cw.Write([]string{csvValue(row)}); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+
+	cols := make([]string, 0, len(colSet))
+	for col := range colSet {
+		cols = append(cols, col)
+	}
+	sort.Strings(cols)
+
+	if err := cw.Write(cols); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		obj, _ := row.(map[string]interface{})
+		record := make([]string, len(cols))
+		for i, col := range cols {
+			record[i] = csvValue(obj[col])
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// parseJSONPath parses a JSONPath expression such as {.pools[*].label}. The
+// supported subset is field access by name, array indexing and wildcards. The
+// enclosing braces and leading $ are optional.
+func parseJSONPath(expr string) ([]pathStep, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "{") && strings.HasSuffix(expr, "}") {
+		expr = expr[1 : len(expr)-1]
+	}
+	expr = strings.TrimPrefix(expr, "$")
+	if expr == "" || expr == "." {
+		return nil, nil
+	}
+	if !strings.HasPrefix(expr, ".") && !strings.HasPrefix(expr, "[") {
+		expr = "." + expr
+	}
+
+	var steps []pathStep
+	for expr != "" {
+		m := pathStepRegexp.FindStringSubmatch(expr)
+		if m == nil {
+			return nil, errors.Errorf("unexpected %q", expr)
+		}
+		expr = expr[len(m[0]):]
+
+		switch {
+		case m[1] != "":
+			steps = append(steps, pathStep{field: m[1]})
+		case m[3] != "":
+			steps = append(steps, pathStep{field: m[3]})
+		case m[2] != "" && m[2] != "*":
+			idx, err := strconv.Atoi(m[2])
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, pathStep{index: &idx})
+		default:
+			steps = append(steps, pathStep{wildcard: true})
+		}
+	}
+
+	return steps, nil
+}
+
+// evalJSONPath returns the values selected by the path steps.
+func evalJSONPath(steps []pathStep, data interface{}) []interface{} {
+	nodes := []interface{}{data}
+
+	for _, step := range steps {
+		var next []interface{}
+		for _, node := range nodes {
+			switch v := node.(type) {
+			case map[string]interface{}:
+				switch {
+				case step.wildcard:
+					keys := make([]string, 0, len(v))
+					for key := range v {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					for _, key := range keys {
+						next = append(next, v[key])
+					}
+				case step.index == nil:
+					if val, found := v[step.field]; found {
+						next = append(next, val)
+					}
+				}
+			case []interface{}:
+				switch {
+				case step.wildcard:
+					next = append(next, v...)
+				case step.index != nil:
+					idx := *step.index
+					if idx < 0 {
+						idx += len(v)
+					}
+					if idx >= 0 && idx < len(v) {
+						next = append(next, v[idx])
+					}
+				}
+			}
+		}
+		nodes = next
+	}
+
+	return nodes
+}
+
+// writeJSONPath writes each value selected by the path on its own line.
+// Scalar values are written as-is and other values as JSON.
+func writeJSONPath(writer io.Writer, steps []pathStep, data interface{}) error {
+	for _, val := range evalJSONPath(steps, data) {
+		if _, err := fmt.Fprintln(writer, csvValue(val)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
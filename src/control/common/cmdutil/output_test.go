@@ -0,0 +1,244 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package cmdutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+type (
+	testOutputPool struct {
+		UUID  string   `json:"uuid"`
+		Label string   `json:"label,omitempty"`
+		Size  uint64   `json:"size"`
+		Ranks []uint32 `json:"ranks"`
+	}
+
+	testOutputResp struct {
+		Pools  []*testOutputPool `json:"pools"`
+		Status int               `json:"status"`
+	}
+)
+
+func TestCmdutil_ParseOutputFormat(t *testing.T) {
+	for name, tc := range map[string]struct {
+		spec    string
+		expNil  bool
+		expJSON bool
+		expErr  error
+	}{
+		"empty": {
+			expNil: true,
+		},
+		"json": {
+			spec:    "json",
+			expJSON: true,
+		},
+		"yaml": {
+			spec: "yaml",
+		},
+		"csv": {
+			spec: "csv",
+		},
+		"template": {
+			spec: "template={{.status}}",
+		},
+		"jsonpath": {
+			spec: "jsonpath={.pools[*].label}",
+		},
+		"unknown format": {
+			spec:   "xml",
+			expErr: errors.New("unknown output format"),
+		},
+		"expression not expected": {
+			spec:   "yaml=foo",
+			expErr: errors.New("does not take an expression"),
+		},
+		"missing template": {
+			spec:   "template=",
+			expErr: errors.New("requires an expression"),
+		},
+		"bad template": {
+			spec:   "template={{.foo",
+			expErr: errors.New("invalid output template"),
+		},
+		"missing jsonpath": {
+			spec:   "jsonpath",
+			expErr: errors.New("requires an expression"),
+		},
+		"bad jsonpath": {
+			spec:   "jsonpath={.pools[x]}",
+			expErr: errors.New("invalid jsonpath expression"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			of, err := ParseOutputFormat(tc.spec)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expNil, of == nil, "unexpected nil format")
+			test.AssertEqual(t, tc.expJSON, of.IsJSON(), "unexpected IsJSON()")
+		})
+	}
+}
+
+func TestCmdutil_OutputFormat_Write(t *testing.T) {
+	resp := &testOutputResp{
+		Pools: []*testOutputPool{
+			{UUID: "uuid-1", Label: "tank", Size: 1 << 40, Ranks: []uint32{0, 1}},
+			{UUID: "uuid-2", Size: 1024},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		spec      string
+		in        interface{}
+		inErr     error
+		expOutput string
+		expErr    error
+	}{
+		"yaml": {
+			spec: "yaml",
+			in:   resp,
+			expOutput: `response:
+  pools:
+  - label: tank
+    ranks:
+    - 0
+    - 1
+    size: 1099511627776
+    uuid: uuid-1
+  - ranks: null
+    size: 1024
+    uuid: uuid-2
+  status: 0
+error: null
+status: 0
+`,
+		},
+		"yaml with error": {
+			spec:  "yaml",
+			inErr: errors.New("failed"),
+			expOutput: `response: null
+error: failed
+status: -1025
+`,
+			expErr: errors.New("failed"),
+		},
+		"csv list": {
+			spec: "csv",
+			in:   resp,
+			expOutput: `label,ranks,size,uuid
+tank,"[0,1]",1099511627776,uuid-1
+,,1024,uuid-2
+`,
+		},
+		"csv empty list": {
+			spec: "csv",
+			in:   &testOutputResp{Pools: []*testOutputPool{}},
+		},
+		"csv object": {
+			spec: "csv",
+			in:   resp.Pools[1],
+			expOutput: `ranks,size,uuid
+,1024,uuid-2
+`,
+		},
+		"csv scalars": {
+			spec: "csv",
+			in:   []string{"a", "b"},
+			expOutput: `value
+a
+b
+`,
+		},
+		"csv with error": {
+			spec:   "csv",
+			inErr:  errors.New("failed"),
+			expErr: errors.New("failed"),
+		},
+		"template": {
+			spec:      `template={{range .pools}}{{.uuid}} {{.size}} {{join "," .ranks}}{{"\n"}}{{end}}`,
+			in:        resp,
+			expOutput: "uuid-1 1099511627776 0,1\nuuid-2 1024 \n",
+		},
+		"template json": {
+			spec:      `template={{json (index .pools 0).ranks}}`,
+			in:        resp,
+			expOutput: "[0,1]",
+		},
+		"template missing field": {
+			spec:   `template={{.pools.label}}`,
+			in:     resp,
+			expErr: errors.New("writing template output"),
+		},
+		"jsonpath wildcard": {
+			spec:      "jsonpath={.pools[*].uuid}",
+			in:        resp,
+			expOutput: "uuid-1\nuuid-2\n",
+		},
+		"jsonpath index": {
+			spec:      "jsonpath=$.pools[-1].size",
+			in:        resp,
+			expOutput: "1024\n",
+		},
+		"jsonpath object": {
+			spec:      "jsonpath=pools[0].ranks",
+			in:        resp,
+			expOutput: "[0,1]\n",
+		},
+		"jsonpath no match": {
+			spec: "jsonpath={.pools[5].uuid}",
+			in:   resp,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			of, err := ParseOutputFormat(tc.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			err = of.Write(&buf, tc.in, tc.inErr)
+			test.CmpErr(t, tc.expErr, err)
+
+			if diff := cmp.Diff(tc.expOutput, buf.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCmdutil_JSONOutputCmd_SetOutputFormat(t *testing.T) {
+	of, err := ParseOutputFormat("jsonpath={.label}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	var cmd JSONOutputCmd
+	cmd.EnableJSONOutput(&buf, nil)
+	cmd.SetOutputFormat(of)
+
+	if err := cmd.OutputJSON(&testOutputPool{Label: "tank"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	// Only the first output is written.
+	if err := cmd.OutputJSON(&testOutputPool{Label: "other"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	test.AssertEqual(t, "tank\n", buf.String(), "unexpected output")
+}
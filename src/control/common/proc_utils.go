@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,8 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -65,6 +68,99 @@ func getProcName(pid int, procDir string) (string, error) {
 	return readProcName(filepath.Join(procDir, strconv.Itoa(pid), "cmdline"))
 }
 
+// procStatStartTimeField is the index of the starttime field in
+// /proc/<pid>/stat, counting from the state field that follows the command
+// name.
+const procStatStartTimeField = 19
+
+func getProcStartTime(pid int, procDir string) (uint64, error) {
+	statPath := filepath.Join(procDir, strconv.Itoa(pid), "stat")
+	data, err := os.ReadFile(statPath)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to read %q", statPath)
+	}
+
+	// The command name may contain spaces and parentheses, so skip past
+	// the last closing parenthesis before splitting the remaining fields.
+	stat := string(data)
+	idx := strings.LastIndexByte(stat, ')')
+	if idx < 0 {
+		return 0, errors.Errorf("malformed %q", statPath)
+	}
+	fields := strings.Fields(stat[idx+1:])
+	if len(fields) <= procStatStartTimeField {
+		return 0, errors.Errorf("malformed %q: too few fields", statPath)
+	}
+
+	startTime, err := strconv.ParseUint(fields[procStatStartTimeField], 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "malformed %q: invalid start time", statPath)
+	}
+
+	return startTime, nil
+}
+
+// GetProcStartTime returns the start time of the process with the given pid,
+// in clock ticks since boot. Together with the pid, the start time uniquely
+// identifies a process and can be used to detect pid reuse.
+func GetProcStartTime(pid int) (uint64, error) {
+	return getProcStartTime(pid, "/proc")
+}
+
+// procClockTicks is the kernel USER_HZ value used for process times in procfs,
+// which is fixed at 100 on all supported architectures.
+const procClockTicks = 100
+
+func getBootTime(procDir string) (time.Time, error) {
+	statPath := filepath.Join(procDir, "stat")
+	data, err := os.ReadFile(statPath)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to read %q", statPath)
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != "btime" {
+			continue
+		}
+		btime, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return time.Time{}, errors.Wrapf(err, "malformed %q: invalid btime", statPath)
+		}
+		return time.Unix(btime, 0), nil
+	}
+
+	return time.Time{}, errors.Errorf("malformed %q: btime not found", statPath)
+}
+
+func procStartTimeToTime(startTime uint64, procDir string) (time.Time, error) {
+	bootTime, err := getBootTime(procDir)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return bootTime.Add(time.Duration(startTime) * time.Second / procClockTicks), nil
+}
+
+var (
+	bootTimeOnce sync.Once
+	bootTime     time.Time
+	bootTimeErr  error
+)
+
+// ProcStartTimeToTime converts a process start time as returned by
+// GetProcStartTime to wall clock time.
+func ProcStartTimeToTime(startTime uint64) (time.Time, error) {
+	bootTimeOnce.Do(func() {
+		bootTime, bootTimeErr = getBootTime("/proc")
+	})
+	if bootTimeErr != nil {
+		return time.Time{}, bootTimeErr
+	}
+
+	return bootTime.Add(time.Duration(startTime) * time.Second / procClockTicks), nil
+}
+
 // GetProcName returns the name of the process with the given pid.
 func GetProcName(pid int) (string, error) {
 	return getProcName(pid, "/proc")
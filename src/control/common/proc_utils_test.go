@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -164,3 +166,90 @@ func Test_Common_getProcPids(t *testing.T) {
 		})
 	}
 }
+
+func Test_Common_getProcStartTime(t *testing.T) {
+	procRoot := makeProcTree(t, 3)
+
+	writeStat := func(t *testing.T, pid int, contents string) {
+		t.Helper()
+		if err := os.WriteFile(fmt.Sprintf("%s/%d/stat", procRoot, pid), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeStat(t, 0, "0 (test-0) S 1 0 0 0 -1 4194560 100 0 0 0 1 2 0 0 20 0 1 0 123456 1000 10 0\n")
+	writeStat(t, 1, "1 (a (weird) name) S 1 0 0 0 -1 4194560 100 0 0 0 1 2 0 0 20 0 1 0 654321 1000 10 0\n")
+	writeStat(t, 2, "2 (test-2) S 1 0 0\n")
+
+	for name, tc := range map[string]struct {
+		procPid      int
+		expStartTime uint64
+		expErr       error
+	}{
+		"valid process": {
+			procPid:      0,
+			expStartTime: 123456,
+		},
+		"parentheses in process name": {
+			procPid:      1,
+			expStartTime: 654321,
+		},
+		"truncated stat file": {
+			procPid: 2,
+			expErr:  errors.New("too few fields"),
+		},
+		"nonexistent process": {
+			procPid: 4,
+			expErr:  errors.New("failed to read"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotStartTime, gotErr := getProcStartTime(tc.procPid, procRoot)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expStartTime, gotStartTime, "unexpected start time")
+		})
+	}
+}
+
+func Test_Common_procStartTimeToTime(t *testing.T) {
+	for name, tc := range map[string]struct {
+		stat      string
+		startTime uint64
+		expTime   time.Time
+		expErr    error
+	}{
+		"valid": {
+			stat:      "cpu  1 2 3 4\nbtime 1700000000\nprocesses 42\n",
+			startTime: 12345,
+			expTime:   time.Unix(1700000123, int64(450*time.Millisecond)),
+		},
+		"missing btime": {
+			stat:   "cpu  1 2 3 4\nprocesses 42\n",
+			expErr: errors.New("btime not found"),
+		},
+		"invalid btime": {
+			stat:   "btime foo\n",
+			expErr: errors.New("invalid btime"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			procRoot := t.TempDir()
+			if err := os.WriteFile(procRoot+"/stat", []byte(tc.stat), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			gotTime, gotErr := procStartTimeToTime(tc.startTime, procRoot)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if !gotTime.Equal(tc.expTime) {
+				t.Fatalf("expected %s, got %s", tc.expTime, gotTime)
+			}
+		})
+	}
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.2.0
+// - protoc-gen-go-grpc v1.3.0
 // - protoc             v3.5.0
 // source: ctl/ctl.proto
 
@@ -18,6 +18,26 @@ import (
 // Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
+const (
+	CtlSvc_StorageScan_FullMethodName          = "/ctl.CtlSvc/StorageScan"
+	CtlSvc_StorageFormat_FullMethodName        = "/ctl.CtlSvc/StorageFormat"
+	CtlSvc_StorageNvmeRebind_FullMethodName    = "/ctl.CtlSvc/StorageNvmeRebind"
+	CtlSvc_StorageNvmeAddDevice_FullMethodName = "/ctl.CtlSvc/StorageNvmeAddDevice"
+	CtlSvc_NetworkScan_FullMethodName          = "/ctl.CtlSvc/NetworkScan"
+	CtlSvc_FirmwareQuery_FullMethodName        = "/ctl.CtlSvc/FirmwareQuery"
+	CtlSvc_FirmwareUpdate_FullMethodName       = "/ctl.CtlSvc/FirmwareUpdate"
+	CtlSvc_SmdQuery_FullMethodName             = "/ctl.CtlSvc/SmdQuery"
+	CtlSvc_SmdManage_FullMethodName            = "/ctl.CtlSvc/SmdManage"
+	CtlSvc_SetEngineLogMasks_FullMethodName    = "/ctl.CtlSvc/SetEngineLogMasks"
+	CtlSvc_PrepShutdownRanks_FullMethodName    = "/ctl.CtlSvc/PrepShutdownRanks"
+	CtlSvc_StopRanks_FullMethodName            = "/ctl.CtlSvc/StopRanks"
+	CtlSvc_ResetFormatRanks_FullMethodName     = "/ctl.CtlSvc/ResetFormatRanks"
+	CtlSvc_StartRanks_FullMethodName           = "/ctl.CtlSvc/StartRanks"
+	CtlSvc_CollectLog_FullMethodName           = "/ctl.CtlSvc/CollectLog"
+	CtlSvc_CertCheck_FullMethodName            = "/ctl.CtlSvc/CertCheck"
+	CtlSvc_AuditList_FullMethodName            = "/ctl.CtlSvc/AuditList"
+)
+
 // CtlSvcClient is the client API for CtlSvc service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -52,6 +72,10 @@ type CtlSvcClient interface {
 	StartRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
 	// Perform a Log collection on Servers for support/debug purpose
 	CollectLog(ctx context.Context, in *CollectLogReq, opts ...grpc.CallOption) (*CollectLogResp, error)
+	// Retrieve details of the TLS certificates in use by the server
+	CertCheck(ctx context.Context, in *CertCheckReq, opts ...grpc.CallOption) (*CertCheckResp, error)
+	// Retrieve entries from the admin audit log of the server
+	AuditList(ctx context.Context, in *AuditListReq, opts ...grpc.CallOption) (*AuditListResp, error)
 }
 
 type ctlSvcClient struct {
@@ -64,7 +88,7 @@ func NewCtlSvcClient(cc grpc.ClientConnInterface) CtlSvcClient {
 
 func (c *ctlSvcClient) StorageScan(ctx context.Context, in *StorageScanReq, opts ...grpc.CallOption) (*StorageScanResp, error) {
 	out := new(StorageScanResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageScan", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageScan_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +97,7 @@ func (c *ctlSvcClient) StorageScan(ctx context.Context, in *StorageScanReq, opts
 
 func (c *ctlSvcClient) StorageFormat(ctx context.Context, in *StorageFormatReq, opts ...grpc.CallOption) (*StorageFormatResp, error) {
 	out := new(StorageFormatResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageFormat", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageFormat_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +106,7 @@ func (c *ctlSvcClient) StorageFormat(ctx context.Context, in *StorageFormatReq,
 
 func (c *ctlSvcClient) StorageNvmeRebind(ctx context.Context, in *NvmeRebindReq, opts ...grpc.CallOption) (*NvmeRebindResp, error) {
 	out := new(NvmeRebindResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageNvmeRebind", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageNvmeRebind_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +115,7 @@ func (c *ctlSvcClient) StorageNvmeRebind(ctx context.Context, in *NvmeRebindReq,
 
 func (c *ctlSvcClient) StorageNvmeAddDevice(ctx context.Context, in *NvmeAddDeviceReq, opts ...grpc.CallOption) (*NvmeAddDeviceResp, error) {
 	out := new(NvmeAddDeviceResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StorageNvmeAddDevice", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageNvmeAddDevice_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +124,7 @@ func (c *ctlSvcClient) StorageNvmeAddDevice(ctx context.Context, in *NvmeAddDevi
 
 func (c *ctlSvcClient) NetworkScan(ctx context.Context, in *NetworkScanReq, opts ...grpc.CallOption) (*NetworkScanResp, error) {
 	out := new(NetworkScanResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/NetworkScan", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_NetworkScan_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +133,7 @@ func (c *ctlSvcClient) NetworkScan(ctx context.Context, in *NetworkScanReq, opts
 
 func (c *ctlSvcClient) FirmwareQuery(ctx context.Context, in *FirmwareQueryReq, opts ...grpc.CallOption) (*FirmwareQueryResp, error) {
 	out := new(FirmwareQueryResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/FirmwareQuery", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_FirmwareQuery_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +142,7 @@ func (c *ctlSvcClient) FirmwareQuery(ctx context.Context, in *FirmwareQueryReq,
 
 func (c *ctlSvcClient) FirmwareUpdate(ctx context.Context, in *FirmwareUpdateReq, opts ...grpc.CallOption) (*FirmwareUpdateResp, error) {
 	out := new(FirmwareUpdateResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/FirmwareUpdate", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_FirmwareUpdate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +151,7 @@ func (c *ctlSvcClient) FirmwareUpdate(ctx context.Context, in *FirmwareUpdateReq
 
 func (c *ctlSvcClient) SmdQuery(ctx context.Context, in *SmdQueryReq, opts ...grpc.CallOption) (*SmdQueryResp, error) {
 	out := new(SmdQueryResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/SmdQuery", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_SmdQuery_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +160,7 @@ func (c *ctlSvcClient) SmdQuery(ctx context.Context, in *SmdQueryReq, opts ...gr
 
 func (c *ctlSvcClient) SmdManage(ctx context.Context, in *SmdManageReq, opts ...grpc.CallOption) (*SmdManageResp, error) {
 	out := new(SmdManageResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/SmdManage", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_SmdManage_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +169,7 @@ func (c *ctlSvcClient) SmdManage(ctx context.Context, in *SmdManageReq, opts ...
 
 func (c *ctlSvcClient) SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq, opts ...grpc.CallOption) (*SetLogMasksResp, error) {
 	out := new(SetLogMasksResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/SetEngineLogMasks", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_SetEngineLogMasks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +178,7 @@ func (c *ctlSvcClient) SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq
 
 func (c *ctlSvcClient) PrepShutdownRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	out := new(RanksResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/PrepShutdownRanks", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_PrepShutdownRanks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +187,7 @@ func (c *ctlSvcClient) PrepShutdownRanks(ctx context.Context, in *RanksReq, opts
 
 func (c *ctlSvcClient) StopRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	out := new(RanksResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StopRanks", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StopRanks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +196,7 @@ func (c *ctlSvcClient) StopRanks(ctx context.Context, in *RanksReq, opts ...grpc
 
 func (c *ctlSvcClient) ResetFormatRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	out := new(RanksResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/ResetFormatRanks", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_ResetFormatRanks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +205,7 @@ func (c *ctlSvcClient) ResetFormatRanks(ctx context.Context, in *RanksReq, opts
 
 func (c *ctlSvcClient) StartRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	out := new(RanksResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/StartRanks", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_StartRanks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -190,7 +214,25 @@ func (c *ctlSvcClient) StartRanks(ctx context.Context, in *RanksReq, opts ...grp
 
 func (c *ctlSvcClient) CollectLog(ctx context.Context, in *CollectLogReq, opts ...grpc.CallOption) (*CollectLogResp, error) {
 	out := new(CollectLogResp)
-	err := c.cc.Invoke(ctx, "/ctl.CtlSvc/CollectLog", in, out, opts...)
+	err := c.cc.Invoke(ctx, CtlSvc_CollectLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) CertCheck(ctx context.Context, in *CertCheckReq, opts ...grpc.CallOption) (*CertCheckResp, error) {
+	out := new(CertCheckResp)
+	err := c.cc.Invoke(ctx, CtlSvc_CertCheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) AuditList(ctx context.Context, in *AuditListReq, opts ...grpc.CallOption) (*AuditListResp, error) {
+	out := new(AuditListResp)
+	err := c.cc.Invoke(ctx, CtlSvc_AuditList_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +273,10 @@ type CtlSvcServer interface {
 	StartRanks(context.Context, *RanksReq) (*RanksResp, error)
 	// Perform a Log collection on Servers for support/debug purpose
 	CollectLog(context.Context, *CollectLogReq) (*CollectLogResp, error)
+	// Retrieve details of the TLS certificates in use by the server
+	CertCheck(context.Context, *CertCheckReq) (*CertCheckResp, error)
+	// Retrieve entries from the admin audit log of the server
+	AuditList(context.Context, *AuditListReq) (*AuditListResp, error)
 	mustEmbedUnimplementedCtlSvcServer()
 }
 
@@ -283,6 +329,12 @@ func (UnimplementedCtlSvcServer) StartRanks(context.Context, *RanksReq) (*RanksR
 func (UnimplementedCtlSvcServer) CollectLog(context.Context, *CollectLogReq) (*CollectLogResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectLog not implemented")
 }
+func (UnimplementedCtlSvcServer) CertCheck(context.Context, *CertCheckReq) (*CertCheckResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CertCheck not implemented")
+}
+func (UnimplementedCtlSvcServer) AuditList(context.Context, *AuditListReq) (*AuditListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditList not implemented")
+}
 func (UnimplementedCtlSvcServer) mustEmbedUnimplementedCtlSvcServer() {}
 
 // UnsafeCtlSvcServer may be embedded to opt out of forward compatibility for this service.
@@ -306,7 +358,7 @@ func _CtlSvc_StorageScan_Handler(srv interface{}, ctx context.Context, dec func(
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StorageScan",
+		FullMethod: CtlSvc_StorageScan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StorageScan(ctx, req.(*StorageScanReq))
@@ -324,7 +376,7 @@ func _CtlSvc_StorageFormat_Handler(srv interface{}, ctx context.Context, dec fun
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StorageFormat",
+		FullMethod: CtlSvc_StorageFormat_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StorageFormat(ctx, req.(*StorageFormatReq))
@@ -342,7 +394,7 @@ func _CtlSvc_StorageNvmeRebind_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StorageNvmeRebind",
+		FullMethod: CtlSvc_StorageNvmeRebind_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StorageNvmeRebind(ctx, req.(*NvmeRebindReq))
@@ -360,7 +412,7 @@ func _CtlSvc_StorageNvmeAddDevice_Handler(srv interface{}, ctx context.Context,
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StorageNvmeAddDevice",
+		FullMethod: CtlSvc_StorageNvmeAddDevice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StorageNvmeAddDevice(ctx, req.(*NvmeAddDeviceReq))
@@ -378,7 +430,7 @@ func _CtlSvc_NetworkScan_Handler(srv interface{}, ctx context.Context, dec func(
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/NetworkScan",
+		FullMethod: CtlSvc_NetworkScan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).NetworkScan(ctx, req.(*NetworkScanReq))
@@ -396,7 +448,7 @@ func _CtlSvc_FirmwareQuery_Handler(srv interface{}, ctx context.Context, dec fun
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/FirmwareQuery",
+		FullMethod: CtlSvc_FirmwareQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).FirmwareQuery(ctx, req.(*FirmwareQueryReq))
@@ -414,7 +466,7 @@ func _CtlSvc_FirmwareUpdate_Handler(srv interface{}, ctx context.Context, dec fu
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/FirmwareUpdate",
+		FullMethod: CtlSvc_FirmwareUpdate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).FirmwareUpdate(ctx, req.(*FirmwareUpdateReq))
@@ -432,7 +484,7 @@ func _CtlSvc_SmdQuery_Handler(srv interface{}, ctx context.Context, dec func(int
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/SmdQuery",
+		FullMethod: CtlSvc_SmdQuery_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).SmdQuery(ctx, req.(*SmdQueryReq))
@@ -450,7 +502,7 @@ func _CtlSvc_SmdManage_Handler(srv interface{}, ctx context.Context, dec func(in
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/SmdManage",
+		FullMethod: CtlSvc_SmdManage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).SmdManage(ctx, req.(*SmdManageReq))
@@ -468,7 +520,7 @@ func _CtlSvc_SetEngineLogMasks_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/SetEngineLogMasks",
+		FullMethod: CtlSvc_SetEngineLogMasks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).SetEngineLogMasks(ctx, req.(*SetLogMasksReq))
@@ -486,7 +538,7 @@ func _CtlSvc_PrepShutdownRanks_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/PrepShutdownRanks",
+		FullMethod: CtlSvc_PrepShutdownRanks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).PrepShutdownRanks(ctx, req.(*RanksReq))
@@ -504,7 +556,7 @@ func _CtlSvc_StopRanks_Handler(srv interface{}, ctx context.Context, dec func(in
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StopRanks",
+		FullMethod: CtlSvc_StopRanks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StopRanks(ctx, req.(*RanksReq))
@@ -522,7 +574,7 @@ func _CtlSvc_ResetFormatRanks_Handler(srv interface{}, ctx context.Context, dec
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/ResetFormatRanks",
+		FullMethod: CtlSvc_ResetFormatRanks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).ResetFormatRanks(ctx, req.(*RanksReq))
@@ -540,7 +592,7 @@ func _CtlSvc_StartRanks_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/StartRanks",
+		FullMethod: CtlSvc_StartRanks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).StartRanks(ctx, req.(*RanksReq))
@@ -558,7 +610,7 @@ func _CtlSvc_CollectLog_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ctl.CtlSvc/CollectLog",
+		FullMethod: CtlSvc_CollectLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CtlSvcServer).CollectLog(ctx, req.(*CollectLogReq))
@@ -566,6 +618,42 @@ func _CtlSvc_CollectLog_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_CertCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertCheckReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).CertCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_CertCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).CertCheck(ctx, req.(*CertCheckReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_AuditList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).AuditList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_AuditList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).AuditList(ctx, req.(*AuditListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CtlSvc_ServiceDesc is the grpc.ServiceDesc for CtlSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -633,6 +721,14 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CollectLog",
 			Handler:    _CtlSvc_CollectLog_Handler,
 		},
+		{
+			MethodName: "CertCheck",
+			Handler:    _CtlSvc_CertCheck_Handler,
+		},
+		{
+			MethodName: "AuditList",
+			Handler:    _CtlSvc_AuditList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ctl/ctl.proto",
@@ -42,7 +42,7 @@ var file_mgmt_mgmt_proto_rawDesc = []byte{
 	0x11, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x0d, 0x63, 0x68, 0x6b, 0x2f, 0x63, 0x68, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x10, 0x63, 0x68, 0x6b, 0x2f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x32, 0xc2, 0x15, 0x0a, 0x07, 0x4d, 0x67, 0x6d, 0x74, 0x53, 0x76, 0x63, 0x12,
+	0x6f, 0x74, 0x6f, 0x32, 0xd8, 0x17, 0x0a, 0x07, 0x4d, 0x67, 0x6d, 0x74, 0x53, 0x76, 0x63, 0x12,
 	0x27, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x0d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4a,
 	0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4a, 0x6f,
 	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x6c, 0x75, 0x73,
@@ -204,21 +204,39 @@ var file_mgmt_mgmt_proto_rawDesc = []byte{
 	0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
 	0x70, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x53, 0x79, 0x73, 0x74,
 	0x65, 0x6d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
-	0x37, 0x0a, 0x11, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x2e, 0x63, 0x68, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x44, 0x61,
-	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x14, 0x46, 0x61, 0x75, 0x6c,
-	0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x46, 0x61, 0x75, 0x6c, 0x74,
+	0x45, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x17, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x17, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0c, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x6d, 0x67, 0x6d,
+	0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x18,
+	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x11, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x2e, 0x63, 0x68, 0x6b,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x0e, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x44, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34,
+	0x0a, 0x14, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x6f,
+	0x6c, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x0a, 0x2e, 0x63, 0x68, 0x6b, 0x2e, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x44, 0x61, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x18, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a,
+	0x65, 0x63, 0x74, 0x4d, 0x67, 0x6d, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x46, 0x61, 0x75, 0x6c, 0x74,
 	0x12, 0x0a, 0x2e, 0x63, 0x68, 0x6b, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x1a, 0x0e, 0x2e, 0x6d,
-	0x67, 0x6d, 0x74, 0x2e, 0x44, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x38,
-	0x0a, 0x18, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x67, 0x6d,
-	0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x0a, 0x2e, 0x63, 0x68, 0x6b,
-	0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x44, 0x61,
-	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63,
-	0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x6d, 0x74, 0x2e, 0x44, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x3a,
+	0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f,
+	0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63,
+	0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var file_mgmt_mgmt_proto_goTypes = []interface{}{
@@ -263,42 +281,50 @@ var file_mgmt_mgmt_proto_goTypes = []interface{}{
 	(*SystemGetAttrReq)(nil),        // 38: mgmt.SystemGetAttrReq
 	(*SystemSetPropReq)(nil),        // 39: mgmt.SystemSetPropReq
 	(*SystemGetPropReq)(nil),        // 40: mgmt.SystemGetPropReq
-	(*chk.CheckReport)(nil),         // 41: chk.CheckReport
-	(*chk.Fault)(nil),               // 42: chk.Fault
-	(*JoinResp)(nil),                // 43: mgmt.JoinResp
-	(*shared.ClusterEventResp)(nil), // 44: shared.ClusterEventResp
-	(*LeaderQueryResp)(nil),         // 45: mgmt.LeaderQueryResp
-	(*PoolCreateResp)(nil),          // 46: mgmt.PoolCreateResp
-	(*PoolDestroyResp)(nil),         // 47: mgmt.PoolDestroyResp
-	(*PoolEvictResp)(nil),           // 48: mgmt.PoolEvictResp
-	(*PoolExcludeResp)(nil),         // 49: mgmt.PoolExcludeResp
-	(*PoolDrainResp)(nil),           // 50: mgmt.PoolDrainResp
-	(*PoolExtendResp)(nil),          // 51: mgmt.PoolExtendResp
-	(*PoolReintResp)(nil),           // 52: mgmt.PoolReintResp
-	(*PoolQueryResp)(nil),           // 53: mgmt.PoolQueryResp
-	(*PoolQueryTargetResp)(nil),     // 54: mgmt.PoolQueryTargetResp
-	(*PoolSetPropResp)(nil),         // 55: mgmt.PoolSetPropResp
-	(*PoolGetPropResp)(nil),         // 56: mgmt.PoolGetPropResp
-	(*ACLResp)(nil),                 // 57: mgmt.ACLResp
-	(*GetAttachInfoResp)(nil),       // 58: mgmt.GetAttachInfoResp
-	(*ListPoolsResp)(nil),           // 59: mgmt.ListPoolsResp
-	(*ListContResp)(nil),            // 60: mgmt.ListContResp
-	(*DaosResp)(nil),                // 61: mgmt.DaosResp
-	(*SystemQueryResp)(nil),         // 62: mgmt.SystemQueryResp
-	(*SystemStopResp)(nil),          // 63: mgmt.SystemStopResp
-	(*SystemStartResp)(nil),         // 64: mgmt.SystemStartResp
-	(*SystemExcludeResp)(nil),       // 65: mgmt.SystemExcludeResp
-	(*SystemDrainResp)(nil),         // 66: mgmt.SystemDrainResp
-	(*SystemEraseResp)(nil),         // 67: mgmt.SystemEraseResp
-	(*SystemCleanupResp)(nil),       // 68: mgmt.SystemCleanupResp
-	(*CheckStartResp)(nil),          // 69: mgmt.CheckStartResp
-	(*CheckStopResp)(nil),           // 70: mgmt.CheckStopResp
-	(*CheckQueryResp)(nil),          // 71: mgmt.CheckQueryResp
-	(*CheckGetPolicyResp)(nil),      // 72: mgmt.CheckGetPolicyResp
-	(*CheckActResp)(nil),            // 73: mgmt.CheckActResp
-	(*PoolUpgradeResp)(nil),         // 74: mgmt.PoolUpgradeResp
-	(*SystemGetAttrResp)(nil),       // 75: mgmt.SystemGetAttrResp
-	(*SystemGetPropResp)(nil),       // 76: mgmt.SystemGetPropResp
+	(*OperationStartReq)(nil),       // 41: mgmt.OperationStartReq
+	(*OperationListReq)(nil),        // 42: mgmt.OperationListReq
+	(*OperationGetReq)(nil),         // 43: mgmt.OperationGetReq
+	(*OperationCancelReq)(nil),      // 44: mgmt.OperationCancelReq
+	(*chk.CheckReport)(nil),         // 45: chk.CheckReport
+	(*chk.Fault)(nil),               // 46: chk.Fault
+	(*JoinResp)(nil),                // 47: mgmt.JoinResp
+	(*shared.ClusterEventResp)(nil), // 48: shared.ClusterEventResp
+	(*LeaderQueryResp)(nil),         // 49: mgmt.LeaderQueryResp
+	(*PoolCreateResp)(nil),          // 50: mgmt.PoolCreateResp
+	(*PoolDestroyResp)(nil),         // 51: mgmt.PoolDestroyResp
+	(*PoolEvictResp)(nil),           // 52: mgmt.PoolEvictResp
+	(*PoolExcludeResp)(nil),         // 53: mgmt.PoolExcludeResp
+	(*PoolDrainResp)(nil),           // 54: mgmt.PoolDrainResp
+	(*PoolExtendResp)(nil),          // 55: mgmt.PoolExtendResp
+	(*PoolReintResp)(nil),           // 56: mgmt.PoolReintResp
+	(*PoolQueryResp)(nil),           // 57: mgmt.PoolQueryResp
+	(*PoolQueryTargetResp)(nil),     // 58: mgmt.PoolQueryTargetResp
+	(*PoolSetPropResp)(nil),         // 59: mgmt.PoolSetPropResp
+	(*PoolGetPropResp)(nil),         // 60: mgmt.PoolGetPropResp
+	(*ACLResp)(nil),                 // 61: mgmt.ACLResp
+	(*GetAttachInfoResp)(nil),       // 62: mgmt.GetAttachInfoResp
+	(*ListPoolsResp)(nil),           // 63: mgmt.ListPoolsResp
+	(*ListContResp)(nil),            // 64: mgmt.ListContResp
+	(*DaosResp)(nil),                // 65: mgmt.DaosResp
+	(*SystemQueryResp)(nil),         // 66: mgmt.SystemQueryResp
+	(*SystemStopResp)(nil),          // 67: mgmt.SystemStopResp
+	(*SystemStartResp)(nil),         // 68: mgmt.SystemStartResp
+	(*SystemExcludeResp)(nil),       // 69: mgmt.SystemExcludeResp
+	(*SystemDrainResp)(nil),         // 70: mgmt.SystemDrainResp
+	(*SystemEraseResp)(nil),         // 71: mgmt.SystemEraseResp
+	(*SystemCleanupResp)(nil),       // 72: mgmt.SystemCleanupResp
+	(*CheckStartResp)(nil),          // 73: mgmt.CheckStartResp
+	(*CheckStopResp)(nil),           // 74: mgmt.CheckStopResp
+	(*CheckQueryResp)(nil),          // 75: mgmt.CheckQueryResp
+	(*CheckGetPolicyResp)(nil),      // 76: mgmt.CheckGetPolicyResp
+	(*CheckActResp)(nil),            // 77: mgmt.CheckActResp
+	(*PoolUpgradeResp)(nil),         // 78: mgmt.PoolUpgradeResp
+	(*SystemGetAttrResp)(nil),       // 79: mgmt.SystemGetAttrResp
+	(*SystemGetPropResp)(nil),       // 80: mgmt.SystemGetPropResp
+	(*OperationStartResp)(nil),      // 81: mgmt.OperationStartResp
+	(*OperationListResp)(nil),       // 82: mgmt.OperationListResp
+	(*OperationGetResp)(nil),        // 83: mgmt.OperationGetResp
+	(*OperationCancelResp)(nil),     // 84: mgmt.OperationCancelResp
 }
 var file_mgmt_mgmt_proto_depIdxs = []int32{
 	0,  // 0: mgmt.MgmtSvc.Join:input_type -> mgmt.JoinReq
@@ -343,56 +369,64 @@ var file_mgmt_mgmt_proto_depIdxs = []int32{
 	38, // 39: mgmt.MgmtSvc.SystemGetAttr:input_type -> mgmt.SystemGetAttrReq
 	39, // 40: mgmt.MgmtSvc.SystemSetProp:input_type -> mgmt.SystemSetPropReq
 	40, // 41: mgmt.MgmtSvc.SystemGetProp:input_type -> mgmt.SystemGetPropReq
-	41, // 42: mgmt.MgmtSvc.FaultInjectReport:input_type -> chk.CheckReport
-	42, // 43: mgmt.MgmtSvc.FaultInjectPoolFault:input_type -> chk.Fault
-	42, // 44: mgmt.MgmtSvc.FaultInjectMgmtPoolFault:input_type -> chk.Fault
-	43, // 45: mgmt.MgmtSvc.Join:output_type -> mgmt.JoinResp
-	44, // 46: mgmt.MgmtSvc.ClusterEvent:output_type -> shared.ClusterEventResp
-	45, // 47: mgmt.MgmtSvc.LeaderQuery:output_type -> mgmt.LeaderQueryResp
-	46, // 48: mgmt.MgmtSvc.PoolCreate:output_type -> mgmt.PoolCreateResp
-	47, // 49: mgmt.MgmtSvc.PoolDestroy:output_type -> mgmt.PoolDestroyResp
-	48, // 50: mgmt.MgmtSvc.PoolEvict:output_type -> mgmt.PoolEvictResp
-	49, // 51: mgmt.MgmtSvc.PoolExclude:output_type -> mgmt.PoolExcludeResp
-	50, // 52: mgmt.MgmtSvc.PoolDrain:output_type -> mgmt.PoolDrainResp
-	51, // 53: mgmt.MgmtSvc.PoolExtend:output_type -> mgmt.PoolExtendResp
-	52, // 54: mgmt.MgmtSvc.PoolReintegrate:output_type -> mgmt.PoolReintResp
-	53, // 55: mgmt.MgmtSvc.PoolQuery:output_type -> mgmt.PoolQueryResp
-	54, // 56: mgmt.MgmtSvc.PoolQueryTarget:output_type -> mgmt.PoolQueryTargetResp
-	55, // 57: mgmt.MgmtSvc.PoolSetProp:output_type -> mgmt.PoolSetPropResp
-	56, // 58: mgmt.MgmtSvc.PoolGetProp:output_type -> mgmt.PoolGetPropResp
-	57, // 59: mgmt.MgmtSvc.PoolGetACL:output_type -> mgmt.ACLResp
-	57, // 60: mgmt.MgmtSvc.PoolOverwriteACL:output_type -> mgmt.ACLResp
-	57, // 61: mgmt.MgmtSvc.PoolUpdateACL:output_type -> mgmt.ACLResp
-	57, // 62: mgmt.MgmtSvc.PoolDeleteACL:output_type -> mgmt.ACLResp
-	58, // 63: mgmt.MgmtSvc.GetAttachInfo:output_type -> mgmt.GetAttachInfoResp
-	59, // 64: mgmt.MgmtSvc.ListPools:output_type -> mgmt.ListPoolsResp
-	60, // 65: mgmt.MgmtSvc.ListContainers:output_type -> mgmt.ListContResp
-	61, // 66: mgmt.MgmtSvc.ContSetOwner:output_type -> mgmt.DaosResp
-	62, // 67: mgmt.MgmtSvc.SystemQuery:output_type -> mgmt.SystemQueryResp
-	63, // 68: mgmt.MgmtSvc.SystemStop:output_type -> mgmt.SystemStopResp
-	64, // 69: mgmt.MgmtSvc.SystemStart:output_type -> mgmt.SystemStartResp
-	65, // 70: mgmt.MgmtSvc.SystemExclude:output_type -> mgmt.SystemExcludeResp
-	66, // 71: mgmt.MgmtSvc.SystemDrain:output_type -> mgmt.SystemDrainResp
-	67, // 72: mgmt.MgmtSvc.SystemErase:output_type -> mgmt.SystemEraseResp
-	68, // 73: mgmt.MgmtSvc.SystemCleanup:output_type -> mgmt.SystemCleanupResp
-	61, // 74: mgmt.MgmtSvc.SystemCheckEnable:output_type -> mgmt.DaosResp
-	61, // 75: mgmt.MgmtSvc.SystemCheckDisable:output_type -> mgmt.DaosResp
-	69, // 76: mgmt.MgmtSvc.SystemCheckStart:output_type -> mgmt.CheckStartResp
-	70, // 77: mgmt.MgmtSvc.SystemCheckStop:output_type -> mgmt.CheckStopResp
-	71, // 78: mgmt.MgmtSvc.SystemCheckQuery:output_type -> mgmt.CheckQueryResp
-	61, // 79: mgmt.MgmtSvc.SystemCheckSetPolicy:output_type -> mgmt.DaosResp
-	72, // 80: mgmt.MgmtSvc.SystemCheckGetPolicy:output_type -> mgmt.CheckGetPolicyResp
-	73, // 81: mgmt.MgmtSvc.SystemCheckRepair:output_type -> mgmt.CheckActResp
-	74, // 82: mgmt.MgmtSvc.PoolUpgrade:output_type -> mgmt.PoolUpgradeResp
-	61, // 83: mgmt.MgmtSvc.SystemSetAttr:output_type -> mgmt.DaosResp
-	75, // 84: mgmt.MgmtSvc.SystemGetAttr:output_type -> mgmt.SystemGetAttrResp
-	61, // 85: mgmt.MgmtSvc.SystemSetProp:output_type -> mgmt.DaosResp
-	76, // 86: mgmt.MgmtSvc.SystemGetProp:output_type -> mgmt.SystemGetPropResp
-	61, // 87: mgmt.MgmtSvc.FaultInjectReport:output_type -> mgmt.DaosResp
-	61, // 88: mgmt.MgmtSvc.FaultInjectPoolFault:output_type -> mgmt.DaosResp
-	61, // 89: mgmt.MgmtSvc.FaultInjectMgmtPoolFault:output_type -> mgmt.DaosResp
-	45, // [45:90] is the sub-list for method output_type
-	0,  // [0:45] is the sub-list for method input_type
+	41, // 42: mgmt.MgmtSvc.OperationStart:input_type -> mgmt.OperationStartReq
+	42, // 43: mgmt.MgmtSvc.OperationList:input_type -> mgmt.OperationListReq
+	43, // 44: mgmt.MgmtSvc.OperationGet:input_type -> mgmt.OperationGetReq
+	44, // 45: mgmt.MgmtSvc.OperationCancel:input_type -> mgmt.OperationCancelReq
+	45, // 46: mgmt.MgmtSvc.FaultInjectReport:input_type -> chk.CheckReport
+	46, // 47: mgmt.MgmtSvc.FaultInjectPoolFault:input_type -> chk.Fault
+	46, // 48: mgmt.MgmtSvc.FaultInjectMgmtPoolFault:input_type -> chk.Fault
+	47, // 49: mgmt.MgmtSvc.Join:output_type -> mgmt.JoinResp
+	48, // 50: mgmt.MgmtSvc.ClusterEvent:output_type -> shared.ClusterEventResp
+	49, // 51: mgmt.MgmtSvc.LeaderQuery:output_type -> mgmt.LeaderQueryResp
+	50, // 52: mgmt.MgmtSvc.PoolCreate:output_type -> mgmt.PoolCreateResp
+	51, // 53: mgmt.MgmtSvc.PoolDestroy:output_type -> mgmt.PoolDestroyResp
+	52, // 54: mgmt.MgmtSvc.PoolEvict:output_type -> mgmt.PoolEvictResp
+	53, // 55: mgmt.MgmtSvc.PoolExclude:output_type -> mgmt.PoolExcludeResp
+	54, // 56: mgmt.MgmtSvc.PoolDrain:output_type -> mgmt.PoolDrainResp
+	55, // 57: mgmt.MgmtSvc.PoolExtend:output_type -> mgmt.PoolExtendResp
+	56, // 58: mgmt.MgmtSvc.PoolReintegrate:output_type -> mgmt.PoolReintResp
+	57, // 59: mgmt.MgmtSvc.PoolQuery:output_type -> mgmt.PoolQueryResp
+	58, // 60: mgmt.MgmtSvc.PoolQueryTarget:output_type -> mgmt.PoolQueryTargetResp
+	59, // 61: mgmt.MgmtSvc.PoolSetProp:output_type -> mgmt.PoolSetPropResp
+	60, // 62: mgmt.MgmtSvc.PoolGetProp:output_type -> mgmt.PoolGetPropResp
+	61, // 63: mgmt.MgmtSvc.PoolGetACL:output_type -> mgmt.ACLResp
+	61, // 64: mgmt.MgmtSvc.PoolOverwriteACL:output_type -> mgmt.ACLResp
+	61, // 65: mgmt.MgmtSvc.PoolUpdateACL:output_type -> mgmt.ACLResp
+	61, // 66: mgmt.MgmtSvc.PoolDeleteACL:output_type -> mgmt.ACLResp
+	62, // 67: mgmt.MgmtSvc.GetAttachInfo:output_type -> mgmt.GetAttachInfoResp
+	63, // 68: mgmt.MgmtSvc.ListPools:output_type -> mgmt.ListPoolsResp
+	64, // 69: mgmt.MgmtSvc.ListContainers:output_type -> mgmt.ListContResp
+	65, // 70: mgmt.MgmtSvc.ContSetOwner:output_type -> mgmt.DaosResp
+	66, // 71: mgmt.MgmtSvc.SystemQuery:output_type -> mgmt.SystemQueryResp
+	67, // 72: mgmt.MgmtSvc.SystemStop:output_type -> mgmt.SystemStopResp
+	68, // 73: mgmt.MgmtSvc.SystemStart:output_type -> mgmt.SystemStartResp
+	69, // 74: mgmt.MgmtSvc.SystemExclude:output_type -> mgmt.SystemExcludeResp
+	70, // 75: mgmt.MgmtSvc.SystemDrain:output_type -> mgmt.SystemDrainResp
+	71, // 76: mgmt.MgmtSvc.SystemErase:output_type -> mgmt.SystemEraseResp
+	72, // 77: mgmt.MgmtSvc.SystemCleanup:output_type -> mgmt.SystemCleanupResp
+	65, // 78: mgmt.MgmtSvc.SystemCheckEnable:output_type -> mgmt.DaosResp
+	65, // 79: mgmt.MgmtSvc.SystemCheckDisable:output_type -> mgmt.DaosResp
+	73, // 80: mgmt.MgmtSvc.SystemCheckStart:output_type -> mgmt.CheckStartResp
+	74, // 81: mgmt.MgmtSvc.SystemCheckStop:output_type -> mgmt.CheckStopResp
+	75, // 82: mgmt.MgmtSvc.SystemCheckQuery:output_type -> mgmt.CheckQueryResp
+	65, // 83: mgmt.MgmtSvc.SystemCheckSetPolicy:output_type -> mgmt.DaosResp
+	76, // 84: mgmt.MgmtSvc.SystemCheckGetPolicy:output_type -> mgmt.CheckGetPolicyResp
+	77, // 85: mgmt.MgmtSvc.SystemCheckRepair:output_type -> mgmt.CheckActResp
+	78, // 86: mgmt.MgmtSvc.PoolUpgrade:output_type -> mgmt.PoolUpgradeResp
+	65, // 87: mgmt.MgmtSvc.SystemSetAttr:output_type -> mgmt.DaosResp
+	79, // 88: mgmt.MgmtSvc.SystemGetAttr:output_type -> mgmt.SystemGetAttrResp
+	65, // 89: mgmt.MgmtSvc.SystemSetProp:output_type -> mgmt.DaosResp
+	80, // 90: mgmt.MgmtSvc.SystemGetProp:output_type -> mgmt.SystemGetPropResp
+	81, // 91: mgmt.MgmtSvc.OperationStart:output_type -> mgmt.OperationStartResp
+	82, // 92: mgmt.MgmtSvc.OperationList:output_type -> mgmt.OperationListResp
+	83, // 93: mgmt.MgmtSvc.OperationGet:output_type -> mgmt.OperationGetResp
+	84, // 94: mgmt.MgmtSvc.OperationCancel:output_type -> mgmt.OperationCancelResp
+	65, // 95: mgmt.MgmtSvc.FaultInjectReport:output_type -> mgmt.DaosResp
+	65, // 96: mgmt.MgmtSvc.FaultInjectPoolFault:output_type -> mgmt.DaosResp
+	65, // 97: mgmt.MgmtSvc.FaultInjectMgmtPoolFault:output_type -> mgmt.DaosResp
+	49, // [49:98] is the sub-list for method output_type
+	0,  // [0:49] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -70,6 +70,10 @@ const (
 	MgmtSvc_SystemGetAttr_FullMethodName            = "/mgmt.MgmtSvc/SystemGetAttr"
 	MgmtSvc_SystemSetProp_FullMethodName            = "/mgmt.MgmtSvc/SystemSetProp"
 	MgmtSvc_SystemGetProp_FullMethodName            = "/mgmt.MgmtSvc/SystemGetProp"
+	MgmtSvc_OperationStart_FullMethodName           = "/mgmt.MgmtSvc/OperationStart"
+	MgmtSvc_OperationList_FullMethodName            = "/mgmt.MgmtSvc/OperationList"
+	MgmtSvc_OperationGet_FullMethodName             = "/mgmt.MgmtSvc/OperationGet"
+	MgmtSvc_OperationCancel_FullMethodName          = "/mgmt.MgmtSvc/OperationCancel"
 	MgmtSvc_FaultInjectReport_FullMethodName        = "/mgmt.MgmtSvc/FaultInjectReport"
 	MgmtSvc_FaultInjectPoolFault_FullMethodName     = "/mgmt.MgmtSvc/FaultInjectPoolFault"
 	MgmtSvc_FaultInjectMgmtPoolFault_FullMethodName = "/mgmt.MgmtSvc/FaultInjectMgmtPoolFault"
@@ -164,6 +168,14 @@ type MgmtSvcClient interface {
 	SystemSetProp(ctx context.Context, in *SystemSetPropReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(ctx context.Context, in *SystemGetPropReq, opts ...grpc.CallOption) (*SystemGetPropResp, error)
+	// Start a management service request as a long-running operation.
+	OperationStart(ctx context.Context, in *OperationStartReq, opts ...grpc.CallOption) (*OperationStartResp, error)
+	// List long-running operations.
+	OperationList(ctx context.Context, in *OperationListReq, opts ...grpc.CallOption) (*OperationListResp, error)
+	// Get a long-running operation.
+	OperationGet(ctx context.Context, in *OperationGetReq, opts ...grpc.CallOption) (*OperationGetResp, error)
+	// Cancel a long-running operation.
+	OperationCancel(ctx context.Context, in *OperationCancelReq, opts ...grpc.CallOption) (*OperationCancelResp, error)
 	// Fault injection handlers are only implemented in non-release builds.
 	// FaultInjectReport injects a checker report.
 	FaultInjectReport(ctx context.Context, in *chk.CheckReport, opts ...grpc.CallOption) (*DaosResp, error)
@@ -558,6 +570,42 @@ func (c *mgmtSvcClient) SystemGetProp(ctx context.Context, in *SystemGetPropReq,
 	return out, nil
 }
 
+func (c *mgmtSvcClient) OperationStart(ctx context.Context, in *OperationStartReq, opts ...grpc.CallOption) (*OperationStartResp, error) {
+	out := new(OperationStartResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_OperationStart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) OperationList(ctx context.Context, in *OperationListReq, opts ...grpc.CallOption) (*OperationListResp, error) {
+	out := new(OperationListResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_OperationList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) OperationGet(ctx context.Context, in *OperationGetReq, opts ...grpc.CallOption) (*OperationGetResp, error) {
+	out := new(OperationGetResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_OperationGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) OperationCancel(ctx context.Context, in *OperationCancelReq, opts ...grpc.CallOption) (*OperationCancelResp, error) {
+	out := new(OperationCancelResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_OperationCancel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) FaultInjectReport(ctx context.Context, in *chk.CheckReport, opts ...grpc.CallOption) (*DaosResp, error) {
 	out := new(DaosResp)
 	err := c.cc.Invoke(ctx, MgmtSvc_FaultInjectReport_FullMethodName, in, out, opts...)
@@ -674,6 +722,14 @@ type MgmtSvcServer interface {
 	SystemSetProp(context.Context, *SystemSetPropReq) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error)
+	// Start a management service request as a long-running operation.
+	OperationStart(context.Context, *OperationStartReq) (*OperationStartResp, error)
+	// List long-running operations.
+	OperationList(context.Context, *OperationListReq) (*OperationListResp, error)
+	// Get a long-running operation.
+	OperationGet(context.Context, *OperationGetReq) (*OperationGetResp, error)
+	// Cancel a long-running operation.
+	OperationCancel(context.Context, *OperationCancelReq) (*OperationCancelResp, error)
 	// Fault injection handlers are only implemented in non-release builds.
 	// FaultInjectReport injects a checker report.
 	FaultInjectReport(context.Context, *chk.CheckReport) (*DaosResp, error)
@@ -813,6 +869,18 @@ func (UnimplementedMgmtSvcServer) SystemSetProp(context.Context, *SystemSetPropR
 func (UnimplementedMgmtSvcServer) SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemGetProp not implemented")
 }
+func (UnimplementedMgmtSvcServer) OperationStart(context.Context, *OperationStartReq) (*OperationStartResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationStart not implemented")
+}
+func (UnimplementedMgmtSvcServer) OperationList(context.Context, *OperationListReq) (*OperationListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationList not implemented")
+}
+func (UnimplementedMgmtSvcServer) OperationGet(context.Context, *OperationGetReq) (*OperationGetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationGet not implemented")
+}
+func (UnimplementedMgmtSvcServer) OperationCancel(context.Context, *OperationCancelReq) (*OperationCancelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationCancel not implemented")
+}
 func (UnimplementedMgmtSvcServer) FaultInjectReport(context.Context, *chk.CheckReport) (*DaosResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FaultInjectReport not implemented")
 }
@@ -1591,6 +1659,78 @@ func _MgmtSvc_SystemGetProp_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_OperationStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationStartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).OperationStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_OperationStart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).OperationStart(ctx, req.(*OperationStartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_OperationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).OperationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_OperationList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).OperationList(ctx, req.(*OperationListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_OperationGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationGetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).OperationGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_OperationGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).OperationGet(ctx, req.(*OperationGetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_OperationCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationCancelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).OperationCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_OperationCancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).OperationCancel(ctx, req.(*OperationCancelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_FaultInjectReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(chk.CheckReport)
 	if err := dec(in); err != nil {
@@ -1820,6 +1960,22 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SystemGetProp",
 			Handler:    _MgmtSvc_SystemGetProp_Handler,
 		},
+		{
+			MethodName: "OperationStart",
+			Handler:    _MgmtSvc_OperationStart_Handler,
+		},
+		{
+			MethodName: "OperationList",
+			Handler:    _MgmtSvc_OperationList_Handler,
+		},
+		{
+			MethodName: "OperationGet",
+			Handler:    _MgmtSvc_OperationGet_Handler,
+		},
+		{
+			MethodName: "OperationCancel",
+			Handler:    _MgmtSvc_OperationCancel_Handler,
+		},
 		{
 			MethodName: "FaultInjectReport",
 			Handler:    _MgmtSvc_FaultInjectReport_Handler,
@@ -30,6 +30,7 @@ func (id ModuleID) String() string {
 		ModuleMgmt:          "Management",
 		ModuleSrv:           "Server",
 		ModuleSecurity:      "Security",
+		ModuleAgentAdmin:    "Agent Administration",
 	}[id]; ok {
 		return name
 	}
@@ -43,6 +44,7 @@ func (id ModuleID) GetMethod(methodID int32) (Method, error) {
 		ModuleMgmt:          MgmtMethod(methodID),
 		ModuleSrv:           srvMethod(methodID),
 		ModuleSecurity:      securityMethod(methodID),
+		ModuleAgentAdmin:    agentAdminMethod(methodID),
 	}[id]; ok {
 		if !m.IsValid() {
 			return nil, errors.Errorf("invalid method %d for module %s",
@@ -67,6 +69,8 @@ const (
 	ModuleSrv ModuleID = C.DRPC_MODULE_SRV
 	// ModuleSecurity is the dRPC module for security tasks in DAOS server
 	ModuleSecurity ModuleID = C.DRPC_MODULE_SEC
+	// ModuleAgentAdmin is the dRPC module for administration of DAOS agent
+	ModuleAgentAdmin ModuleID = C.DRPC_MODULE_AGENT_ADMIN
 )
 
 type Method interface {
@@ -159,6 +163,7 @@ func (m MgmtMethod) String() string {
 		MethodPoolUpgrade:          "PoolUpgrade",
 		MethodLedManage:            "LedManage",
 		MethodSetupClientTelemetry: "SetupClientTelemetry",
+		MethodGetACLTemplate:       "GetACLTemplate",
 	}[m]; ok {
 		return s
 	}
@@ -258,6 +263,8 @@ const (
 	MethodLedManage MgmtMethod = C.DRPC_METHOD_MGMT_LED_MANAGE
 	// MethodSetupClientTelemetry defines a method to setup client telemetry
 	MethodSetupClientTelemetry MgmtMethod = C.DRPC_METHOD_MGMT_SETUP_CLIENT_TELEM
+	// MethodGetACLTemplate defines a method to look up a named ACL template
+	MethodGetACLTemplate MgmtMethod = C.DRPC_METHOD_MGMT_GET_ACL_TEMPLATE
 )
 
 type srvMethod int32
@@ -352,6 +359,48 @@ const (
 	MethodValidateCredentials securityMethod = C.DRPC_METHOD_SEC_VALIDATE_CREDS
 )
 
+type agentAdminMethod int32
+
+func (m agentAdminMethod) Module() ModuleID {
+	return ModuleAgentAdmin
+}
+
+func (m agentAdminMethod) ID() int32 {
+	return int32(m)
+}
+
+func (m agentAdminMethod) String() string {
+	if s, ok := map[agentAdminMethod]string{
+		MethodListClients:  "list clients",
+		MethodEvictClient:  "evict client",
+		MethodReloadConfig: "reload config",
+	}[m]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("%s:%d", m.Module(), m.ID())
+}
+
+// IsValid sanity checks the Method ID is within expected bounds.
+func (m agentAdminMethod) IsValid() bool {
+	startMethodID := int32(m.Module()) * moduleMethodOffset
+
+	if m.ID() <= startMethodID || m.ID() >= int32(C.NUM_DRPC_AGENT_ADMIN_METHODS) {
+		return false
+	}
+
+	return true
+}
+
+const (
+	// MethodListClients is a ModuleAgentAdmin method for listing monitored client processes
+	MethodListClients agentAdminMethod = C.DRPC_METHOD_AGENT_ADMIN_LIST_CLIENTS
+	// MethodEvictClient is a ModuleAgentAdmin method for evicting a client process's pool handles
+	MethodEvictClient agentAdminMethod = C.DRPC_METHOD_AGENT_ADMIN_EVICT_CLIENT
+	// MethodReloadConfig is a ModuleAgentAdmin method for reloading the agent configuration
+	MethodReloadConfig agentAdminMethod = C.DRPC_METHOD_AGENT_ADMIN_RELOAD_CONFIG
+)
+
 // Marshal is a utility function that can be used by dRPC method handlers to
 // marshal their method-specific response to be passed back to the ModuleService.
 func Marshal(message proto.Message) ([]byte, error) {
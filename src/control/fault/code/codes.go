@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -114,6 +115,8 @@ const (
 	SystemUnknown Code = iota + 400
 	SystemBadFaultDomainDepth
 	SystemPoolLocked
+	SystemOperationNotFound
+	SystemOperationNotRunning
 )
 
 // client fault codes
@@ -130,6 +133,7 @@ const (
 	ClientFormatRunningSystem
 	ClientRpcTimeout
 	ClientConfigVMDImbalance
+	ClientHostUnresponsive
 )
 
 // server fault codes
@@ -156,6 +160,8 @@ const (
 	ServerHugepagesDisabled
 	ServerPoolMemRatioNoRoles
 	ServerBadFaultDomainLabels
+	ServerPoolIdempotencyKeyReused
+	ServerPoolLabelDeprecated
 )
 
 // server config fault codes
@@ -211,6 +217,8 @@ const (
 	SecurityMissingCertFile
 	SecurityUnreadableCertFile
 	SecurityInvalidCert
+	SecurityRevokedCert
+	SecurityInvalidCRL
 )
 
 const (
@@ -0,0 +1,295 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+// Package audit provides an append-only, tamper-evident log of administrative
+// operations.
+//
+// Each entry is written as a single line of JSON and records the hash of the
+// entry that precedes it, so that any modification, insertion or removal of an
+// entry (other than truncation of the end of the log) can be detected by
+// verifying the chain of hashes.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// logFilePerm restricts the audit log to the owner of the server process.
+const logFilePerm os.FileMode = 0600
+
+// maxLineLen is the maximum length of a single audit log entry.
+const maxLineLen = 1024 * 1024
+
+type (
+	// Entry describes a single audited operation.
+	Entry struct {
+		Seq        uint64        `json:"seq"`
+		Time       time.Time     `json:"time"`
+		Method     string        `json:"method"`
+		Caller     string        `json:"caller"`
+		Serial     string        `json:"serial,omitempty"`
+		OnBehalfOf string        `json:"on_behalf_of,omitempty"`
+		PeerAddr   string        `json:"peer_addr"`
+		Request    string        `json:"request"`
+		Result     string        `json:"result"`
+		Duration   time.Duration `json:"duration"`
+		PrevHash   string        `json:"prev_hash"`
+		Hash       string        `json:"hash"`
+	}
+
+	// Filter selects the entries returned by Read.
+	Filter struct {
+		// Since excludes entries recorded before the given time.
+		Since time.Time
+		// Method excludes entries with methods that do not contain the
+		// given string.
+		Method string
+		// Limit restricts the result to the given number of most recent
+		// entries.
+		Limit int
+	}
+
+	// ChainError indicates that the chain of hashes in the audit log is
+	// broken, meaning that the log has been modified.
+	ChainError struct {
+		Seq    uint64
+		Reason string
+	}
+
+	// Log is an open audit log that entries may be appended to.
+	Log struct {
+		sync.Mutex
+		path     string
+		file     *os.File
+		lastSeq  uint64
+		lastHash string
+	}
+)
+
+// ResultOK is the result recorded for successful operations.
+const ResultOK = "ok"
+
+func (e *ChainError) Error() string {
+	return "audit log chain broken at entry " + strconv.FormatUint(e.Seq, 10) + ": " + e.Reason
+}
+
+// IsChainError indicates whether the error is a ChainError.
+func IsChainError(err error) bool {
+	_, ok := errors.Cause(err).(*ChainError)
+	return ok
+}
+
+// computeHash returns the hash of the entry, excluding its own hash, chained to
+// the hash of the previous entry.
+func (e *Entry) computeHash() (string, error) {
+	tmp := *e
+	tmp.Hash = ""
+	data, err := json.Marshal(&tmp)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(append([]byte(e.PrevHash), data...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (f *Filter) matches(e *Entry) bool {
+	if f == nil {
+		return true
+	}
+	if !f.Since.IsZero() && e.Time.Before(f.Since) {
+		return false
+	}
+	if f.Method != "" && !strings.Contains(e.Method, f.Method) {
+		return false
+	}
+	return true
+}
+
+// scan calls the supplied function for each entry in the log, in order.
+func scan(r io.Reader, fn func(*Entry) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineLen)
+
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		entry := new(Entry)
+		if err := json.Unmarshal(data, entry); err != nil {
+			return errors.Wrapf(err, "audit log line %d", line)
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// verifier checks each entry against the one that preceded it.
+type verifier struct {
+	lastSeq  uint64
+	lastHash string
+}
+
+func (v *verifier) check(e *Entry) error {
+	if e.Seq != v.lastSeq+1 {
+		return &ChainError{Seq: e.Seq, Reason: "expected entry " + strconv.FormatUint(v.lastSeq+1, 10)}
+	}
+	if e.PrevHash != v.lastHash {
+		return &ChainError{Seq: e.Seq, Reason: "previous hash does not match"}
+	}
+	hash, err := e.computeHash()
+	if err != nil {
+		return err
+	}
+	if e.Hash != hash {
+		return &ChainError{Seq: e.Seq, Reason: "entry hash does not match"}
+	}
+
+	v.lastSeq = e.Seq
+	v.lastHash = e.Hash
+	return nil
+}
+
+// Open opens the audit log at the given path for appending, creating it if it
+// does not exist. The log is verified before any new entries are appended to
+// it; a ChainError is returned if it has been modified.
+func Open(path string) (*Log, error) {
+	if path == "" {
+		return nil, errors.New("empty audit log path")
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, logFilePerm)
+	if err != nil {
+		return nil, errors.Wrap(err, "opening audit log")
+	}
+
+	v := new(verifier)
+	if err := scan(file, v.check); err != nil {
+		file.Close()
+		return nil, errors.Wrapf(err, "verifying audit log %s", path)
+	}
+
+	return &Log{
+		path:     path,
+		file:     file,
+		lastSeq:  v.lastSeq,
+		lastHash: v.lastHash,
+	}, nil
+}
+
+// Path returns the path of the audit log file.
+func (l *Log) Path() string {
+	return l.path
+}
+
+// Head returns the sequence number and hash of the most recent entry in the
+// log. Recording these elsewhere allows truncation of the log to be detected.
+func (l *Log) Head() (uint64, string) {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.lastSeq, l.lastHash
+}
+
+// Append adds the entry to the end of the log. The sequence number and hashes
+// of the entry are set by Append, and the entry is synced to stable storage
+// before returning.
+func (l *Log) Append(e *Entry) error {
+	if e == nil {
+		return errors.Errorf("nil %T", e)
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	if l.file == nil {
+		return errors.New("audit log is closed")
+	}
+
+	e.Seq = l.lastSeq + 1
+	e.Time = e.Time.UTC()
+	e.PrevHash = l.lastHash
+	hash, err := e.computeHash()
+	if err != nil {
+		return err
+	}
+	e.Hash = hash
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err := l.file.Write(append(data, '\n')); err != nil {
+		return errors.Wrap(err, "writing audit log entry")
+	}
+	if err := l.file.Sync(); err != nil {
+		return errors.Wrap(err, "syncing audit log")
+	}
+
+	l.lastSeq = e.Seq
+	l.lastHash = e.Hash
+	return nil
+}
+
+// Close closes the audit log.
+func (l *Log) Close() error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// Read returns the entries in the audit log at the given path that match the
+// filter, oldest first. The whole log is verified while it is read; if the
+// chain of hashes is broken, the entries read up to that point are returned
+// along with a ChainError.
+func Read(path string, filter *Filter) ([]*Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "opening audit log")
+	}
+	defer file.Close()
+
+	var entries []*Entry
+	v := new(verifier)
+	err = scan(file, func(e *Entry) error {
+		if err := v.check(e); err != nil {
+			return err
+		}
+		if filter.matches(e) {
+			entries = append(entries, e)
+		}
+		return nil
+	})
+
+	if filter != nil && filter.Limit > 0 && len(entries) > filter.Limit {
+		entries = entries[len(entries)-filter.Limit:]
+	}
+
+	return entries, err
+}
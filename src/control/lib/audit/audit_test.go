@@ -0,0 +1,213 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+var testStart = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func writeTestLog(t *testing.T, path string, methods ...string) []*Entry {
+	t.Helper()
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	var entries []*Entry
+	for i, method := range methods {
+		e := &Entry{
+			Time:     testStart.Add(time.Duration(i) * time.Hour),
+			Method:   method,
+			Caller:   "admin",
+			PeerAddr: "10.0.0.1:4242",
+			Request:  "request",
+			Result:   ResultOK,
+			Duration: time.Second,
+		}
+		if err := l.Append(e); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, e)
+	}
+
+	return entries
+}
+
+func TestAudit_Log(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	path := filepath.Join(dir, "audit.log")
+	first := writeTestLog(t, path, "/mgmt.MgmtSvc/PoolCreate", "/mgmt.MgmtSvc/PoolDestroy")
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, logFilePerm, fi.Mode().Perm(), "unexpected audit log permissions")
+
+	// Entries appended after reopening continue the chain.
+	l, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seq, hash := l.Head()
+	test.AssertEqual(t, uint64(2), seq, "")
+	test.AssertEqual(t, first[1].Hash, hash, "")
+
+	next := &Entry{Time: testStart.Add(5 * time.Hour), Method: "/mgmt.MgmtSvc/SystemStop"}
+	if err := l.Append(next); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, uint64(3), next.Seq, "")
+	test.AssertEqual(t, first[1].Hash, next.PrevHash, "")
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	test.CmpErr(t, errors.New("closed"), l.Append(&Entry{}))
+
+	entries, err := Read(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(append(first, next), entries); diff != "" {
+		t.Fatalf("unexpected entries (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestAudit_Read(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	path := filepath.Join(dir, "audit.log")
+	entries := writeTestLog(t, path,
+		"/mgmt.MgmtSvc/PoolCreate",
+		"/mgmt.MgmtSvc/PoolDestroy",
+		"/mgmt.MgmtSvc/SystemStop",
+		"/mgmt.MgmtSvc/PoolCreate",
+	)
+
+	for name, tc := range map[string]struct {
+		path       string
+		filter     *Filter
+		expEntries []*Entry
+		expErr     error
+	}{
+		"missing log": {
+			path:   filepath.Join(dir, "missing.log"),
+			expErr: errors.New("no such file"),
+		},
+		"no filter": {
+			path:       path,
+			expEntries: entries,
+		},
+		"since": {
+			path:       path,
+			filter:     &Filter{Since: testStart.Add(2 * time.Hour)},
+			expEntries: entries[2:],
+		},
+		"method": {
+			path:       path,
+			filter:     &Filter{Method: "PoolCreate"},
+			expEntries: []*Entry{entries[0], entries[3]},
+		},
+		"limit": {
+			path:       path,
+			filter:     &Filter{Method: "Pool", Limit: 2},
+			expEntries: []*Entry{entries[1], entries[3]},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := Read(tc.path, tc.filter)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expEntries, got); diff != "" {
+				t.Fatalf("unexpected entries (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAudit_Tampering(t *testing.T) {
+	for name, tc := range map[string]struct {
+		tamper     func(lines []string) []string
+		expEntries int
+		expSeq     uint64
+	}{
+		"modified entry": {
+			tamper: func(lines []string) []string {
+				lines[1] = strings.Replace(lines[1], "PoolDestroy", "PoolQuery", 1)
+				return lines
+			},
+			expEntries: 1,
+			expSeq:     2,
+		},
+		"removed entry": {
+			tamper: func(lines []string) []string {
+				return append(lines[:1], lines[2:]...)
+			},
+			expEntries: 1,
+			expSeq:     3,
+		},
+		"reordered entries": {
+			tamper: func(lines []string) []string {
+				lines[1], lines[2] = lines[2], lines[1]
+				return lines
+			},
+			expEntries: 1,
+			expSeq:     3,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			path := filepath.Join(dir, "audit.log")
+			writeTestLog(t, path,
+				"/mgmt.MgmtSvc/PoolCreate",
+				"/mgmt.MgmtSvc/PoolDestroy",
+				"/mgmt.MgmtSvc/SystemStop",
+			)
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			lines := tc.tamper(strings.Split(strings.TrimSpace(string(data)), "\n"))
+			if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), logFilePerm); err != nil {
+				t.Fatal(err)
+			}
+
+			entries, err := Read(path, nil)
+			if !IsChainError(err) {
+				t.Fatalf("expected chain error, got %v", err)
+			}
+			test.AssertEqual(t, tc.expSeq, err.(*ChainError).Seq, "")
+			test.AssertEqual(t, tc.expEntries, len(entries), "entries before the break should be returned")
+
+			_, err = Open(path)
+			if !IsChainError(err) {
+				t.Fatalf("expected chain error on open, got %v", err)
+			}
+		})
+	}
+}
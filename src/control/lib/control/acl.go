@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -142,6 +144,122 @@ func FormatACLDefault(acl *AccessControlList) string {
 	return FormatACL(acl, false)
 }
 
+// ACLDiff describes the differences between a current ACL and a desired set of
+// entries. Entries are matched by principal.
+type ACLDiff struct {
+	Added     []string `json:"added"`     // Entries for principals not in the current ACL
+	Changed   []string `json:"changed"`   // Entries with different access than the current ACL
+	Removed   []string `json:"removed"`   // Current entries for principals not desired
+	Unchanged []string `json:"unchanged"` // Entries already present in the current ACL
+}
+
+// Updates returns the entries that need to be added to or updated in the current
+// ACL in order to grant the desired access.
+func (d *ACLDiff) Updates() []string {
+	if d == nil {
+		return nil
+	}
+	return append(append([]string{}, d.Added...), d.Changed...)
+}
+
+// HasChanges indicates whether the current ACL differs from the desired one. If
+// removed is false, current entries that are not desired are not considered.
+func (d *ACLDiff) HasChanges(removed bool) bool {
+	if d == nil {
+		return false
+	}
+	return len(d.Added) > 0 || len(d.Changed) > 0 || (removed && len(d.Removed) > 0)
+}
+
+// sortedChars returns the characters of the string in sorted order.
+func sortedChars(str string) string {
+	chars := strings.Split(str, "")
+	sort.Strings(chars)
+	return strings.Join(chars, "")
+}
+
+// acePrincipal splits a short-form ACE into a key made up of its access types,
+// flags and the principal it applies to, and its permissions. Access types,
+// flags and permissions are put in sorted order. The access types are part of
+// the key so that allow, audit and alarm entries for the same principal are
+// compared separately.
+func acePrincipal(ace string) (string, string) {
+	fields := strings.Split(ace, ":")
+	if len(fields) != 4 {
+		return ace, ""
+	}
+
+	return sortedChars(fields[0]) + ":" + sortedChars(fields[1]) + ":" + fields[2], sortedChars(fields[3])
+}
+
+// DiffACL compares the entries in the current ACL with the desired entries.
+func DiffACL(current, desired *AccessControlList) *ACLDiff {
+	diff := new(ACLDiff)
+
+	curAccess := make(map[string]string)
+	if current != nil {
+		for _, ace := range current.Entries {
+			principal, access := acePrincipal(ace)
+			curAccess[principal] = access
+		}
+	}
+
+	wanted := make(map[string]struct{})
+	if desired != nil {
+		for _, ace := range desired.Entries {
+			principal, access := acePrincipal(ace)
+			wanted[principal] = struct{}{}
+
+			cur, found := curAccess[principal]
+			switch {
+			case !found:
+				diff.Added = append(diff.Added, ace)
+			case cur != access:
+				diff.Changed = append(diff.Changed, ace)
+			default:
+				diff.Unchanged = append(diff.Unchanged, ace)
+			}
+		}
+	}
+
+	if current != nil {
+		for _, ace := range current.Entries {
+			principal, _ := acePrincipal(ace)
+			if _, found := wanted[principal]; !found {
+				diff.Removed = append(diff.Removed, ace)
+			}
+		}
+	}
+
+	return diff
+}
+
+// FormatACLDiff converts the ACLDiff to a human-readable string with one line per
+// entry prefixed by "+" (added), "~" (changed) or "-" (removed). Removed entries
+// are only included if removed is true.
+func FormatACLDiff(diff *ACLDiff, removed bool) string {
+	var builder strings.Builder
+
+	if !diff.HasChanges(removed) {
+		builder.WriteString("# No changes\n")
+		return builder.String()
+	}
+
+	for _, ace := range diff.Added {
+		fmt.Fprintf(&builder, "+ %s\n", ace)
+	}
+	for _, ace := range diff.Changed {
+		fmt.Fprintf(&builder, "~ %s\n", ace)
+	}
+	if removed {
+		for _, ace := range diff.Removed {
+			fmt.Fprintf(&builder, "- %s\n", ace)
+		}
+	}
+
+	return builder.String()
+}
+
 func getVerboseACE(shortACE string) string {
 	if shortACE == "" {
 		return ""
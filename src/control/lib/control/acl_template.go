@@ -0,0 +1,203 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// ACLTemplateAttrPrefix is prepended to the name of an ACL template to form the key
+// of the system attribute that stores it.
+const ACLTemplateAttrPrefix = "acl_template."
+
+// aclTemplateSep separates the entries of an ACL template in its system attribute
+// value.
+const aclTemplateSep = ","
+
+type errACLTemplateNotFound struct {
+	name string
+}
+
+func (err *errACLTemplateNotFound) Error() string {
+	return fmt.Sprintf("ACL template %q not found", err.name)
+}
+
+// IsACLTemplateNotFound indicates whether the error is the result of a lookup of
+// an ACL template that does not exist.
+func IsACLTemplateNotFound(err error) bool {
+	_, ok := errors.Cause(err).(*errACLTemplateNotFound)
+	return ok
+}
+
+func aclTemplateKey(name string) (string, error) {
+	if name == "" {
+		return "", errors.New("ACL template name must not be empty")
+	}
+	if strings.IndexFunc(name, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == ':'
+	}) >= 0 {
+		return "", errors.Errorf("invalid ACL template name %q", name)
+	}
+
+	return ACLTemplateAttrPrefix + name, nil
+}
+
+func encodeACLTemplate(acl *AccessControlList) (string, error) {
+	if acl.Empty() {
+		return "", errors.New("ACL template must contain at least one entry")
+	}
+	for _, ace := range acl.Entries {
+		if strings.Contains(ace, aclTemplateSep) {
+			return "", errors.Errorf("invalid ACE %q in ACL template", ace)
+		}
+	}
+
+	return strings.Join(acl.Entries, aclTemplateSep), nil
+}
+
+func decodeACLTemplate(value string) *AccessControlList {
+	acl := &AccessControlList{Entries: []string{}}
+	for _, ace := range strings.Split(value, aclTemplateSep) {
+		if ace = strings.TrimSpace(ace); ace != "" {
+			acl.Entries = append(acl.Entries, ace)
+		}
+	}
+
+	return acl
+}
+
+type (
+	// ACLTemplateSetReq contains the inputs for the ACL template set request.
+	ACLTemplateSetReq struct {
+		unaryRequest
+		msRequest
+
+		Name string
+		ACL  *AccessControlList
+	}
+
+	// ACLTemplateGetReq contains the inputs for the ACL template get request.
+	ACLTemplateGetReq struct {
+		unaryRequest
+		msRequest
+
+		// Names of templates to fetch. If empty, all templates are returned.
+		Names []string
+	}
+
+	// ACLTemplateGetResp contains the ACL templates keyed by name.
+	ACLTemplateGetResp struct {
+		Templates map[string]*AccessControlList `json:"templates"`
+	}
+
+	// ACLTemplateDeleteReq contains the inputs for the ACL template delete request.
+	ACLTemplateDeleteReq struct {
+		unaryRequest
+		msRequest
+
+		Name string
+	}
+)
+
+// SetACLTemplate stores a named ACL template as a system attribute, replacing any
+// existing template with the same name.
+func SetACLTemplate(ctx context.Context, rpcClient UnaryInvoker, req *ACLTemplateSetReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+
+	key, err := aclTemplateKey(req.Name)
+	if err != nil {
+		return err
+	}
+	value, err := encodeACLTemplate(req.ACL)
+	if err != nil {
+		return err
+	}
+
+	attrReq := &SystemSetAttrReq{
+		Attributes: map[string]string{key: value},
+	}
+	attrReq.SetSystem(req.Sys)
+
+	return errors.Wrap(SystemSetAttr(ctx, rpcClient, attrReq), "set ACL template")
+}
+
+// GetACLTemplates fetches named ACL templates from the system attributes.
+func GetACLTemplates(ctx context.Context, rpcClient UnaryInvoker, req *ACLTemplateGetReq) (*ACLTemplateGetResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	attrReq := new(SystemGetAttrReq)
+	attrReq.SetSystem(req.Sys)
+	for _, name := range req.Names {
+		key, err := aclTemplateKey(name)
+		if err != nil {
+			return nil, err
+		}
+		attrReq.Keys = append(attrReq.Keys, key)
+	}
+
+	attrResp, err := SystemGetAttr(ctx, rpcClient, attrReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "get ACL templates")
+	}
+
+	resp := &ACLTemplateGetResp{
+		Templates: make(map[string]*AccessControlList),
+	}
+	for key, value := range attrResp.Attributes {
+		if !strings.HasPrefix(key, ACLTemplateAttrPrefix) {
+			continue
+		}
+		resp.Templates[strings.TrimPrefix(key, ACLTemplateAttrPrefix)] = decodeACLTemplate(value)
+	}
+
+	for _, name := range req.Names {
+		if _, found := resp.Templates[name]; !found {
+			return nil, &errACLTemplateNotFound{name: name}
+		}
+	}
+
+	return resp, nil
+}
+
+// GetACLTemplate is a convenience wrapper around GetACLTemplates that fetches a
+// single named ACL template.
+func GetACLTemplate(ctx context.Context, rpcClient UnaryInvoker, name string) (*AccessControlList, error) {
+	resp, err := GetACLTemplates(ctx, rpcClient, &ACLTemplateGetReq{Names: []string{name}})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Templates[name], nil
+}
+
+// DeleteACLTemplate removes a named ACL template from the system attributes.
+func DeleteACLTemplate(ctx context.Context, rpcClient UnaryInvoker, req *ACLTemplateDeleteReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+
+	key, err := aclTemplateKey(req.Name)
+	if err != nil {
+		return err
+	}
+
+	attrReq := &SystemSetAttrReq{
+		Attributes: map[string]string{key: ""},
+	}
+	attrReq.SetSystem(req.Sys)
+
+	return errors.Wrap(SystemSetAttr(ctx, rpcClient, attrReq), "delete ACL template")
+}
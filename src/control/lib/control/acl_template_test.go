@@ -0,0 +1,256 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_aclTemplateKey(t *testing.T) {
+	for name, tc := range map[string]struct {
+		name   string
+		expKey string
+		expErr error
+	}{
+		"empty": {
+			expErr: errors.New("must not be empty"),
+		},
+		"whitespace": {
+			name:   "read only",
+			expErr: errors.New("invalid ACL template name"),
+		},
+		"separator": {
+			name:   "a,b",
+			expErr: errors.New("invalid ACL template name"),
+		},
+		"colon": {
+			name:   "a:b",
+			expErr: errors.New("invalid ACL template name"),
+		},
+		"valid": {
+			name:   "read-only",
+			expKey: ACLTemplateAttrPrefix + "read-only",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotKey, gotErr := aclTemplateKey(tc.name)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expKey, gotKey, "unexpected key")
+		})
+	}
+}
+
+func TestControl_encodeACLTemplate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		acl      *AccessControlList
+		expValue string
+		expErr   error
+	}{
+		"nil": {
+			expErr: errors.New("at least one entry"),
+		},
+		"empty": {
+			acl:    &AccessControlList{},
+			expErr: errors.New("at least one entry"),
+		},
+		"separator in ACE": {
+			acl:    &AccessControlList{Entries: []string{"A::a,b@:r"}},
+			expErr: errors.New("invalid ACE"),
+		},
+		"round trip": {
+			acl:      &AccessControlList{Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:r"}},
+			expValue: "A::OWNER@:rw,A:G:GROUP@:r",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotValue, gotErr := encodeACLTemplate(tc.acl)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expValue, gotValue, "unexpected value")
+			if diff := cmp.Diff(tc.acl, decodeACLTemplate(gotValue)); diff != "" {
+				t.Fatalf("unexpected decoded ACL (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SetACLTemplate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *ACLTemplateSetReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"bad name": {
+			req: &ACLTemplateSetReq{
+				Name: "a b",
+				ACL:  &AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+			},
+			expErr: errors.New("invalid ACL template name"),
+		},
+		"empty ACL": {
+			req: &ACLTemplateSetReq{
+				Name: "ro",
+				ACL:  &AccessControlList{},
+			},
+			expErr: errors.New("at least one entry"),
+		},
+		"req fails": {
+			req: &ACLTemplateSetReq{
+				Name: "ro",
+				ACL:  &AccessControlList{Entries: []string{"A::OWNER@:r"}},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &ACLTemplateSetReq{
+				Name: "ro",
+				ACL:  &AccessControlList{Entries: []string{"A::OWNER@:r"}},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := SetACLTemplate(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_GetACLTemplates(t *testing.T) {
+	attrResp := &mgmtpb.SystemGetAttrResp{
+		Attributes: map[string]string{
+			"unrelated":                  "value",
+			ACLTemplateAttrPrefix + "ro": "A::OWNER@:r,A:G:GROUP@:r",
+			ACLTemplateAttrPrefix + "rw": "A::OWNER@:rw",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		req         *ACLTemplateGetReq
+		mic         *MockInvokerConfig
+		expResp     *ACLTemplateGetResp
+		expErr      error
+		expNotFound bool
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"bad name": {
+			req:    &ACLTemplateGetReq{Names: []string{""}},
+			expErr: errors.New("must not be empty"),
+		},
+		"req fails": {
+			req: new(ACLTemplateGetReq),
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"not found": {
+			req: &ACLTemplateGetReq{Names: []string{"missing"}},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.SystemGetAttrResp{}),
+			},
+			expErr:      errors.New("\"missing\" not found"),
+			expNotFound: true,
+		},
+		"all templates": {
+			req: new(ACLTemplateGetReq),
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, attrResp),
+			},
+			expResp: &ACLTemplateGetResp{
+				Templates: map[string]*AccessControlList{
+					"ro": {Entries: []string{"A::OWNER@:r", "A:G:GROUP@:r"}},
+					"rw": {Entries: []string{"A::OWNER@:rw"}},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := GetACLTemplates(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expNotFound, IsACLTemplateNotFound(gotErr),
+				"unexpected not found result")
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_DeleteACLTemplate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *ACLTemplateDeleteReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"bad name": {
+			req:    &ACLTemplateDeleteReq{},
+			expErr: errors.New("must not be empty"),
+		},
+		"req fails": {
+			req: &ACLTemplateDeleteReq{Name: "ro"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &ACLTemplateDeleteReq{Name: "ro"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.DaosResp{}),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := DeleteACLTemplate(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -437,3 +438,136 @@ func TestControl_GetVerboseACE(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_DiffACL(t *testing.T) {
+	for name, tc := range map[string]struct {
+		current *AccessControlList
+		desired *AccessControlList
+		expDiff *ACLDiff
+	}{
+		"nil": {
+			expDiff: &ACLDiff{},
+		},
+		"all added": {
+			desired: &AccessControlList{Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:r"}},
+			expDiff: &ACLDiff{
+				Added: []string{"A::OWNER@:rw", "A:G:GROUP@:r"},
+			},
+		},
+		"all removed": {
+			current: &AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+			desired: &AccessControlList{},
+			expDiff: &ACLDiff{
+				Removed: []string{"A::OWNER@:rw"},
+			},
+		},
+		"permission order ignored": {
+			current: &AccessControlList{Entries: []string{"A::OWNER@:wr"}},
+			desired: &AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+			expDiff: &ACLDiff{
+				Unchanged: []string{"A::OWNER@:rw"},
+			},
+		},
+		"mixed": {
+			current: &AccessControlList{
+				Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:r", "A::bob@:r"},
+			},
+			desired: &AccessControlList{
+				Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:rw", "A::alice@:r"},
+			},
+			expDiff: &ACLDiff{
+				Added:     []string{"A::alice@:r"},
+				Changed:   []string{"A:G:GROUP@:rw"},
+				Removed:   []string{"A::bob@:r"},
+				Unchanged: []string{"A::OWNER@:rw"},
+			},
+		},
+		"allow and audit entries for same principal": {
+			current: &AccessControlList{
+				Entries: []string{"A::bob@:r", "U:S:bob@:rw"},
+			},
+			desired: &AccessControlList{
+				Entries: []string{"A::bob@:rw", "U:S:bob@:rw", "L:F:bob@:w"},
+			},
+			expDiff: &ACLDiff{
+				Added:     []string{"L:F:bob@:w"},
+				Changed:   []string{"A::bob@:rw"},
+				Unchanged: []string{"U:S:bob@:rw"},
+			},
+		},
+		"access type and flag order ignored": {
+			current: &AccessControlList{Entries: []string{"UA:FS:bob@:r"}},
+			desired: &AccessControlList{Entries: []string{"AU:SF:bob@:r"}},
+			expDiff: &ACLDiff{
+				Unchanged: []string{"AU:SF:bob@:r"},
+			},
+		},
+		"access type changed": {
+			current: &AccessControlList{Entries: []string{"A::bob@:r"}},
+			desired: &AccessControlList{Entries: []string{"U:S:bob@:r"}},
+			expDiff: &ACLDiff{
+				Added:   []string{"U:S:bob@:r"},
+				Removed: []string{"A::bob@:r"},
+			},
+		},
+		"user and group with same name": {
+			current: &AccessControlList{Entries: []string{"A::admin@:r"}},
+			desired: &AccessControlList{Entries: []string{"A:G:admin@:r"}},
+			expDiff: &ACLDiff{
+				Added:   []string{"A:G:admin@:r"},
+				Removed: []string{"A::admin@:r"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotDiff := DiffACL(tc.current, tc.desired)
+
+			if diff := cmp.Diff(tc.expDiff, gotDiff); diff != "" {
+				t.Fatalf("unexpected diff (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_FormatACLDiff(t *testing.T) {
+	mixed := &ACLDiff{
+		Added:     []string{"A::alice@:r"},
+		Changed:   []string{"A:G:GROUP@:rw"},
+		Removed:   []string{"A::bob@:r"},
+		Unchanged: []string{"A::OWNER@:rw"},
+	}
+
+	for name, tc := range map[string]struct {
+		diff    *ACLDiff
+		removed bool
+		expStr  string
+	}{
+		"no changes": {
+			diff:   &ACLDiff{Unchanged: []string{"A::OWNER@:rw"}},
+			expStr: "# No changes\n",
+		},
+		"only removals ignored": {
+			diff:   &ACLDiff{Removed: []string{"A::bob@:r"}},
+			expStr: "# No changes\n",
+		},
+		"only removals": {
+			diff:    &ACLDiff{Removed: []string{"A::bob@:r"}},
+			removed: true,
+			expStr:  "- A::bob@:r\n",
+		},
+		"mixed": {
+			diff:   mixed,
+			expStr: "+ A::alice@:r\n~ A:G:GROUP@:rw\n",
+		},
+		"mixed with removals": {
+			diff:    mixed,
+			removed: true,
+			expStr:  "+ A::alice@:r\n~ A:G:GROUP@:rw\n- A::bob@:r\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expStr, FormatACLDiff(tc.diff, tc.removed),
+				"incorrect output")
+		})
+	}
+}
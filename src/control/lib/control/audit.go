@@ -0,0 +1,123 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/audit"
+)
+
+type (
+	// AuditListReq contains the parameters for an audit log list request.
+	AuditListReq struct {
+		unaryRequest
+		failFastRequest
+		Since  time.Time
+		Method string
+		Limit  int
+	}
+
+	// HostAuditLog contains the entries retrieved from the audit log of a
+	// server.
+	HostAuditLog struct {
+		Enabled     bool           `json:"enabled"`
+		VerifyError string         `json:"verify_error,omitempty"`
+		Entries     []*audit.Entry `json:"entries"`
+	}
+
+	// AuditListResp contains the results of an audit log list request.
+	AuditListResp struct {
+		HostErrorsResp
+		HostLogs map[string]*HostAuditLog `json:"host_logs"`
+	}
+)
+
+func (resp *AuditListResp) addHostResponse(hr *HostResponse) error {
+	pbResp, ok := hr.Message.(*ctlpb.AuditListResp)
+	if !ok {
+		return errors.Errorf("unable to unpack message: %+v", hr.Message)
+	}
+
+	hl := &HostAuditLog{
+		Enabled:     pbResp.GetEnabled(),
+		VerifyError: pbResp.GetVerifyError(),
+	}
+	for _, pbEntry := range pbResp.GetEntries() {
+		hl.Entries = append(hl.Entries, &audit.Entry{
+			Seq:        pbEntry.GetSeq(),
+			Time:       time.Unix(0, int64(pbEntry.GetTime())),
+			Method:     pbEntry.GetMethod(),
+			Caller:     pbEntry.GetCaller(),
+			Serial:     pbEntry.GetSerial(),
+			OnBehalfOf: pbEntry.GetOnBehalfOf(),
+			PeerAddr:   pbEntry.GetPeerAddr(),
+			Request:    pbEntry.GetRequest(),
+			Result:     pbEntry.GetResult(),
+			Duration:   time.Duration(pbEntry.GetDuration()),
+			Hash:       pbEntry.GetHash(),
+		})
+	}
+
+	if resp.HostLogs == nil {
+		resp.HostLogs = make(map[string]*HostAuditLog)
+	}
+	resp.HostLogs[hr.Addr] = hl
+
+	return nil
+}
+
+// AuditList concurrently retrieves entries from the admin audit logs of all
+// hosts supplied in the request's hostlist, or all configured hosts if not
+// explicitly specified. Entries are only recorded by the MS leader, so the
+// hostlist should include all MS replicas for a complete history.
+func AuditList(ctx context.Context, rpcClient UnaryInvoker, req *AuditListReq) (*AuditListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+	if req.Limit < 0 {
+		return nil, errors.New("limit must not be negative")
+	}
+
+	pbReq := &ctlpb.AuditListReq{
+		Method: req.Method,
+		Limit:  uint32(req.Limit),
+	}
+	if !req.Since.IsZero() {
+		pbReq.Since = uint64(req.Since.Unix())
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).AuditList(ctx, pbReq)
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(AuditListResp)
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := resp.addHostResponse(hr); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,145 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/audit"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_AuditList(t *testing.T) {
+	entryTime := time.Unix(1735689600, 500)
+
+	for name, tc := range map[string]struct {
+		req     *AuditListReq
+		mic     *MockInvokerConfig
+		expResp *AuditListResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"negative limit": {
+			req:    &AuditListReq{Limit: -1},
+			expErr: errors.New("limit"),
+		},
+		"invoke fails": {
+			req: new(AuditListReq),
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("failed"),
+			},
+			expErr: errors.New("failed"),
+		},
+		"nil message": {
+			req: new(AuditListReq),
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host1"},
+					},
+				},
+			},
+			expErr: errors.New("unpack"),
+		},
+		"host error": {
+			req: new(AuditListReq),
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr:  "host1",
+							Error: errors.New("failed"),
+						},
+					},
+				},
+			},
+			expResp: &AuditListResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{
+					Hosts: "host1",
+					Error: "failed",
+				}),
+			},
+		},
+		"success": {
+			req: &AuditListReq{Method: "Pool", Limit: 10},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.AuditListResp{
+								Enabled: true,
+								Entries: []*ctlpb.AuditEntry{
+									{
+										Seq:      1,
+										Time:     uint64(entryTime.UnixNano()),
+										Method:   "/mgmt.MgmtSvc/PoolCreate",
+										Caller:   "CN=admin",
+										Serial:   "42",
+										PeerAddr: "10.0.0.1:4242",
+										Request:  "request",
+										Result:   "ok",
+										Duration: uint64(time.Second),
+										Hash:     "abc",
+									},
+								},
+								VerifyError: "broken",
+							},
+						},
+						{
+							Addr:    "host2",
+							Message: &ctlpb.AuditListResp{},
+						},
+					},
+				},
+			},
+			expResp: &AuditListResp{
+				HostLogs: map[string]*HostAuditLog{
+					"host1": {
+						Enabled:     true,
+						VerifyError: "broken",
+						Entries: []*audit.Entry{
+							{
+								Seq:      1,
+								Time:     entryTime,
+								Method:   "/mgmt.MgmtSvc/PoolCreate",
+								Caller:   "CN=admin",
+								Serial:   "42",
+								PeerAddr: "10.0.0.1:4242",
+								Request:  "request",
+								Result:   "ok",
+								Duration: time.Second,
+								Hash:     "abc",
+							},
+						},
+					},
+					"host2": {},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, tc.mic)
+			resp, err := AuditList(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expResp, resp, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// breakerOpenTime is how long requests to a host are failed without
+	// being sent after the host first fails to respond. The time doubles
+	// with each consecutive failure, up to maxBreakerOpenTime.
+	breakerOpenTime    = 30 * time.Second
+	maxBreakerOpenTime = 10 * time.Minute
+	// breakerExpiry is how long the failures of a host are remembered.
+	breakerExpiry = time.Hour
+
+	breakerCacheFile = "control_host_health.json"
+)
+
+type (
+	// hostHealth records the recent failures of a host to respond.
+	hostHealth struct {
+		Failures    uint      `json:"failures"`
+		LastFailure time.Time `json:"last_failure"`
+		OpenUntil   time.Time `json:"open_until"`
+	}
+
+	// CircuitBreaker records the hosts that recently failed to respond to
+	// requests, so that fan-out requests fail fast for those hosts instead
+	// of waiting for the request to time out. A host is tried again once
+	// the breaker for it has been open for a while, and is forgotten as
+	// soon as it responds. The record can be saved to a file so that it is
+	// shared between invocations of a command.
+	CircuitBreaker struct {
+		sync.Mutex
+		path    string
+		hosts   map[string]*hostHealth
+		changed bool
+		now     func() time.Time
+	}
+)
+
+// DefaultCircuitBreakerPath returns the path of the file in the user's cache
+// directory used to record host health between invocations.
+func DefaultCircuitBreakerPath() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cacheDir, "daos", breakerCacheFile), nil
+}
+
+// NewCircuitBreaker returns a CircuitBreaker that is saved to the given path.
+// If the path is empty, the CircuitBreaker is not saved.
+func NewCircuitBreaker(path string) *CircuitBreaker {
+	return &CircuitBreaker{
+		path:  path,
+		hosts: make(map[string]*hostHealth),
+		now:   time.Now,
+	}
+}
+
+// LoadCircuitBreaker returns a CircuitBreaker with the host health recorded in
+// the file at the given path, if it exists. A usable CircuitBreaker is
+// returned along with any error encountered while reading the file.
+func LoadCircuitBreaker(path string) (*CircuitBreaker, error) {
+	cb := NewCircuitBreaker(path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cb, nil
+		}
+		return cb, err
+	}
+
+	hosts := make(map[string]*hostHealth)
+	if err := json.Unmarshal(data, &hosts); err != nil {
+		return cb, errors.Wrapf(err, "invalid host health file %s", path)
+	}
+
+	now := cb.now()
+	for host, hh := range hosts {
+		if hh == nil || now.Sub(hh.LastFailure) > breakerExpiry {
+			cb.changed = true
+			continue
+		}
+		cb.hosts[host] = hh
+	}
+
+	return cb, nil
+}
+
+// Save writes the recorded host health to the CircuitBreaker's file if it
+// has changed.
+func (cb *CircuitBreaker) Save() error {
+	if cb == nil || cb.path == "" {
+		return nil
+	}
+
+	cb.Lock()
+	defer cb.Unlock()
+
+	if !cb.changed {
+		return nil
+	}
+
+	data, err := json.Marshal(cb.hosts)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cb.path), 0700); err != nil {
+		return err
+	}
+
+	// Write to a uniquely named temporary file and rename it so that a
+	// concurrent invocation never reads a partially written file, and
+	// concurrent saves don't write to the same temporary file.
+	tmp, err := os.CreateTemp(filepath.Dir(cb.path), "."+filepath.Base(cb.path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), cb.path); err != nil {
+		return err
+	}
+	cb.changed = false
+
+	return nil
+}
+
+// IsOpen returns true if requests to the host are currently failed without
+// being sent.
+func (cb *CircuitBreaker) IsOpen(host string) bool {
+	if cb == nil {
+		return false
+	}
+
+	cb.Lock()
+	defer cb.Unlock()
+
+	hh, found := cb.hosts[host]
+	return found && cb.now().Before(hh.OpenUntil)
+}
+
+// check returns an error if requests to the host should fail fast.
+func (cb *CircuitBreaker) check(host string) error {
+	if cb.IsOpen(host) {
+		return FaultHostUnresponsive
+	}
+	return nil
+}
+
+// record updates the health of the host with the result of a request to it.
+// A timeout opens the breaker for the host and any other result closes it.
+func (cb *CircuitBreaker) record(host string, err error) {
+	if cb == nil {
+		return
+	}
+
+	cause := errors.Cause(err)
+	if cause == context.Canceled || status.Code(cause) == codes.Canceled {
+		// The request was abandoned, so nothing was learned about the host.
+		return
+	}
+
+	cb.Lock()
+	defer cb.Unlock()
+
+	if !isTimeout(err) {
+		if _, found := cb.hosts[host]; found {
+			delete(cb.hosts, host)
+			cb.changed = true
+		}
+		return
+	}
+
+	hh, found := cb.hosts[host]
+	if !found {
+		hh = new(hostHealth)
+		cb.hosts[host] = hh
+	}
+	openTime := breakerOpenTime
+	for i := uint(0); i < hh.Failures && openTime < maxBreakerOpenTime; i++ {
+		openTime *= 2
+	}
+	if openTime > maxBreakerOpenTime {
+		openTime = maxBreakerOpenTime
+	}
+	hh.Failures++
+	hh.LastFailure = cb.now()
+	hh.OpenUntil = hh.LastFailure.Add(openTime)
+	cb.changed = true
+}
+
+// prioritize returns the hosts ordered so that those for which the breaker is
+// closed come first, followed by those for which it is open, along with the
+// number of hosts for which it is closed.
+func (cb *CircuitBreaker) prioritize(hosts []string) ([]string, int) {
+	if cb == nil {
+		return hosts, len(hosts)
+	}
+
+	healthy := make([]string, 0, len(hosts))
+	var unhealthy []string
+	for _, host := range hosts {
+		if cb.IsOpen(host) {
+			unhealthy = append(unhealthy, host)
+			continue
+		}
+		healthy = append(healthy, host)
+	}
+
+	return append(healthy, unhealthy...), len(healthy)
+}
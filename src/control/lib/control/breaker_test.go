@@ -0,0 +1,315 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_CircuitBreaker_record(t *testing.T) {
+	timeout := FaultConnectionTimedOut("host1")
+
+	for name, tc := range map[string]struct {
+		errs        []error
+		expOpen     bool
+		expOpenTime time.Duration
+		expFailures uint
+	}{
+		"success": {
+			errs: []error{nil},
+		},
+		"non-timeout error": {
+			errs: []error{errors.New("whoops")},
+		},
+		"timeout": {
+			errs:        []error{timeout},
+			expOpen:     true,
+			expOpenTime: breakerOpenTime,
+			expFailures: 1,
+		},
+		"deadline exceeded": {
+			errs:        []error{status.Error(codes.DeadlineExceeded, "deadline")},
+			expOpen:     true,
+			expOpenTime: breakerOpenTime,
+			expFailures: 1,
+		},
+		"consecutive timeouts": {
+			errs:        []error{timeout, timeout, timeout},
+			expOpen:     true,
+			expOpenTime: 4 * breakerOpenTime,
+			expFailures: 3,
+		},
+		"open time is capped": {
+			errs:        []error{timeout, timeout, timeout, timeout, timeout, timeout, timeout, timeout},
+			expOpen:     true,
+			expOpenTime: maxBreakerOpenTime,
+			expFailures: 8,
+		},
+		"success after timeout": {
+			errs: []error{timeout, nil},
+		},
+		"canceled after timeout": {
+			errs:        []error{timeout, context.Canceled},
+			expOpen:     true,
+			expOpenTime: breakerOpenTime,
+			expFailures: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			cb := NewCircuitBreaker("")
+			cb.now = func() time.Time { return now }
+
+			for _, err := range tc.errs {
+				cb.record("host1", err)
+			}
+
+			test.AssertEqual(t, tc.expOpen, cb.IsOpen("host1"), "unexpected breaker state")
+			test.AssertEqual(t, false, cb.IsOpen("host2"), "unexpected breaker state for other host")
+			if !tc.expOpen {
+				test.AssertEqual(t, 0, len(cb.hosts), "host health not cleared")
+				return
+			}
+
+			hh := cb.hosts["host1"]
+			test.AssertEqual(t, tc.expFailures, hh.Failures, "unexpected failure count")
+			test.AssertEqual(t, now.Add(tc.expOpenTime), hh.OpenUntil, "unexpected open time")
+			test.CmpErr(t, FaultHostUnresponsive, cb.check("host1"))
+
+			// The host is tried again once the open time has passed.
+			cb.now = func() time.Time { return now.Add(tc.expOpenTime) }
+			test.AssertEqual(t, false, cb.IsOpen("host1"), "breaker not closed after open time")
+		})
+	}
+}
+
+func TestControl_CircuitBreaker_prioritize(t *testing.T) {
+	cb := NewCircuitBreaker("")
+	cb.record("host2", FaultConnectionTimedOut("host2"))
+
+	for name, tc := range map[string]struct {
+		cb           *CircuitBreaker
+		hosts        []string
+		expHosts     []string
+		expPreferred int
+	}{
+		"nil breaker": {
+			hosts:        []string{"host1", "host2", "host3"},
+			expHosts:     []string{"host1", "host2", "host3"},
+			expPreferred: 3,
+		},
+		"unresponsive host moved to end": {
+			cb:           cb,
+			hosts:        []string{"host1", "host2", "host3"},
+			expHosts:     []string{"host1", "host3", "host2"},
+			expPreferred: 2,
+		},
+		"all hosts unresponsive": {
+			cb:       cb,
+			hosts:    []string{"host2"},
+			expHosts: []string{"host2"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotHosts, gotPreferred := tc.cb.prioritize(tc.hosts)
+			if diff := cmp.Diff(tc.expHosts, gotHosts); diff != "" {
+				t.Fatalf("unexpected hosts (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expPreferred, gotPreferred, "unexpected number of preferred hosts")
+		})
+	}
+}
+
+func TestControl_LoadCircuitBreaker(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	path := filepath.Join(testDir, "cache", breakerCacheFile)
+
+	cb, err := LoadCircuitBreaker(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Nothing is written if nothing changed.
+	if err := cb.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("unexpected host health file: %v", err)
+	}
+
+	cb.record("host1", FaultConnectionTimedOut("host1"))
+	cb.record("host2", FaultConnectionTimedOut("host2"))
+	cb.hosts["host2"].LastFailure = time.Now().Add(-2 * breakerExpiry)
+	if err := cb.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The temporary file used for the update is not left behind.
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != breakerCacheFile {
+		t.Fatalf("unexpected files in %s: %v", filepath.Dir(path), entries)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, os.FileMode(0600), fi.Mode().Perm(), "unexpected host health file permissions")
+
+	loaded, err := LoadCircuitBreaker(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, loaded.IsOpen("host1"), "host1 health not loaded")
+	_, found := loaded.hosts["host2"]
+	test.AssertFalse(t, found, "expired host health loaded")
+
+	if err := os.WriteFile(path, []byte("garbage"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err = LoadCircuitBreaker(path)
+	test.CmpErr(t, errors.New("invalid host health file"), err)
+	test.AssertFalse(t, loaded.IsOpen("host1"), "unexpected host health")
+}
+
+func TestControl_InvokeUnaryRPCAsync_CircuitBreaker(t *testing.T) {
+	for name, tc := range map[string]struct {
+		toMS      bool
+		failFast  bool
+		expCalls  int32
+		expErrs   map[string]error
+		expClosed bool
+	}{
+		"read-only fan-out request": {
+			failFast: true,
+			expCalls: 1,
+			expErrs: map[string]error{
+				"host1:10001": nil,
+				"host2:10001": FaultHostUnresponsive,
+			},
+		},
+		"other fan-out request": {
+			expCalls: 2,
+			expErrs: map[string]error{
+				"host1:10001": nil,
+				"host2:10001": nil,
+			},
+			expClosed: true,
+		},
+		"MS request": {
+			toMS:      true,
+			failFast:  true,
+			expClosed: true,
+			expCalls:  2,
+			expErrs: map[string]error{
+				"host1:10001": nil,
+				"host2:10001": nil,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := DefaultConfig()
+			cfg.TransportConfig.AllowInsecure = true
+			client := NewClient(WithConfig(cfg), WithClientLogger(log))
+
+			cb := NewCircuitBreaker("")
+			cb.record("host2:10001", FaultConnectionTimedOut("host2:10001"))
+			client.SetCircuitBreaker(cb)
+
+			var calls int32
+			respChan, err := client.InvokeUnaryRPCAsync(test.Context(t), &testRequest{
+				HostList: []string{"host1:10001", "host2:10001"},
+				toMS:     tc.toMS,
+				failFast: tc.failFast,
+				rpcFn: func(_ context.Context, _ *grpc.ClientConn) (proto.Message, error) {
+					atomic.AddInt32(&calls, 1)
+					return defaultMessage, nil
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotErrs := make(map[string]error)
+			for hr := range respChan {
+				gotErrs[hr.Addr] = hr.Error
+			}
+
+			test.AssertEqual(t, tc.expCalls, atomic.LoadInt32(&calls), "unexpected number of RPCs")
+			cmpOpts := []cmp.Option{
+				cmp.Comparer(func(x, y error) bool { return test.CmpErrBool(x, y) }),
+			}
+			if diff := cmp.Diff(tc.expErrs, gotErrs, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected host errors (-want, +got):\n%s\n", diff)
+			}
+			// A response closes the breaker.
+			test.AssertEqual(t, !tc.expClosed, cb.IsOpen("host2:10001"), "unexpected breaker state")
+		})
+	}
+}
+
+func TestControl_InvokeUnaryRPC_CircuitBreaker(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	cfg := DefaultConfig()
+	cfg.TransportConfig.AllowInsecure = true
+	cfg.HostList = nil
+	for i := 0; i < maxMSCandidates+2; i++ {
+		cfg.HostList = append(cfg.HostList, fmt.Sprintf("host%d:10001", i))
+	}
+	client := NewClient(WithConfig(cfg), WithClientLogger(log))
+
+	cb := NewCircuitBreaker("")
+	cb.record("host0:10001", FaultConnectionTimedOut("host0:10001"))
+	cb.record("host1:10001", FaultConnectionTimedOut("host1:10001"))
+	client.SetCircuitBreaker(cb)
+
+	var mu sync.Mutex
+	called := make(map[string]bool)
+	_, err := client.InvokeUnaryRPC(test.Context(t), &testRequest{
+		toMS: true,
+		rpcFn: func(_ context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			called[conn.Target()] = true
+			return defaultMessage, nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The MS candidates are chosen from the responsive hosts first.
+	test.AssertEqual(t, maxMSCandidates, len(called), "unexpected number of hosts called")
+	for _, host := range []string{"host0:10001", "host1:10001"} {
+		test.AssertFalse(t, called[host], "unresponsive host called: "+host)
+	}
+}
@@ -0,0 +1,101 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+type (
+	// CertCheckReq contains the parameters for a certificate check request.
+	CertCheckReq struct {
+		unaryRequest
+		failFastRequest
+	}
+
+	// HostCerts describes the TLS certificates in use by a server.
+	HostCerts struct {
+		Insecure bool                        `json:"insecure"`
+		Certs    []*security.CertificateInfo `json:"certs"`
+	}
+
+	// CertCheckResp contains the results of a certificate check request.
+	CertCheckResp struct {
+		HostErrorsResp
+		HostCerts map[string]*HostCerts `json:"host_certs"`
+	}
+)
+
+func (resp *CertCheckResp) addHostResponse(hr *HostResponse) error {
+	pbResp, ok := hr.Message.(*ctlpb.CertCheckResp)
+	if !ok {
+		return errors.Errorf("unable to unpack message: %+v", hr.Message)
+	}
+
+	hc := &HostCerts{
+		Insecure: pbResp.GetInsecure(),
+	}
+	for _, pbCert := range pbResp.GetCerts() {
+		hc.Certs = append(hc.Certs, &security.CertificateInfo{
+			Name:      pbCert.GetName(),
+			Path:      pbCert.GetPath(),
+			Subject:   pbCert.GetSubject(),
+			Serial:    pbCert.GetSerial(),
+			NotBefore: time.Unix(int64(pbCert.GetNotBefore()), 0),
+			NotAfter:  time.Unix(int64(pbCert.GetNotAfter()), 0),
+		})
+	}
+
+	if resp.HostCerts == nil {
+		resp.HostCerts = make(map[string]*HostCerts)
+	}
+	resp.HostCerts[hr.Addr] = hc
+
+	return nil
+}
+
+// CertCheck concurrently retrieves details of the TLS certificates in use by
+// all hosts supplied in the request's hostlist, or all configured hosts if not
+// explicitly specified.
+func CertCheck(ctx context.Context, rpcClient UnaryInvoker, req *CertCheckReq) (*CertCheckResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).CertCheck(ctx, new(ctlpb.CertCheckReq))
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(CertCheckResp)
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := resp.addHostResponse(hr); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,130 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestControl_CertCheck(t *testing.T) {
+	notBefore := time.Unix(1735689600, 0)
+	notAfter := notBefore.Add(365 * 24 * time.Hour)
+
+	for name, tc := range map[string]struct {
+		req     *CertCheckReq
+		mic     *MockInvokerConfig
+		expResp *CertCheckResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"invoke fails": {
+			req: new(CertCheckReq),
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("failed"),
+			},
+			expErr: errors.New("failed"),
+		},
+		"nil message": {
+			req: new(CertCheckReq),
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host1"},
+					},
+				},
+			},
+			expErr: errors.New("unpack"),
+		},
+		"host error": {
+			req: new(CertCheckReq),
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr:  "host1",
+							Error: errors.New("failed"),
+						},
+					},
+				},
+			},
+			expResp: &CertCheckResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{
+					Hosts: "host1",
+					Error: "failed",
+				}),
+			},
+		},
+		"success": {
+			req: new(CertCheckReq),
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.CertCheckResp{
+								Certs: []*ctlpb.CertInfo{
+									{
+										Name:      "cert",
+										Path:      "/etc/daos/certs/server.crt",
+										Subject:   "CN=server,O=DAOS",
+										Serial:    "42",
+										NotBefore: uint64(notBefore.Unix()),
+										NotAfter:  uint64(notAfter.Unix()),
+									},
+								},
+							},
+						},
+						{
+							Addr:    "host2",
+							Message: &ctlpb.CertCheckResp{Insecure: true},
+						},
+					},
+				},
+			},
+			expResp: &CertCheckResp{
+				HostCerts: map[string]*HostCerts{
+					"host1": {
+						Certs: []*security.CertificateInfo{
+							{
+								Name:      "cert",
+								Path:      "/etc/daos/certs/server.crt",
+								Subject:   "CN=server,O=DAOS",
+								Serial:    "42",
+								NotBefore: notBefore,
+								NotAfter:  notAfter,
+							},
+						},
+					},
+					"host2": {Insecure: true},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, tc.mic)
+			resp, err := CertCheck(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expResp, resp, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
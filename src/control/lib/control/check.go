@@ -252,8 +252,7 @@ func checkSetFlags(setFlags uint32, incompatFlags ...chkpb.CheckFlag) error {
 	return errors.Errorf("flags %s are mutually exclusive", strings.Join(strFlags, ", "))
 }
 
-// SystemCheckStart starts the system checker.
-func SystemCheckStart(ctx context.Context, rpcClient UnaryInvoker, req *SystemCheckStartReq) error {
+func (req *SystemCheckStartReq) prepare(rpcClient UnaryInvoker) error {
 	if req == nil {
 		return errors.Errorf("nil %T", req)
 	}
@@ -268,6 +267,14 @@ func SystemCheckStart(ctx context.Context, rpcClient UnaryInvoker, req *SystemCh
 	for _, p := range req.Policies {
 		req.CheckStartReq.Policies = append(req.CheckStartReq.Policies, p.toPB())
 	}
+	return nil
+}
+
+// SystemCheckStart starts the system checker.
+func SystemCheckStart(ctx context.Context, rpcClient UnaryInvoker, req *SystemCheckStartReq) error {
+	if err := req.prepare(rpcClient); err != nil {
+		return err
+	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).SystemCheckStart(ctx, &req.CheckStartReq)
 	})
@@ -281,6 +288,17 @@ func SystemCheckStart(ctx context.Context, rpcClient UnaryInvoker, req *SystemCh
 	return ur.getMSError()
 }
 
+// SystemCheckStartAsync starts the system checker as an operation on the MS
+// leader and returns without waiting for the checker to start.
+func SystemCheckStartAsync(ctx context.Context, rpcClient UnaryInvoker, req *SystemCheckStartReq) (*Operation, error) {
+	if err := req.prepare(rpcClient); err != nil {
+		return nil, err
+	}
+
+	return startOperation(ctx, rpcClient, req, mgmtpb.MgmtSvc_SystemCheckStart_FullMethodName,
+		&req.CheckStartReq)
+}
+
 type SystemCheckStopReq struct {
 	unaryRequest
 	msRequest
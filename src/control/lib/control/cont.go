@@ -24,6 +24,9 @@ type ContSetOwnerReq struct {
 	PoolID string // UUID or label of the pool for the container
 	User   string // User to own the container, or empty if none
 	Group  string // Group to own the container, or empty if none
+	// Optional key identifying retries of the request. The MS returns
+	// the result of the first request for retries made with the key.
+	IdempotencyKey string
 }
 
 // ContSetOwner changes the owner user and/or group of a DAOS container.
@@ -46,11 +49,12 @@ func ContSetOwner(ctx context.Context, rpcClient UnaryInvoker, req *ContSetOwner
 
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).ContSetOwner(ctx, &mgmtpb.ContSetOwnerReq{
-			Sys:        req.getSystem(rpcClient),
-			ContId:     req.ContID,
-			PoolId:     req.PoolID,
-			OwnerUser:  req.User,
-			OwnerGroup: req.Group,
+			Sys:            req.getSystem(rpcClient),
+			ContId:         req.ContID,
+			PoolId:         req.PoolID,
+			OwnerUser:      req.User,
+			OwnerGroup:     req.Group,
+			IdempotencyKey: req.IdempotencyKey,
 		})
 	})
 
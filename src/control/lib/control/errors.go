@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,4 +13,8 @@ var (
 	// ErrNoConfigFile indicates that no configuration file was able
 	// to be located.
 	ErrNoConfigFile = errors.New("no configuration file found")
+
+	// ErrNoProfilesFile indicates that no system profiles file was able
+	// to be located.
+	ErrNoProfilesFile = errors.New("no system profiles file found")
 )
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -41,6 +42,11 @@ var (
 		"different number of backing devices behind each engine's VMD addresses",
 		"assign an equal number of NVMe SSDs to each VMD when configuring in BIOS",
 	)
+	FaultHostUnresponsive = clientFault(
+		code.ClientHostUnresponsive,
+		"the server did not respond to a recent request and was not contacted",
+		"verify that the server is running and reachable, or disable the client circuit breaker (e.g. dmg --no-circuit-breaker) to contact it anyway",
+	)
 )
 
 // IsRetryableConnErr indicates whether the error is a connection error that
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -25,6 +26,7 @@ type (
 	// devices.
 	FirmwareQueryReq struct {
 		unaryRequest
+		failFastRequest
 		SCM         bool     // Query SCM devices
 		NVMe        bool     // Query NVMe devices
 		Devices     []string // Specific devices to query
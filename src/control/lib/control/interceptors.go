@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,6 +13,7 @@ import (
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/daos-stack/daos/src/control/build"
@@ -99,3 +101,14 @@ func unaryVersionedComponentInterceptor(comp build.Component) grpc.UnaryClientIn
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+// unaryTraceInterceptor appends the trace context of a traced RPC to the
+// outgoing request headers so that the server can log it.
+func unaryTraceInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if rpc := rpcTraceFromContext(ctx); rpc != nil {
+			ctx = metadata.AppendToOutgoingContext(ctx, TraceParentHeader, rpc.traceParent())
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
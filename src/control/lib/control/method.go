@@ -0,0 +1,106 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+type (
+	// MethodReq contains the parameters for a request that calls a
+	// control plane gRPC method by name, for use by proxies that handle
+	// the request and response messages generically. Management service
+	// methods are sent to the management service leader; other methods
+	// are sent to each host in the hostlist.
+	MethodReq struct {
+		unaryRequest
+		// Method is the full name of the gRPC method, e.g.
+		// "/mgmt.MgmtSvc/ListPools".
+		Method string
+		// Message is the request message.
+		Message proto.Message
+		// Response is a message of the type returned by the method.
+		Response proto.Message
+	}
+
+	// MethodResp contains the results of a MethodReq.
+	MethodResp struct {
+		// Message is the response of the management service leader, for
+		// management service methods.
+		Message proto.Message
+		// HostResponses are the responses of each host, for other
+		// methods.
+		HostResponses []*HostResponse
+	}
+)
+
+// isMSRequest implements part of the targetChooser interface, and returns
+// true for management service methods.
+func (r *MethodReq) isMSRequest() bool {
+	return strings.HasPrefix(r.Method, "/"+mgmtpb.MgmtSvc_ServiceDesc.ServiceName+"/")
+}
+
+// setSystem sets the system name in the request message, if it has a "sys"
+// field that is unset.
+func setSystem(msg proto.Message, sys string) {
+	pm := msg.ProtoReflect()
+	fd := pm.Descriptor().Fields().ByName("sys")
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() || pm.Has(fd) {
+		return
+	}
+	pm.Set(fd, protoreflect.ValueOfString(sys))
+}
+
+// InvokeMethod calls a control plane gRPC method with the request message and
+// returns the response of the management service leader, or of each host in
+// the request's hostlist for other methods.
+func InvokeMethod(ctx context.Context, rpcClient UnaryInvoker, req *MethodReq) (*MethodResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Method == "" || req.Message == nil || req.Response == nil {
+		return nil, errors.New("method, message and response type must be set")
+	}
+
+	setSystem(req.Message, req.getSystem(rpcClient))
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		resp := req.Response.ProtoReflect().New().Interface()
+		if err := conn.Invoke(ctx, req.Method, req.Message, resp); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	})
+
+	rpcClient.Debugf("%s request: %s", req.Method, pbUtil.Debug(req.Message))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.isMSRequest() {
+		return &MethodResp{HostResponses: ur.Responses}, nil
+	}
+
+	msg, err := ur.getMSResponse()
+	if err != nil {
+		if IsConnErr(err) {
+			return nil, errors.Wrap(errMSConnectionFailure, err.Error())
+		}
+		return nil, err
+	}
+
+	return &MethodResp{Message: msg}, nil
+}
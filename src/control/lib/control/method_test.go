@@ -0,0 +1,131 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_InvokeMethod(t *testing.T) {
+	poolsResp := &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{Uuid: test.MockUUID(1), Label: "tank"},
+		},
+	}
+	scanResp := &ctlpb.NetworkScanResp{Numacount: 2}
+
+	for name, tc := range map[string]struct {
+		req     *MethodReq
+		mic     *MockInvokerConfig
+		expSys  string
+		expResp *MethodResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"no method": {
+			req: &MethodReq{
+				Message:  new(mgmtpb.ListPoolsReq),
+				Response: new(mgmtpb.ListPoolsResp),
+			},
+			expErr: errors.New("must be set"),
+		},
+		"MS method": {
+			req: &MethodReq{
+				Method:   mgmtpb.MgmtSvc_ListPools_FullMethodName,
+				Message:  new(mgmtpb.ListPoolsReq),
+				Response: new(mgmtpb.ListPoolsResp),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, poolsResp),
+			},
+			expSys:  build.DefaultSystemName + "-" + build.DaosVersion,
+			expResp: &MethodResp{Message: poolsResp},
+		},
+		"MS method with system set": {
+			req: &MethodReq{
+				Method:   mgmtpb.MgmtSvc_ListPools_FullMethodName,
+				Message:  &mgmtpb.ListPoolsReq{Sys: "other"},
+				Response: new(mgmtpb.ListPoolsResp),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, poolsResp),
+			},
+			expSys:  "other",
+			expResp: &MethodResp{Message: poolsResp},
+		},
+		"MS method fails": {
+			req: &MethodReq{
+				Method:   mgmtpb.MgmtSvc_ListPools_FullMethodName,
+				Message:  new(mgmtpb.ListPoolsReq),
+				Response: new(mgmtpb.ListPoolsResp),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", system.ErrPoolLabelNotFound("tank"), nil),
+			},
+			expSys: build.DefaultSystemName + "-" + build.DaosVersion,
+			expErr: system.ErrPoolLabelNotFound("tank"),
+		},
+		"control service method": {
+			req: &MethodReq{
+				Method:   ctlpb.CtlSvc_NetworkScan_FullMethodName,
+				Message:  new(ctlpb.NetworkScanReq),
+				Response: new(ctlpb.NetworkScanResp),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host1", Message: scanResp},
+						{Addr: "host2", Error: errors.New("failed")},
+					},
+				},
+			},
+			expResp: &MethodResp{
+				HostResponses: []*HostResponse{
+					{Addr: "host1", Message: scanResp},
+					{Addr: "host2", Error: errors.New("failed")},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, tc.mic)
+			resp, err := InvokeMethod(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, err)
+
+			cmpOpts := []cmp.Option{
+				protocmp.Transform(),
+				cmp.Comparer(func(x, y error) bool { return test.CmpErrBool(x, y) }),
+			}
+			if diff := cmp.Diff(tc.expResp, resp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			if tc.expSys != "" {
+				var gotSys string
+				if sr, ok := tc.req.Message.(interface{ GetSys() string }); ok {
+					gotSys = sr.GetSys()
+				}
+				test.AssertEqual(t, tc.expSys, gotSys, "unexpected system name")
+			}
+		})
+	}
+}
@@ -118,7 +118,7 @@ func (mi *MockInvoker) InvokeUnaryRPC(ctx context.Context, uReq UnaryRequest) (*
 			rReq.setRetryTimeout(mi.cfg.RetryTimeout)
 		}
 	}
-	return invokeUnaryRPC(ctx, mi.log, mi, uReq, nil)
+	return invokeUnaryRPC(ctx, mi.log, mi, uReq, nil, 0)
 }
 
 func (mi *MockInvoker) InvokeUnaryRPCAsync(ctx context.Context, uReq UnaryRequest) (HostResponseChan, error) {
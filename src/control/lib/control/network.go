@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -150,6 +151,7 @@ type (
 	// NetworkScanReq contains the parameters for a network scan request.
 	NetworkScanReq struct {
 		unaryRequest
+		failFastRequest
 		Provider string
 	}
 
@@ -0,0 +1,315 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// DefaultOperationWaitInterval is the default interval between polls of an
+// operation that is being waited on.
+const DefaultOperationWaitInterval = 2 * time.Second
+
+type (
+	// Operation describes a management service request that is run
+	// asynchronously on the MS leader, and its outcome.
+	Operation struct {
+		ID       string                `json:"id"`
+		Method   string                `json:"method"`
+		State    system.OperationState `json:"state"`
+		Caller   string                `json:"caller"`
+		Error    string                `json:"error,omitempty"`
+		Created  time.Time             `json:"created"`
+		Updated  time.Time             `json:"updated"`
+		Response json.RawMessage       `json:"response,omitempty"`
+	}
+
+	// operationRequest is implemented by the requests of methods that may
+	// be run as long-running operations.
+	operationRequest interface {
+		UnaryRequest
+		setRPC(unaryRPC)
+		getSystem(sysGetter) string
+	}
+
+	// OperationListReq contains the parameters for an operation list request.
+	OperationListReq struct {
+		unaryRequest
+		msRequest
+		retryableRequest
+		State system.OperationState
+	}
+
+	// OperationListResp contains the results of an operation list request.
+	OperationListResp struct {
+		Operations []*Operation `json:"operations"`
+	}
+
+	// OperationGetReq contains the parameters for an operation get request.
+	OperationGetReq struct {
+		unaryRequest
+		msRequest
+		retryableRequest
+		ID string
+	}
+
+	// OperationCancelReq contains the parameters for an operation cancel
+	// request.
+	OperationCancelReq struct {
+		unaryRequest
+		msRequest
+		ID string
+	}
+
+	// OperationWaitReq contains the parameters for an operation wait request.
+	OperationWaitReq struct {
+		OperationGetReq
+		Interval time.Duration
+	}
+)
+
+// Name returns the short name of the operation's method, e.g. "PoolCreate".
+func (op *Operation) Name() string {
+	return op.Method[strings.LastIndex(op.Method, "/")+1:]
+}
+
+// operationResponseJSON decodes the serialized response message of a method
+// into JSON.
+func operationResponseJSON(method string, data []byte) (json.RawMessage, error) {
+	name := strings.Replace(strings.TrimPrefix(method, "/"), "/", ".", 1)
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "method %s", method)
+	}
+	md, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, errors.Errorf("%s is not a method", method)
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, errors.Wrapf(err, "method %s", method)
+	}
+
+	msg := mt.New().Interface()
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, errors.Wrapf(err, "decoding %s response", method)
+	}
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+}
+
+func operationFromPB(pbOp *mgmtpb.Operation) (*Operation, error) {
+	if pbOp == nil {
+		return nil, errors.New("no operation in response")
+	}
+
+	op := &Operation{
+		ID:      pbOp.GetId(),
+		Method:  pbOp.GetMethod(),
+		State:   system.OperationState(pbOp.GetState()),
+		Caller:  pbOp.GetCaller(),
+		Error:   pbOp.GetError(),
+		Created: time.Unix(int64(pbOp.GetCreated()), 0),
+		Updated: time.Unix(int64(pbOp.GetUpdated()), 0),
+	}
+	if op.State == system.OperationStateSucceeded {
+		resp, err := operationResponseJSON(op.Method, pbOp.GetResponse())
+		if err != nil {
+			return nil, err
+		}
+		op.Response = resp
+	}
+
+	return op, nil
+}
+
+// startOperation runs the management service request of the given method as
+// a long-running operation on the MS leader, returning without waiting for
+// the operation to finish.
+func startOperation(ctx context.Context, rpcClient UnaryInvoker, req operationRequest, method string, pbReq proto.Message) (*Operation, error) {
+	data, err := proto.Marshal(pbReq)
+	if err != nil {
+		return nil, errors.Wrapf(err, "encoding %s request", method)
+	}
+
+	pbStart := &mgmtpb.OperationStartReq{
+		Sys:     req.getSystem(rpcClient),
+		Method:  method,
+		Request: data,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).OperationStart(ctx, pbStart)
+	})
+
+	rpcClient.Debugf("DAOS operation start request: %s %+v", method, pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "operation start failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.OperationStartResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", msResp)
+	}
+
+	return operationFromPB(pbResp.GetOperation())
+}
+
+// OperationList lists the long-running operations known to the MS.
+func OperationList(ctx context.Context, rpcClient UnaryInvoker, req *OperationListReq) (*OperationListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+
+	pbReq := &mgmtpb.OperationListReq{
+		Sys:   req.getSystem(rpcClient),
+		State: string(req.State),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).OperationList(ctx, pbReq)
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "operation list failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.OperationListResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", msResp)
+	}
+
+	resp := &OperationListResp{Operations: []*Operation{}}
+	for _, pbOp := range pbResp.GetOperations() {
+		op, err := operationFromPB(pbOp)
+		if err != nil {
+			return nil, err
+		}
+		resp.Operations = append(resp.Operations, op)
+	}
+
+	return resp, nil
+}
+
+// OperationGet gets a long-running operation by ID.
+func OperationGet(ctx context.Context, rpcClient UnaryInvoker, req *OperationGetReq) (*Operation, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+	if req.ID == "" {
+		return nil, errors.New("operation ID must be set")
+	}
+
+	pbReq := &mgmtpb.OperationGetReq{
+		Sys: req.getSystem(rpcClient),
+		Id:  req.ID,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).OperationGet(ctx, pbReq)
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "operation get failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.OperationGetResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", msResp)
+	}
+
+	return operationFromPB(pbResp.GetOperation())
+}
+
+// OperationCancel cancels a running long-running operation. The operation
+// is returned in its state before cancellation; the canceled state is
+// recorded once its request has returned.
+func OperationCancel(ctx context.Context, rpcClient UnaryInvoker, req *OperationCancelReq) (*Operation, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+	if req.ID == "" {
+		return nil, errors.New("operation ID must be set")
+	}
+
+	pbReq := &mgmtpb.OperationCancelReq{
+		Sys: req.getSystem(rpcClient),
+		Id:  req.ID,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).OperationCancel(ctx, pbReq)
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "operation cancel failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.OperationCancelResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", msResp)
+	}
+
+	return operationFromPB(pbResp.GetOperation())
+}
+
+// OperationWait polls a long-running operation until it has finished or the
+// context is done, and returns the finished operation.
+func OperationWait(ctx context.Context, rpcClient UnaryInvoker, req *OperationWaitReq) (*Operation, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+
+	interval := req.Interval
+	if interval <= 0 {
+		interval = DefaultOperationWaitInterval
+	}
+
+	for {
+		op, err := OperationGet(ctx, rpcClient, &req.OperationGetReq)
+		if err != nil {
+			return nil, err
+		}
+		if op.State.IsFinished() {
+			return op, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "waiting for operation %s", req.ID)
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,234 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockPBOperation(t *testing.T, id string, state system.OperationState, resp proto.Message) *mgmtpb.Operation {
+	t.Helper()
+
+	op := &mgmtpb.Operation{
+		Id:      id,
+		Method:  mgmtpb.MgmtSvc_PoolCreate_FullMethodName,
+		State:   string(state),
+		Caller:  "CN=admin",
+		Created: 1700000000,
+		Updated: 1700000060,
+	}
+	if resp != nil {
+		data, err := proto.Marshal(resp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		op.Response = data
+	}
+	return op
+}
+
+func mockOperation(id string, state system.OperationState, resp string) *Operation {
+	op := &Operation{
+		ID:      id,
+		Method:  mgmtpb.MgmtSvc_PoolCreate_FullMethodName,
+		State:   state,
+		Caller:  "CN=admin",
+		Created: time.Unix(1700000000, 0),
+		Updated: time.Unix(1700000060, 0),
+	}
+	if resp != "" {
+		op.Response = json.RawMessage(resp)
+	}
+	return op
+}
+
+func cmpOperationJSON(t *testing.T, exp, got interface{}) {
+	t.Helper()
+
+	// The spacing of the protojson response is not stable, so compare the
+	// decoded JSON values.
+	toValue := func(v interface{}) interface{} {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out interface{}
+		if err := json.Unmarshal(data, &out); err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+	if diff := cmp.Diff(toValue(exp), toValue(got)); diff != "" {
+		t.Fatalf("unexpected operation (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestControl_OperationGet(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *OperationGetReq
+		mic    *MockInvokerConfig
+		expOp  *Operation
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"missing ID": {
+			req:    &OperationGetReq{},
+			expErr: errors.New("must be set"),
+		},
+		"local failure": {
+			req: &OperationGetReq{ID: "op1"},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &OperationGetReq{ID: "op1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", system.FaultOperationNotFound("op1"), nil),
+			},
+			expErr: system.FaultOperationNotFound("op1"),
+		},
+		"running": {
+			req: &OperationGetReq{ID: "op1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.OperationGetResp{
+					Operation: mockPBOperation(t, "op1", system.OperationStateRunning, nil),
+				}),
+			},
+			expOp: mockOperation("op1", system.OperationStateRunning, ""),
+		},
+		"succeeded": {
+			req: &OperationGetReq{ID: "op1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.OperationGetResp{
+					Operation: mockPBOperation(t, "op1", system.OperationStateSucceeded,
+						&mgmtpb.PoolCreateResp{SvcReps: []uint32{0, 1}}),
+				}),
+			},
+			expOp: mockOperation("op1", system.OperationStateSucceeded, `{"svc_reps":[0,1]}`),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			mi := NewMockInvoker(log, mic)
+
+			gotOp, gotErr := OperationGet(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOperationJSON(t, tc.expOp, gotOp)
+		})
+	}
+}
+
+func TestControl_OperationList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		expResp *OperationListResp
+		expErr  error
+	}{
+		"local failure": {
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"no operations": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.OperationListResp{}),
+			},
+			expResp: &OperationListResp{Operations: []*Operation{}},
+		},
+		"operations": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.OperationListResp{
+					Operations: []*mgmtpb.Operation{
+						mockPBOperation(t, "op1", system.OperationStateFailed, nil),
+						mockPBOperation(t, "op2", system.OperationStateRunning, nil),
+					},
+				}),
+			},
+			expResp: &OperationListResp{
+				Operations: []*Operation{
+					mockOperation("op1", system.OperationStateFailed, ""),
+					mockOperation("op2", system.OperationStateRunning, ""),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResp, gotErr := OperationList(test.Context(t), mi, &OperationListReq{})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOperationJSON(t, tc.expResp, gotResp)
+		})
+	}
+}
+
+func TestControl_OperationWait(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mi := NewMockInvoker(log, &MockInvokerConfig{
+		UnaryResponseSet: []*UnaryResponse{
+			MockMSResponse("host1", nil, &mgmtpb.OperationGetResp{
+				Operation: mockPBOperation(t, "op1", system.OperationStateRunning, nil),
+			}),
+			MockMSResponse("host1", nil, &mgmtpb.OperationGetResp{
+				Operation: mockPBOperation(t, "op1", system.OperationStateRunning, nil),
+			}),
+			MockMSResponse("host1", nil, &mgmtpb.OperationGetResp{
+				Operation: mockPBOperation(t, "op1", system.OperationStateCanceled, nil),
+			}),
+		},
+	})
+
+	gotOp, err := OperationWait(test.Context(t), mi, &OperationWaitReq{
+		OperationGetReq: OperationGetReq{ID: "op1"},
+		Interval:        time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmpOperationJSON(t, mockOperation("op1", system.OperationStateCanceled, ""), gotOp)
+	test.AssertEqual(t, 3, mi.GetInvokeCount(), "unexpected number of polls")
+}
+
+func TestControl_Operation_Name(t *testing.T) {
+	op := mockOperation("op1", system.OperationStateRunning, "")
+	test.AssertEqual(t, "PoolCreate", op.Name(), "unexpected name")
+}
@@ -225,6 +225,9 @@ type (
 		Ranks      []ranklist.Rank      `json:"ranks"`       // Manual-sizing param
 		TierBytes  []uint64             `json:"tier_bytes"`  // Per-rank values
 		MemRatio   float32              `json:"mem_ratio"`   // mem_file_size:meta_blob_size
+		// Optional key identifying retries of the request. The MS returns
+		// the result of the first request for retries made with the key.
+		IdempotencyKey string `json:"idempotency_key,omitempty"`
 	}
 
 	// PoolCreateResp contains the response from a pool create request.
@@ -357,22 +360,46 @@ func PoolCreate(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateReq)
 	return pcr, nil
 }
 
+// PoolCreateAsync starts a pool create operation on the MS leader and returns
+// without waiting for the pool to be created. The pool's UUID is generated
+// if not supplied, so that it is known before the operation finishes.
+func PoolCreateAsync(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateReq) (*Operation, error) {
+	pbReq, err := poolCreateGenPBReq(ctx, rpcClient, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate PoolCreate request")
+	}
+	pbReq.Sys = req.getSystem(rpcClient)
+	if req.UUID == uuid.Nil {
+		req.UUID = uuid.MustParse(pbReq.Uuid)
+	}
+
+	return startOperation(ctx, rpcClient, req, mgmtpb.MgmtSvc_PoolCreate_FullMethodName, pbReq)
+}
+
 // PoolDestroyReq contains the parameters for a pool destroy request.
 type PoolDestroyReq struct {
 	poolRequest
 	ID        string
 	Recursive bool // Remove pool and any child containers.
 	Force     bool
+	// Optional key identifying retries of the request. The MS returns
+	// the result of the first request for retries made with the key.
+	IdempotencyKey string
+}
+
+func (req *PoolDestroyReq) toPB(rpcClient UnaryInvoker) *mgmtpb.PoolDestroyReq {
+	return &mgmtpb.PoolDestroyReq{
+		Sys:            req.getSystem(rpcClient),
+		Id:             req.ID,
+		Recursive:      req.Recursive,
+		Force:          req.Force,
+		IdempotencyKey: req.IdempotencyKey,
+	}
 }
 
 // PoolDestroy performs a pool destroy operation on a DAOS Management Server instance.
 func PoolDestroy(ctx context.Context, rpcClient UnaryInvoker, req *PoolDestroyReq) error {
-	pbReq := &mgmtpb.PoolDestroyReq{
-		Sys:       req.getSystem(rpcClient),
-		Id:        req.ID,
-		Recursive: req.Recursive,
-		Force:     req.Force,
-	}
+	pbReq := req.toPB(rpcClient)
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolDestroy(ctx, pbReq)
 	})
@@ -396,6 +423,13 @@ func PoolDestroy(ctx context.Context, rpcClient UnaryInvoker, req *PoolDestroyRe
 	return nil
 }
 
+// PoolDestroyAsync starts a pool destroy operation on the MS leader and
+// returns without waiting for the pool to be destroyed.
+func PoolDestroyAsync(ctx context.Context, rpcClient UnaryInvoker, req *PoolDestroyReq) (*Operation, error) {
+	return startOperation(ctx, rpcClient, req, mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+		req.toPB(rpcClient))
+}
+
 // PoolUpgradeReq contains the parameters for a pool upgrade request.
 type PoolUpgradeReq struct {
 	poolRequest
@@ -458,7 +492,8 @@ type (
 
 	// PoolQueryResp contains the pool query response.
 	PoolQueryResp struct {
-		Status int32 `json:"status"`
+		Status       int32  `json:"status"`
+		LabelWarning string `json:"label_warning,omitempty"`
 		daos.PoolInfo
 	}
 
@@ -377,6 +377,7 @@ func TestControl_PoolCreateReq_Convert(t *testing.T) {
 				Value:  strVal("foo"),
 			},
 		},
+		IdempotencyKey: "key1",
 	}
 	reqPB := new(mgmtpb.PoolCreateReq)
 	if err := convert.Types(req, reqPB); err != nil {
@@ -395,6 +396,7 @@ func TestControl_PoolCreateReq_Convert(t *testing.T) {
 		Properties: []*mgmtpb.PoolProperty{
 			{Number: 1, Value: &mgmtpb.PoolProperty_Strval{"foo"}},
 		},
+		IdempotencyKey: "key1",
 	}
 
 	cmpOpt := cmpopts.IgnoreUnexported(mgmtpb.PoolCreateReq{}, mgmtpb.PoolProperty{})
@@ -722,6 +724,29 @@ func TestControl_PoolCreate(t *testing.T) {
 			},
 			cmpUUID: true,
 		},
+		"UUID of previous request with idempotency key": {
+			req: &PoolCreateReq{
+				TierBytes:      validReq.TierBytes,
+				IdempotencyKey: "provision-42",
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolCreateResp{
+						Uuid:     customPoolUUID.String(),
+						SvcLdr:   1,
+						SvcReps:  []uint32{0, 1, 2},
+						TgtRanks: []uint32{0, 1, 2},
+					},
+				),
+			},
+			expResp: &PoolCreateResp{
+				UUID:     customPoolUUID.String(),
+				Leader:   1,
+				SvcReps:  []uint32{0, 1, 2},
+				TgtRanks: []uint32{0, 1, 2},
+			},
+			cmpUUID: true,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -0,0 +1,173 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+const (
+	defaultProfilesFile = "daos_control_profiles.yml"
+)
+
+// currentSystemRegexp matches the top-level current_system setting in a
+// system profiles file.
+var currentSystemRegexp = regexp.MustCompile(`(?m)^current_system:.*$`)
+
+type (
+	// profileConfig is a Config that is populated with default values
+	// before a system profile is unmarshaled into it.
+	profileConfig Config
+
+	// SystemProfiles defines the parameters used to connect to each of
+	// multiple DAOS systems, identified by profile name.
+	SystemProfiles struct {
+		CurrentSystem string                    `yaml:"current_system,omitempty"`
+		Systems       map[string]*profileConfig `yaml:"systems"`
+		Path          string                    `yaml:"-"`
+	}
+)
+
+// UnmarshalYAML populates the profile with default values before applying
+// the values supplied in the profile.
+func (pc *profileConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	cfg := DefaultConfig()
+	if err := unmarshal(cfg); err != nil {
+		return err
+	}
+	*pc = profileConfig(*cfg)
+
+	return nil
+}
+
+// UserProfilesPath returns the computed path to the per-user system
+// profiles file.
+func UserProfilesPath() string {
+	// If we can't determine $HOME it's weird but not fatal.
+	userHome, _ := os.UserHomeDir()
+	return path.Join(userHome, "."+defaultProfilesFile)
+}
+
+// LoadSystemProfiles loads the system profiles from the supplied path, or
+// from the per-user location if the path is empty. ErrNoProfilesFile is
+// returned if the file does not exist.
+func LoadSystemProfiles(profilesPath string) (*SystemProfiles, error) {
+	if profilesPath == "" {
+		profilesPath = UserProfilesPath()
+	}
+
+	data, err := os.ReadFile(profilesPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoProfilesFile
+		}
+		return nil, err
+	}
+
+	sp := new(SystemProfiles)
+	if err := yaml.UnmarshalStrict(data, sp); err != nil {
+		return nil, errors.Wrapf(err, "parsing system profiles file %s", profilesPath)
+	}
+	sp.Path = profilesPath
+
+	if err := sp.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "system profiles file %s", profilesPath)
+	}
+
+	return sp, nil
+}
+
+// Validate checks that the system profiles are usable.
+func (sp *SystemProfiles) Validate() error {
+	if len(sp.Systems) == 0 {
+		return errors.New("no systems defined")
+	}
+	for name, pc := range sp.Systems {
+		if pc == nil {
+			return errors.Errorf("system %q has no configuration", name)
+		}
+		if !daos.SystemNameIsValid(pc.SystemName) {
+			return errors.Errorf("system %q: invalid system name: %q", name, pc.SystemName)
+		}
+	}
+	if sp.CurrentSystem != "" {
+		if _, found := sp.Systems[sp.CurrentSystem]; !found {
+			return errors.Errorf("current system %q is not defined", sp.CurrentSystem)
+		}
+	}
+
+	return nil
+}
+
+// Names returns the sorted names of the system profiles.
+func (sp *SystemProfiles) Names() []string {
+	names := make([]string, 0, len(sp.Systems))
+	for name := range sp.Systems {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Config returns the configuration of the named system profile.
+func (sp *SystemProfiles) Config(name string) (*Config, error) {
+	pc, found := sp.Systems[name]
+	if !found {
+		return nil, errors.Errorf("unknown system %q (defined systems: %v)", name, sp.Names())
+	}
+
+	cfg := Config(*pc)
+	cfg.HostList = append([]string{}, pc.HostList...)
+	cfg.Path = sp.Path
+
+	return &cfg, nil
+}
+
+// SetCurrentSystem makes the named system profile the default and saves the
+// choice in the system profiles file. The rest of the file, including any
+// comments, is left unchanged.
+func (sp *SystemProfiles) SetCurrentSystem(name string) error {
+	if _, found := sp.Systems[name]; !found {
+		return errors.Errorf("unknown system %q (defined systems: %v)", name, sp.Names())
+	}
+
+	data, err := os.ReadFile(sp.Path)
+	if err != nil {
+		return err
+	}
+
+	setting := []byte("current_system: " + name)
+	if currentSystemRegexp.Match(data) {
+		data = currentSystemRegexp.ReplaceAllLiteral(data, setting)
+	} else {
+		data = append(append(setting, '\n'), data...)
+	}
+	if !bytes.HasSuffix(data, []byte("\n")) {
+		data = append(data, '\n')
+	}
+
+	fi, err := os.Stat(sp.Path)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(sp.Path, data, fi.Mode().Perm()); err != nil {
+		return errors.Wrapf(err, "updating system profiles file %s", sp.Path)
+	}
+	sp.CurrentSystem = name
+
+	return nil
+}
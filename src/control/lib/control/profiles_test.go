@@ -0,0 +1,203 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+const profilesExample = "../../../../utils/config/examples/daos_control_profiles.yml"
+
+const testProfiles = `# DAOS systems managed from this host
+current_system: prod
+
+systems:
+  prod:
+    name: daos_prod
+    hostlist: ['prod-1', 'prod-2']
+    transport_config:
+      ca_cert: /etc/daos/prod/daosCA.crt
+      cert: /etc/daos/prod/admin.crt
+      key: /etc/daos/prod/admin.key
+  test:
+    port: 10002
+    hostlist: ['test-1']
+    transport_config:
+      allow_insecure: true
+`
+
+func writeTestProfiles(t *testing.T, dir, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, defaultProfilesFile)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestControl_LoadSystemProfiles(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	for name, tc := range map[string]struct {
+		content string
+		path    string
+		expErr  error
+	}{
+		"missing": {
+			path:   filepath.Join(tmpDir, "missing.yml"),
+			expErr: ErrNoProfilesFile,
+		},
+		"no systems": {
+			content: "current_system: prod\n",
+			expErr:  errors.New("no systems defined"),
+		},
+		"unknown field": {
+			content: "systems:\n  prod:\n    hosts: ['prod-1']\n",
+			expErr:  errors.New("field hosts not found"),
+		},
+		"invalid system name": {
+			content: "systems:\n  prod:\n    name: 'bad name'\n",
+			expErr:  errors.New("invalid system name"),
+		},
+		"undefined current system": {
+			content: "current_system: dev\nsystems:\n  prod:\n    name: daos_prod\n",
+			expErr:  errors.New(`current system "dev" is not defined`),
+		},
+		"success": {
+			content: testProfiles,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := tc.path
+			if path == "" {
+				path = writeTestProfiles(t, tmpDir, tc.content)
+			}
+
+			sp, err := LoadSystemProfiles(path)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, "prod", sp.CurrentSystem, "")
+			if diff := cmp.Diff([]string{"prod", "test"}, sp.Names()); diff != "" {
+				t.Fatalf("unexpected names (-want, +got):\n%s\n", diff)
+			}
+
+			prodTC := security.DefaultClientTransportConfig()
+			prodTC.CARootPath = "/etc/daos/prod/daosCA.crt"
+			prodTC.CertificatePath = "/etc/daos/prod/admin.crt"
+			prodTC.PrivateKeyPath = "/etc/daos/prod/admin.key"
+			expProd := DefaultConfig()
+			expProd.SystemName = "daos_prod"
+			expProd.HostList = []string{"prod-1", "prod-2"}
+			expProd.TransportConfig = prodTC
+			expProd.Path = path
+
+			gotProd, err := sp.Config("prod")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(expProd, gotProd, defCfgCmpOpts...); diff != "" {
+				t.Fatalf("unexpected prod config (-want, +got):\n%s\n", diff)
+			}
+
+			// Unset values take the defaults.
+			gotTest, err := sp.Config("test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, DefaultConfig().SystemName, gotTest.SystemName, "")
+			test.AssertEqual(t, 10002, gotTest.ControlPort, "")
+			test.AssertTrue(t, gotTest.TransportConfig.AllowInsecure, "expected insecure transport")
+
+			_, err = sp.Config("dev")
+			test.CmpErr(t, errors.New(`unknown system "dev"`), err)
+		})
+	}
+}
+
+func TestControl_SystemProfiles_SetCurrentSystem(t *testing.T) {
+	noCurrent := strings.Replace(testProfiles, "current_system: prod\n", "", 1)
+
+	for name, tc := range map[string]struct {
+		content    string
+		system     string
+		expErr     error
+		expContent string
+	}{
+		"unknown system": {
+			content: testProfiles,
+			system:  "dev",
+			expErr:  errors.New(`unknown system "dev"`),
+		},
+		"replace current system": {
+			content:    testProfiles,
+			system:     "test",
+			expContent: strings.Replace(testProfiles, "current_system: prod", "current_system: test", 1),
+		},
+		"add current system": {
+			content:    noCurrent,
+			system:     "test",
+			expContent: "current_system: test\n" + noCurrent,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tmpDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			path := writeTestProfiles(t, tmpDir, tc.content)
+			sp, err := LoadSystemProfiles(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = sp.SetCurrentSystem(tc.system)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expContent, string(data)); diff != "" {
+				t.Fatalf("unexpected file content (-want, +got):\n%s\n", diff)
+			}
+
+			reloaded, err := LoadSystemProfiles(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.system, reloaded.CurrentSystem, "")
+		})
+	}
+}
+
+func TestControl_SystemProfiles_Example(t *testing.T) {
+	sp, err := LoadSystemProfiles(profilesExample)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]string{"development", "production", "scratch"}, sp.Names()); diff != "" {
+		t.Fatalf("unexpected names (-want, +got):\n%s\n", diff)
+	}
+	test.AssertEqual(t, "", sp.CurrentSystem, "")
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -66,6 +67,13 @@ type (
 		reportResponse(*HostResponse)
 	}
 
+	// failFaster defines an interface to be implemented by
+	// requests that may fail fast for hosts that recently failed
+	// to respond, rather than being sent to them.
+	failFaster interface {
+		canFailFast() bool
+	}
+
 	// UnaryRequest defines an interface to be implemented by
 	// unary request types (1 response to 1 request).
 	UnaryRequest interface {
@@ -199,6 +207,18 @@ func (r *msRequest) isMSRequest() bool {
 	return true
 }
 
+// failFastRequest is an embeddable struct to implement the failFaster
+// interface. Should only be embedded in read-only request types (e.g. scans
+// and queries), which are harmless to skip for a host that recently failed to
+// respond.
+type failFastRequest struct{}
+
+// canFailFast implements the failFaster interface, and will always return
+// true for a failFastRequest.
+func (r *failFastRequest) canFailFast() bool {
+	return true
+}
+
 // retryableRequest is the default implementation of the retryer interface.
 type retryableRequest struct {
 	// retryTimeout sets an optional timeout for each retry.
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -16,6 +17,7 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
@@ -127,6 +129,18 @@ type (
 		config    *Config
 		log       debugLogger
 		component build.Component
+		conns     *connCache
+		tracer    *Tracer
+		breaker   *CircuitBreaker
+	}
+
+	// connCache holds the connections to each host that are reused across
+	// requests when persistent connections are enabled. The cache is
+	// emptied if the transport configuration changes.
+	connCache struct {
+		sync.Mutex
+		tc    *security.TransportConfig
+		conns map[string]*grpc.ClientConn
 	}
 
 	// ClientOption defines the signature for functional Client options.
@@ -154,6 +168,14 @@ func WithConfig(cfg *Config) ClientOption {
 	}
 }
 
+// WithPersistentConnections enables the reuse of connections to each host
+// across requests.
+func WithPersistentConnections() ClientOption {
+	return func(c *Client) {
+		c.PersistConnections()
+	}
+}
+
 // NewClient returns an initialized Client with its
 // parameters set by the provided ClientOption list.
 func NewClient(opts ...ClientOption) *Client {
@@ -198,6 +220,38 @@ func (c *Client) GetSystem() string {
 	return c.config.SystemName
 }
 
+// PersistConnections enables the reuse of connections to each host across
+// requests, avoiding the cost of connection setup for each request. The
+// connections are held open until Close is called.
+func (c *Client) PersistConnections() {
+	if c.conns == nil {
+		c.conns = &connCache{
+			conns: make(map[string]*grpc.ClientConn),
+		}
+	}
+}
+
+// Close closes any persistent connections held by the client.
+func (c *Client) Close() error {
+	if c.conns == nil {
+		return nil
+	}
+	return c.conns.closeAll()
+}
+
+// SetTracer sets the Tracer used to record the requests made by the client.
+// Tracing is disabled if the Tracer is nil.
+func (c *Client) SetTracer(t *Tracer) {
+	c.tracer = t
+}
+
+// SetCircuitBreaker sets the CircuitBreaker used to fail fast the requests
+// to hosts that recently failed to respond. The circuit breaker is disabled if
+// it is nil.
+func (c *Client) SetCircuitBreaker(cb *CircuitBreaker) {
+	c.breaker = cb
+}
+
 func (c *Client) Debug(msg string) {
 	c.log.Debug(msg)
 }
@@ -214,6 +268,7 @@ func (c *Client) dialOptions() ([]grpc.DialOption, error) {
 		grpc.WithChainUnaryInterceptor(
 			unaryErrorInterceptor(),
 			unaryVersionedComponentInterceptor(c.GetComponent()),
+			unaryTraceInterceptor(),
 		),
 		grpc.FailOnNonTempDialError(true),
 	}
@@ -227,6 +282,88 @@ func (c *Client) dialOptions() ([]grpc.DialOption, error) {
 	return opts, nil
 }
 
+// dial is a helper method to open a connection to the given host.
+func (c *Client) dial(ctx context.Context, hostAddr string) (*grpc.ClientConn, error) {
+	opts, err := c.dialOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.DialContext(ctx, hostAddr, opts...)
+}
+
+// getConn returns a connection to the given host along with a function to
+// be called when the connection is no longer needed. If persistent
+// connections are enabled, an existing connection to the host is returned
+// if one is available.
+func (c *Client) getConn(ctx context.Context, hostAddr string) (*grpc.ClientConn, func(), error) {
+	if c.conns == nil {
+		conn, err := c.dial(ctx, hostAddr)
+		if err != nil {
+			return nil, nil, err
+		}
+		return conn, func() { conn.Close() }, nil
+	}
+
+	conn, err := c.conns.get(ctx, c.config.TransportConfig, hostAddr, c.dial)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, func() {}, nil
+}
+
+// get returns the cached connection to the given host, or opens a new one if
+// there is no usable connection in the cache.
+func (cc *connCache) get(ctx context.Context, tc *security.TransportConfig, hostAddr string, dial func(context.Context, string) (*grpc.ClientConn, error)) (*grpc.ClientConn, error) {
+	cc.Lock()
+	defer cc.Unlock()
+
+	if tc != cc.tc {
+		cc.closeConns()
+		cc.tc = tc
+	}
+
+	if conn, found := cc.conns[hostAddr]; found {
+		switch conn.GetState() {
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			conn.Close()
+			delete(cc.conns, hostAddr)
+		default:
+			return conn, nil
+		}
+	}
+
+	conn, err := dial(ctx, hostAddr)
+	if err != nil {
+		return nil, err
+	}
+	cc.conns[hostAddr] = conn
+
+	return conn, nil
+}
+
+// closeConns closes and removes all connections in the cache. The caller
+// must hold the lock.
+func (cc *connCache) closeConns() error {
+	var firstErr error
+	for addr, conn := range cc.conns {
+		if err := conn.Close(); err != nil && firstErr == nil {
+			firstErr = errors.Wrapf(err, "closing connection to %s", addr)
+		}
+		delete(cc.conns, addr)
+	}
+
+	return firstErr
+}
+
+// closeAll closes and removes all connections in the cache.
+func (cc *connCache) closeAll() error {
+	cc.Lock()
+	defer cc.Unlock()
+
+	return cc.closeConns()
+}
+
 // setDeadlineIfUnset sets a deadline on the context unless there is already
 // one set. If the request does not define a specific deadline, then the
 // default timeout is used.
@@ -265,6 +402,29 @@ func wrapReqTimeout(req UnaryRequest, err error) error {
 	return err
 }
 
+// invokeHost invokes the request's RPC on a single host, recording the result
+// in the request trace and circuit breaker.
+func (c *Client) invokeHost(ctx context.Context, req UnaryRequest, hostAddr string) (proto.Message, error) {
+	rt := requestTraceFromContext(ctx)
+	rpc := rt.startRPC(hostAddr)
+	ctx = withRPCTrace(ctx, rpc)
+
+	var msg proto.Message
+	conn, release, err := c.getConn(ctx, hostAddr)
+	if err == nil {
+		if rpc != nil {
+			waitForConnect(ctx, conn)
+			rpc.setDialed()
+		}
+		msg, err = req.getRPC()(ctx, conn)
+		release()
+	}
+	rt.finishRPC(rpc, err)
+	c.breaker.record(hostAddr, err)
+
+	return msg, err
+}
+
 // InvokeUnaryRPCAsync performs an asynchronous invocation of the given RPC
 // across all hosts in the request's host list. The returned HostResponseChan
 // provides access to a stream of HostResponse items as they are received, and
@@ -290,14 +450,17 @@ func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (
 			wg.Add(1)
 			go func(hostAddr string) {
 				var msg proto.Message
-				opts, err := c.dialOptions()
+				var err error
+				// Read-only fan-out requests fail fast for hosts that
+				// recently failed to respond. MS requests handle
+				// unresponsive hosts in their retry logic, and other
+				// requests are always sent so that they are not
+				// skipped without the caller asking.
+				if ff, ok := req.(failFaster); ok && ff.canFailFast() && !req.isMSRequest() {
+					err = c.breaker.check(hostAddr)
+				}
 				if err == nil {
-					var conn *grpc.ClientConn
-					conn, err = grpc.DialContext(ctx, hostAddr, opts...)
-					if err == nil {
-						msg, err = req.getRPC()(ctx, conn)
-						conn.Close()
-					}
+					msg, err = c.invokeHost(ctx, req, hostAddr)
 				}
 
 				select {
@@ -317,8 +480,10 @@ func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (
 
 // invokeUnaryRPC is the actual implementation which is called by the
 // real Client as well as the MockInvoker. This allows us to ensure that
-// the retry logic here gets adequate test coverage.
-func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker, req UnaryRequest, defaultHosts []string) (*UnaryResponse, error) {
+// the retry logic here gets adequate test coverage. The candidate hosts for
+// a MS request without a hostlist are chosen from the default hosts, of which
+// the first numPreferred are preferred, or all of them if zero.
+func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker, req UnaryRequest, defaultHosts []string, numPreferred int) (*UnaryResponse, error) {
 	gatherResponses := func(ctx context.Context, respChan chan *HostResponse, ur *UnaryResponse) error {
 		for {
 			select {
@@ -333,6 +498,8 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 		}
 	}
 
+	rt := requestTraceFromContext(parentCtx)
+
 	// Set a deadline for the request across all retries.
 	reqCtx, cancel := setDeadlineIfUnset(parentCtx, req)
 	defer cancel()
@@ -366,8 +533,23 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 			numCandidates = len(defaultHosts)
 		}
 
+		// Choose the candidates from the preferred hosts, and only make
+		// up the numbers from the others if there are not enough of them.
+		candidates := defaultHosts
+		if numPreferred > 0 && numPreferred < len(defaultHosts) {
+			candidates = defaultHosts[:numPreferred]
+			if numPreferred < numCandidates {
+				for _, host := range candidates {
+					if _, err := msCandidates.Insert(host); err != nil {
+						return nil, errors.Wrap(err, "failed to build MS candidates set")
+					}
+				}
+				candidates = defaultHosts[numPreferred:]
+			}
+		}
+
 		for msCandidates.Count() < numCandidates {
-			if _, err := msCandidates.Insert(defaultHosts[rnd.Intn(len(defaultHosts))]); err != nil {
+			if _, err := msCandidates.Insert(candidates[rnd.Intn(len(candidates))]); err != nil {
 				return nil, errors.Wrap(err, "failed to build MS candidates set")
 			}
 		}
@@ -422,6 +604,7 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 	// replica is returning the same answer.
 	var try uint = 0
 	for {
+		rt.setTry(try)
 		tryCtx := reqCtx
 		if tryTimeout := req.getRetryTimeout(); tryTimeout > 0 {
 			var tryCancel context.CancelFunc
@@ -464,10 +647,12 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 			if e.LeaderHint == "" {
 				if len(e.Replicas) > 0 {
 					req.SetHostList(e.Replicas)
+					rt.addRedirect("not leader", e.Replicas)
 				}
 				break
 			}
 			req.SetHostList([]string{e.LeaderHint})
+			rt.addRedirect("not leader", []string{e.LeaderHint})
 		case *system.ErrNotReplica:
 			// If we went the request to a non-replica host, then
 			// the error should give us the list of replicas to try.
@@ -475,6 +660,7 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 			// service the request.
 			if len(e.Replicas) > 0 {
 				req.SetHostList(e.Replicas)
+				rt.addRedirect("not replica", e.Replicas)
 			}
 		default:
 			// As long as the outer context hasn't timed out, we
@@ -528,5 +714,10 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 // items which represent the success or failure of the RPC invocation for each host
 // in the request.
 func (c *Client) InvokeUnaryRPC(ctx context.Context, req UnaryRequest) (*UnaryResponse, error) {
-	return invokeUnaryRPC(ctx, c.log, c, req, c.config.HostList)
+	rt := c.tracer.startRequest(req)
+	hosts, numPreferred := c.breaker.prioritize(c.config.HostList)
+	resp, err := invokeUnaryRPC(withRequestTrace(ctx, rt), c.log, c, req, hosts, numPreferred)
+	rt.finish(err)
+
+	return resp, err
 }
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +10,7 @@ package control
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"runtime"
 	"sort"
@@ -21,6 +23,7 @@ import (
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
@@ -35,6 +38,7 @@ type testRequest struct {
 	retryableRequest
 	rpcFn    unaryRPC
 	toMS     bool
+	failFast bool
 	HostList []string
 	Deadline time.Time
 	Timeout  time.Duration
@@ -45,6 +49,10 @@ func (tr *testRequest) isMSRequest() bool {
 	return tr.toMS
 }
 
+func (tr *testRequest) canFailFast() bool {
+	return tr.failFast
+}
+
 func (tr *testRequest) SetHostList(hl []string) {
 	tr.HostList = hl
 }
@@ -556,3 +564,75 @@ func TestControl_InvokeUnaryRPC(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_Client_PersistConnections(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	go srv.Serve(lis)
+	defer srv.Stop()
+	addr := lis.Addr().String()
+
+	newCfg := func() *Config {
+		cfg := DefaultConfig()
+		cfg.TransportConfig.AllowInsecure = true
+		return cfg
+	}
+
+	for name, tc := range map[string]struct {
+		persist   bool
+		expReused bool
+	}{
+		"not persistent": {},
+		"persistent": {
+			persist:   true,
+			expReused: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			opts := []ClientOption{WithConfig(newCfg()), WithClientLogger(log)}
+			if tc.persist {
+				opts = append(opts, WithPersistentConnections())
+			}
+			client := NewClient(opts...)
+			ctx := test.Context(t)
+
+			first, release, err := client.getConn(ctx, addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			release()
+
+			second, release, err := client.getConn(ctx, addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			release()
+
+			test.AssertEqual(t, tc.expReused, first == second, "unexpected connection reuse")
+			if !tc.expReused {
+				test.AssertEqual(t, connectivity.Shutdown, first.GetState(), "connection not closed")
+				return
+			}
+
+			// A change of transport config invalidates the cached connections.
+			client.SetConfig(newCfg())
+			third, _, err := client.getConn(ctx, addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertTrue(t, third != first, "expected a new connection")
+			test.AssertEqual(t, connectivity.Shutdown, first.GetState(), "stale connection not closed")
+
+			if err := client.Close(); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, connectivity.Shutdown, third.GetState(), "connection not closed")
+		})
+	}
+}
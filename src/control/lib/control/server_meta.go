@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -66,6 +67,7 @@ type (
 	// SmdQueryReq contains the request parameters for a SMD query operation.
 	SmdQueryReq struct {
 		unaryRequest
+		failFastRequest
 		OmitDevices      bool          `json:"omit_devices"`
 		OmitPools        bool          `json:"omit_pools"`
 		IncludeBioHealth bool          `json:"include_bio_health"`
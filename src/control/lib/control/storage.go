@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -174,6 +175,7 @@ type (
 	// StorageScanReq contains the parameters for a storage scan request.
 	StorageScanReq struct {
 		unaryRequest
+		failFastRequest
 		Usage      bool    `json:"usage"`
 		NvmeHealth bool    `json:"nvme_health"`
 		NvmeBasic  bool    `json:"nvme_basic"`
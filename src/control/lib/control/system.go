@@ -128,6 +128,7 @@ type SystemJoinReq struct {
 	InstanceIdx          uint32              `json:"idx"`
 	Incarnation          uint64              `json:"incarnation"`
 	CheckMode            bool                `json:"check_mode"`
+	DBSchemaVersion      uint32              `json:"db_schema_version"`
 }
 
 // MarshalJSON packs SystemJoinResp struct into a JSON message.
@@ -614,12 +615,7 @@ func SystemDrain(ctx context.Context, rpcClient UnaryInvoker, req *SystemDrainRe
 		return nil, errors.Errorf("nil %T request", req)
 	}
 
-	pbReq := &mgmtpb.SystemDrainReq{
-		Hosts: req.Hosts.String(),
-		Ranks: req.Ranks.String(),
-		Sys:   req.getSystem(rpcClient),
-		Reint: req.Reint,
-	}
+	pbReq := req.toPB(rpcClient)
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).SystemDrain(ctx, pbReq)
 	})
@@ -634,6 +630,26 @@ func SystemDrain(ctx context.Context, rpcClient UnaryInvoker, req *SystemDrainRe
 	return resp, convertMSResponse(ur, resp)
 }
 
+func (req *SystemDrainReq) toPB(rpcClient UnaryInvoker) *mgmtpb.SystemDrainReq {
+	return &mgmtpb.SystemDrainReq{
+		Hosts: req.Hosts.String(),
+		Ranks: req.Ranks.String(),
+		Sys:   req.getSystem(rpcClient),
+		Reint: req.Reint,
+	}
+}
+
+// SystemDrainAsync starts a system drain or reintegrate operation on the MS
+// leader and returns without waiting for the ranks to be drained.
+func SystemDrainAsync(ctx context.Context, rpcClient UnaryInvoker, req *SystemDrainReq) (*Operation, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	return startOperation(ctx, rpcClient, req, mgmtpb.MgmtSvc_SystemDrain_FullMethodName,
+		req.toPB(rpcClient))
+}
+
 // SystemEraseReq contains the inputs for a system erase request.
 type SystemEraseReq struct {
 	msRequest
@@ -0,0 +1,454 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/daos-stack/daos/src/control/build"
+)
+
+// TraceParentHeader is the gRPC metadata key used to propagate the trace
+// context of a request to the server, in the W3C Trace Context format.
+const TraceParentHeader = "traceparent"
+
+var traceParentRegexp = regexp.MustCompile(`^00-([0-9a-f]{32})-([0-9a-f]{16})-[0-9a-f]{2}$`)
+
+type (
+	// RPCTrace records a single RPC made to a host as part of a request.
+	RPCTrace struct {
+		SpanID   string
+		Host     string
+		Try      uint
+		Start    time.Time
+		Dial     time.Duration
+		Duration time.Duration
+		Error    string
+		TimedOut bool
+
+		traceID string
+		dialed  time.Time
+	}
+
+	// RequestRedirect records the redirection of a request to other hosts
+	// after an attempt failed, e.g. because it was not sent to the
+	// management service leader.
+	RequestRedirect struct {
+		Try    uint
+		Reason string
+		Hosts  []string
+	}
+
+	// RequestTrace records the RPCs, retries and redirects made in order to
+	// complete a single request.
+	RequestTrace struct {
+		sync.Mutex
+		SpanID    string
+		Name      string
+		Start     time.Time
+		Duration  time.Duration
+		Retries   uint
+		Timeouts  uint
+		Redirects []*RequestRedirect
+		RPCs      []*RPCTrace
+		Error     string
+
+		traceID string
+	}
+
+	// Tracer records the requests made by a client while it is set on the
+	// client, for example while running a single command.
+	Tracer struct {
+		sync.Mutex
+		TraceID  string
+		SpanID   string
+		Name     string
+		Start    time.Time
+		Duration time.Duration
+		Requests []*RequestTrace
+	}
+
+	requestTraceKey struct{}
+	rpcTraceKey     struct{}
+)
+
+func randomHex(numBytes int) string {
+	buf := make([]byte, numBytes)
+	if _, err := rand.Read(buf); err != nil {
+		// Fall back on the time, which is good enough to
+		// distinguish traces.
+		return fmt.Sprintf("%0*x", numBytes*2, time.Now().UnixNano())
+	}
+	return hex.EncodeToString(buf)
+}
+
+// NewTracer returns a Tracer with a new trace ID for the named operation.
+func NewTracer(name string) *Tracer {
+	return &Tracer{
+		TraceID: randomHex(16),
+		SpanID:  randomHex(8),
+		Name:    name,
+		Start:   time.Now(),
+	}
+}
+
+// Finish records the end of the traced operation.
+func (t *Tracer) Finish() {
+	t.Lock()
+	defer t.Unlock()
+
+	t.Duration = time.Since(t.Start)
+}
+
+// startRequest begins the trace of a request. A nil trace is returned if
+// the Tracer is nil.
+func (t *Tracer) startRequest(req UnaryRequest) *RequestTrace {
+	if t == nil {
+		return nil
+	}
+
+	rt := &RequestTrace{
+		SpanID:  randomHex(8),
+		Name:    strings.TrimPrefix(fmt.Sprintf("%T", req), "*control."),
+		Start:   time.Now(),
+		traceID: t.TraceID,
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	t.Requests = append(t.Requests, rt)
+
+	return rt
+}
+
+func (rt *RequestTrace) finish(err error) {
+	if rt == nil {
+		return
+	}
+
+	rt.Lock()
+	defer rt.Unlock()
+
+	rt.Duration = time.Since(rt.Start)
+	if err != nil {
+		rt.Error = err.Error()
+	}
+}
+
+// setTry records the number of the attempt that is about to be made.
+func (rt *RequestTrace) setTry(try uint) {
+	if rt == nil {
+		return
+	}
+
+	rt.Lock()
+	defer rt.Unlock()
+
+	rt.Retries = try
+}
+
+func (rt *RequestTrace) addRedirect(reason string, hosts []string) {
+	if rt == nil {
+		return
+	}
+
+	rt.Lock()
+	defer rt.Unlock()
+
+	rt.Redirects = append(rt.Redirects, &RequestRedirect{
+		Try:    rt.Retries,
+		Reason: reason,
+		Hosts:  append([]string{}, hosts...),
+	})
+}
+
+// startRPC begins the trace of an RPC to the given host.
+func (rt *RequestTrace) startRPC(host string) *RPCTrace {
+	if rt == nil {
+		return nil
+	}
+
+	rt.Lock()
+	defer rt.Unlock()
+
+	rpc := &RPCTrace{
+		SpanID:  randomHex(8),
+		Host:    host,
+		Try:     rt.Retries,
+		Start:   time.Now(),
+		traceID: rt.traceID,
+	}
+	rt.RPCs = append(rt.RPCs, rpc)
+
+	return rpc
+}
+
+// finishRPC records the result of an RPC started with startRPC.
+func (rt *RequestTrace) finishRPC(rpc *RPCTrace, err error) {
+	if rt == nil || rpc == nil {
+		return
+	}
+
+	rt.Lock()
+	defer rt.Unlock()
+
+	end := time.Now()
+	if rpc.dialed.IsZero() {
+		rpc.Dial = end.Sub(rpc.Start)
+	} else {
+		rpc.Dial = rpc.dialed.Sub(rpc.Start)
+		rpc.Duration = end.Sub(rpc.dialed)
+	}
+	if err != nil {
+		rpc.Error = err.Error()
+		if isTimeout(err) {
+			rpc.TimedOut = true
+			rt.Timeouts++
+		}
+	}
+}
+
+// setDialed records the time at which the connection for the RPC was ready.
+func (rpc *RPCTrace) setDialed() {
+	if rpc == nil {
+		return
+	}
+	rpc.dialed = time.Now()
+}
+
+// traceParent returns the W3C Trace Context traceparent value identifying
+// the RPC as the parent of the server's handling of it.
+func (rpc *RPCTrace) traceParent() string {
+	return fmt.Sprintf("00-%s-%s-01", rpc.traceID, rpc.SpanID)
+}
+
+func withRequestTrace(parent context.Context, rt *RequestTrace) context.Context {
+	if rt == nil {
+		return parent
+	}
+	return context.WithValue(parent, requestTraceKey{}, rt)
+}
+
+func requestTraceFromContext(ctx context.Context) *RequestTrace {
+	rt, _ := ctx.Value(requestTraceKey{}).(*RequestTrace)
+	return rt
+}
+
+func withRPCTrace(parent context.Context, rpc *RPCTrace) context.Context {
+	if rpc == nil {
+		return parent
+	}
+	return context.WithValue(parent, rpcTraceKey{}, rpc)
+}
+
+func rpcTraceFromContext(ctx context.Context) *RPCTrace {
+	rpc, _ := ctx.Value(rpcTraceKey{}).(*RPCTrace)
+	return rpc
+}
+
+// TraceFromIncomingContext returns the trace ID and parent span ID of a traced
+// RPC from the gRPC metadata of the incoming context.
+func TraceFromIncomingContext(ctx context.Context) (traceID, spanID string, found bool) {
+	md, hasMD := metadata.FromIncomingContext(ctx)
+	if !hasMD {
+		return "", "", false
+	}
+	vals := md.Get(TraceParentHeader)
+	if len(vals) == 0 {
+		return "", "", false
+	}
+
+	m := traceParentRegexp.FindStringSubmatch(vals[0])
+	if m == nil {
+		return "", "", false
+	}
+	return m[1], m[2], true
+}
+
+// waitForConnect waits for a connection to finish connecting, so that the
+// time taken to connect is not included in the RPC latency of a trace. The
+// wait ends on failure as well, as the RPC would then fail without waiting.
+func waitForConnect(ctx context.Context, conn *grpc.ClientConn) {
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		if state != connectivity.Idle && state != connectivity.Connecting {
+			return
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return
+		}
+	}
+}
+
+// The following types define the subset of the OpenTelemetry protocol (OTLP)
+// JSON encoding that is needed to export traces.
+type (
+	otlpValue struct {
+		StringValue *string `json:"stringValue,omitempty"`
+		IntValue    *string `json:"intValue,omitempty"`
+		BoolValue   *bool   `json:"boolValue,omitempty"`
+	}
+
+	otlpAttribute struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+
+	otlpStatus struct {
+		Code    int    `json:"code"`
+		Message string `json:"message,omitempty"`
+	}
+
+	otlpSpan struct {
+		TraceID           string          `json:"traceId"`
+		SpanID            string          `json:"spanId"`
+		ParentSpanID      string          `json:"parentSpanId,omitempty"`
+		Name              string          `json:"name"`
+		Kind              int             `json:"kind"`
+		StartTimeUnixNano string          `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+		Attributes        []otlpAttribute `json:"attributes,omitempty"`
+		Status            otlpStatus      `json:"status"`
+	}
+
+	otlpScopeSpans struct {
+		Scope struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"scope"`
+		Spans []*otlpSpan `json:"spans"`
+	}
+
+	otlpResourceSpans struct {
+		Resource struct {
+			Attributes []otlpAttribute `json:"attributes"`
+		} `json:"resource"`
+		ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+	}
+
+	otlpTraces struct {
+		ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+	}
+)
+
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindClient   = 3
+
+	otlpStatusOK    = 1
+	otlpStatusError = 2
+)
+
+func otlpString(key, val string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpValue{StringValue: &val}}
+}
+
+func otlpInt(key string, val int64) otlpAttribute {
+	str := strconv.FormatInt(val, 10)
+	return otlpAttribute{Key: key, Value: otlpValue{IntValue: &str}}
+}
+
+func otlpBool(key string, val bool) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpValue{BoolValue: &val}}
+}
+
+func otlpTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func otlpSpanStatus(errStr string) otlpStatus {
+	if errStr == "" {
+		return otlpStatus{Code: otlpStatusOK}
+	}
+	return otlpStatus{Code: otlpStatusError, Message: errStr}
+}
+
+// WriteOTLP writes the trace to the supplied writer as OpenTelemetry spans in
+// the OTLP JSON encoding, for import into a tracing backend. The traced
+// operation is the root span, with a child span for each request and a span
+// for each RPC made to a host as part of the request.
+func (t *Tracer) WriteOTLP(out io.Writer, serviceName string) error {
+	t.Lock()
+	defer t.Unlock()
+
+	scope := &otlpScopeSpans{}
+	scope.Scope.Name = "github.com/daos-stack/daos/src/control/lib/control"
+	scope.Scope.Version = build.DaosVersion
+
+	scope.Spans = append(scope.Spans, &otlpSpan{
+		TraceID:           t.TraceID,
+		SpanID:            t.SpanID,
+		Name:              t.Name,
+		Kind:              otlpSpanKindInternal,
+		StartTimeUnixNano: otlpTime(t.Start),
+		EndTimeUnixNano:   otlpTime(t.Start.Add(t.Duration)),
+	})
+
+	for _, rt := range t.Requests {
+		rt.Lock()
+		attrs := []otlpAttribute{
+			otlpInt("daos.retries", int64(rt.Retries)),
+			otlpInt("daos.timeouts", int64(rt.Timeouts)),
+		}
+		for _, rd := range rt.Redirects {
+			attrs = append(attrs, otlpString(fmt.Sprintf("daos.redirect.%d", rd.Try),
+				fmt.Sprintf("%s: %s", rd.Reason, strings.Join(rd.Hosts, ","))))
+		}
+		scope.Spans = append(scope.Spans, &otlpSpan{
+			TraceID:           t.TraceID,
+			SpanID:            rt.SpanID,
+			ParentSpanID:      t.SpanID,
+			Name:              rt.Name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: otlpTime(rt.Start),
+			EndTimeUnixNano:   otlpTime(rt.Start.Add(rt.Duration)),
+			Attributes:        attrs,
+			Status:            otlpSpanStatus(rt.Error),
+		})
+
+		for _, rpc := range rt.RPCs {
+			scope.Spans = append(scope.Spans, &otlpSpan{
+				TraceID:           t.TraceID,
+				SpanID:            rpc.SpanID,
+				ParentSpanID:      rt.SpanID,
+				Name:              rt.Name + " " + rpc.Host,
+				Kind:              otlpSpanKindClient,
+				StartTimeUnixNano: otlpTime(rpc.Start),
+				EndTimeUnixNano:   otlpTime(rpc.Start.Add(rpc.Dial + rpc.Duration)),
+				Attributes: []otlpAttribute{
+					otlpString("server.address", rpc.Host),
+					otlpInt("daos.try", int64(rpc.Try)),
+					otlpInt("daos.dial_ns", rpc.Dial.Nanoseconds()),
+					otlpBool("daos.timed_out", rpc.TimedOut),
+				},
+				Status: otlpSpanStatus(rpc.Error),
+			})
+		}
+		rt.Unlock()
+	}
+
+	rs := &otlpResourceSpans{ScopeSpans: []*otlpScopeSpans{scope}}
+	rs.Resource.Attributes = []otlpAttribute{otlpString("service.name", serviceName)}
+
+	enc := json.NewEncoder(out)
+	return enc.Encode(&otlpTraces{ResourceSpans: []*otlpResourceSpans{rs}})
+}
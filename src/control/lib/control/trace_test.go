@@ -0,0 +1,244 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_TraceFromIncomingContext(t *testing.T) {
+	for name, tc := range map[string]struct {
+		md         metadata.MD
+		expTraceID string
+		expSpanID  string
+		expFound   bool
+	}{
+		"no metadata": {},
+		"no trace": {
+			md: metadata.Pairs(build.DaosComponentHeader, "dmg"),
+		},
+		"malformed trace": {
+			md: metadata.Pairs(TraceParentHeader, "00-1234-5678-01"),
+		},
+		"trace": {
+			md:         metadata.Pairs(TraceParentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"),
+			expTraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+			expSpanID:  "00f067aa0ba902b7",
+			expFound:   true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := test.Context(t)
+			if tc.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.md)
+			}
+
+			traceID, spanID, found := TraceFromIncomingContext(ctx)
+			test.AssertEqual(t, tc.expFound, found, "unexpected found")
+			test.AssertEqual(t, tc.expTraceID, traceID, "unexpected trace ID")
+			test.AssertEqual(t, tc.expSpanID, spanID, "unexpected span ID")
+		})
+	}
+}
+
+func TestControl_Client_Trace(t *testing.T) {
+	var mu sync.Mutex
+	var gotTraceID, gotSpanID string
+	var gotTraced bool
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+		if err := stream.RecvMsg(new(mgmtpb.SystemQueryReq)); err != nil {
+			return err
+		}
+		mu.Lock()
+		gotTraceID, gotSpanID, gotTraced = TraceFromIncomingContext(stream.Context())
+		mu.Unlock()
+		return stream.SendMsg(new(mgmtpb.SystemQueryResp))
+	}))
+	go srv.Serve(lis)
+	defer srv.Stop()
+	addr := lis.Addr().String()
+
+	rpcFn := func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		resp := new(mgmtpb.SystemQueryResp)
+		return resp, conn.Invoke(ctx, "/test.Svc/Trace", new(mgmtpb.SystemQueryReq), resp)
+	}
+
+	for name, tc := range map[string]struct {
+		trace bool
+	}{
+		"not traced": {},
+		"traced": {
+			trace: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := DefaultConfig()
+			cfg.TransportConfig.AllowInsecure = true
+			client := NewClient(
+				WithConfig(cfg),
+				WithClientLogger(log),
+				WithClientComponent(build.ComponentAdmin),
+			)
+
+			var tracer *Tracer
+			if tc.trace {
+				tracer = NewTracer("test")
+				client.SetTracer(tracer)
+			}
+
+			resp, err := client.InvokeUnaryRPC(test.Context(t), &testRequest{
+				HostList: []string{addr},
+				rpcFn:    rpcFn,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := resp.Responses[0].Error; err != nil {
+				t.Fatal(err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			test.AssertEqual(t, tc.trace, gotTraced, "unexpected trace context on server")
+			if !tc.trace {
+				return
+			}
+
+			tracer.Finish()
+			test.AssertEqual(t, 1, len(tracer.Requests), "unexpected number of requests")
+			rt := tracer.Requests[0]
+			test.AssertEqual(t, "testRequest", rt.Name, "unexpected request name")
+			test.AssertEqual(t, "", rt.Error, "unexpected request error")
+			test.AssertEqual(t, 1, len(rt.RPCs), "unexpected number of RPCs")
+
+			rpc := rt.RPCs[0]
+			test.AssertEqual(t, addr, rpc.Host, "unexpected RPC host")
+			test.AssertEqual(t, "", rpc.Error, "unexpected RPC error")
+			test.AssertEqual(t, tracer.TraceID, gotTraceID, "unexpected trace ID on server")
+			test.AssertEqual(t, rpc.SpanID, gotSpanID, "unexpected span ID on server")
+			test.AssertTrue(t, rpc.Dial > 0 && rpc.Duration > 0, "RPC times not recorded")
+		})
+	}
+}
+
+func TestControl_invokeUnaryRPC_Trace(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mi := NewMockInvoker(log, &MockInvokerConfig{
+		UnaryResponseSet: []*UnaryResponse{
+			MockMSResponse("host1", &system.ErrNotLeader{LeaderHint: "host2"}, nil),
+			MockMSResponse("host2", nil, defaultMessage),
+		},
+	})
+	req := &testRequest{
+		HostList: []string{"host1"},
+		toMS:     true,
+	}
+
+	tracer := NewTracer("test")
+	rt := tracer.startRequest(req)
+	_, err := invokeUnaryRPC(withRequestTrace(test.Context(t), rt), log, mi, req, nil, 0)
+	rt.finish(err)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test.AssertEqual(t, uint(1), rt.Retries, "unexpected number of retries")
+	if diff := cmp.Diff([]*RequestRedirect{
+		{Try: 0, Reason: "not leader", Hosts: []string{"host2"}},
+	}, rt.Redirects); diff != "" {
+		t.Fatalf("unexpected redirects (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestControl_Tracer_WriteOTLP(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	tracer := &Tracer{
+		TraceID:  "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanID:   "00f067aa0ba902b7",
+		Name:     "dmg system query",
+		Start:    start,
+		Duration: time.Second,
+		Requests: []*RequestTrace{
+			{
+				SpanID:   "1111111111111111",
+				Name:     "SystemQueryReq",
+				Start:    start,
+				Duration: 500 * time.Millisecond,
+				Retries:  1,
+				Error:    "failed",
+				RPCs: []*RPCTrace{
+					{
+						SpanID:   "2222222222222222",
+						Host:     "host1:10001",
+						Start:    start,
+						Dial:     time.Millisecond,
+						Duration: 2 * time.Millisecond,
+						Error:    "failed",
+					},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := tracer.WriteOTLP(&buf, "dmg"); err != nil {
+		t.Fatal(err)
+	}
+
+	var got otlpTraces
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, len(got.ResourceSpans), "unexpected number of resource spans")
+	test.AssertEqual(t, "dmg", *got.ResourceSpans[0].Resource.Attributes[0].Value.StringValue,
+		"unexpected service name")
+
+	type span struct {
+		SpanID, ParentSpanID, Name, End string
+		Status                          int
+	}
+	var gotSpans []span
+	for _, s := range got.ResourceSpans[0].ScopeSpans[0].Spans {
+		test.AssertEqual(t, tracer.TraceID, s.TraceID, "unexpected trace ID")
+		gotSpans = append(gotSpans, span{s.SpanID, s.ParentSpanID, s.Name, s.EndTimeUnixNano, s.Status.Code})
+	}
+	expSpans := []span{
+		{"00f067aa0ba902b7", "", "dmg system query", "1700000001000000000", 0},
+		{"1111111111111111", "00f067aa0ba902b7", "SystemQueryReq", "1700000000500000000", otlpStatusError},
+		{"2222222222222222", "1111111111111111", "SystemQueryReq host1:10001", "1700000000003000000", otlpStatusError},
+	}
+	if diff := cmp.Diff(expSpans, gotSpans); diff != "" {
+		t.Fatalf("unexpected spans (-want, +got):\n%s\n", diff)
+	}
+}
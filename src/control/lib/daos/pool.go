@@ -12,6 +12,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -59,6 +60,16 @@ type (
 		Objects      uint64           `json:"objects"`
 		Records      uint64           `json:"records"`
 		TotalObjects uint64           `json:"total_objects"`
+		ObjectRate   float64          `json:"object_rate,omitempty"`
+		RecordRate   float64          `json:"record_rate,omitempty"`
+		ETASeconds   uint64           `json:"eta_seconds,omitempty"`
+	}
+
+	// PoolLabelAlias is a previous label of the pool that still resolves to it
+	// until the expiry time.
+	PoolLabelAlias struct {
+		Label   string    `json:"label"`
+		Expires time.Time `json:"expires"`
 	}
 
 	// PoolInfo contains information about the pool.
@@ -83,6 +94,7 @@ type (
 		UpgradeLayoutVer uint32               `json:"upgrade_layout_ver"`
 		MemFileBytes     uint64               `json:"mem_file_bytes"`
 		MdOnSsdActive    bool                 `json:"md_on_ssd_active"`
+		LabelAliases     []*PoolLabelAlias    `json:"label_aliases,omitempty"`
 	}
 
 	PoolQueryTargetType  int32
@@ -129,6 +129,12 @@ const (
 	PoolPropertyReintMode      = C.DAOS_PROP_PO_REINT_MODE
 	PoolPropertySvcOpsEnabled  = C.DAOS_PROP_PO_SVC_OPS_ENABLED
 	PoolPropertySvcOpsEntryAge = C.DAOS_PROP_PO_SVC_OPS_ENTRY_AGE
+	// PoolPropertyRebuildThrottle is the percentage of the migration budget used by rebuild.
+	PoolPropertyRebuildThrottle = C.DAOS_PROP_PO_REBUILD_THROTTLE
+	// PoolPropertyRebuildPriority is the scheduling priority of rebuild against user I/O.
+	PoolPropertyRebuildPriority = C.DAOS_PROP_PO_REBUILD_PRIORITY
+	// PoolPropertyRebuildPause indicates whether rebuild data movement is paused.
+	PoolPropertyRebuildPause = C.DAOS_PROP_PO_REBUILD_PAUSE
 )
 
 const (
@@ -270,3 +276,19 @@ const (
 	PoolReintModeNoDataSync  = C.DAOS_REINT_MODE_NO_DATA_SYNC
 	PoolReintModeIncremental = C.DAOS_REINT_MODE_INCREMENTAL
 )
+
+const (
+	PoolRebuildThrottleMin = C.DAOS_PROP_PO_REBUILD_THROTTLE_MIN
+	PoolRebuildThrottleMax = C.DAOS_PROP_PO_REBUILD_THROTTLE_MAX
+)
+
+const (
+	PoolRebuildPriorityLow    = C.DAOS_REBUILD_PRIORITY_LOW
+	PoolRebuildPriorityNormal = C.DAOS_REBUILD_PRIORITY_NORMAL
+	PoolRebuildPriorityHigh   = C.DAOS_REBUILD_PRIORITY_HIGH
+)
+
+const (
+	PoolRebuildPauseOff = C.DAOS_REBUILD_PAUSE_OFF
+	PoolRebuildPauseOn  = C.DAOS_REBUILD_PAUSE_ON
+)
@@ -487,6 +487,53 @@ func PoolProperties() PoolPropertyMap {
 				"incremental":  PoolReintModeIncremental,
 			},
 		},
+		"rebuild_throttle": {
+			Property: PoolProperty{
+				Number:      PoolPropertyRebuildThrottle,
+				Description: "Rebuild throttle",
+				valueHandler: func(s string) (*PoolPropertyValue, error) {
+					rtErr := errors.Errorf("invalid rebuild_throttle value %s (valid values: %d-%d)",
+						s, PoolRebuildThrottleMin, PoolRebuildThrottleMax)
+					rtPct, err := strconv.ParseUint(strings.ReplaceAll(s, "%", ""), 10, 64)
+					if err != nil {
+						return nil, rtErr
+					}
+					if rtPct < PoolRebuildThrottleMin || rtPct > PoolRebuildThrottleMax {
+						return nil, errors.Wrap(rtErr, "value supplied is out of range")
+					}
+					return &PoolPropertyValue{rtPct}, nil
+				},
+				valueStringer: func(v *PoolPropertyValue) string {
+					n, err := v.GetNumber()
+					if err != nil {
+						return "not set"
+					}
+					return fmt.Sprintf("%d%%", n)
+				},
+				valueMarshaler: numericMarshaler,
+			},
+		},
+		"rebuild_priority": {
+			Property: PoolProperty{
+				Number:      PoolPropertyRebuildPriority,
+				Description: "Rebuild priority",
+			},
+			values: map[string]uint64{
+				"low":    PoolRebuildPriorityLow,
+				"normal": PoolRebuildPriorityNormal,
+				"high":   PoolRebuildPriorityHigh,
+			},
+		},
+		"rebuild_pause": {
+			Property: PoolProperty{
+				Number:      PoolPropertyRebuildPause,
+				Description: "Rebuild paused",
+			},
+			values: map[string]uint64{
+				"off": PoolRebuildPauseOff,
+				"on":  PoolRebuildPauseOn,
+			},
+		},
 	}
 }
 
@@ -259,6 +259,55 @@ func TestControl_PoolProperties(t *testing.T) {
 			value:  "bad mode",
 			expErr: errors.New(`invalid value "bad mode" for reintegration (valid: data_sync,incremental,no_data_sync)`),
 		},
+		"rebuild_throttle-valid": {
+			name:    "rebuild_throttle",
+			value:   "25%",
+			expStr:  "rebuild_throttle:25%",
+			expJson: []byte(`{"name":"rebuild_throttle","description":"Rebuild throttle","value":25}`),
+		},
+		"rebuild_throttle-valid-maxval": {
+			name:    "rebuild_throttle",
+			value:   "100",
+			expStr:  "rebuild_throttle:100%",
+			expJson: []byte(`{"name":"rebuild_throttle","description":"Rebuild throttle","value":100}`),
+		},
+		"rebuild_throttle-invalid-toolow": {
+			name:   "rebuild_throttle",
+			value:  "0",
+			expErr: errors.New("invalid rebuild_throttle value 0 (valid values: 1-100)"),
+		},
+		"rebuild_throttle-invalid-toohigh": {
+			name:   "rebuild_throttle",
+			value:  "101",
+			expErr: errors.New("invalid rebuild_throttle value 101 (valid values: 1-100)"),
+		},
+		"rebuild_throttle-invalid": {
+			name:   "rebuild_throttle",
+			value:  "fast",
+			expErr: errors.New("invalid rebuild_throttle value fast"),
+		},
+		"rebuild_priority-low": {
+			name:    "rebuild_priority",
+			value:   "low",
+			expStr:  "rebuild_priority:low",
+			expJson: []byte(`{"name":"rebuild_priority","description":"Rebuild priority","value":"low"}`),
+		},
+		"rebuild_priority-invalid": {
+			name:   "rebuild_priority",
+			value:  "urgent",
+			expErr: errors.New(`invalid value "urgent" for rebuild_priority (valid: high,low,normal)`),
+		},
+		"rebuild_pause-on": {
+			name:    "rebuild_pause",
+			value:   "on",
+			expStr:  "rebuild_pause:on",
+			expJson: []byte(`{"name":"rebuild_pause","description":"Rebuild paused","value":"on"}`),
+		},
+		"rebuild_pause-invalid": {
+			name:   "rebuild_pause",
+			value:  "maybe",
+			expErr: errors.New(`invalid value "maybe" for rebuild_pause (valid: off,on)`),
+		},
 		"svc_ops_enabled-zero-is-valid": {
 			name:    "svc_ops_enabled",
 			value:   "0",
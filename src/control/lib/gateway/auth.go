@@ -0,0 +1,99 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package gateway
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+const bearerPrefix = "Bearer "
+
+// PrincipalMetadataKey is the gRPC metadata key with which the gateway passes
+// the client that made a request to the control plane, so that the request
+// can be attributed to the client in the audit log.
+const PrincipalMetadataKey = "daos-gateway-principal"
+
+// principal identifies the client that made a request.
+type principal struct {
+	// name is the common name of the client certificate, or the subject
+	// of the bearer token.
+	name string
+	// roles are the access policy roles assigned to the client.
+	roles []string
+	// token is true if the client was authenticated by a bearer token.
+	token bool
+}
+
+func (p *principal) String() string {
+	return p.name
+}
+
+// authenticate identifies the client by its verified TLS certificate, or
+// otherwise by the bearer token in the Authorization header.
+func (g *Gateway) authenticate(r *http.Request) (*principal, error) {
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 {
+		cert := r.TLS.VerifiedChains[0][0]
+		if security.CommonNameToComponent(cert.Subject.CommonName) != security.ComponentAdmin {
+			return nil, errors.Errorf("certificate %q is not an admin certificate", cert.Subject.CommonName)
+		}
+		p := &principal{name: fmt.Sprintf("cert:%s", cert.Subject.CommonName)}
+		if g.policy != nil {
+			p.roles = g.policy.CertRoles(cert)
+		}
+		return p, nil
+	}
+
+	hdr := r.Header.Get("Authorization")
+	if hdr == "" {
+		return nil, errors.New("no client certificate or bearer token")
+	}
+	if g.verifier == nil {
+		return nil, errors.New("bearer token authentication is not enabled")
+	}
+	token, found := strings.CutPrefix(hdr, bearerPrefix)
+	if !found {
+		return nil, errors.New("unsupported authorization scheme")
+	}
+
+	claims, err := g.verifier.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, err
+	}
+	p := &principal{name: fmt.Sprintf("token:%s", claims.Subject), token: true}
+	if g.policy != nil {
+		groups := claims.Groups
+		if claims.Group != "" {
+			groups = append([]string{claims.Group}, groups...)
+		}
+		p.roles = g.policy.NamedRoles(groups)
+	}
+	return p, nil
+}
+
+// authorize checks that the client may call the route's method. Without an
+// access policy, clients authenticated by an admin certificate may call any
+// method that is exposed, and clients authenticated by a bearer token may only
+// call methods that do not modify the system.
+func (g *Gateway) authorize(p *principal, rt *route) error {
+	switch {
+	case g.policy != nil:
+		if g.policy.Allows(p.roles, rt.method) {
+			return nil
+		}
+	case !p.token || rt.readOnly:
+		return nil
+	}
+
+	g.log.Noticef("gateway: %s (roles: %s) denied access to %s", p, strings.Join(p.roles, ","), rt.method)
+	return errors.Errorf("%s is not permitted to call %s", p, rt.method)
+}
@@ -0,0 +1,73 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package gateway
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+// DefaultAddress is the address that the gateway listens on if none is
+// configured.
+const DefaultAddress = "0.0.0.0:8443"
+
+// Config defines the configuration of the REST gateway.
+type Config struct {
+	// Address is the host:port that the gateway listens on.
+	Address string `yaml:"address,omitempty"`
+	// Cert and Key are the paths of the TLS certificate and key that the
+	// gateway presents to HTTPS clients.
+	Cert string `yaml:"cert"`
+	Key  string `yaml:"key"`
+	// ClientCACert is the path of the CA certificate used to verify client
+	// certificates. If unset, client certificates are not requested.
+	ClientCACert string `yaml:"client_ca_cert,omitempty"`
+	// TokenAuth enables authentication of clients with bearer tokens.
+	TokenAuth *security.TokenAuthConfig `yaml:"token_auth,omitempty"`
+	// AccessPolicy is the path of an access policy that restricts the
+	// methods that clients may call according to their roles.
+	AccessPolicy string `yaml:"access_policy,omitempty"`
+	// AdminCert and AdminKey are the paths of the admin certificate and
+	// key used by the gateway to call the control plane. If unset, the
+	// default admin certificate and key are used.
+	AdminCert string `yaml:"admin_cert,omitempty"`
+	AdminKey  string `yaml:"admin_key,omitempty"`
+}
+
+// Validate checks the gateway configuration.
+func (cfg *Config) Validate() error {
+	if cfg == nil {
+		return nil
+	}
+
+	if cfg.Address != "" {
+		if _, _, err := net.SplitHostPort(cfg.Address); err != nil {
+			return errors.Wrapf(err, "gateway: invalid address %q", cfg.Address)
+		}
+	}
+	if cfg.Cert == "" || cfg.Key == "" {
+		return errors.New("gateway: cert and key are required")
+	}
+	if cfg.ClientCACert == "" && cfg.TokenAuth == nil {
+		return errors.New("gateway: client_ca_cert or token_auth is required to authenticate clients")
+	}
+	if (cfg.AdminCert == "") != (cfg.AdminKey == "") {
+		return errors.New("gateway: admin_cert and admin_key must be set together")
+	}
+
+	return errors.Wrap(cfg.TokenAuth.Validate(), "gateway")
+}
+
+func (cfg *Config) address() string {
+	if cfg.Address == "" {
+		return DefaultAddress
+	}
+	return cfg.Address
+}
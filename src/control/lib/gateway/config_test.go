@@ -0,0 +1,61 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package gateway
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestGateway_Config_Validate(t *testing.T) {
+	tokenAuth := &security.TokenAuthConfig{
+		Issuers: []*security.TokenIssuerConfig{
+			{Issuer: "idp", PublicKey: "/etc/daos/idp.pem"},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		cfg    *Config
+		expErr error
+	}{
+		"nil": {},
+		"bad address": {
+			cfg:    &Config{Address: "8443", Cert: "gw.crt", Key: "gw.key", ClientCACert: "ca.crt"},
+			expErr: errors.New("invalid address"),
+		},
+		"no cert": {
+			cfg:    &Config{Key: "gw.key", ClientCACert: "ca.crt"},
+			expErr: errors.New("cert and key are required"),
+		},
+		"no client authentication": {
+			cfg:    &Config{Cert: "gw.crt", Key: "gw.key"},
+			expErr: errors.New("client_ca_cert or token_auth"),
+		},
+		"admin cert without key": {
+			cfg:    &Config{Cert: "gw.crt", Key: "gw.key", ClientCACert: "ca.crt", AdminCert: "admin.crt"},
+			expErr: errors.New("set together"),
+		},
+		"invalid token auth": {
+			cfg:    &Config{Cert: "gw.crt", Key: "gw.key", TokenAuth: &security.TokenAuthConfig{}},
+			expErr: errors.New("at least one issuer"),
+		},
+		"client certificates": {
+			cfg: &Config{Address: ":8443", Cert: "gw.crt", Key: "gw.key", ClientCACert: "ca.crt"},
+		},
+		"bearer tokens": {
+			cfg: &Config{Cert: "gw.crt", Key: "gw.key", TokenAuth: tokenAuth},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
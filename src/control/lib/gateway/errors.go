@@ -0,0 +1,96 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package gateway
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/fault"
+)
+
+type (
+	httpStatusError struct {
+		error
+		status int
+	}
+
+	// errorBody is the JSON body of an error response.
+	errorBody struct {
+		Status     int    `json:"status"`
+		Error      string `json:"error"`
+		Code       int    `json:"code,omitempty"`
+		Resolution string `json:"resolution,omitempty"`
+	}
+)
+
+func (e *httpStatusError) Cause() error {
+	return e.error
+}
+
+func (e *httpStatusError) Unwrap() error {
+	return e.error
+}
+
+// WithHTTPStatus annotates the error with the HTTP status of the response that
+// reports it, e.g. http.StatusNotFound for errors that indicate that the
+// requested resource does not exist.
+func WithHTTPStatus(err error, status int) error {
+	if err == nil {
+		return nil
+	}
+	return &httpStatusError{error: err, status: status}
+}
+
+func badRequest(err error) error {
+	return WithHTTPStatus(err, http.StatusBadRequest)
+}
+
+// grpcHTTPStatus maps gRPC status codes to HTTP status codes.
+var grpcHTTPStatus = map[codes.Code]int{
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+}
+
+// httpStatus returns the HTTP status of the response for the error.
+func httpStatus(err error) int {
+	var hse *httpStatusError
+	if errors.As(err, &hse) {
+		return hse.status
+	}
+	if st, ok := status.FromError(errors.Cause(err)); ok {
+		if hs, found := grpcHTTPStatus[st.Code()]; found {
+			return hs
+		}
+	}
+	return http.StatusInternalServerError
+}
+
+func newErrorBody(err error) *errorBody {
+	body := &errorBody{
+		Status: httpStatus(err),
+		Error:  err.Error(),
+	}
+	if f, ok := errors.Cause(err).(*fault.Fault); ok && f != nil {
+		body.Code = int(f.Code)
+		body.Resolution = f.Resolution
+	}
+	return body
+}
@@ -0,0 +1,382 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+// Package gateway provides an HTTPS REST gateway to the management and control
+// gRPC services.
+//
+// Each unary method that may be called with an admin certificate is exposed as
+// POST /v1/<service>/<method>, which takes the request message as a JSON body
+// and returns the response message as JSON, using the proto field names.
+// Methods that do not modify the system may also be called with GET, with the
+// top-level fields of the request given as query parameters. Control service
+// methods are called on each of the hosts in the "hosts" query parameter, and
+// return the response of each host.
+//
+// Clients are authenticated by a TLS client certificate or a bearer token, and
+// the methods they may call are restricted by an optional access policy. The
+// client is passed to the control plane in the request's gRPC metadata for the
+// audit log. An OpenAPI document describing the endpoints is served at
+// /v1/openapi.json.
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/security/auth"
+)
+
+const (
+	openAPIPath       = apiPrefix + "openapi.json"
+	maxRequestSize    = 4 << 20
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+var marshalOpts = protojson.MarshalOptions{
+	UseProtoNames:   true,
+	EmitUnpopulated: true,
+}
+
+type (
+	// HostResponse is the response of a single host to a control service
+	// method.
+	HostResponse struct {
+		Addr    string
+		Error   error
+		Message proto.Message
+	}
+
+	// Result contains the response of the management service to a
+	// management service method, or of each host to a control service
+	// method.
+	Result struct {
+		Message       proto.Message
+		HostResponses []*HostResponse
+	}
+
+	// Backend calls gRPC methods on behalf of the gateway.
+	Backend interface {
+		// Invoke calls the method with the request and returns the
+		// result, with responses of the same type as resp. Control
+		// service methods are called on the hosts, or on all hosts if
+		// none are given.
+		Invoke(ctx context.Context, method string, req, resp proto.Message, hosts []string) (*Result, error)
+	}
+
+	// Gateway serves the REST API.
+	Gateway struct {
+		log      logging.Logger
+		cfg      *Config
+		backend  Backend
+		verifier *auth.TokenVerifier
+		policy   *security.AccessPolicy
+		routes   map[string]*route
+		openAPI  []byte
+	}
+
+	hostResponseBody struct {
+		Addr     string          `json:"addr"`
+		Error    string          `json:"error,omitempty"`
+		Response json.RawMessage `json:"response,omitempty"`
+	}
+
+	hostResponsesBody struct {
+		Responses []*hostResponseBody `json:"responses"`
+	}
+)
+
+// New creates a gateway with the configuration that calls methods using the
+// backend.
+func New(log logging.Logger, cfg *Config, backend Backend) (*Gateway, error) {
+	if cfg == nil {
+		return nil, errors.New("nil gateway config")
+	}
+	if backend == nil {
+		return nil, errors.New("nil gateway backend")
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	gw := &Gateway{
+		log:     log,
+		cfg:     cfg,
+		backend: backend,
+		routes:  make(map[string]*route),
+	}
+
+	verifier, err := auth.NewTokenVerifier(cfg.TokenAuth)
+	if err != nil {
+		return nil, errors.Wrap(err, "gateway")
+	}
+	gw.verifier = verifier
+
+	if cfg.AccessPolicy != "" {
+		policy, err := security.LoadAccessPolicy(cfg.AccessPolicy)
+		if err != nil {
+			return nil, errors.Wrap(err, "gateway")
+		}
+		gw.policy = policy
+	}
+
+	routes, err := buildRoutes()
+	if err != nil {
+		return nil, err
+	}
+	for _, rt := range routes {
+		gw.routes[rt.path] = rt
+	}
+	gw.openAPI, err = json.MarshalIndent(buildOpenAPI(routes), "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "generating OpenAPI document")
+	}
+
+	return gw, nil
+}
+
+// TLSConfig returns the TLS configuration of the gateway's HTTPS server.
+func (g *Gateway) TLSConfig() (*tls.Config, error) {
+	certPEM, err := security.LoadPEMData(g.cfg.Cert, security.MaxCertPerm)
+	if err != nil {
+		return nil, errors.Wrap(err, "gateway cert")
+	}
+	keyPEM, err := security.LoadPEMData(g.cfg.Key, security.MaxUserOnlyKeyPerm)
+	if err != nil {
+		return nil, errors.Wrap(err, "gateway key")
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "gateway cert")
+	}
+
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if g.cfg.ClientCACert != "" {
+		caPEM, err := security.LoadPEMData(g.cfg.ClientCACert, security.MaxCertPerm)
+		if err != nil {
+			return nil, errors.Wrap(err, "gateway client CA cert")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.Errorf("gateway client CA cert %s contains no certificates", g.cfg.ClientCACert)
+		}
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return tlsCfg, nil
+}
+
+// Start starts serving HTTPS requests on the configured address in the
+// background. The server is shut down when the context is canceled.
+func (g *Gateway) Start(ctx context.Context) error {
+	tlsCfg, err := g.TLSConfig()
+	if err != nil {
+		return err
+	}
+
+	lis, err := net.Listen("tcp", g.cfg.address())
+	if err != nil {
+		return errors.Wrap(err, "gateway listen")
+	}
+
+	srv := &http.Server{
+		Handler:           g,
+		TLSConfig:         tlsCfg,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		g.log.Infof("REST gateway listening on https://%s", lis.Addr())
+		if err := srv.ServeTLS(lis, "", ""); err != nil && err != http.ErrServerClosed {
+			g.log.Errorf("REST gateway stopped: %s", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		// The original context has already been canceled.
+		timedCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(timedCtx); err != nil {
+			g.log.Noticef("REST gateway didn't shut down within timeout: %s", err)
+		}
+	}()
+
+	return nil
+}
+
+// ServeHTTP implements http.Handler.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == openAPIPath {
+		if r.Method != http.MethodGet {
+			g.writeMethodNotAllowed(w, http.MethodGet)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(g.openAPI); err != nil {
+			g.log.Errorf("gateway: writing OpenAPI document: %s", err)
+		}
+		return
+	}
+
+	rt, found := g.routes[r.URL.Path]
+	if !found {
+		g.writeError(w, WithHTTPStatus(errors.Errorf("unknown endpoint %s", r.URL.Path), http.StatusNotFound))
+		return
+	}
+	switch {
+	case r.Method == http.MethodPost:
+	case r.Method == http.MethodGet && rt.readOnly:
+	default:
+		if rt.readOnly {
+			g.writeMethodNotAllowed(w, http.MethodGet+", "+http.MethodPost)
+		} else {
+			g.writeMethodNotAllowed(w, http.MethodPost)
+		}
+		return
+	}
+
+	p, err := g.authenticate(r)
+	if err != nil {
+		g.log.Debugf("gateway: %s %s from %s: %s", r.Method, r.URL.Path, r.RemoteAddr, err)
+		if g.verifier != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="daos"`)
+		}
+		g.writeError(w, WithHTTPStatus(err, http.StatusUnauthorized))
+		return
+	}
+	if err := g.authorize(p, rt); err != nil {
+		g.writeError(w, WithHTTPStatus(err, http.StatusForbidden))
+		return
+	}
+	g.log.Debugf("gateway: %s %s from %s (%s)", r.Method, r.URL.Path, r.RemoteAddr, p)
+
+	ctx := metadata.AppendToOutgoingContext(r.Context(), PrincipalMetadataKey, p.name)
+	if err := g.serveRoute(w, r.WithContext(ctx), rt); err != nil {
+		g.writeError(w, err)
+	}
+}
+
+func (g *Gateway) serveRoute(w http.ResponseWriter, r *http.Request, rt *route) error {
+	req, err := rt.newRequest()
+	if err != nil {
+		return err
+	}
+	resp, err := rt.newResponse()
+	if err != nil {
+		return err
+	}
+
+	query := r.URL.Query()
+	if r.Method == http.MethodGet {
+		if err := parseQuery(query, req); err != nil {
+			return badRequest(err)
+		}
+	} else {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+		if err != nil {
+			return badRequest(errors.Wrap(err, "reading request body"))
+		}
+		if len(body) > 0 {
+			if err := protojson.Unmarshal(body, req); err != nil {
+				return badRequest(errors.Wrap(err, "invalid request body"))
+			}
+		}
+	}
+
+	hosts, err := parseHosts(query)
+	if err != nil {
+		return badRequest(err)
+	}
+	if len(hosts) > 0 && !rt.perHost {
+		return badRequest(errors.Errorf("%s is only supported for control service methods", hostsParam))
+	}
+
+	result, err := g.backend.Invoke(r.Context(), rt.method, req, resp, hosts)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return errors.Errorf("%s: no result", rt.method)
+	}
+
+	var data []byte
+	if rt.perHost {
+		data, err = marshalHostResponses(result.HostResponses)
+	} else if result.Message != nil {
+		data, err = marshalOpts.Marshal(result.Message)
+	} else {
+		return errors.Errorf("%s: no response message", rt.method)
+	}
+	if err != nil {
+		return errors.Wrap(err, "encoding response")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		g.log.Errorf("gateway: writing %s response: %s", rt.method, err)
+	}
+	return nil
+}
+
+func marshalHostResponses(hrs []*HostResponse) ([]byte, error) {
+	body := &hostResponsesBody{Responses: []*hostResponseBody{}}
+	for _, hr := range hrs {
+		hrb := &hostResponseBody{Addr: hr.Addr}
+		if hr.Error != nil {
+			hrb.Error = hr.Error.Error()
+		}
+		if hr.Message != nil {
+			data, err := marshalOpts.Marshal(hr.Message)
+			if err != nil {
+				return nil, err
+			}
+			hrb.Response = data
+		}
+		body.Responses = append(body.Responses, hrb)
+	}
+	return json.Marshal(body)
+}
+
+func (g *Gateway) writeMethodNotAllowed(w http.ResponseWriter, allowed string) {
+	w.Header().Set("Allow", allowed)
+	g.writeError(w, WithHTTPStatus(errors.Errorf("method must be %s", strings.ReplaceAll(allowed, ", ", " or ")),
+		http.StatusMethodNotAllowed))
+}
+
+func (g *Gateway) writeError(w http.ResponseWriter, err error) {
+	body := newErrorBody(err)
+	data, mErr := json.Marshal(body)
+	if mErr != nil {
+		g.log.Errorf("gateway: encoding error response: %s", mErr)
+		http.Error(w, err.Error(), body.Status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(body.Status)
+	if _, err := w.Write(data); err != nil {
+		g.log.Errorf("gateway: writing error response: %s", err)
+	}
+}
@@ -0,0 +1,562 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package gateway
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/security/auth"
+)
+
+const accessPolicyExample = "../../../../utils/config/examples/daos_access_policy.yml"
+
+type (
+	testPKI struct {
+		dir     string
+		caCert  *x509.Certificate
+		caKey   crypto.Signer
+		caPath  string
+		tlsCert string
+		tlsKey  string
+		issuer  crypto.Signer
+		pubKey  string
+	}
+
+	mockBackend struct {
+		method    string
+		req       proto.Message
+		hosts     []string
+		principal []string
+		result    *Result
+		err       error
+	}
+)
+
+func (mb *mockBackend) Invoke(ctx context.Context, method string, req, resp proto.Message, hosts []string) (*Result, error) {
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		mb.principal = md.Get(PrincipalMetadataKey)
+	}
+	mb.method = method
+	mb.req = req
+	mb.hosts = hosts
+	if mb.result != nil && mb.result.Message != nil &&
+		mb.result.Message.ProtoReflect().Descriptor() != resp.ProtoReflect().Descriptor() {
+		return nil, errors.Errorf("unexpected response type %T", resp)
+	}
+	return mb.result, mb.err
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte, perm os.FileMode) {
+	t.Helper()
+
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(path, data, perm); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+
+	dir, cleanup := test.CreateTestDir(t)
+	t.Cleanup(cleanup)
+
+	pki := &testPKI{
+		dir:    dir,
+		caKey:  newKey(t),
+		caPath: filepath.Join(dir, "ca.crt"),
+		issuer: newKey(t),
+		pubKey: filepath.Join(dir, "issuer.pem"),
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, pki.caKey.Public(), pki.caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pki.caCert, err = x509.ParseCertificate(der); err != nil {
+		t.Fatal(err)
+	}
+	writePEM(t, pki.caPath, "CERTIFICATE", der, 0644)
+
+	tlsCert, tlsKey := pki.issue(t, "gateway", nil)
+	pki.tlsCert = filepath.Join(dir, "gateway.crt")
+	pki.tlsKey = filepath.Join(dir, "gateway.key")
+	writePEM(t, pki.tlsCert, "CERTIFICATE", tlsCert.Certificate[0], 0644)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(tlsKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePEM(t, pki.tlsKey, "PRIVATE KEY", keyDER, 0400)
+
+	pubDER, err := x509.MarshalPKIXPublicKey(pki.issuer.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePEM(t, pki.pubKey, "PUBLIC KEY", pubDER, 0644)
+
+	return pki
+}
+
+// issue creates a certificate with the common name and organizational units,
+// signed by the test CA.
+func (pki *testPKI) issue(t *testing.T, cn string, ous []string) (tls.Certificate, crypto.Signer) {
+	t.Helper()
+
+	key := newKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn, OrganizationalUnit: ous},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, pki.caCert, key.Public(), pki.caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, key
+}
+
+func (pki *testPKI) token(t *testing.T, group string, groups ...string) string {
+	t.Helper()
+
+	token, err := auth.NewBearerToken(pki.issuer, &auth.BearerClaims{
+		Issuer:    "idp",
+		Subject:   "alice",
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		Group:     group,
+		Groups:    groups,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func (pki *testPKI) config() *Config {
+	return &Config{
+		Cert:         pki.tlsCert,
+		Key:          pki.tlsKey,
+		ClientCACert: pki.caPath,
+		TokenAuth: &security.TokenAuthConfig{
+			Issuers: []*security.TokenIssuerConfig{
+				{Issuer: "idp", PublicKey: pki.pubKey},
+			},
+		},
+	}
+}
+
+func startTestGateway(t *testing.T, log logging.Logger, cfg *Config, backend Backend) *httptest.Server {
+	t.Helper()
+
+	gw, err := New(log, cfg, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlsCfg, err := gw.TLSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewUnstartedServer(gw)
+	srv.TLS = tlsCfg
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestGateway_New(t *testing.T) {
+	pki := newTestPKI(t)
+
+	for name, tc := range map[string]struct {
+		cfg    *Config
+		expErr error
+	}{
+		"nil config": {
+			expErr: errors.New("nil gateway config"),
+		},
+		"no authentication": {
+			cfg:    &Config{Cert: pki.tlsCert, Key: pki.tlsKey},
+			expErr: errors.New("client_ca_cert or token_auth"),
+		},
+		"bad issuer key": {
+			cfg: &Config{
+				Cert: pki.tlsCert,
+				Key:  pki.tlsKey,
+				TokenAuth: &security.TokenAuthConfig{
+					Issuers: []*security.TokenIssuerConfig{
+						{Issuer: "idp", PublicKey: filepath.Join(pki.dir, "missing.pem")},
+					},
+				},
+			},
+			expErr: errors.New("no such file"),
+		},
+		"bad access policy": {
+			cfg: &Config{
+				Cert:         pki.tlsCert,
+				Key:          pki.tlsKey,
+				ClientCACert: pki.caPath,
+				AccessPolicy: filepath.Join(pki.dir, "missing.yml"),
+			},
+			expErr: errors.New("access policy"),
+		},
+		"success": {
+			cfg: pki.config(),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			_, err := New(log, tc.cfg, &mockBackend{})
+			test.CmpErr(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestGateway_ServeHTTP(t *testing.T) {
+	pki := newTestPKI(t)
+	adminCert, _ := pki.issue(t, "admin", nil)
+	readOnlyCert, _ := pki.issue(t, "admin", []string{"read-only"})
+	agentCert, _ := pki.issue(t, "agent", nil)
+
+	poolsResp := &mgmtpb.ListPoolsResp{
+		Pools: []*mgmtpb.ListPoolsResp_Pool{
+			{Uuid: test.MockUUID(1), Label: "tank", SvcReps: []uint32{0}},
+		},
+	}
+	scanResp := &ctlpb.NetworkScanResp{Numacount: 2}
+
+	for name, tc := range map[string]struct {
+		policy       bool
+		method       string
+		path         string
+		body         string
+		cert         *tls.Certificate
+		token        string
+		result       *Result
+		err          error
+		expStatus    int
+		expBody      string
+		expMethod    string
+		expReq       proto.Message
+		expHosts     []string
+		expPrincipal []string
+	}{
+		"OpenAPI document without credentials": {
+			method:    http.MethodGet,
+			path:      openAPIPath,
+			expStatus: http.StatusOK,
+		},
+		"unknown endpoint": {
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/Join",
+			cert:      &adminCert,
+			expStatus: http.StatusNotFound,
+		},
+		"no credentials": {
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/ListPools",
+			expStatus: http.StatusUnauthorized,
+		},
+		"non-admin certificate": {
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/ListPools",
+			cert:      &agentCert,
+			expStatus: http.StatusUnauthorized,
+		},
+		"invalid token": {
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/ListPools",
+			token:     "abc.def.ghi",
+			expStatus: http.StatusUnauthorized,
+		},
+		"admin certificate": {
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/ListPools",
+			body:      `{"sys": "daos_server"}`,
+			cert:      &adminCert,
+			result:    &Result{Message: poolsResp},
+			expStatus: http.StatusOK,
+			expBody: `{"status":0, "pools":[{"uuid":"` + test.MockUUID(1) + `", "label":"tank",
+				"svc_reps":[0], "state":"", "rebuild_state":"", "label_aliases":[]}],
+				"data_version":"0"}`,
+			expMethod:    mgmtpb.MgmtSvc_ListPools_FullMethodName,
+			expReq:       &mgmtpb.ListPoolsReq{Sys: "daos_server"},
+			expPrincipal: []string{"cert:admin"},
+		},
+		"bearer token": {
+			method:       http.MethodPost,
+			path:         "/v1/mgmt.MgmtSvc/ListPools",
+			token:        pki.token(t, "staff"),
+			result:       &Result{Message: &mgmtpb.ListPoolsResp{}},
+			expStatus:    http.StatusOK,
+			expMethod:    mgmtpb.MgmtSvc_ListPools_FullMethodName,
+			expReq:       &mgmtpb.ListPoolsReq{},
+			expPrincipal: []string{"token:alice"},
+		},
+		"bearer token without policy denied mutating method": {
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/PoolDestroy",
+			body:      `{"id": "tank", "recursive": true}`,
+			token:     pki.token(t, "staff"),
+			expStatus: http.StatusForbidden,
+		},
+		"GET with query": {
+			method:    http.MethodGet,
+			path:      "/v1/mgmt.MgmtSvc/PoolQuery?id=tank&queryMask=5&svc_ranks=1&svc_ranks=2",
+			cert:      &adminCert,
+			result:    &Result{Message: &mgmtpb.PoolQueryResp{}},
+			expStatus: http.StatusOK,
+			expMethod: mgmtpb.MgmtSvc_PoolQuery_FullMethodName,
+			expReq:    &mgmtpb.PoolQueryReq{Id: "tank", QueryMask: 5, SvcRanks: []uint32{1, 2}},
+		},
+		"GET with unknown query parameter": {
+			method:    http.MethodGet,
+			path:      "/v1/mgmt.MgmtSvc/PoolQuery?pool=tank",
+			cert:      &adminCert,
+			expStatus: http.StatusBadRequest,
+		},
+		"GET not allowed": {
+			method:    http.MethodGet,
+			path:      "/v1/mgmt.MgmtSvc/PoolDestroy?id=tank",
+			cert:      &adminCert,
+			expStatus: http.StatusMethodNotAllowed,
+		},
+		"invalid body": {
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/PoolDestroy",
+			body:      `{"pool": "tank"}`,
+			cert:      &adminCert,
+			expStatus: http.StatusBadRequest,
+		},
+		"hosts on management service method": {
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/ListPools?hosts=host1",
+			cert:      &adminCert,
+			expStatus: http.StatusBadRequest,
+		},
+		"control service method": {
+			method: http.MethodGet,
+			path:   "/v1/ctl.CtlSvc/NetworkScan?hosts=host[1-2]",
+			token:  pki.token(t, "staff"),
+			result: &Result{
+				HostResponses: []*HostResponse{
+					{Addr: "host1:10001", Message: scanResp},
+					{Addr: "host2:10001", Error: errors.New("failed")},
+				},
+			},
+			expStatus: http.StatusOK,
+			expBody: `{"responses":[
+				{"addr":"host1:10001", "response":{"interfaces":[], "numacount":2, "corespernuma":0}},
+				{"addr":"host2:10001", "error":"failed"}]}`,
+			expMethod: ctlpb.CtlSvc_NetworkScan_FullMethodName,
+			expReq:    &ctlpb.NetworkScanReq{},
+			expHosts:  []string{"host1", "host2"},
+		},
+		"backend error with HTTP status": {
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/PoolQuery",
+			cert:      &adminCert,
+			err:       WithHTTPStatus(errors.New("pool not found"), http.StatusNotFound),
+			expMethod: mgmtpb.MgmtSvc_PoolQuery_FullMethodName,
+			expStatus: http.StatusNotFound,
+			expBody:   `{"status":404, "error":"pool not found"}`,
+		},
+		"backend gRPC error": {
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/PoolQuery",
+			cert:      &adminCert,
+			err:       errors.Wrap(status.Error(codes.Unavailable, "down"), "query"),
+			expMethod: mgmtpb.MgmtSvc_PoolQuery_FullMethodName,
+			expStatus: http.StatusServiceUnavailable,
+		},
+		"backend fault": {
+			method: http.MethodPost,
+			path:   "/v1/mgmt.MgmtSvc/PoolQuery",
+			cert:   &adminCert,
+			err: &fault.Fault{
+				Domain:      "test",
+				Code:        code.Unknown,
+				Description: "failed",
+				Resolution:  "retry",
+			},
+			expStatus: http.StatusInternalServerError,
+			expMethod: mgmtpb.MgmtSvc_PoolQuery_FullMethodName,
+			expBody: `{"status":500, "error":"test: code = 0 description = \"failed\"",
+				"resolution":"retry"}`,
+		},
+		"policy allows certificate role": {
+			policy:    true,
+			method:    http.MethodGet,
+			path:      "/v1/mgmt.MgmtSvc/ListPools",
+			cert:      &readOnlyCert,
+			result:    &Result{Message: &mgmtpb.ListPoolsResp{}},
+			expStatus: http.StatusOK,
+			expMethod: mgmtpb.MgmtSvc_ListPools_FullMethodName,
+			expReq:    &mgmtpb.ListPoolsReq{},
+		},
+		"policy denies certificate role": {
+			policy:    true,
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/PoolDestroy",
+			cert:      &readOnlyCert,
+			expStatus: http.StatusForbidden,
+		},
+		"policy denies certificate without role": {
+			policy:    true,
+			method:    http.MethodGet,
+			path:      "/v1/mgmt.MgmtSvc/ListPools",
+			cert:      &adminCert,
+			expStatus: http.StatusForbidden,
+		},
+		"policy allows token group": {
+			policy:    true,
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/PoolDestroy",
+			token:     pki.token(t, "staff", "pool-operator"),
+			result:    &Result{Message: &mgmtpb.PoolDestroyResp{}},
+			expStatus: http.StatusOK,
+			expMethod: mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+			expReq:    &mgmtpb.PoolDestroyReq{},
+		},
+		"policy denies token group": {
+			policy:    true,
+			method:    http.MethodPost,
+			path:      "/v1/mgmt.MgmtSvc/SystemErase",
+			token:     pki.token(t, "pool-operator"),
+			expStatus: http.StatusForbidden,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := pki.config()
+			if tc.policy {
+				cfg.AccessPolicy = accessPolicyExample
+			}
+			backend := &mockBackend{result: tc.result, err: tc.err}
+			srv := startTestGateway(t, log, cfg, backend)
+
+			roots := x509.NewCertPool()
+			roots.AddCert(pki.caCert)
+			tlsCfg := &tls.Config{RootCAs: roots}
+			if tc.cert != nil {
+				tlsCfg.Certificates = []tls.Certificate{*tc.cert}
+			}
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsCfg}}
+
+			req, err := http.NewRequestWithContext(test.Context(t), tc.method, srv.URL+tc.path,
+				strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			test.AssertEqual(t, tc.expStatus, resp.StatusCode, string(body))
+			test.AssertEqual(t, "application/json", resp.Header.Get("Content-Type"), "")
+
+			var gotBody map[string]interface{}
+			if err := json.Unmarshal(body, &gotBody); err != nil {
+				t.Fatalf("invalid JSON response %q: %s", body, err)
+			}
+			if tc.expBody != "" {
+				var expBody map[string]interface{}
+				if err := json.Unmarshal([]byte(tc.expBody), &expBody); err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(expBody, gotBody); diff != "" {
+					t.Fatalf("unexpected body (-want, +got):\n%s\n", diff)
+				}
+			}
+
+			test.AssertEqual(t, tc.expMethod, backend.method, "unexpected method")
+			if tc.expReq != nil {
+				if diff := cmp.Diff(tc.expReq, backend.req, protocmp.Transform()); diff != "" {
+					t.Fatalf("unexpected request (-want, +got):\n%s\n", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.expHosts, backend.hosts); diff != "" {
+				t.Fatalf("unexpected hosts (-want, +got):\n%s\n", diff)
+			}
+			if tc.expPrincipal != nil {
+				if diff := cmp.Diff(tc.expPrincipal, backend.principal); diff != "" {
+					t.Fatalf("unexpected principal (-want, +got):\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
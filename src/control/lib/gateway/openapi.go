@@ -0,0 +1,254 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package gateway
+
+import (
+	"net/http"
+	"strconv"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/daos-stack/daos/src/control/build"
+)
+
+// openAPIVersion is the version of the OpenAPI specification that the
+// generated document conforms to.
+const openAPIVersion = "3.1.0"
+
+const (
+	errorSchema     = "Error"
+	schemaRefPrefix = "#/components/schemas/"
+)
+
+type (
+	object map[string]interface{}
+
+	// openAPIBuilder generates an OpenAPI document from the descriptors
+	// of the gRPC methods exposed by the gateway.
+	openAPIBuilder struct {
+		schemas object
+	}
+)
+
+func schemaRef(name string) object {
+	return object{"$ref": schemaRefPrefix + name}
+}
+
+// buildOpenAPI generates an OpenAPI document describing the routes.
+func buildOpenAPI(routes []*route) object {
+	b := &openAPIBuilder{
+		schemas: object{
+			errorSchema: object{
+				"type": "object",
+				"properties": object{
+					"status":     object{"type": "integer", "description": "HTTP status"},
+					"error":      object{"type": "string"},
+					"code":       object{"type": "integer", "description": "DAOS fault code"},
+					"resolution": object{"type": "string"},
+				},
+				"required": []string{"status", "error"},
+			},
+		},
+	}
+
+	paths := object{}
+	for _, rt := range routes {
+		paths[rt.path] = b.pathItem(rt)
+	}
+
+	return object{
+		"openapi": openAPIVersion,
+		"info": object{
+			"title":       "DAOS Control Plane REST API",
+			"description": "Management (mgmt.MgmtSvc) and control (ctl.CtlSvc) service methods.",
+			"version":     build.DaosVersion,
+		},
+		"paths": paths,
+		"components": object{
+			"schemas": b.schemas,
+			"securitySchemes": object{
+				"bearerAuth": object{
+					"type":         "http",
+					"scheme":       "bearer",
+					"bearerFormat": "JWT",
+				},
+				"clientCert": object{
+					"type": "mutualTLS",
+				},
+			},
+		},
+		"security": []object{
+			{"bearerAuth": []string{}},
+			{"clientCert": []string{}},
+		},
+	}
+}
+
+func (b *openAPIBuilder) pathItem(rt *route) object {
+	svc := string(rt.service.FullName())
+	respSchema := b.message(rt.desc.Output())
+	if rt.perHost {
+		respSchema = object{
+			"type": "object",
+			"properties": object{
+				"responses": object{
+					"type": "array",
+					"items": object{
+						"type": "object",
+						"properties": object{
+							"addr":     object{"type": "string"},
+							"error":    object{"type": "string"},
+							"response": respSchema,
+						},
+						"required": []string{"addr"},
+					},
+				},
+			},
+			"required": []string{"responses"},
+		}
+	}
+
+	responses := object{
+		strconv.Itoa(http.StatusOK): object{
+			"description": "Success",
+			"content": object{
+				"application/json": object{"schema": respSchema},
+			},
+		},
+		"default": object{
+			"description": "Error",
+			"content": object{
+				"application/json": object{"schema": schemaRef(errorSchema)},
+			},
+		},
+	}
+
+	var hostParams []object
+	if rt.perHost {
+		hostParams = append(hostParams, object{
+			"name":        hostsParam,
+			"in":          "query",
+			"description": "Hostlist of the hosts to call the method on, e.g. \"host[1-4]\". Defaults to all hosts.",
+			"schema":      object{"type": "string"},
+		})
+	}
+
+	post := object{
+		"operationId": svc + "." + string(rt.desc.Name()),
+		"tags":        []string{svc},
+		"requestBody": object{
+			"content": object{
+				"application/json": object{"schema": b.message(rt.desc.Input())},
+			},
+		},
+		"responses": responses,
+	}
+	if len(hostParams) > 0 {
+		post["parameters"] = hostParams
+	}
+	item := object{"post": post}
+
+	if rt.readOnly {
+		params := hostParams
+		for _, fd := range queryFields(rt.desc.Input()) {
+			params = append(params, object{
+				"name":   string(fd.Name()),
+				"in":     "query",
+				"schema": b.field(fd),
+			})
+		}
+		get := object{
+			"operationId": svc + "." + string(rt.desc.Name()) + "_get",
+			"tags":        []string{svc},
+			"responses":   responses,
+		}
+		if len(params) > 0 {
+			get["parameters"] = params
+		}
+		item["get"] = get
+	}
+
+	return item
+}
+
+// message returns a reference to the schema of the message, adding the schema
+// and those of the messages it refers to if not already added.
+func (b *openAPIBuilder) message(md protoreflect.MessageDescriptor) object {
+	name := string(md.FullName())
+	if _, found := b.schemas[name]; found {
+		return schemaRef(name)
+	}
+
+	props := object{}
+	schema := object{
+		"type":       "object",
+		"properties": props,
+	}
+	// Add the schema before its fields to terminate recursion.
+	b.schemas[name] = schema
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		props[string(fd.Name())] = b.field(fd)
+	}
+
+	return schemaRef(name)
+}
+
+// field returns the schema of the field's value, following the proto3 JSON
+// mapping used by the gateway.
+func (b *openAPIBuilder) field(fd protoreflect.FieldDescriptor) object {
+	if fd.IsMap() {
+		return object{
+			"type":                 "object",
+			"additionalProperties": b.value(fd.MapValue()),
+		}
+	}
+	if fd.IsList() {
+		return object{
+			"type":  "array",
+			"items": b.value(fd),
+		}
+	}
+	return b.value(fd)
+}
+
+func (b *openAPIBuilder) value(fd protoreflect.FieldDescriptor) object {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return object{"type": "boolean"}
+	case protoreflect.StringKind:
+		return object{"type": "string"}
+	case protoreflect.BytesKind:
+		return object{"type": "string", "format": "byte"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return object{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return object{"type": "integer", "format": "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// 64-bit integers are encoded as strings in JSON.
+		return object{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return object{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return object{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return object{"type": "number", "format": "double"}
+	case protoreflect.EnumKind:
+		vals := fd.Enum().Values()
+		names := make([]string, 0, vals.Len())
+		for i := 0; i < vals.Len(); i++ {
+			names = append(names, string(vals.Get(i).Name()))
+		}
+		return object{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.message(fd.Message())
+	default:
+		return object{}
+	}
+}
@@ -0,0 +1,122 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package gateway
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+// collectRefs returns the schema references in the decoded JSON value.
+func collectRefs(v interface{}) []string {
+	var refs []string
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if ref, ok := val.(string); ok && key == "$ref" {
+				refs = append(refs, ref)
+				continue
+			}
+			refs = append(refs, collectRefs(val)...)
+		}
+	case []interface{}:
+		for _, val := range v {
+			refs = append(refs, collectRefs(val)...)
+		}
+	}
+	return refs
+}
+
+func TestGateway_OpenAPI(t *testing.T) {
+	routes, err := buildRoutes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(buildOpenAPI(routes))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		OpenAPI    string                                       `json:"openapi"`
+		Paths      map[string]map[string]map[string]interface{} `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, openAPIVersion, doc.OpenAPI, "unexpected version")
+	test.AssertEqual(t, len(routes), len(doc.Paths), "unexpected number of paths")
+
+	for _, rt := range routes {
+		item, found := doc.Paths[rt.path]
+		if !found {
+			t.Fatalf("no path for %s", rt.method)
+		}
+		_, hasGet := item["get"]
+		test.AssertEqual(t, rt.readOnly, hasGet, rt.path+": unexpected GET operation")
+		if _, found := item["post"]; !found {
+			t.Fatalf("%s: no POST operation", rt.path)
+		}
+	}
+
+	for path, exp := range map[string]bool{
+		"/v1/mgmt.MgmtSvc/SystemErase":          false,
+		"/v1/mgmt.MgmtSvc/SystemCheckSetPolicy": false,
+		"/v1/mgmt.MgmtSvc/OperationStart":       false,
+		"/v1/mgmt.MgmtSvc/PoolQuery":            true,
+		"/v1/mgmt.MgmtSvc/SystemCheckGetPolicy": true,
+		"/v1/ctl.CtlSvc/StorageScan":            true,
+	} {
+		_, found := doc.Paths[path]["get"]
+		test.AssertEqual(t, exp, found, path+": unexpected GET operation")
+	}
+	for method := range readOnlyMethods {
+		if _, found := doc.Paths[strings.TrimSuffix(apiPrefix, "/")+method]; !found {
+			t.Fatalf("read-only method %s has no route", method)
+		}
+	}
+	if _, found := doc.Paths["/v1/mgmt.MgmtSvc/Join"]; found {
+		t.Fatal("server-only method should not be exposed")
+	}
+	if !strings.Contains(string(data), `"name":"hosts"`) {
+		t.Fatal("no hosts parameter for control service methods")
+	}
+
+	for _, ref := range collectRefs(doc.Paths) {
+		name := strings.TrimPrefix(ref, schemaRefPrefix)
+		if _, found := doc.Components.Schemas[name]; !found {
+			t.Fatalf("unresolved schema reference %q", ref)
+		}
+	}
+
+	props := doc.Components.Schemas["mgmt.PoolQueryReq"].Properties
+	expProps := map[string]map[string]interface{}{
+		"sys":        {"type": "string"},
+		"id":         {"type": "string"},
+		"svc_ranks":  {"type": "array", "items": map[string]interface{}{"type": "integer", "format": "uint32"}},
+		"query_mask": {"type": "string", "format": "uint64"},
+	}
+	if diff := cmp.Diff(expProps, props); diff != "" {
+		t.Fatalf("unexpected PoolQueryReq schema (-want, +got):\n%s\n", diff)
+	}
+
+	media := doc.Components.Schemas["mgmt.StorageUsageStats"].Properties["media_type"]
+	expMedia := map[string]interface{}{"type": "string", "enum": []interface{}{"SCM", "NVME"}}
+	if diff := cmp.Diff(expMedia, media); diff != "" {
+		t.Fatalf("unexpected enum schema (-want, +got):\n%s\n", diff)
+	}
+}
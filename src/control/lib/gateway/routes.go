@@ -0,0 +1,223 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package gateway
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+const (
+	// apiPrefix is the prefix of the paths of all gateway endpoints.
+	apiPrefix = "/v1/"
+	// hostsParam is the query parameter that selects the hosts that
+	// control service methods are called on.
+	hostsParam = "hosts"
+)
+
+// readOnlyMethods are the methods that do not modify the system, which may
+// also be called with GET requests and with bearer tokens. Methods are only
+// added explicitly, so that a new method is not treated as read-only because
+// of its name.
+var readOnlyMethods = map[string]struct{}{
+	mgmtpb.MgmtSvc_LeaderQuery_FullMethodName:          {},
+	mgmtpb.MgmtSvc_PoolQuery_FullMethodName:            {},
+	mgmtpb.MgmtSvc_PoolQueryTarget_FullMethodName:      {},
+	mgmtpb.MgmtSvc_PoolGetProp_FullMethodName:          {},
+	mgmtpb.MgmtSvc_PoolGetACL_FullMethodName:           {},
+	mgmtpb.MgmtSvc_ListPools_FullMethodName:            {},
+	mgmtpb.MgmtSvc_ListContainers_FullMethodName:       {},
+	mgmtpb.MgmtSvc_SystemQuery_FullMethodName:          {},
+	mgmtpb.MgmtSvc_SystemCheckQuery_FullMethodName:     {},
+	mgmtpb.MgmtSvc_SystemCheckGetPolicy_FullMethodName: {},
+	mgmtpb.MgmtSvc_SystemGetAttr_FullMethodName:        {},
+	mgmtpb.MgmtSvc_SystemGetProp_FullMethodName:        {},
+	mgmtpb.MgmtSvc_OperationList_FullMethodName:        {},
+	mgmtpb.MgmtSvc_OperationGet_FullMethodName:         {},
+	ctlpb.CtlSvc_StorageScan_FullMethodName:            {},
+	ctlpb.CtlSvc_NetworkScan_FullMethodName:            {},
+	ctlpb.CtlSvc_FirmwareQuery_FullMethodName:          {},
+	ctlpb.CtlSvc_SmdQuery_FullMethodName:               {},
+	ctlpb.CtlSvc_CertCheck_FullMethodName:              {},
+	ctlpb.CtlSvc_AuditList_FullMethodName:              {},
+}
+
+// route maps a gateway endpoint to a gRPC method.
+type route struct {
+	path     string
+	method   string
+	service  protoreflect.ServiceDescriptor
+	desc     protoreflect.MethodDescriptor
+	readOnly bool
+	perHost  bool
+}
+
+func (rt *route) newRequest() (proto.Message, error) {
+	return newMessage(rt.desc.Input())
+}
+
+func (rt *route) newResponse() (proto.Message, error) {
+	return newMessage(rt.desc.Output())
+}
+
+func newMessage(md protoreflect.MessageDescriptor) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err != nil {
+		return nil, errors.Wrapf(err, "message type %s", md.FullName())
+	}
+	return mt.New().Interface(), nil
+}
+
+// buildRoutes returns the routes for the unary methods of the management and
+// control services that may be called with an admin certificate.
+func buildRoutes() ([]*route, error) {
+	var routes []*route
+	for _, svcName := range []string{
+		mgmtpb.MgmtSvc_ServiceDesc.ServiceName,
+		ctlpb.CtlSvc_ServiceDesc.ServiceName,
+	} {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(svcName))
+		if err != nil {
+			return nil, errors.Wrapf(err, "service %s", svcName)
+		}
+		sd, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, errors.Errorf("%s is not a service", svcName)
+		}
+
+		methods := sd.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			md := methods.Get(i)
+			if md.IsStreamingClient() || md.IsStreamingServer() {
+				continue
+			}
+			method := "/" + svcName + "/" + string(md.Name())
+			if !security.ComponentAdmin.HasAccess(method) {
+				continue
+			}
+
+			routes = append(routes, &route{
+				path:     apiPrefix + svcName + "/" + string(md.Name()),
+				method:   method,
+				service:  sd,
+				desc:     md,
+				readOnly: isReadOnly(method),
+				perHost:  svcName != mgmtpb.MgmtSvc_ServiceDesc.ServiceName,
+			})
+		}
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].path < routes[j].path
+	})
+	return routes, nil
+}
+
+func isReadOnly(method string) bool {
+	_, found := readOnlyMethods[method]
+	return found
+}
+
+// queryFields returns the fields of the message that may be set by query
+// parameters: those with scalar or enum values, or lists of them.
+func queryFields(md protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	var fds []protoreflect.FieldDescriptor
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() || fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+			continue
+		}
+		fds = append(fds, fd)
+	}
+	return fds
+}
+
+// parseQuery sets the fields of the message from query parameters, which are
+// named by either the proto or JSON names of the fields. Enum values are given
+// by name.
+func parseQuery(query url.Values, msg proto.Message) error {
+	fields := make(map[string]protoreflect.FieldDescriptor)
+	for _, fd := range queryFields(msg.ProtoReflect().Descriptor()) {
+		fields[string(fd.Name())] = fd
+		fields[fd.JSONName()] = fd
+	}
+
+	obj := make(map[string]interface{})
+	for key, vals := range query {
+		if key == hostsParam {
+			continue
+		}
+		fd, found := fields[key]
+		if !found {
+			return errors.Errorf("unknown query parameter %q", key)
+		}
+
+		var items []interface{}
+		for _, val := range vals {
+			// protojson accepts strings for numeric values, but not
+			// for booleans.
+			if fd.Kind() == protoreflect.BoolKind {
+				switch val {
+				case "true", "1", "":
+					items = append(items, true)
+				case "false", "0":
+					items = append(items, false)
+				default:
+					return errors.Errorf("query parameter %q: invalid boolean %q", key, val)
+				}
+				continue
+			}
+			items = append(items, val)
+		}
+
+		name := string(fd.Name())
+		if fd.IsList() {
+			prev, _ := obj[name].([]interface{})
+			obj[name] = append(prev, items...)
+			continue
+		}
+		if _, found := obj[name]; found || len(items) != 1 {
+			return errors.Errorf("query parameter %q may only be set once", key)
+		}
+		obj[name] = items[0]
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(protojson.Unmarshal(data, msg), "invalid query")
+}
+
+// parseHosts returns the hosts selected by the hosts query parameters, each of
+// which may be a hostlist, e.g. "host[1-4],host8".
+func parseHosts(query url.Values) ([]string, error) {
+	vals, found := query[hostsParam]
+	if !found {
+		return nil, nil
+	}
+
+	hs, err := hostlist.CreateSet(strings.Join(vals, ","))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", hostsParam)
+	}
+	return hs.Slice(), nil
+}
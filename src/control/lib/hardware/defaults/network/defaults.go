@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -56,3 +57,8 @@ func DefaultFabricScanner(log logging.Logger) *hardware.FabricScanner {
 func DefaultNetDevStateProvider(log logging.Logger) hardware.NetDevStateProvider {
 	return sysfs.NewProvider(log)
 }
+
+// DefaultNetDevSpeedProvider gets the default provider for getting the fabric interface link speed.
+func DefaultNetDevSpeedProvider(log logging.Logger) hardware.NetDevSpeedProvider {
+	return sysfs.NewProvider(log)
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -126,3 +127,16 @@ func TestFabric_DefaultNetDevStateProvider(t *testing.T) {
 		t.Fatalf("(-want, +got)\n%s\n", diff)
 	}
 }
+
+func TestFabric_DefaultNetDevSpeedProvider(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	result := network.DefaultNetDevSpeedProvider(log)
+
+	if diff := cmp.Diff(sysfs.NewProvider(log), result,
+		cmpopts.IgnoreUnexported(sysfs.Provider{}),
+	); diff != "" {
+		t.Fatalf("(-want, +got)\n%s\n", diff)
+	}
+}
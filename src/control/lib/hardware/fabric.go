@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -1040,6 +1041,12 @@ type NetDevStateProvider interface {
 	GetNetDevState(string) (NetDevState, error)
 }
 
+// NetDevSpeedProvider is an interface for a type that can be used to get the link speed of a
+// network device in Mb/s.
+type NetDevSpeedProvider interface {
+	GetNetDevSpeed(string) (uint64, error)
+}
+
 // WaitFabricReadyParams defines the parameters for a WaitFabricReady call.
 type WaitFabricReadyParams struct {
 	StateProvider  NetDevStateProvider
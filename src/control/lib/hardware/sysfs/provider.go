@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -585,6 +586,105 @@ func condenseNetDevState(states []hardware.NetDevState) hardware.NetDevState {
 	return condensed
 }
 
+// GetNetDevSpeed fetches the link speed of a network interface in Mb/s.
+func (s *Provider) GetNetDevSpeed(iface string) (uint64, error) {
+	if s == nil {
+		return 0, errors.New("sysfs provider is nil")
+	}
+
+	if iface == "" {
+		return 0, errors.New("fabric interface name is required")
+	}
+
+	devClass, err := s.GetNetDevClass(iface)
+	if err != nil {
+		return 0, errors.Wrapf(err, "can't determine device class for %q", iface)
+	}
+
+	if devClass == hardware.Infiniband {
+		return s.getInfinibandSpeed(iface)
+	}
+	return s.getNetSpeed(iface)
+}
+
+func (s *Provider) getNetSpeed(iface string) (uint64, error) {
+	speedBytes, err := os.ReadFile(s.sysPath("class", "net", iface, "speed"))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get %q link speed", iface)
+	}
+
+	// The kernel reports -1 if the link speed is unknown, e.g. if the link is down.
+	speed, err := strconv.ParseInt(strings.TrimSpace(string(speedBytes)), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse %q link speed", iface)
+	}
+	if speed <= 0 {
+		return 0, errors.Errorf("link speed of %q is unknown", iface)
+	}
+	return uint64(speed), nil
+}
+
+func (s *Provider) getInfinibandSpeed(iface string) (uint64, error) {
+	if s.isVirtualNetIface(iface) {
+		if parent, err := s.getParentDevName(iface); err == nil {
+			return s.getInfinibandSpeed(parent)
+		}
+	}
+
+	ibPath := s.sysPath("class", "net", iface, "device", "infiniband")
+	ibDevs, err := os.ReadDir(ibPath)
+	if err != nil {
+		return 0, errors.Wrapf(err, "can't access Infiniband details for %q", iface)
+	}
+
+	// The interface is as fast as its fastest port.
+	var maxSpeed uint64
+	for _, dev := range ibDevs {
+		portPath := filepath.Join(ibPath, dev.Name(), "ports")
+		ports, err := os.ReadDir(portPath)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unable to get ports for %s/%s", iface, dev.Name())
+		}
+
+		for _, port := range ports {
+			rateBytes, err := os.ReadFile(filepath.Join(portPath, port.Name(), "rate"))
+			if err != nil {
+				return 0, errors.Wrapf(err, "unable to get rate for %s/%s port %s",
+					iface, dev.Name(), port.Name())
+			}
+
+			speed, err := ibRateToSpeed(string(rateBytes))
+			if err != nil {
+				s.log.Noticef("%s/%s port %s: %s", iface, dev.Name(), port.Name(), err.Error())
+				continue
+			}
+			if speed > maxSpeed {
+				maxSpeed = speed
+			}
+		}
+	}
+
+	if maxSpeed == 0 {
+		return 0, errors.Errorf("link speed of %q is unknown", iface)
+	}
+	return maxSpeed, nil
+}
+
+// ibRateToSpeed converts an Infiniband port rate string (e.g. "100 Gb/sec (4X EDR)")
+// to a speed in Mb/s.
+func ibRateToSpeed(rateStr string) (uint64, error) {
+	fields := strings.Fields(rateStr)
+	if len(fields) < 2 || fields[1] != "Gb/sec" {
+		return 0, errors.Errorf("unable to parse IB rate %q", strings.TrimSpace(rateStr))
+	}
+
+	gbps, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to parse IB rate %q", strings.TrimSpace(rateStr))
+	}
+	return uint64(gbps * 1000), nil
+}
+
 // IsIOMMUEnabled checks whether IOMMU is enabled by interrogating files in sysfs and implements
 // the IOMMUDetector interface on sysfs provider.
 func (s *Provider) IsIOMMUEnabled() (bool, error) {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -1180,6 +1181,153 @@ func TestSysfs_Provider_GetNetDevState(t *testing.T) {
 	}
 }
 
+func TestSysfs_Provider_GetNetDevSpeed(t *testing.T) {
+	setupNet := func(t *testing.T, root, speed string) {
+		t.Helper()
+
+		path := setupPCIDev(t, root, "0000:02:02.1", "net", "net0")
+		setupClassLink(t, root, "net", path)
+
+		setupTestNetDevClasses(t, root, map[string]uint32{
+			"net0": uint32(hardware.Ether),
+		})
+		if speed != "" {
+			writeTestFile(t, filepath.Join(root, "class", "net", "net0", "speed"), speed)
+		}
+	}
+
+	setupIB := func(t *testing.T, root string, portRate map[int]string) {
+		t.Helper()
+
+		ibPath := setupPCIDev(t, root, "0000:01:01.1", "infiniband", "mlx0")
+		setupClassLink(t, root, "infiniband", ibPath)
+		netPath := setupPCIDev(t, root, "0000:01:01.1", "net", "ib0")
+		setupClassLink(t, root, "net", netPath)
+
+		setupTestNetDevClasses(t, root, map[string]uint32{
+			"ib0": uint32(hardware.Infiniband),
+		})
+
+		for port, rate := range portRate {
+			portPath := filepath.Join(ibPath, "ports", strconv.Itoa(port))
+			if err := os.MkdirAll(portPath, 0755); err != nil {
+				t.Fatal(err)
+			}
+			writeTestFile(t, filepath.Join(portPath, "rate"), rate)
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		setup    func(*testing.T, string)
+		p        *Provider
+		iface    string
+		expSpeed uint64
+		expErr   error
+	}{
+		"nil": {
+			iface:  "net0",
+			expErr: errors.New("nil"),
+		},
+		"no iface": {
+			p:      &Provider{},
+			expErr: errors.New("interface name is required"),
+		},
+		"bad interface": {
+			p:      &Provider{},
+			iface:  "fake",
+			expErr: errors.New("can't determine device class"),
+		},
+		"ethernet": {
+			setup: func(t *testing.T, root string) {
+				setupNet(t, root, "25000\n")
+			},
+			p:        &Provider{},
+			iface:    "net0",
+			expSpeed: 25000,
+		},
+		"ethernet speed unknown": {
+			setup: func(t *testing.T, root string) {
+				setupNet(t, root, "-1\n")
+			},
+			p:      &Provider{},
+			iface:  "net0",
+			expErr: errors.New("unknown"),
+		},
+		"ethernet no speed file": {
+			setup: func(t *testing.T, root string) {
+				setupNet(t, root, "")
+			},
+			p:      &Provider{},
+			iface:  "net0",
+			expErr: errors.New("failed to get"),
+		},
+		"infiniband": {
+			setup: func(t *testing.T, root string) {
+				setupIB(t, root, map[int]string{
+					1: "100 Gb/sec (4X EDR)\n",
+					2: "200 Gb/sec (4X HDR)\n",
+				})
+			},
+			p:        &Provider{},
+			iface:    "ib0",
+			expSpeed: 200000,
+		},
+		"infiniband fractional rate": {
+			setup: func(t *testing.T, root string) {
+				setupIB(t, root, map[int]string{
+					1: "2.5 Gb/sec (1X SDR)\n",
+				})
+			},
+			p:        &Provider{},
+			iface:    "ib0",
+			expSpeed: 2500,
+		},
+		"infiniband virtual device": {
+			setup: func(t *testing.T, root string) {
+				setupIB(t, root, map[int]string{
+					1: "100 Gb/sec (4X EDR)\n",
+				})
+				setupVirtualIB(t, root, "ib0.1", "ib0")
+			},
+			p:        &Provider{},
+			iface:    "ib0.1",
+			expSpeed: 100000,
+		},
+		"infiniband unparseable rate": {
+			setup: func(t *testing.T, root string) {
+				setupIB(t, root, map[int]string{
+					1: "garbage\n",
+				})
+			},
+			p:      &Provider{},
+			iface:  "ib0",
+			expErr: errors.New("unknown"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanupTestDir := test.CreateTestDir(t)
+			defer cleanupTestDir()
+
+			if tc.p != nil {
+				tc.p.log = log
+				tc.p.root = testDir
+			}
+
+			if tc.setup != nil {
+				tc.setup(t, testDir)
+			}
+
+			speed, err := tc.p.GetNetDevSpeed(tc.iface)
+
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expSpeed, speed, "")
+		})
+	}
+}
+
 func TestSysfs_Provider_ibStateToNetDevState(t *testing.T) {
 	for name, tc := range map[string]struct {
 		input     string
@@ -0,0 +1,188 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+type (
+	// CheckerService provides the system checker operations of a Client.
+	CheckerService struct {
+		c *Client
+	}
+
+	// CheckerStartOptions contains the options of a checker start.
+	CheckerStartOptions struct {
+		// Pools lists the UUIDs of the pools to check. All pools are
+		// checked if it is empty.
+		Pools []string
+		// DryRun reports the findings without repairing them.
+		DryRun bool
+		// Reset discards the state of any previous check.
+		Reset bool
+		// FindOrphans looks for pools that are not known to the
+		// management service.
+		FindOrphans bool
+	}
+
+	// CheckerPool describes the progress of the check of a pool.
+	CheckerPool struct {
+		UUID   string `json:"uuid"`
+		Label  string `json:"label,omitempty"`
+		Status string `json:"status"`
+		Phase  string `json:"phase"`
+	}
+
+	// CheckerRepairChoice describes a possible repair of a finding.
+	CheckerRepairChoice struct {
+		Action int32  `json:"action"`
+		Info   string `json:"info"`
+	}
+
+	// CheckerFinding describes an inconsistency found by the checker.
+	CheckerFinding struct {
+		Seq       uint64 `json:"seq"`
+		Class     string `json:"class"`
+		Action    string `json:"action"`
+		Rank      uint32 `json:"rank"`
+		PoolUUID  string `json:"pool_uuid,omitempty"`
+		PoolLabel string `json:"pool_label,omitempty"`
+		ContUUID  string `json:"cont_uuid,omitempty"`
+		ContLabel string `json:"cont_label,omitempty"`
+		Message   string `json:"msg"`
+		// RepairChoices lists the possible repairs of a finding that
+		// awaits a decision. Pass the Action of one of them to Repair.
+		RepairChoices []*CheckerRepairChoice `json:"repair_choices,omitempty"`
+	}
+
+	// CheckerStatus describes the state of the system checker.
+	CheckerStatus struct {
+		Status    string            `json:"status"`
+		ScanPhase string            `json:"scan_phase"`
+		StartTime time.Time         `json:"start_time"`
+		Pools     []*CheckerPool    `json:"pools,omitempty"`
+		Findings  []*CheckerFinding `json:"findings,omitempty"`
+	}
+)
+
+// Enable enables the system checker. The system must be stopped.
+func (s *CheckerService) Enable(ctx context.Context) error {
+	req := new(control.SystemCheckEnableReq)
+	applyCallOptions(ctx, req)
+
+	return wrapError(control.SystemCheckEnable(ctx, s.c.invoker, req))
+}
+
+// Disable disables the system checker.
+func (s *CheckerService) Disable(ctx context.Context) error {
+	req := new(control.SystemCheckDisableReq)
+	applyCallOptions(ctx, req)
+
+	return wrapError(control.SystemCheckDisable(ctx, s.c.invoker, req))
+}
+
+// Start starts a check of the system. The options may be nil.
+func (s *CheckerService) Start(ctx context.Context, opts *CheckerStartOptions) error {
+	req := new(control.SystemCheckStartReq)
+	if opts != nil {
+		req.Uuids = opts.Pools
+		if opts.DryRun {
+			req.Flags |= uint32(control.SystemCheckFlagDryRun)
+		}
+		if opts.Reset {
+			req.Flags |= uint32(control.SystemCheckFlagReset)
+		}
+		if opts.FindOrphans {
+			req.Flags |= uint32(control.SystemCheckFlagFindOrphans)
+		}
+	}
+	applyCallOptions(ctx, req)
+
+	return wrapError(control.SystemCheckStart(ctx, s.c.invoker, req))
+}
+
+// Stop stops the check of the pools with the given UUIDs, or of all pools if
+// none are given.
+func (s *CheckerService) Stop(ctx context.Context, pools ...string) error {
+	req := new(control.SystemCheckStopReq)
+	req.Uuids = pools
+	applyCallOptions(ctx, req)
+
+	return wrapError(control.SystemCheckStop(ctx, s.c.invoker, req))
+}
+
+// Query returns the state of the check of the pools with the given UUIDs, or
+// of all pools if none are given.
+func (s *CheckerService) Query(ctx context.Context, pools ...string) (*CheckerStatus, error) {
+	req := new(control.SystemCheckQueryReq)
+	req.Uuids = pools
+	applyCallOptions(ctx, req)
+
+	resp, err := control.SystemCheckQuery(ctx, s.c.invoker, req)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	status := &CheckerStatus{
+		Status:    resp.Status.String(),
+		ScanPhase: resp.ScanPhase.String(),
+		StartTime: resp.StartTime,
+	}
+	for _, pi := range resp.Pools {
+		status.Pools = append(status.Pools, &CheckerPool{
+			UUID:   pi.UUID,
+			Label:  pi.Label,
+			Status: pi.Status,
+			Phase:  pi.Phase,
+		})
+	}
+	sort.Slice(status.Pools, func(i, j int) bool {
+		return status.Pools[i].UUID < status.Pools[j].UUID
+	})
+	for _, rpt := range resp.Reports {
+		finding := &CheckerFinding{
+			Seq:       rpt.Seq,
+			Class:     control.SystemCheckFindingClass(rpt.Class).String(),
+			Action:    control.SystemCheckRepairAction(rpt.Action).String(),
+			Rank:      rpt.Rank,
+			PoolUUID:  rpt.PoolUuid,
+			PoolLabel: rpt.PoolLabel,
+			ContUUID:  rpt.ContUuid,
+			ContLabel: rpt.ContLabel,
+			Message:   rpt.Msg,
+		}
+		if rpt.IsInteractive() {
+			for _, choice := range rpt.RepairChoices() {
+				finding.RepairChoices = append(finding.RepairChoices, &CheckerRepairChoice{
+					Action: int32(choice.Action),
+					Info:   choice.Info,
+				})
+			}
+		}
+		status.Findings = append(status.Findings, finding)
+	}
+
+	return status, nil
+}
+
+// Repair repairs the finding with the given sequence number using the given
+// action, which is one of the RepairChoices of the finding.
+func (s *CheckerService) Repair(ctx context.Context, seq uint64, action int32) error {
+	req := new(control.SystemCheckRepairReq)
+	req.Seq = seq
+	if err := req.SetAction(action); err != nil {
+		return err
+	}
+	applyCallOptions(ctx, req)
+
+	return wrapError(control.SystemCheckRepair(ctx, s.c.invoker, req))
+}
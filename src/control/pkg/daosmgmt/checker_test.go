@@ -0,0 +1,112 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	chkpb "github.com/daos-stack/daos/src/control/common/proto/chk"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/system/checker"
+)
+
+func TestDaosmgmt_CheckerService(t *testing.T) {
+	ctx := test.Context(t)
+	fake := newTestFake(t)
+	client := fake.Client()
+	chk := client.Checker()
+
+	pool, err := client.Pools().Create(ctx, &PoolCreateRequest{Label: "tank", Size: humanize.TByte})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test.CmpErr(t, checker.FaultCheckerNotEnabled, chk.Start(ctx, nil))
+	err = chk.Enable(ctx)
+	test.CmpErr(t, errors.New("stop system"), err)
+
+	if _, err := client.System().Stop(ctx, nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := chk.Enable(ctx); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Pools().Create(ctx, &PoolCreateRequest{Label: "scratch", Size: humanize.TByte})
+	test.CmpErr(t, checker.FaultCheckerEnabled, err)
+
+	if err := chk.Start(ctx, &CheckerStartOptions{DryRun: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := fake.AddCheckerFinding(&CheckerFinding{
+		Class:     "POOL_BAD_LABEL",
+		Action:    "INTERACT",
+		PoolUUID:  pool.UUID,
+		PoolLabel: "tank",
+		Message:   "pool label mismatch",
+		RepairChoices: []*CheckerRepairChoice{
+			{Action: int32(chkpb.CheckInconsistAction_CIA_TRUST_MS), Info: "Trust the MS pool label"},
+			{Action: int32(chkpb.CheckInconsistAction_CIA_TRUST_PS), Info: "Trust the PS pool label"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	status, err := chk.Query(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "RUNNING", status.Status, "unexpected checker status")
+	expPools := []*CheckerPool{
+		{UUID: pool.UUID, Status: "CPS_CHECKING", Phase: "CSP_PREPARE"},
+	}
+	if diff := cmp.Diff(expPools, status.Pools); diff != "" {
+		t.Fatalf("unexpected pools (-want, +got):\n%s\n", diff)
+	}
+	expFindings := []*CheckerFinding{
+		{
+			Seq:       1,
+			Class:     "POOL_BAD_LABEL",
+			Action:    "INTERACT",
+			PoolUUID:  pool.UUID,
+			PoolLabel: "tank",
+			Message:   "pool label mismatch",
+			RepairChoices: []*CheckerRepairChoice{
+				{Action: int32(chkpb.CheckInconsistAction_CIA_TRUST_MS), Info: "Trust the MS pool label"},
+				{Action: int32(chkpb.CheckInconsistAction_CIA_TRUST_PS), Info: "Trust the PS pool label"},
+			},
+		},
+	}
+	if diff := cmp.Diff(expFindings, status.Findings); diff != "" {
+		t.Fatalf("unexpected findings (-want, +got):\n%s\n", diff)
+	}
+
+	err = chk.Repair(ctx, 1, int32(chkpb.CheckInconsistAction_CIA_IGNORE))
+	test.CmpErr(t, errors.New("not a repair choice"), err)
+	if err := chk.Repair(ctx, 1, int32(chkpb.CheckInconsistAction_CIA_TRUST_MS)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := chk.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+	status, err = chk.Query(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "STOPPED", status.Status, "unexpected checker status")
+	test.AssertEqual(t, "TRUST_MS", status.Findings[0].Action, "finding not repaired")
+	test.AssertEqual(t, 0, len(status.Findings[0].RepairChoices), "unexpected repair choices")
+
+	if err := chk.Disable(ctx); err != nil {
+		t.Fatal(err)
+	}
+	test.CmpErr(t, checker.FaultCheckerNotEnabled, chk.Disable(ctx))
+}
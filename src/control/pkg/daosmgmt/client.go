@@ -0,0 +1,205 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type (
+	metricsListFn  func(context.Context, *control.MetricsListReq) (*control.MetricsListResp, error)
+	metricsQueryFn func(context.Context, *control.MetricsQueryReq) (*control.MetricsQueryResp, error)
+
+	// Client is a client for the DAOS management API. A Client is safe for
+	// concurrent use.
+	Client struct {
+		invoker      control.UnaryInvoker
+		closer       func() error
+		metricsList  metricsListFn
+		metricsQuery metricsQueryFn
+	}
+
+	clientOptions struct {
+		cfgPath    string
+		hostList   []string
+		systemName string
+		insecure   bool
+		log        logging.Logger
+	}
+
+	// Option configures a Client created by New.
+	Option func(*clientOptions)
+)
+
+// WithConfigFile sets the path of the daos_control.yml configuration file
+// to load. If not set, the file is looked for in the same locations as dmg,
+// and the default configuration is used if it is not found.
+func WithConfigFile(path string) Option {
+	return func(opts *clientOptions) {
+		opts.cfgPath = path
+	}
+}
+
+// WithHostList sets the DAOS servers that requests are sent to, overriding
+// the hostlist in the configuration file.
+func WithHostList(hosts ...string) Option {
+	return func(opts *clientOptions) {
+		opts.hostList = hosts
+	}
+}
+
+// WithSystemName sets the name of the DAOS system, overriding the name in
+// the configuration file.
+func WithSystemName(name string) Option {
+	return func(opts *clientOptions) {
+		opts.systemName = name
+	}
+}
+
+// WithInsecure disables the use of certificates to authenticate the
+// connections to the DAOS servers.
+func WithInsecure() Option {
+	return func(opts *clientOptions) {
+		opts.insecure = true
+	}
+}
+
+// WithLogger sets the logger used to log the requests made by the client.
+func WithLogger(log logging.Logger) Option {
+	return func(opts *clientOptions) {
+		opts.log = log
+	}
+}
+
+// New returns a Client configured with the supplied options. The Client
+// keeps its connections to the DAOS servers open until Close is called.
+func New(opts ...Option) (*Client, error) {
+	co := new(clientOptions)
+	for _, opt := range opts {
+		opt(co)
+	}
+
+	cfg, err := control.LoadConfig(co.cfgPath)
+	if err != nil {
+		if co.cfgPath != "" || err != control.ErrNoConfigFile {
+			return nil, errors.Wrap(err, "failed to load control configuration")
+		}
+		cfg = control.DefaultConfig()
+	}
+	if len(co.hostList) > 0 {
+		cfg.HostList = co.hostList
+	}
+	if co.systemName != "" {
+		cfg.SystemName = co.systemName
+	}
+	if co.insecure {
+		cfg.TransportConfig.AllowInsecure = true
+	}
+
+	clientOpts := []control.ClientOption{
+		control.WithConfig(cfg),
+		control.WithClientComponent(build.ComponentAdmin),
+		control.WithPersistentConnections(),
+	}
+	if co.log != nil {
+		clientOpts = append(clientOpts, control.WithClientLogger(co.log))
+	}
+	cc := control.NewClient(clientOpts...)
+
+	return &Client{
+		invoker:      cc,
+		closer:       cc.Close,
+		metricsList:  control.MetricsList,
+		metricsQuery: control.MetricsQuery,
+	}, nil
+}
+
+// Close closes the connections to the DAOS servers.
+func (c *Client) Close() error {
+	if c.closer == nil {
+		return nil
+	}
+	return c.closer()
+}
+
+// Pools returns the pool operations of the client.
+func (c *Client) Pools() *PoolService {
+	return &PoolService{c: c}
+}
+
+// System returns the system operations of the client.
+func (c *Client) System() *SystemService {
+	return &SystemService{c: c}
+}
+
+// Storage returns the storage operations of the client.
+func (c *Client) Storage() *StorageService {
+	return &StorageService{c: c}
+}
+
+// Checker returns the system checker operations of the client.
+func (c *Client) Checker() *CheckerService {
+	return &CheckerService{c: c}
+}
+
+// Telemetry returns the telemetry operations of the client.
+func (c *Client) Telemetry() *TelemetryService {
+	return &TelemetryService{c: c}
+}
+
+type (
+	callOptions struct {
+		hosts  []string
+		system string
+	}
+
+	callOptionsKey struct{}
+)
+
+func getCallOptions(ctx context.Context) callOptions {
+	if co, ok := ctx.Value(callOptionsKey{}).(callOptions); ok {
+		return co
+	}
+	return callOptions{}
+}
+
+// WithRequestHosts returns a context that sends the calls made with it to
+// the given hosts instead of the hosts in the client's configuration.
+func WithRequestHosts(ctx context.Context, hosts ...string) context.Context {
+	co := getCallOptions(ctx)
+	co.hosts = hosts
+	return context.WithValue(ctx, callOptionsKey{}, co)
+}
+
+// WithRequestSystem returns a context that sends the calls made with it to
+// the named DAOS system instead of the system in the client's configuration.
+func WithRequestSystem(ctx context.Context, name string) context.Context {
+	co := getCallOptions(ctx)
+	co.system = name
+	return context.WithValue(ctx, callOptionsKey{}, co)
+}
+
+// applyCallOptions sets the options attached to the context on the request.
+func applyCallOptions(ctx context.Context, req interface{}) {
+	co := getCallOptions(ctx)
+	if len(co.hosts) > 0 {
+		if r, ok := req.(interface{ SetHostList([]string) }); ok {
+			r.SetHostList(co.hosts)
+		}
+	}
+	if co.system != "" {
+		if r, ok := req.(interface{ SetSystem(string) }); ok {
+			r.SetSystem(co.system)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+/*
+Package daosmgmt provides a stable Go client for the DAOS management API, for
+use by programs that manage a DAOS system without shelling out to dmg.
+
+The package is a facade over lib/control. Unlike lib/control, its requests
+and responses are plain structs that are independent of the wire format, its
+methods are grouped by area on a single Client, and its behavior can be
+faked in tests without a running DAOS system.
+
+# Versioning
+
+The API of this package is versioned independently of DAOS, following
+semantic versioning. Version reports the version of the API implemented by
+the package. Fields and methods may be added in minor versions; nothing is
+removed or changed incompatibly without a new major version, which would be
+published under a new import path (e.g. pkg/daosmgmt/v2).
+
+# Usage
+
+A Client is created with New, which reads the same daos_control.yml
+configuration file as dmg by default:
+
+	client, err := daosmgmt.New(daosmgmt.WithConfigFile("/etc/daos/daos_control.yml"))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	pool, err := client.Pools().Create(ctx, &daosmgmt.PoolCreateRequest{
+		Label: "tank",
+		Size:  10 * humanize.TByte,
+	})
+
+The deadline of the context limits the time taken by each call. The hosts
+and DAOS system that a call is sent to can be overridden for a single call
+by attaching options to its context:
+
+	ctx = daosmgmt.WithRequestHosts(ctx, "server-1", "server-2")
+	hosts, err := client.Storage().Scan(ctx)
+
+# Errors
+
+Errors reported by DAOS are returned as *Error values, which carry the
+domain, code, description and suggested resolution of the underlying fault
+or DAOS status. Calls that are sent to several hosts return a HostErrors
+value, along with any results, if some of the hosts fail.
+
+# Testing
+
+NewFake returns a Fake, an in-memory DAOS system whose Client can be used in
+place of a real one in tests:
+
+	fake := daosmgmt.NewFake(nil)
+	if err := fake.AddHost(&daosmgmt.FakeHost{
+		Addr:      "10.0.0.1:10001",
+		Ranks:     []uint32{0, 1},
+		ScmBytes:  100 * humanize.GByte,
+		NvmeBytes: 2 * humanize.TByte,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	client := fake.Client()
+
+Requests that the Fake does not model are handled by a
+control.MockInvoker, and FailNext makes the next call fail with a given
+error.
+*/
+package daosmgmt
+
+// Version is the version of the API implemented by this package.
+const Version = "1.0.0"
@@ -0,0 +1,159 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// daosDomain is the domain of errors that are DAOS status codes.
+const daosDomain = "daos"
+
+type (
+	// Error describes a failure reported by DAOS. The original error, e.g. a
+	// *fault.Fault, can be retrieved with errors.Unwrap.
+	Error struct {
+		// Domain is the part of DAOS that reported the error (e.g.
+		// "client", "server" or "daos"). It is empty if unknown.
+		Domain string `json:"domain,omitempty"`
+		// Code identifies the error within its domain. It is a fault
+		// code, or a DAOS status code if the domain is "daos", and is
+		// zero if unknown.
+		Code int `json:"code"`
+		// Description describes the error.
+		Description string `json:"description"`
+		// Resolution suggests how the error may be resolved, if known.
+		Resolution string `json:"resolution,omitempty"`
+		// Hosts lists the hosts that reported the error, for calls
+		// that are sent to several hosts.
+		Hosts []string `json:"hosts,omitempty"`
+
+		err error
+	}
+
+	// HostErrors is returned by calls that are sent to several hosts if
+	// some of the hosts fail. Each Error lists the hosts that reported it.
+	HostErrors []*Error
+)
+
+func (e *Error) Error() string {
+	msg := e.Description
+	if e.Domain != "" {
+		msg = fmt.Sprintf("%s: code = %d description = %q", e.Domain, e.Code, e.Description)
+	}
+	if len(e.Hosts) > 0 {
+		msg = fmt.Sprintf("%s: %s", strings.Join(e.Hosts, ","), msg)
+	}
+	return msg
+}
+
+// Unwrap returns the original error.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+func (he HostErrors) Error() string {
+	msgs := make([]string, 0, len(he))
+	for _, e := range he {
+		msgs = append(msgs, e.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the errors reported by the hosts.
+func (he HostErrors) Unwrap() []error {
+	errs := make([]error, 0, len(he))
+	for _, e := range he {
+		errs = append(errs, e)
+	}
+	return errs
+}
+
+// wrapError converts an error returned by lib/control into an *Error.
+func wrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+
+	return newError(err)
+}
+
+func newError(err error) *Error {
+	e := &Error{
+		Description: err.Error(),
+		err:         err,
+	}
+
+	var f *fault.Fault
+	var ds daos.Status
+	switch {
+	case errors.As(err, &f):
+		e.Domain = f.Domain
+		e.Code = int(f.Code)
+		e.Description = f.Description
+		e.Resolution = f.Resolution
+	case errors.As(err, &ds):
+		e.Domain = daosDomain
+		e.Code = int(ds)
+		e.Description = ds.Error()
+	}
+
+	return e
+}
+
+// wrapHostErrors converts the host errors of a response sent to several
+// hosts into HostErrors.
+func wrapHostErrors(hem control.HostErrorsMap) error {
+	if len(hem) == 0 {
+		return nil
+	}
+
+	he := make(HostErrors, 0, len(hem))
+	for _, key := range hem.Keys() {
+		hes := hem[key]
+		e := newError(hes.HostError)
+		e.Hosts = hes.HostSet.Slice()
+		he = append(he, e)
+	}
+
+	return he
+}
+
+// IsNotFound returns true if the error reports that a pool, system member or
+// other DAOS object was not found.
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var ds daos.Status
+	if errors.As(err, &ds) && ds == daos.Nonexistent {
+		return true
+	}
+
+	for err != nil {
+		if system.IsPoolNotFound(err) || system.IsMemberNotFound(err) {
+			return true
+		}
+		err = errors.Unwrap(err)
+	}
+
+	return false
+}
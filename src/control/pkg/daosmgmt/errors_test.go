@@ -0,0 +1,158 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestDaosmgmt_wrapError(t *testing.T) {
+	testFault := &fault.Fault{
+		Domain:      "server",
+		Code:        code.ServerPoolDuplicateLabel,
+		Description: "pool label \"tank\" already exists in the system",
+		Resolution:  "retry the request with a unique pool label",
+	}
+
+	for name, tc := range map[string]struct {
+		err      error
+		expErr   *Error
+		expError string
+	}{
+		"nil": {},
+		"plain error": {
+			err: errors.New("whoops"),
+			expErr: &Error{
+				Description: "whoops",
+			},
+			expError: "whoops",
+		},
+		"fault": {
+			err: errors.Wrap(testFault, "pool create failed"),
+			expErr: &Error{
+				Domain:      "server",
+				Code:        int(code.ServerPoolDuplicateLabel),
+				Description: testFault.Description,
+				Resolution:  testFault.Resolution,
+			},
+			expError: "server: code = 609 description = \"pool label \\\"tank\\\" already exists in the system\"",
+		},
+		"daos status": {
+			err: errors.Wrap(daos.Nonexistent, "pool query failed"),
+			expErr: &Error{
+				Domain:      daosDomain,
+				Code:        int(daos.Nonexistent),
+				Description: daos.Nonexistent.Error(),
+			},
+		},
+		"already wrapped": {
+			err: &Error{Description: "wrapped"},
+			expErr: &Error{
+				Description: "wrapped",
+			},
+			expError: "wrapped",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotErr := wrapError(tc.err)
+			if tc.expErr == nil {
+				if gotErr != nil {
+					t.Fatalf("expected nil error, got %v", gotErr)
+				}
+				return
+			}
+
+			var e *Error
+			if !errors.As(gotErr, &e) {
+				t.Fatalf("expected *Error, got %T", gotErr)
+			}
+			if diff := cmp.Diff(tc.expErr, e, cmpopts.IgnoreUnexported(Error{})); diff != "" {
+				t.Fatalf("unexpected error (-want, +got):\n%s\n", diff)
+			}
+			if tc.expError != "" {
+				test.AssertEqual(t, tc.expError, e.Error(), "unexpected error string")
+			}
+			if _, isWrapped := tc.err.(*Error); !isWrapped {
+				test.AssertEqual(t, tc.err, errors.Unwrap(e), "original error not retained")
+			}
+		})
+	}
+}
+
+func TestDaosmgmt_wrapHostErrors(t *testing.T) {
+	hem := make(control.HostErrorsMap)
+	for _, host := range []string{"host1", "host2"} {
+		if err := hem.Add(host, errors.New("scan failed")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := hem.Add("host3", daos.TimedOut); err != nil {
+		t.Fatal(err)
+	}
+
+	gotErr := wrapHostErrors(hem)
+
+	var he HostErrors
+	if !errors.As(gotErr, &he) {
+		t.Fatalf("expected HostErrors, got %T", gotErr)
+	}
+	expErrs := HostErrors{
+		{Description: "scan failed", Hosts: []string{"host1", "host2"}},
+		{Domain: daosDomain, Code: int(daos.TimedOut), Description: daos.TimedOut.Error(), Hosts: []string{"host3"}},
+	}
+	if diff := cmp.Diff(expErrs, he, cmpopts.IgnoreUnexported(Error{})); diff != "" {
+		t.Fatalf("unexpected host errors (-want, +got):\n%s\n", diff)
+	}
+	test.AssertTrue(t, errors.Is(gotErr, daos.TimedOut), "host error not found by errors.Is")
+
+	test.AssertEqual(t, nil, wrapHostErrors(nil), "unexpected error for no host errors")
+}
+
+func TestDaosmgmt_IsNotFound(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err    error
+		expRes bool
+	}{
+		"nil": {},
+		"other error": {
+			err: errors.New("whoops"),
+		},
+		"nonexistent status": {
+			err:    wrapError(daos.Nonexistent),
+			expRes: true,
+		},
+		"pool label not found": {
+			err:    wrapError(errors.Wrap(system.ErrPoolLabelNotFound("tank"), "pool query failed")),
+			expRes: true,
+		},
+		"pool uuid not found": {
+			err:    system.ErrPoolUUIDNotFound(uuid.New()),
+			expRes: true,
+		},
+		"member not found": {
+			err:    wrapError(system.ErrMemberRankNotFound(ranklist.Rank(3))),
+			expRes: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expRes, IsNotFound(tc.err), "unexpected result")
+		})
+	}
+}
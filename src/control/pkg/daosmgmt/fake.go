@@ -0,0 +1,807 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"context"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	chkpb "github.com/daos-stack/daos/src/control/common/proto/chk"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/checker"
+)
+
+const (
+	// fakeTargetsPerRank is the number of targets of each rank of a Fake.
+	fakeTargetsPerRank = 8
+	// fakeSvcReps is the default number of pool service replicas of a
+	// pool created in a Fake.
+	fakeSvcReps = 3
+)
+
+type (
+	// FakeHost describes a DAOS server of a Fake.
+	FakeHost struct {
+		// Addr is the control address of the server, as "ip:port".
+		Addr string
+		// Ranks lists the ranks of the engines of the server.
+		Ranks []uint32
+		// ScmBytes and NvmeBytes are the storage capacity of the
+		// server, which is reported by storage scans and used to
+		// create pools.
+		ScmBytes  uint64
+		NvmeBytes uint64
+		// Metrics are the metrics published by the server.
+		Metrics []*Metric
+	}
+
+	fakeMember struct {
+		uuid  uuid.UUID
+		host  *FakeHost
+		state system.MemberState
+	}
+
+	fakePool struct {
+		uuid      uuid.UUID
+		label     string
+		ranks     []uint32
+		svcReps   []uint32
+		tierBytes []uint64
+	}
+
+	// Fake is an in-memory DAOS system for use in tests. Its Client
+	// supports the pool, system, storage, checker and telemetry operations
+	// of this package, and they change the state of the Fake as they would
+	// change the state of a real system. Requests that the Fake does not
+	// model are handled by a control.MockInvoker.
+	Fake struct {
+		*control.MockInvoker
+
+		sync.Mutex
+		hosts       []*FakeHost
+		members     map[uint32]*fakeMember
+		pools       map[uuid.UUID]*fakePool
+		failures    []error
+		leaderReqs  map[*control.LeaderQueryReq]bool
+		chkEnabled  bool
+		chkStatus   chkpb.CheckInstStatus
+		chkStart    time.Time
+		chkPools    []string
+		chkReports  []*chkpb.CheckReport
+		chkFindings uint64
+	}
+)
+
+// NewFake returns an empty Fake. Requests that the Fake does not model are
+// handled by a control.MockInvoker with the given configuration, which may
+// be nil.
+func NewFake(cfg *control.MockInvokerConfig) *Fake {
+	return &Fake{
+		MockInvoker: control.NewMockInvoker(logging.NewCombinedLogger("fake", io.Discard), cfg),
+		members:     make(map[uint32]*fakeMember),
+		pools:       make(map[uuid.UUID]*fakePool),
+		leaderReqs:  make(map[*control.LeaderQueryReq]bool),
+		chkStatus:   chkpb.CheckInstStatus_CIS_INIT,
+	}
+}
+
+// Client returns a Client of the Fake.
+func (f *Fake) Client() *Client {
+	return &Client{
+		invoker:      f,
+		metricsList:  f.metricsList,
+		metricsQuery: f.metricsQuery,
+	}
+}
+
+// AddHost adds a DAOS server to the Fake. Its ranks join the system.
+func (f *Fake) AddHost(host *FakeHost) error {
+	f.Lock()
+	defer f.Unlock()
+
+	if _, err := net.ResolveTCPAddr("tcp", host.Addr); err != nil {
+		return errors.Wrapf(err, "invalid host address %q", host.Addr)
+	}
+	for _, rank := range host.Ranks {
+		if _, found := f.members[rank]; found {
+			return system.ErrRankExists(ranklist.Rank(rank))
+		}
+	}
+
+	f.hosts = append(f.hosts, host)
+	for _, rank := range host.Ranks {
+		f.members[rank] = &fakeMember{
+			uuid:  uuid.New(),
+			host:  host,
+			state: system.MemberStateJoined,
+		}
+	}
+
+	return nil
+}
+
+// AddCheckerFinding adds a finding to those reported by the system checker.
+// The finding is given the next sequence number if its Seq is zero.
+func (f *Fake) AddCheckerFinding(finding *CheckerFinding) error {
+	var cls control.SystemCheckFindingClass
+	if err := cls.FromString(finding.Class); err != nil {
+		return err
+	}
+	var act control.SystemCheckRepairAction
+	if err := act.FromString(finding.Action); err != nil {
+		return err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	f.chkFindings++
+	rpt := &chkpb.CheckReport{
+		Seq:       finding.Seq,
+		Class:     chkpb.CheckInconsistClass(cls),
+		Action:    chkpb.CheckInconsistAction(act),
+		Rank:      finding.Rank,
+		PoolUuid:  finding.PoolUUID,
+		PoolLabel: finding.PoolLabel,
+		ContUuid:  finding.ContUUID,
+		ContLabel: finding.ContLabel,
+		Msg:       finding.Message,
+	}
+	if rpt.Seq == 0 {
+		rpt.Seq = f.chkFindings
+	}
+	for _, choice := range finding.RepairChoices {
+		rpt.ActChoices = append(rpt.ActChoices, chkpb.CheckInconsistAction(choice.Action))
+		rpt.ActMsgs = append(rpt.ActMsgs, choice.Info)
+		rpt.ActDetails = append(rpt.ActDetails, "")
+	}
+	f.chkReports = append(f.chkReports, rpt)
+
+	return nil
+}
+
+// FailNext makes the next request to the Fake fail with the given error.
+func (f *Fake) FailNext(err error) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.failures = append(f.failures, err)
+}
+
+// leaderAddr returns the address that management service responses are
+// returned from.
+func (f *Fake) leaderAddr() string {
+	if len(f.hosts) == 0 {
+		return "localhost"
+	}
+	return f.hosts[0].Addr
+}
+
+func (f *Fake) msResponse(err error, msg proto.Message) *control.UnaryResponse {
+	return control.MockMSResponse(f.leaderAddr(), err, msg)
+}
+
+// InvokeUnaryRPC handles the requests modeled by the Fake and passes any
+// others to its control.MockInvoker.
+func (f *Fake) InvokeUnaryRPC(ctx context.Context, req control.UnaryRequest) (*control.UnaryResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	if len(f.failures) > 0 {
+		err := f.failures[0]
+		f.failures = f.failures[1:]
+		if _, isScan := req.(*control.StorageScanReq); isScan {
+			return f.hostResponses(req, func(*FakeHost) (proto.Message, error) {
+				return nil, err
+			}), nil
+		}
+		return f.msResponse(err, nil), nil
+	}
+
+	var msg proto.Message
+	var err error
+	switch req := req.(type) {
+	case *control.PoolCreateReq:
+		msg, err = f.poolCreate(req)
+	case *control.PoolDestroyReq:
+		msg, err = f.poolDestroy(req)
+	case *control.PoolEvictReq:
+		msg, err = f.poolEvict(req)
+	case *control.PoolQueryReq:
+		msg, err = f.poolQuery(req)
+	case *control.ListPoolsReq:
+		msg, err = f.listPools()
+	case *control.SystemQueryReq:
+		msg, err = f.systemQuery(req)
+	case *control.SystemStartReq:
+		msg, err = f.systemStart(req)
+	case *control.SystemStopReq:
+		msg, err = f.systemStop(req)
+	case *control.LeaderQueryReq:
+		return f.leaderQuery(req), nil
+	case *control.StorageScanReq:
+		return f.hostResponses(req, f.storageScan), nil
+	case *control.SystemCheckEnableReq:
+		msg, err = f.checkEnable()
+	case *control.SystemCheckDisableReq:
+		msg, err = f.checkDisable()
+	case *control.SystemCheckStartReq:
+		msg, err = f.checkStart(req)
+	case *control.SystemCheckStopReq:
+		msg, err = f.checkStop()
+	case *control.SystemCheckQueryReq:
+		msg, err = f.checkQuery(req)
+	case *control.SystemCheckRepairReq:
+		msg, err = f.checkRepair(req)
+	default:
+		return f.MockInvoker.InvokeUnaryRPC(ctx, req)
+	}
+
+	return f.msResponse(err, msg), nil
+}
+
+// hostResponses returns a response from each of the hosts in the request's
+// host list, or from all hosts if the list is empty.
+func (f *Fake) hostResponses(req control.UnaryRequest, respFn func(*FakeHost) (proto.Message, error)) *control.UnaryResponse {
+	var hostList []string
+	if r, ok := req.(*control.StorageScanReq); ok {
+		hostList = r.HostList
+	}
+
+	ur := new(control.UnaryResponse)
+	for _, host := range f.hosts {
+		if len(hostList) > 0 && !hostInList(host.Addr, hostList) {
+			continue
+		}
+		msg, err := respFn(host)
+		ur.Responses = append(ur.Responses, &control.HostResponse{
+			Addr:    host.Addr,
+			Error:   err,
+			Message: msg,
+		})
+	}
+
+	return ur
+}
+
+// hostInList returns true if the address or hostname of the host is in the
+// list.
+func hostInList(addr string, list []string) bool {
+	name, _, _ := net.SplitHostPort(addr)
+	for _, entry := range list {
+		if entry == addr || entry == name {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedRanks returns the ranks of the system in ascending order.
+func (f *Fake) sortedRanks() []uint32 {
+	ranks := make([]uint32, 0, len(f.members))
+	for rank := range f.members {
+		ranks = append(ranks, rank)
+	}
+	sort.Slice(ranks, func(i, j int) bool { return ranks[i] < ranks[j] })
+	return ranks
+}
+
+func (f *Fake) findPool(id string) (*fakePool, error) {
+	if poolUUID, err := uuid.Parse(id); err == nil {
+		if p, found := f.pools[poolUUID]; found {
+			return p, nil
+		}
+		return nil, system.ErrPoolUUIDNotFound(poolUUID)
+	}
+
+	for _, p := range f.pools {
+		if p.label == id {
+			return p, nil
+		}
+	}
+	return nil, system.ErrPoolLabelNotFound(id)
+}
+
+func (f *Fake) poolCreate(req *control.PoolCreateReq) (proto.Message, error) {
+	if f.chkEnabled {
+		return nil, checker.FaultCheckerEnabled
+	}
+
+	p := &fakePool{uuid: req.UUID}
+	if p.uuid == uuid.Nil {
+		p.uuid = uuid.New()
+	}
+	for _, prop := range req.Properties {
+		if prop.Name == "label" {
+			p.label = prop.Value.String()
+		}
+	}
+	for _, other := range f.pools {
+		if p.label != "" && other.label == p.label {
+			return nil, &fault.Fault{
+				Domain:      "server",
+				Code:        code.ServerPoolDuplicateLabel,
+				Description: "pool label \"" + p.label + "\" already exists in the system",
+				Resolution:  "retry the request with a unique pool label",
+			}
+		}
+	}
+
+	p.ranks = ranklist.RanksToUint32(req.Ranks)
+	if len(p.ranks) == 0 {
+		p.ranks = f.sortedRanks()
+		if req.NumRanks > 0 && int(req.NumRanks) < len(p.ranks) {
+			p.ranks = p.ranks[:req.NumRanks]
+		}
+	}
+	if len(p.ranks) == 0 {
+		return nil, errors.New("no ranks available to create the pool on")
+	}
+	for _, rank := range p.ranks {
+		if _, found := f.members[rank]; !found {
+			return nil, system.ErrMemberRankNotFound(ranklist.Rank(rank))
+		}
+	}
+
+	p.tierBytes = req.TierBytes
+	if req.TotalBytes > 0 {
+		p.tierBytes = make([]uint64, len(req.TierRatio))
+		for i, ratio := range req.TierRatio {
+			p.tierBytes[i] = uint64(float64(req.TotalBytes)*ratio) / uint64(len(p.ranks))
+		}
+	}
+
+	numSvcReps := int(req.NumSvcReps)
+	if numSvcReps == 0 {
+		numSvcReps = fakeSvcReps
+	}
+	if numSvcReps > len(p.ranks) {
+		numSvcReps = len(p.ranks)
+	}
+	p.svcReps = p.ranks[:numSvcReps]
+
+	f.pools[p.uuid] = p
+
+	return &mgmtpb.PoolCreateResp{
+		SvcLdr:    p.svcReps[0],
+		SvcReps:   p.svcReps,
+		TgtRanks:  p.ranks,
+		TierBytes: p.tierBytes,
+	}, nil
+}
+
+func (f *Fake) poolDestroy(req *control.PoolDestroyReq) (proto.Message, error) {
+	p, err := f.findPool(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	delete(f.pools, p.uuid)
+
+	return new(mgmtpb.PoolDestroyResp), nil
+}
+
+func (f *Fake) poolEvict(req *control.PoolEvictReq) (proto.Message, error) {
+	if _, err := f.findPool(req.ID); err != nil {
+		return nil, err
+	}
+
+	return new(mgmtpb.PoolEvictResp), nil
+}
+
+func (f *Fake) poolQuery(req *control.PoolQueryReq) (proto.Message, error) {
+	p, err := f.findPool(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	numRanks := uint64(len(p.ranks))
+	resp := &mgmtpb.PoolQueryResp{
+		Uuid:          p.uuid.String(),
+		Label:         p.label,
+		State:         mgmtpb.PoolServiceState_Ready,
+		TotalTargets:  uint32(numRanks * fakeTargetsPerRank),
+		ActiveTargets: uint32(numRanks * fakeTargetsPerRank),
+		TotalEngines:  uint32(numRanks),
+		SvcLdr:        p.svcReps[0],
+		SvcReps:       p.svcReps,
+		Rebuild:       &mgmtpb.PoolRebuildStatus{State: mgmtpb.PoolRebuildStatus_IDLE},
+	}
+	for i, tb := range p.tierBytes {
+		mediaType := mgmtpb.StorageMediaType_SCM
+		if i > 0 {
+			mediaType = mgmtpb.StorageMediaType_NVME
+		}
+		perTarget := tb / fakeTargetsPerRank
+		resp.TierStats = append(resp.TierStats, &mgmtpb.StorageUsageStats{
+			Total:     tb * numRanks,
+			Free:      tb * numRanks,
+			Min:       perTarget,
+			Max:       perTarget,
+			Mean:      perTarget,
+			MediaType: mediaType,
+		})
+	}
+
+	return resp, nil
+}
+
+func (f *Fake) listPools() (proto.Message, error) {
+	if f.chkEnabled {
+		return nil, checker.FaultCheckerEnabled
+	}
+
+	resp := new(mgmtpb.ListPoolsResp)
+	for _, p := range f.pools {
+		resp.Pools = append(resp.Pools, &mgmtpb.ListPoolsResp_Pool{
+			Uuid:    p.uuid.String(),
+			Label:   p.label,
+			SvcReps: p.svcReps,
+			State:   daos.PoolServiceStateReady.String(),
+		})
+	}
+
+	return resp, nil
+}
+
+// selectMembers returns the ranks selected by the given ranks and hosts, and
+// those of the given ranks and hosts that are not in the system.
+func (f *Fake) selectMembers(ranks *ranklist.RankSet, hosts []string) (selected []uint32, absentRanks *ranklist.RankSet, absentHosts []string) {
+	absentRanks = new(ranklist.RankSet)
+	wanted := make(map[uint32]bool)
+	for _, rank := range ranks.Ranks() {
+		if _, found := f.members[rank.Uint32()]; !found {
+			absentRanks.Add(rank)
+			continue
+		}
+		wanted[rank.Uint32()] = true
+	}
+	for _, host := range hosts {
+		var found bool
+		for _, rank := range f.sortedRanks() {
+			if hostInList(f.members[rank].host.Addr, []string{host}) {
+				wanted[rank] = true
+				found = true
+			}
+		}
+		if !found {
+			absentHosts = append(absentHosts, host)
+		}
+	}
+
+	all := ranks.Count() == 0 && len(hosts) == 0
+	for _, rank := range f.sortedRanks() {
+		if all || wanted[rank] {
+			selected = append(selected, rank)
+		}
+	}
+
+	return
+}
+
+func (f *Fake) systemQuery(req *control.SystemQueryReq) (proto.Message, error) {
+	ranks, absentRanks, absentHosts := f.selectMembers(&req.Ranks, req.Hosts.Slice())
+
+	resp := &mgmtpb.SystemQueryResp{
+		Absentranks: absentRanks.String(),
+		Absenthosts: strings.Join(absentHosts, ","),
+	}
+	for _, rank := range ranks {
+		m := f.members[rank]
+		resp.Members = append(resp.Members, &mgmtpb.SystemMember{
+			Addr:      m.host.Addr,
+			Uuid:      m.uuid.String(),
+			Rank:      rank,
+			State:     m.state.String(),
+			FabricUri: "tcp://" + m.host.Addr,
+		})
+	}
+
+	return resp, nil
+}
+
+// setMemberStates sets the state of the selected members and returns the
+// result for each of them.
+func (f *Fake) setMemberStates(req interface{}, action string, state system.MemberState) ([]*sharedpb.RankResult, string, string) {
+	var ranks *ranklist.RankSet
+	var hosts []string
+	switch req := req.(type) {
+	case *control.SystemStartReq:
+		ranks, hosts = &req.Ranks, req.Hosts.Slice()
+	case *control.SystemStopReq:
+		ranks, hosts = &req.Ranks, req.Hosts.Slice()
+	}
+
+	selected, absentRanks, absentHosts := f.selectMembers(ranks, hosts)
+
+	var results []*sharedpb.RankResult
+	for _, rank := range selected {
+		m := f.members[rank]
+		if m.state != system.MemberStateAdminExcluded {
+			m.state = state
+		}
+		results = append(results, &sharedpb.RankResult{
+			Rank:   rank,
+			Action: action,
+			State:  m.state.String(),
+			Addr:   m.host.Addr,
+		})
+	}
+
+	return results, absentRanks.String(), strings.Join(absentHosts, ",")
+}
+
+func (f *Fake) systemStart(req *control.SystemStartReq) (proto.Message, error) {
+	results, absentRanks, absentHosts := f.setMemberStates(req, "start", system.MemberStateJoined)
+
+	return &mgmtpb.SystemStartResp{
+		Results:     results,
+		Absentranks: absentRanks,
+		Absenthosts: absentHosts,
+	}, nil
+}
+
+func (f *Fake) systemStop(req *control.SystemStopReq) (proto.Message, error) {
+	results, absentRanks, absentHosts := f.setMemberStates(req, "stop", system.MemberStateStopped)
+
+	return &mgmtpb.SystemStopResp{
+		Results:     results,
+		Absentranks: absentRanks,
+		Absenthosts: absentHosts,
+	}, nil
+}
+
+// leaderQuery handles the two requests made by control.LeaderQuery: one to
+// the management service for the leader and replicas, and one to each of the
+// replicas to check whether they are up.
+func (f *Fake) leaderQuery(req *control.LeaderQueryReq) *control.UnaryResponse {
+	resp := &mgmtpb.LeaderQueryResp{CurrentLeader: f.leaderAddr()}
+	for _, host := range f.hosts {
+		resp.Replicas = append(resp.Replicas, host.Addr)
+	}
+
+	if !f.leaderReqs[req] {
+		f.leaderReqs[req] = true
+		return f.msResponse(nil, resp)
+	}
+	delete(f.leaderReqs, req)
+
+	ur := new(control.UnaryResponse)
+	for _, host := range f.hosts {
+		ur.Responses = append(ur.Responses, &control.HostResponse{
+			Addr:    host.Addr,
+			Message: resp,
+		})
+	}
+	return ur
+}
+
+func (f *Fake) storageScan(host *FakeHost) (proto.Message, error) {
+	resp := &ctlpb.StorageScanResp{
+		Nvme: new(ctlpb.ScanNvmeResp),
+		Scm:  new(ctlpb.ScanScmResp),
+	}
+	if host.NvmeBytes > 0 {
+		resp.Nvme.Ctrlrs = []*ctlpb.NvmeController{
+			{
+				PciAddr: "0000:01:00.0",
+				Namespaces: []*ctlpb.NvmeController_Namespace{
+					{Id: 1, Size: host.NvmeBytes},
+				},
+			},
+		}
+	}
+	if host.ScmBytes > 0 {
+		resp.Scm.Namespaces = []*ctlpb.ScmNamespace{
+			{Blockdev: "pmem0", Size: host.ScmBytes},
+		}
+	}
+
+	return resp, nil
+}
+
+func (f *Fake) checkEnable() (proto.Message, error) {
+	var running []string
+	for _, rank := range f.sortedRanks() {
+		switch f.members[rank].state {
+		case system.MemberStateStopped, system.MemberStateAdminExcluded:
+		default:
+			running = append(running, strconv.FormatUint(uint64(rank), 10))
+		}
+	}
+	if len(running) > 0 {
+		return nil, checker.FaultIncorrectMemberStates(true, strings.Join(running, ","),
+			system.MemberStateStopped.String())
+	}
+	f.chkEnabled = true
+
+	return new(mgmtpb.DaosResp), nil
+}
+
+func (f *Fake) checkDisable() (proto.Message, error) {
+	if !f.chkEnabled {
+		return nil, checker.FaultCheckerNotEnabled
+	}
+	f.chkEnabled = false
+
+	return new(mgmtpb.DaosResp), nil
+}
+
+func (f *Fake) checkStart(req *control.SystemCheckStartReq) (proto.Message, error) {
+	if !f.chkEnabled {
+		return nil, checker.FaultCheckerNotEnabled
+	}
+
+	f.chkPools = req.Uuids
+	if len(f.chkPools) == 0 {
+		f.chkPools = nil
+		for _, p := range f.pools {
+			f.chkPools = append(f.chkPools, p.uuid.String())
+		}
+	}
+	sort.Strings(f.chkPools)
+	f.chkStatus = chkpb.CheckInstStatus_CIS_RUNNING
+	f.chkStart = time.Now()
+
+	return new(mgmtpb.CheckStartResp), nil
+}
+
+func (f *Fake) checkStop() (proto.Message, error) {
+	if !f.chkEnabled {
+		return nil, checker.FaultCheckerNotEnabled
+	}
+	f.chkStatus = chkpb.CheckInstStatus_CIS_STOPPED
+
+	return new(mgmtpb.CheckStopResp), nil
+}
+
+func (f *Fake) checkQuery(req *control.SystemCheckQueryReq) (proto.Message, error) {
+	if !f.chkEnabled {
+		return nil, checker.FaultCheckerNotEnabled
+	}
+
+	resp := &mgmtpb.CheckQueryResp{
+		InsStatus: f.chkStatus,
+		InsPhase:  chkpb.CheckScanPhase_CSP_PREPARE,
+		Time:      &mgmtpb.CheckQueryTime{StartTime: uint64(f.chkStart.Unix())},
+		Reports:   f.chkReports,
+	}
+	if f.chkStatus == chkpb.CheckInstStatus_CIS_INIT {
+		return resp, nil
+	}
+
+	poolStatus := chkpb.CheckPoolStatus_CPS_CHECKING
+	if f.chkStatus == chkpb.CheckInstStatus_CIS_STOPPED {
+		poolStatus = chkpb.CheckPoolStatus_CPS_STOPPED
+	}
+	for _, poolUUID := range f.chkPools {
+		if len(req.Uuids) > 0 && !hostInList(poolUUID, req.Uuids) {
+			continue
+		}
+		resp.Pools = append(resp.Pools, &mgmtpb.CheckQueryPool{
+			Uuid:   poolUUID,
+			Status: poolStatus,
+			Phase:  chkpb.CheckScanPhase_CSP_PREPARE,
+			Time:   &mgmtpb.CheckQueryTime{StartTime: uint64(f.chkStart.Unix())},
+		})
+	}
+
+	return resp, nil
+}
+
+func (f *Fake) checkRepair(req *control.SystemCheckRepairReq) (proto.Message, error) {
+	if !f.chkEnabled {
+		return nil, checker.FaultCheckerNotEnabled
+	}
+
+	for _, rpt := range f.chkReports {
+		if rpt.Seq != req.Seq {
+			continue
+		}
+		for _, choice := range rpt.ActChoices {
+			if choice == req.Act {
+				rpt.Action = req.Act
+				rpt.ActChoices, rpt.ActMsgs, rpt.ActDetails = nil, nil, nil
+				return new(mgmtpb.CheckActResp), nil
+			}
+		}
+		return nil, errors.Errorf("action %s is not a repair choice of finding %d",
+			control.SystemCheckRepairAction(req.Act), req.Seq)
+	}
+
+	return nil, errors.Errorf("finding %d not found", req.Seq)
+}
+
+func (f *Fake) findHost(name string) (*FakeHost, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	for _, host := range f.hosts {
+		if hostInList(host.Addr, []string{name}) {
+			return host, nil
+		}
+	}
+	return nil, errors.Errorf("unable to connect to telemetry of host %s", name)
+}
+
+func metricType(typeStr string) daos.MetricType {
+	for _, mt := range []daos.MetricType{
+		daos.MetricTypeGeneric, daos.MetricTypeCounter, daos.MetricTypeGauge,
+		daos.MetricTypeSummary, daos.MetricTypeHistogram,
+	} {
+		if strings.EqualFold(typeStr, mt.String()) {
+			return mt
+		}
+	}
+	return daos.MetricTypeUnknown
+}
+
+func (f *Fake) metricsList(_ context.Context, req *control.MetricsListReq) (*control.MetricsListResp, error) {
+	host, err := f.findHost(req.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(control.MetricsListResp)
+	for _, m := range host.Metrics {
+		resp.AvailableMetricSets = append(resp.AvailableMetricSets, &daos.MetricSet{
+			Name:        m.Name,
+			Description: m.Description,
+			Type:        metricType(m.Type),
+		})
+	}
+	return resp, nil
+}
+
+func (f *Fake) metricsQuery(_ context.Context, req *control.MetricsQueryReq) (*control.MetricsQueryResp, error) {
+	host, err := f.findHost(req.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(control.MetricsQueryResp)
+	for _, m := range host.Metrics {
+		if len(req.MetricNames) > 0 && !hostInList(m.Name, req.MetricNames) {
+			continue
+		}
+		ms := &daos.MetricSet{
+			Name:        m.Name,
+			Description: m.Description,
+			Type:        metricType(m.Type),
+		}
+		for _, s := range m.Samples {
+			switch ms.Type {
+			case daos.MetricTypeSummary:
+				ms.Metrics = append(ms.Metrics, &daos.SummaryMetric{Labels: s.Labels, SampleCount: s.Count, SampleSum: s.Sum})
+			case daos.MetricTypeHistogram:
+				ms.Metrics = append(ms.Metrics, &daos.HistogramMetric{Labels: s.Labels, SampleCount: s.Count, SampleSum: s.Sum})
+			default:
+				ms.Metrics = append(ms.Metrics, &daos.SimpleMetric{Labels: s.Labels, Value: s.Value})
+			}
+		}
+		resp.MetricSets = append(resp.MetricSets, ms)
+	}
+	return resp, nil
+}
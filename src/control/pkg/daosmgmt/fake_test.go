@@ -0,0 +1,77 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// newTestFake returns a Fake with two hosts of two ranks each.
+func newTestFake(t *testing.T) *Fake {
+	t.Helper()
+
+	fake := NewFake(nil)
+	for _, host := range []*FakeHost{
+		{
+			Addr:      "10.0.0.1:10001",
+			Ranks:     []uint32{0, 1},
+			ScmBytes:  100 * humanize.GByte,
+			NvmeBytes: 2 * humanize.TByte,
+		},
+		{
+			Addr:      "10.0.0.2:10001",
+			Ranks:     []uint32{2, 3},
+			ScmBytes:  100 * humanize.GByte,
+			NvmeBytes: 2 * humanize.TByte,
+		},
+	} {
+		if err := fake.AddHost(host); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return fake
+}
+
+func TestDaosmgmt_Fake_AddHost(t *testing.T) {
+	for name, tc := range map[string]struct {
+		host   *FakeHost
+		expErr error
+	}{
+		"invalid address": {
+			host:   &FakeHost{Addr: "10.0.0.3", Ranks: []uint32{4}},
+			expErr: errors.New("invalid host address"),
+		},
+		"duplicate rank": {
+			host:   &FakeHost{Addr: "10.0.0.3:10001", Ranks: []uint32{4, 1}},
+			expErr: system.ErrRankExists(ranklist.Rank(1)),
+		},
+		"success": {
+			host: &FakeHost{Addr: "10.0.0.3:10001", Ranks: []uint32{4, 5}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			fake := newTestFake(t)
+
+			gotErr := fake.AddHost(tc.host)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			expMembers := 4
+			if tc.expErr == nil {
+				expMembers += len(tc.host.Ranks)
+			}
+			test.AssertEqual(t, expMembers, len(fake.members), "unexpected number of members")
+		})
+	}
+}
@@ -0,0 +1,277 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// defaultTierRatio is the share of the pool size given to each storage tier
+// if the size of a pool is set without a tier ratio, as in dmg.
+var defaultTierRatio = []float64{0.06, 0.94}
+
+type (
+	// PoolService provides the pool operations of a Client.
+	PoolService struct {
+		c *Client
+	}
+
+	// PoolTier describes the usage of a storage tier of a pool.
+	PoolTier struct {
+		Name      string `json:"name"`
+		Size      uint64 `json:"size"`
+		Free      uint64 `json:"free"`
+		Imbalance uint32 `json:"imbalance"`
+	}
+
+	// Pool describes a DAOS pool.
+	Pool struct {
+		UUID            string      `json:"uuid"`
+		Label           string      `json:"label,omitempty"`
+		State           string      `json:"state,omitempty"`
+		ServiceLeader   uint32      `json:"svc_ldr"`
+		ServiceReplicas []uint32    `json:"svc_reps,omitempty"`
+		TotalTargets    uint32      `json:"total_targets"`
+		ActiveTargets   uint32      `json:"active_targets"`
+		DisabledTargets uint32      `json:"disabled_targets"`
+		TotalEngines    uint32      `json:"total_engines"`
+		RebuildState    string      `json:"rebuild_state,omitempty"`
+		Tiers           []*PoolTier `json:"tiers,omitempty"`
+		// QueryError is set by List if the pool could not be queried,
+		// in which case only the identity and state of the pool are set.
+		QueryError *Error `json:"query_error,omitempty"`
+	}
+
+	// PoolCreateRequest contains the parameters of a new pool. The size of
+	// the pool is set either with Size, optionally with TierRatio and
+	// NumRanks, or with TierBytes.
+	PoolCreateRequest struct {
+		// Label is the label of the new pool.
+		Label string
+		// UUID is the UUID of the new pool. One is generated if unset.
+		UUID string
+		// Size is the total size of the pool, which is spread across
+		// the storage tiers according to TierRatio.
+		Size uint64
+		// TierRatio is the share of Size given to each storage tier. It
+		// defaults to 6% SCM and 94% NVMe.
+		TierRatio []float64
+		// NumRanks is the number of ranks to spread the pool across. By
+		// default, the pool is spread across all ranks.
+		NumRanks uint32
+		// Ranks lists the ranks to create the pool on.
+		Ranks []uint32
+		// TierBytes is the size of each storage tier of the pool on
+		// each rank, if Size is not set.
+		TierBytes []uint64
+		// NumServiceReplicas is the number of pool service replicas.
+		NumServiceReplicas uint32
+		// Properties are the pool properties to set, by name.
+		Properties map[string]string
+		// User and Group own the pool. They default to the caller.
+		User  string
+		Group string
+	}
+
+	// PoolDestroyOptions contains the options of a pool destroy.
+	PoolDestroyOptions struct {
+		// Force destroys the pool even if it has active connections.
+		Force bool
+		// Recursive destroys the pool even if it contains containers.
+		Recursive bool
+	}
+)
+
+func poolFromInfo(pi *daos.PoolInfo) *Pool {
+	p := &Pool{
+		UUID:            pi.UUID.String(),
+		Label:           pi.Label,
+		State:           pi.State.String(),
+		ServiceLeader:   pi.ServiceLeader,
+		ServiceReplicas: ranklist.RanksToUint32(pi.ServiceReplicas),
+		TotalTargets:    pi.TotalTargets,
+		ActiveTargets:   pi.ActiveTargets,
+		DisabledTargets: pi.DisabledTargets,
+		TotalEngines:    pi.TotalEngines,
+	}
+	if pi.Rebuild != nil {
+		p.RebuildState = pi.RebuildState()
+	}
+	for _, tu := range pi.Usage() {
+		p.Tiers = append(p.Tiers, &PoolTier{
+			Name:      tu.TierName,
+			Size:      tu.Size,
+			Free:      tu.Free,
+			Imbalance: tu.Imbalance,
+		})
+	}
+
+	return p
+}
+
+func (r *PoolCreateRequest) toControl() (*control.PoolCreateReq, error) {
+	if r == nil {
+		return nil, errors.New("nil pool create request")
+	}
+
+	req := &control.PoolCreateReq{
+		User:       r.User,
+		UserGroup:  r.Group,
+		NumSvcReps: r.NumServiceReplicas,
+		NumRanks:   r.NumRanks,
+		Ranks:      ranklist.RanksFromUint32(r.Ranks),
+	}
+
+	// Always generate the UUID here, so that it is known to the caller
+	// whether or not it is returned by the server.
+	req.UUID = uuid.New()
+	if r.UUID != "" {
+		var err error
+		if req.UUID, err = uuid.Parse(r.UUID); err != nil {
+			return nil, errors.Wrapf(err, "invalid pool UUID %q", r.UUID)
+		}
+	}
+
+	switch {
+	case r.Size > 0 && len(r.TierBytes) > 0:
+		return nil, errors.New("pool size and tier bytes may not both be set")
+	case r.Size > 0:
+		req.TotalBytes = r.Size
+		req.TierRatio = r.TierRatio
+		if len(req.TierRatio) == 0 {
+			req.TierRatio = defaultTierRatio
+		}
+	case len(r.TierBytes) > 0:
+		req.TierBytes = r.TierBytes
+	default:
+		return nil, errors.New("pool size or tier bytes must be set")
+	}
+
+	props := make(map[string]string)
+	for name, value := range r.Properties {
+		props[name] = value
+	}
+	if r.Label != "" {
+		if _, found := props["label"]; found {
+			return nil, errors.New("pool label and label property may not both be set")
+		}
+		props["label"] = r.Label
+	}
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	propHdlrs := daos.PoolProperties()
+	for _, name := range names {
+		hdlr, found := propHdlrs[name]
+		if !found {
+			return nil, errors.Errorf("unknown pool property %q", name)
+		}
+		prop := hdlr.GetProperty(name)
+		if err := prop.SetValue(props[name]); err != nil {
+			return nil, err
+		}
+		req.Properties = append(req.Properties, prop)
+	}
+
+	return req, nil
+}
+
+// Create creates a pool and returns its identity and pool service. Use Query
+// to retrieve the rest of the pool's details.
+func (s *PoolService) Create(ctx context.Context, r *PoolCreateRequest) (*Pool, error) {
+	req, err := r.toControl()
+	if err != nil {
+		return nil, err
+	}
+	applyCallOptions(ctx, req)
+
+	resp, err := control.PoolCreate(ctx, s.c.invoker, req)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return &Pool{
+		UUID:            req.UUID.String(),
+		Label:           r.Label,
+		State:           daos.PoolServiceStateReady.String(),
+		ServiceLeader:   resp.Leader,
+		ServiceReplicas: resp.SvcReps,
+	}, nil
+}
+
+// Destroy destroys the pool with the given label or UUID. The options may be
+// nil.
+func (s *PoolService) Destroy(ctx context.Context, id string, opts *PoolDestroyOptions) error {
+	req := &control.PoolDestroyReq{ID: id}
+	if opts != nil {
+		req.Force = opts.Force
+		req.Recursive = opts.Recursive
+	}
+	applyCallOptions(ctx, req)
+
+	return wrapError(control.PoolDestroy(ctx, s.c.invoker, req))
+}
+
+// Query returns the details of the pool with the given label or UUID.
+func (s *PoolService) Query(ctx context.Context, id string) (*Pool, error) {
+	req := &control.PoolQueryReq{
+		ID:        id,
+		QueryMask: daos.DefaultPoolQueryMask,
+	}
+	applyCallOptions(ctx, req)
+
+	resp, err := control.PoolQuery(ctx, s.c.invoker, req)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if resp.Status != 0 {
+		return nil, wrapError(daos.Status(resp.Status))
+	}
+
+	return poolFromInfo(&resp.PoolInfo), nil
+}
+
+// List returns the details of all pools in the system, sorted by label.
+func (s *PoolService) List(ctx context.Context) ([]*Pool, error) {
+	req := new(control.ListPoolsReq)
+	applyCallOptions(ctx, req)
+
+	resp, err := control.ListPools(ctx, s.c.invoker, req)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	pools := make([]*Pool, 0, len(resp.Pools))
+	for _, pi := range resp.Pools {
+		p := poolFromInfo(pi)
+		if err := resp.PoolQueryError(pi.UUID); err != nil {
+			p.QueryError = newError(err)
+		}
+		pools = append(pools, p)
+	}
+
+	return pools, nil
+}
+
+// Evict closes the connections to the pool with the given label or UUID.
+func (s *PoolService) Evict(ctx context.Context, id string) error {
+	req := &control.PoolEvictReq{ID: id}
+	applyCallOptions(ctx, req)
+
+	return wrapError(control.PoolEvict(ctx, s.c.invoker, req))
+}
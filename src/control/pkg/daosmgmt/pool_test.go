@@ -0,0 +1,182 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/fault/code"
+)
+
+func TestDaosmgmt_PoolCreateRequest_toControl(t *testing.T) {
+	testUUID := uuid.New()
+
+	for name, tc := range map[string]struct {
+		req          *PoolCreateRequest
+		expUUID      uuid.UUID
+		expTierRatio []float64
+		expProps     []string
+		expErr       error
+	}{
+		"nil request": {
+			expErr: errors.New("nil pool create request"),
+		},
+		"no size": {
+			req:    &PoolCreateRequest{Label: "tank"},
+			expErr: errors.New("size or tier bytes must be set"),
+		},
+		"size and tier bytes": {
+			req: &PoolCreateRequest{
+				Size:      humanize.TByte,
+				TierBytes: []uint64{humanize.GByte, humanize.TByte},
+			},
+			expErr: errors.New("may not both be set"),
+		},
+		"invalid uuid": {
+			req:    &PoolCreateRequest{UUID: "bad", Size: humanize.TByte},
+			expErr: errors.New("invalid pool UUID"),
+		},
+		"label and label property": {
+			req: &PoolCreateRequest{
+				Label:      "tank",
+				Size:       humanize.TByte,
+				Properties: map[string]string{"label": "other"},
+			},
+			expErr: errors.New("may not both be set"),
+		},
+		"unknown property": {
+			req: &PoolCreateRequest{
+				Size:       humanize.TByte,
+				Properties: map[string]string{"bogus": "on"},
+			},
+			expErr: errors.New("unknown pool property"),
+		},
+		"default tier ratio": {
+			req: &PoolCreateRequest{
+				Label: "tank",
+				UUID:  testUUID.String(),
+				Size:  humanize.TByte,
+			},
+			expUUID:      testUUID,
+			expTierRatio: defaultTierRatio,
+			expProps:     []string{"label"},
+		},
+		"properties": {
+			req: &PoolCreateRequest{
+				Label:      "tank",
+				UUID:       testUUID.String(),
+				TierBytes:  []uint64{humanize.GByte, humanize.TByte},
+				Properties: map[string]string{"reclaim": "lazy"},
+			},
+			expUUID:  testUUID,
+			expProps: []string{"label", "reclaim"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req, gotErr := tc.req.toControl()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expUUID, req.UUID, "unexpected pool UUID")
+			if diff := cmp.Diff(tc.expTierRatio, req.TierRatio); diff != "" {
+				t.Fatalf("unexpected tier ratio (-want, +got):\n%s\n", diff)
+			}
+			gotProps := make([]string, 0, len(req.Properties))
+			for _, prop := range req.Properties {
+				gotProps = append(gotProps, prop.Name)
+			}
+			if diff := cmp.Diff(tc.expProps, gotProps); diff != "" {
+				t.Fatalf("unexpected properties (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaosmgmt_PoolService(t *testing.T) {
+	ctx := test.Context(t)
+	fake := newTestFake(t)
+	pools := fake.Client().Pools()
+
+	created, err := pools.Create(ctx, &PoolCreateRequest{
+		Label:     "tank",
+		TierBytes: []uint64{humanize.GByte, 16 * humanize.GByte},
+		NumRanks:  2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "tank", created.Label, "unexpected label")
+	if _, err := uuid.Parse(created.UUID); err != nil {
+		t.Fatalf("invalid pool UUID: %s", err)
+	}
+	if diff := cmp.Diff([]uint32{0, 1}, created.ServiceReplicas); diff != "" {
+		t.Fatalf("unexpected service replicas (-want, +got):\n%s\n", diff)
+	}
+
+	_, err = pools.Create(ctx, &PoolCreateRequest{Label: "tank", Size: humanize.TByte})
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *Error, got %v", err)
+	}
+	test.AssertEqual(t, int(code.ServerPoolDuplicateLabel), e.Code, "unexpected error code")
+
+	if _, err := pools.Create(ctx, &PoolCreateRequest{Label: "scratch", Size: humanize.TByte}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"tank", created.UUID} {
+		pool, err := pools.Query(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEqual(t, created.UUID, pool.UUID, "unexpected pool UUID")
+		test.AssertEqual(t, uint32(2*fakeTargetsPerRank), pool.TotalTargets, "unexpected total targets")
+		test.AssertEqual(t, 2, len(pool.Tiers), "unexpected number of tiers")
+		test.AssertEqual(t, uint64(2*humanize.GByte), pool.Tiers[0].Size, "unexpected SCM size")
+		test.AssertEqual(t, uint64(32*humanize.GByte), pool.Tiers[1].Size, "unexpected NVMe size")
+	}
+
+	list, err := pools.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotLabels := make([]string, 0, len(list))
+	for _, p := range list {
+		gotLabels = append(gotLabels, p.Label)
+		if p.QueryError != nil {
+			t.Fatalf("unexpected query error: %s", p.QueryError)
+		}
+	}
+	if diff := cmp.Diff([]string{"scratch", "tank"}, gotLabels); diff != "" {
+		t.Fatalf("unexpected pools (-want, +got):\n%s\n", diff)
+	}
+
+	if err := pools.Evict(ctx, "tank"); err != nil {
+		t.Fatal(err)
+	}
+	if err := pools.Destroy(ctx, "tank", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = pools.Query(ctx, "tank")
+	test.AssertTrue(t, IsNotFound(err), fmt.Sprintf("expected not found error, got %v", err))
+	err = pools.Destroy(ctx, "tank", &PoolDestroyOptions{Force: true})
+	test.AssertTrue(t, IsNotFound(err), fmt.Sprintf("expected not found error, got %v", err))
+
+	fake.FailNext(errors.New("whoops"))
+	_, err = pools.List(ctx)
+	test.CmpErr(t, errors.New("whoops"), err)
+}
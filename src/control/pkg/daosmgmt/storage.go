@@ -0,0 +1,61 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"context"
+	"sort"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+type (
+	// StorageService provides the storage operations of a Client.
+	StorageService struct {
+		c *Client
+	}
+
+	// HostStorage describes the storage of a set of hosts with identical
+	// storage.
+	HostStorage struct {
+		Hosts           []string `json:"hosts"`
+		ScmNamespaces   int      `json:"scm_namespaces"`
+		ScmBytes        uint64   `json:"scm_bytes"`
+		NvmeControllers int      `json:"nvme_controllers"`
+		NvmeBytes       uint64   `json:"nvme_bytes"`
+	}
+)
+
+// Scan returns the storage of the DAOS servers, grouped by hosts with
+// identical storage. A HostErrors error is returned along with the storage
+// of the other hosts if any host fails to scan its storage.
+func (s *StorageService) Scan(ctx context.Context) ([]*HostStorage, error) {
+	req := new(control.StorageScanReq)
+	applyCallOptions(ctx, req)
+
+	resp, err := control.StorageScan(ctx, s.c.invoker, req)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	out := make([]*HostStorage, 0, len(resp.HostStorage))
+	for _, key := range resp.HostStorage.Keys() {
+		hss := resp.HostStorage[key]
+		hs := hss.HostStorage
+		out = append(out, &HostStorage{
+			Hosts:           hss.HostSet.Slice(),
+			ScmNamespaces:   len(hs.ScmNamespaces),
+			ScmBytes:        hs.ScmNamespaces.Capacity(),
+			NvmeControllers: len(hs.NvmeDevices),
+			NvmeBytes:       hs.NvmeDevices.Capacity(),
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Hosts[0] < out[j].Hosts[0] })
+
+	return out, wrapHostErrors(resp.HostErrors)
+}
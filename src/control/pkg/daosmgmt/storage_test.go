@@ -0,0 +1,82 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestDaosmgmt_StorageService_Scan(t *testing.T) {
+	for name, tc := range map[string]struct {
+		hosts      []string
+		failNext   error
+		expStorage []*HostStorage
+		expErr     error
+	}{
+		"all hosts": {
+			expStorage: []*HostStorage{
+				{
+					Hosts:           []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+					ScmNamespaces:   1,
+					ScmBytes:        100 * humanize.GByte,
+					NvmeControllers: 1,
+					NvmeBytes:       2 * humanize.TByte,
+				},
+			},
+		},
+		"request hosts": {
+			hosts: []string{"10.0.0.2"},
+			expStorage: []*HostStorage{
+				{
+					Hosts:           []string{"10.0.0.2:10001"},
+					ScmNamespaces:   1,
+					ScmBytes:        100 * humanize.GByte,
+					NvmeControllers: 1,
+					NvmeBytes:       2 * humanize.TByte,
+				},
+			},
+		},
+		"host errors": {
+			failNext:   errors.New("scan failed"),
+			expStorage: []*HostStorage{},
+			expErr:     errors.New("scan failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			fake := newTestFake(t)
+			if tc.failNext != nil {
+				fake.FailNext(tc.failNext)
+			}
+
+			ctx := context.Context(test.Context(t))
+			if tc.hosts != nil {
+				ctx = WithRequestHosts(ctx, tc.hosts...)
+			}
+
+			gotStorage, gotErr := fake.Client().Storage().Scan(ctx)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				var he HostErrors
+				if !errors.As(gotErr, &he) {
+					t.Fatalf("expected HostErrors, got %T", gotErr)
+				}
+				test.AssertEqual(t, 2, len(he[0].Hosts), "unexpected number of failed hosts")
+			}
+
+			if diff := cmp.Diff(tc.expStorage, gotStorage); diff != "" {
+				t.Fatalf("unexpected storage (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// SystemService provides the system operations of a Client.
+	SystemService struct {
+		c *Client
+	}
+
+	// MemberSelection selects the system members that an operation
+	// applies to. All members are selected if it is empty.
+	MemberSelection struct {
+		Ranks []uint32
+		Hosts []string
+	}
+
+	// Member describes a member of the DAOS system, i.e. an engine.
+	Member struct {
+		Rank        uint32 `json:"rank"`
+		UUID        string `json:"uuid"`
+		Addr        string `json:"addr"`
+		FabricURI   string `json:"fabric_uri"`
+		State       string `json:"state"`
+		Info        string `json:"info,omitempty"`
+		FaultDomain string `json:"fault_domain,omitempty"`
+	}
+
+	// SystemStatus describes the members of the DAOS system.
+	SystemStatus struct {
+		Members []*Member `json:"members"`
+		// AbsentHosts and AbsentRanks list the selected hosts and
+		// ranks that are not members of the system.
+		AbsentHosts []string `json:"absent_hosts,omitempty"`
+		AbsentRanks []uint32 `json:"absent_ranks,omitempty"`
+	}
+
+	// RankResult describes the result of an operation on a system member.
+	RankResult struct {
+		Rank    uint32 `json:"rank"`
+		Addr    string `json:"addr"`
+		Action  string `json:"action"`
+		State   string `json:"state"`
+		Errored bool   `json:"errored"`
+		Message string `json:"msg,omitempty"`
+	}
+
+	// LeaderStatus describes the replicas of the management service.
+	LeaderStatus struct {
+		Leader       string   `json:"leader"`
+		Replicas     []string `json:"replicas"`
+		DownReplicas []string `json:"down_replicas,omitempty"`
+	}
+)
+
+func (ms *MemberSelection) ranksHosts() (*ranklist.RankSet, *hostlist.HostSet, error) {
+	if ms == nil {
+		return new(ranklist.RankSet), new(hostlist.HostSet), nil
+	}
+
+	hosts, err := hostlist.CreateSet(strings.Join(ms.Hosts, ","))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid host selection")
+	}
+
+	return ranklist.RankSetFromRanks(ranklist.RanksFromUint32(ms.Ranks)), hosts, nil
+}
+
+func memberFromSystem(sm *system.Member) *Member {
+	m := &Member{
+		Rank:      sm.Rank.Uint32(),
+		UUID:      sm.UUID.String(),
+		FabricURI: sm.PrimaryFabricURI,
+		State:     sm.State.String(),
+		Info:      sm.Info,
+	}
+	if sm.Addr != nil {
+		m.Addr = sm.Addr.String()
+	}
+	if sm.FaultDomain != nil {
+		m.FaultDomain = sm.FaultDomain.String()
+	}
+
+	return m
+}
+
+func rankResults(results system.MemberResults) []*RankResult {
+	out := make([]*RankResult, 0, len(results))
+	for _, mr := range results {
+		out = append(out, &RankResult{
+			Rank:    mr.Rank.Uint32(),
+			Addr:    mr.Addr,
+			Action:  mr.Action,
+			State:   mr.State.String(),
+			Errored: mr.Errored,
+			Message: mr.Msg,
+		})
+	}
+
+	return out
+}
+
+// Query returns the state of the selected system members. The selection may
+// be nil. An error is returned along with the status if any of the selected
+// hosts or ranks are not members of the system.
+func (s *SystemService) Query(ctx context.Context, sel *MemberSelection) (*SystemStatus, error) {
+	ranks, hosts, err := sel.ranksHosts()
+	if err != nil {
+		return nil, err
+	}
+	req := new(control.SystemQueryReq)
+	req.SetRanks(ranks)
+	req.SetHosts(hosts)
+	applyCallOptions(ctx, req)
+
+	resp, err := control.SystemQuery(ctx, s.c.invoker, req)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	status := &SystemStatus{
+		Members:     make([]*Member, 0, len(resp.Members)),
+		AbsentHosts: resp.AbsentHosts.Slice(),
+		AbsentRanks: ranklist.RanksToUint32(resp.AbsentRanks.Ranks()),
+	}
+	for _, sm := range resp.Members {
+		status.Members = append(status.Members, memberFromSystem(sm))
+	}
+
+	return status, wrapError(resp.Errors())
+}
+
+// Start starts the selected system members. The selection may be nil. An
+// error is returned along with the results if any member failed to start.
+func (s *SystemService) Start(ctx context.Context, sel *MemberSelection) ([]*RankResult, error) {
+	ranks, hosts, err := sel.ranksHosts()
+	if err != nil {
+		return nil, err
+	}
+	req := new(control.SystemStartReq)
+	req.SetRanks(ranks)
+	req.SetHosts(hosts)
+	applyCallOptions(ctx, req)
+
+	resp, err := control.SystemStart(ctx, s.c.invoker, req)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return rankResults(resp.Results), wrapError(resp.Errors())
+}
+
+// Stop stops the selected system members. The selection may be nil. Force
+// stops the members even if that leaves pools unavailable. An error is
+// returned along with the results if any member failed to stop.
+func (s *SystemService) Stop(ctx context.Context, sel *MemberSelection, force bool) ([]*RankResult, error) {
+	ranks, hosts, err := sel.ranksHosts()
+	if err != nil {
+		return nil, err
+	}
+	req := &control.SystemStopReq{Force: force}
+	req.SetRanks(ranks)
+	req.SetHosts(hosts)
+	applyCallOptions(ctx, req)
+
+	resp, err := control.SystemStop(ctx, s.c.invoker, req)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return rankResults(resp.Results), wrapError(resp.Errors())
+}
+
+// Leader returns the current management service leader and replicas.
+func (s *SystemService) Leader(ctx context.Context) (*LeaderStatus, error) {
+	req := new(control.LeaderQueryReq)
+	applyCallOptions(ctx, req)
+
+	resp, err := control.LeaderQuery(ctx, s.c.invoker, req)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return &LeaderStatus{
+		Leader:       resp.Leader,
+		Replicas:     resp.Replicas,
+		DownReplicas: resp.DownReplicas,
+	}, nil
+}
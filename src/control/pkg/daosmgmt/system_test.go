@@ -0,0 +1,140 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func memberStates(members []*Member) map[uint32]string {
+	states := make(map[uint32]string)
+	for _, m := range members {
+		states[m.Rank] = m.State
+	}
+	return states
+}
+
+func TestDaosmgmt_SystemService_Query(t *testing.T) {
+	for name, tc := range map[string]struct {
+		sel            *MemberSelection
+		expRanks       []uint32
+		expAbsentRanks []uint32
+		expAbsentHosts []string
+		expErr         error
+	}{
+		"all members": {
+			expRanks: []uint32{0, 1, 2, 3},
+		},
+		"selected ranks": {
+			sel:      &MemberSelection{Ranks: []uint32{1, 2}},
+			expRanks: []uint32{1, 2},
+		},
+		"selected host": {
+			sel:      &MemberSelection{Hosts: []string{"10.0.0.2"}},
+			expRanks: []uint32{2, 3},
+		},
+		"absent rank": {
+			sel:            &MemberSelection{Ranks: []uint32{1, 7}},
+			expRanks:       []uint32{1},
+			expAbsentRanks: []uint32{7},
+			expErr:         errors.New("non-existent rank"),
+		},
+		"invalid host": {
+			sel:    &MemberSelection{Hosts: []string{"host[1-"}},
+			expErr: errors.New("invalid host selection"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			client := newTestFake(t).Client()
+
+			status, gotErr := client.System().Query(test.Context(t), tc.sel)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if status == nil {
+				if tc.expRanks != nil {
+					t.Fatal("expected status")
+				}
+				return
+			}
+
+			gotRanks := make([]uint32, 0, len(status.Members))
+			for _, m := range status.Members {
+				gotRanks = append(gotRanks, m.Rank)
+				test.AssertEqual(t, "Joined", m.State, "unexpected member state")
+			}
+			if diff := cmp.Diff(tc.expRanks, gotRanks); diff != "" {
+				t.Fatalf("unexpected ranks (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expAbsentRanks, status.AbsentRanks, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("unexpected absent ranks (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expAbsentHosts, status.AbsentHosts, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("unexpected absent hosts (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaosmgmt_SystemService_StopStart(t *testing.T) {
+	ctx := test.Context(t)
+	sys := newTestFake(t).Client().System()
+
+	results, err := sys.Stop(ctx, &MemberSelection{Ranks: []uint32{0, 1}}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expResults := []*RankResult{
+		{Rank: 0, Addr: "10.0.0.1:10001", Action: "stop", State: "Stopped"},
+		{Rank: 1, Addr: "10.0.0.1:10001", Action: "stop", State: "Stopped"},
+	}
+	if diff := cmp.Diff(expResults, results); diff != "" {
+		t.Fatalf("unexpected results (-want, +got):\n%s\n", diff)
+	}
+
+	status, err := sys.Query(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expStates := map[uint32]string{0: "Stopped", 1: "Stopped", 2: "Joined", 3: "Joined"}
+	if diff := cmp.Diff(expStates, memberStates(status.Members)); diff != "" {
+		t.Fatalf("unexpected member states (-want, +got):\n%s\n", diff)
+	}
+
+	if _, err := sys.Start(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+	status, err = sys.Query(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expStates = map[uint32]string{0: "Joined", 1: "Joined", 2: "Joined", 3: "Joined"}
+	if diff := cmp.Diff(expStates, memberStates(status.Members)); diff != "" {
+		t.Fatalf("unexpected member states (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestDaosmgmt_SystemService_Leader(t *testing.T) {
+	sys := newTestFake(t).Client().System()
+
+	status, err := sys.Leader(test.Context(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expStatus := &LeaderStatus{
+		Leader:   "10.0.0.1:10001",
+		Replicas: []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+	}
+	if diff := cmp.Diff(expStatus, status, cmpopts.EquateEmpty()); diff != "" {
+		t.Fatalf("unexpected leader status (-want, +got):\n%s\n", diff)
+	}
+}
@@ -0,0 +1,121 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+// DefaultTelemetryPort is the port that telemetry is read from if the host
+// passed to a TelemetryService method does not include one.
+const DefaultTelemetryPort = 9191
+
+type (
+	// TelemetryService provides the telemetry operations of a Client.
+	TelemetryService struct {
+		c *Client
+	}
+
+	// MetricSample is a value of a metric. Simple metrics have a Value;
+	// summaries and histograms have a Count and a Sum of observations.
+	MetricSample struct {
+		Labels map[string]string `json:"labels,omitempty"`
+		Value  float64           `json:"value"`
+		Count  uint64            `json:"count,omitempty"`
+		Sum    float64           `json:"sum,omitempty"`
+	}
+
+	// Metric describes a metric published by a DAOS server and, if it was
+	// queried, its values.
+	Metric struct {
+		Name        string          `json:"name"`
+		Description string          `json:"description"`
+		Type        string          `json:"type"`
+		Samples     []*MetricSample `json:"samples,omitempty"`
+	}
+)
+
+func splitTelemetryHost(host string) (string, uint32, error) {
+	h, p, err := net.SplitHostPort(host)
+	if err != nil {
+		return host, DefaultTelemetryPort, nil
+	}
+
+	port, err := strconv.ParseUint(p, 10, 32)
+	if err != nil {
+		return "", 0, errors.Errorf("invalid telemetry port in %q", host)
+	}
+
+	return h, uint32(port), nil
+}
+
+func metricsFromSets(sets []*daos.MetricSet) []*Metric {
+	out := make([]*Metric, 0, len(sets))
+	for _, ms := range sets {
+		m := &Metric{
+			Name:        ms.Name,
+			Description: ms.Description,
+			Type:        ms.Type.String(),
+		}
+		for _, dm := range ms.Metrics {
+			switch dm := dm.(type) {
+			case *daos.SimpleMetric:
+				m.Samples = append(m.Samples, &MetricSample{Labels: dm.Labels, Value: dm.Value})
+			case *daos.SummaryMetric:
+				m.Samples = append(m.Samples, &MetricSample{Labels: dm.Labels, Count: dm.SampleCount, Sum: dm.SampleSum})
+			case *daos.HistogramMetric:
+				m.Samples = append(m.Samples, &MetricSample{Labels: dm.Labels, Count: dm.SampleCount, Sum: dm.SampleSum})
+			}
+		}
+		out = append(out, m)
+	}
+
+	return out
+}
+
+// List returns the metrics published by the DAOS server on the given host,
+// without their values.
+func (s *TelemetryService) List(ctx context.Context, host string) ([]*Metric, error) {
+	h, port, err := splitTelemetryHost(host)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.c.metricsList(ctx, &control.MetricsListReq{Host: h, Port: port})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return metricsFromSets(resp.AvailableMetricSets), nil
+}
+
+// Query returns the values of the named metrics published by the DAOS server
+// on the given host, or of all of its metrics if no names are given.
+func (s *TelemetryService) Query(ctx context.Context, host string, names ...string) ([]*Metric, error) {
+	h, port, err := splitTelemetryHost(host)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.c.metricsQuery(ctx, &control.MetricsQueryReq{
+		Host:        h,
+		Port:        port,
+		MetricNames: names,
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return metricsFromSets(resp.MetricSets), nil
+}
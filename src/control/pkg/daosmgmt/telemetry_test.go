@@ -0,0 +1,114 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package daosmgmt
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestDaosmgmt_splitTelemetryHost(t *testing.T) {
+	for name, tc := range map[string]struct {
+		host    string
+		expHost string
+		expPort uint32
+		expErr  error
+	}{
+		"no port": {
+			host:    "server-1",
+			expHost: "server-1",
+			expPort: DefaultTelemetryPort,
+		},
+		"port": {
+			host:    "server-1:9192",
+			expHost: "server-1",
+			expPort: 9192,
+		},
+		"invalid port": {
+			host:   "server-1:abc",
+			expErr: errors.New("invalid telemetry port"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotHost, gotPort, gotErr := splitTelemetryHost(tc.host)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expHost, gotHost, "unexpected host")
+			test.AssertEqual(t, tc.expPort, gotPort, "unexpected port")
+		})
+	}
+}
+
+func TestDaosmgmt_TelemetryService(t *testing.T) {
+	fake := NewFake(nil)
+	if err := fake.AddHost(&FakeHost{
+		Addr:  "10.0.0.1:10001",
+		Ranks: []uint32{0},
+		Metrics: []*Metric{
+			{
+				Name:        "engine_pool_ops_fetch",
+				Description: "fetch operations",
+				Type:        "Counter",
+				Samples: []*MetricSample{
+					{Labels: map[string]string{"rank": "0"}, Value: 42},
+				},
+			},
+			{
+				Name:        "engine_io_latency_fetch",
+				Description: "fetch latency",
+				Type:        "Summary",
+				Samples: []*MetricSample{
+					{Labels: map[string]string{"rank": "0"}, Count: 4, Sum: 10},
+				},
+			},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	ctx := test.Context(t)
+	tm := fake.Client().Telemetry()
+
+	list, err := tm.List(ctx, "10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expList := []*Metric{
+		{Name: "engine_pool_ops_fetch", Description: "fetch operations", Type: "Counter"},
+		{Name: "engine_io_latency_fetch", Description: "fetch latency", Type: "Summary"},
+	}
+	if diff := cmp.Diff(expList, list); diff != "" {
+		t.Fatalf("unexpected metrics (-want, +got):\n%s\n", diff)
+	}
+
+	metrics, err := tm.Query(ctx, "10.0.0.1:9191", "engine_io_latency_fetch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expMetrics := []*Metric{
+		{
+			Name:        "engine_io_latency_fetch",
+			Description: "fetch latency",
+			Type:        "Summary",
+			Samples: []*MetricSample{
+				{Labels: map[string]string{"rank": "0"}, Count: 4, Sum: 10},
+			},
+		},
+	}
+	if diff := cmp.Diff(expMetrics, metrics); diff != "" {
+		t.Fatalf("unexpected metrics (-want, +got):\n%s\n", diff)
+	}
+
+	_, err = tm.List(ctx, "10.0.0.2")
+	test.CmpErr(t, errors.New("unable to connect"), err)
+}
@@ -0,0 +1,157 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// RoleURIScheme is the scheme of certificate URI subject alternative names
+// that assign a role to the certificate, e.g. "daos-role:read-only".
+const RoleURIScheme = "daos-role"
+
+type (
+	// AccessRole is a named set of gRPC methods.
+	AccessRole struct {
+		// Methods are full gRPC method names, e.g.
+		// "/mgmt.MgmtSvc/SystemQuery". A trailing "*" matches any method
+		// with the preceding prefix, so "*" matches all methods.
+		Methods []string `yaml:"methods"`
+	}
+
+	// AccessPolicy restricts the methods that admin certificates may call
+	// according to the roles assigned to the certificates.
+	AccessPolicy struct {
+		// DefaultRole is assumed for admin certificates that have no
+		// role defined in the policy. If unset, such certificates are
+		// denied access to all methods.
+		DefaultRole string                 `yaml:"default_role,omitempty"`
+		Roles       map[string]*AccessRole `yaml:"roles"`
+	}
+)
+
+func (r *AccessRole) allows(method string) bool {
+	for _, pattern := range r.Methods {
+		if prefix, isWild := strings.CutSuffix(pattern, "*"); isWild {
+			if strings.HasPrefix(method, prefix) {
+				return true
+			}
+			continue
+		}
+		if pattern == method {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadAccessPolicy reads and validates the access policy in the given file.
+func LoadAccessPolicy(path string) (*AccessPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading access policy")
+	}
+
+	policy := new(AccessPolicy)
+	if err := yaml.UnmarshalStrict(data, policy); err != nil {
+		return nil, errors.Wrapf(err, "parsing access policy %s", path)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "access policy %s", path)
+	}
+
+	return policy, nil
+}
+
+// Validate checks that the policy is usable. Method names without a wildcard
+// must be methods that admin certificates are permitted to call, in order to
+// catch mistakes in the policy.
+func (p *AccessPolicy) Validate() error {
+	if p == nil {
+		return errors.Errorf("nil %T", p)
+	}
+	if len(p.Roles) == 0 {
+		return errors.New("no roles defined")
+	}
+	if p.DefaultRole != "" {
+		if _, found := p.Roles[p.DefaultRole]; !found {
+			return errors.Errorf("default role %q is not defined", p.DefaultRole)
+		}
+	}
+
+	for name, role := range p.Roles {
+		if role == nil || len(role.Methods) == 0 {
+			return errors.Errorf("role %q has no methods", name)
+		}
+		for _, method := range role.Methods {
+			if strings.Contains(strings.TrimSuffix(method, "*"), "*") {
+				return errors.Errorf("role %q: wildcard is only supported at the end of %q", name, method)
+			}
+			if strings.HasSuffix(method, "*") {
+				continue
+			}
+			if !ComponentAdmin.HasAccess(method) {
+				return errors.Errorf("role %q: %q is not a method that can be called with an admin certificate", name, method)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CertRoles returns the roles assigned to the certificate by its
+// organizational units and "daos-role" URI subject alternative names that are
+// defined in the policy. If there are none, the default role is returned.
+func (p *AccessPolicy) CertRoles(cert *x509.Certificate) []string {
+	var names []string
+	names = append(names, cert.Subject.OrganizationalUnit...)
+	for _, uri := range cert.URIs {
+		if uri.Scheme == RoleURIScheme {
+			names = append(names, uri.Opaque)
+		}
+	}
+
+	return p.NamedRoles(names)
+}
+
+// NamedRoles returns the names that are roles defined in the policy, e.g. the
+// groups of a bearer token. If there are none, the default role is returned.
+func (p *AccessPolicy) NamedRoles(names []string) []string {
+	var roles []string
+	seen := make(map[string]struct{})
+	for _, name := range names {
+		if _, found := seen[name]; found {
+			continue
+		}
+		if _, found := p.Roles[name]; found {
+			roles = append(roles, name)
+			seen[name] = struct{}{}
+		}
+	}
+	if len(roles) == 0 && p.DefaultRole != "" {
+		roles = append(roles, p.DefaultRole)
+	}
+	sort.Strings(roles)
+
+	return roles
+}
+
+// Allows indicates whether any of the roles permit the method to be called.
+func (p *AccessPolicy) Allows(roles []string, method string) bool {
+	for _, name := range roles {
+		if role, found := p.Roles[name]; found && role.allows(method) {
+			return true
+		}
+	}
+	return false
+}
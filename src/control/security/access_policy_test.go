@@ -0,0 +1,203 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+const accessPolicyExample = "../../../utils/config/examples/daos_access_policy.yml"
+
+func TestSecurity_LoadAccessPolicy(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	for name, tc := range map[string]struct {
+		content string
+		path    string
+		expErr  error
+	}{
+		"missing file": {
+			path:   filepath.Join(dir, "missing.yml"),
+			expErr: errors.New("no such file"),
+		},
+		"example": {
+			path: accessPolicyExample,
+		},
+		"unknown field": {
+			content: "roles:\n  ro:\n    method: [/mgmt.MgmtSvc/SystemQuery]\n",
+			expErr:  errors.New("field method not found"),
+		},
+		"no roles": {
+			content: "default_role: ro\n",
+			expErr:  errors.New("no roles"),
+		},
+		"role without methods": {
+			content: "roles:\n  ro: {}\n",
+			expErr:  errors.New("no methods"),
+		},
+		"unknown default role": {
+			content: "default_role: rw\nroles:\n  ro:\n    methods: [/mgmt.MgmtSvc/SystemQuery]\n",
+			expErr:  errors.New("default role \"rw\" is not defined"),
+		},
+		"unknown method": {
+			content: "roles:\n  ro:\n    methods: [/mgmt.MgmtSvc/SystemQueery]\n",
+			expErr:  errors.New("not a method"),
+		},
+		"non-admin method": {
+			content: "roles:\n  ro:\n    methods: [/mgmt.MgmtSvc/Join]\n",
+			expErr:  errors.New("not a method"),
+		},
+		"misplaced wildcard": {
+			content: "roles:\n  ro:\n    methods: [/mgmt.MgmtSvc/*Query]\n",
+			expErr:  errors.New("only supported at the end"),
+		},
+		"valid": {
+			content: "default_role: ro\nroles:\n  ro:\n    methods: [/mgmt.MgmtSvc/SystemQuery, /ctl.CtlSvc/*]\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := tc.path
+			if path == "" {
+				path = test.CreateTestFile(t, dir, tc.content)
+			}
+
+			_, err := LoadAccessPolicy(path)
+			test.CmpErr(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestSecurity_AccessPolicy(t *testing.T) {
+	policy, err := LoadAccessPolicy(accessPolicyExample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withDefault := &AccessPolicy{
+		DefaultRole: "read-only",
+		Roles:       policy.Roles,
+	}
+
+	mustParseURI := func(s string) *url.URL {
+		uri, err := url.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return uri
+	}
+
+	for name, tc := range map[string]struct {
+		policy     *AccessPolicy
+		cert       *x509.Certificate
+		expRoles   []string
+		expAllowed []string
+		expDenied  []string
+	}{
+		"no roles": {
+			policy:    policy,
+			cert:      &x509.Certificate{},
+			expDenied: []string{"/mgmt.MgmtSvc/SystemQuery"},
+		},
+		"default role": {
+			policy:     withDefault,
+			cert:       &x509.Certificate{Subject: pkix.Name{OrganizationalUnit: []string{"unknown"}}},
+			expRoles:   []string{"read-only"},
+			expAllowed: []string{"/mgmt.MgmtSvc/SystemQuery"},
+			expDenied:  []string{"/mgmt.MgmtSvc/SystemErase"},
+		},
+		"read-only": {
+			policy:     policy,
+			cert:       &x509.Certificate{Subject: pkix.Name{OrganizationalUnit: []string{"read-only"}}},
+			expRoles:   []string{"read-only"},
+			expAllowed: []string{"/mgmt.MgmtSvc/SystemQuery", "/mgmt.MgmtSvc/PoolQuery"},
+			expDenied:  []string{"/mgmt.MgmtSvc/SystemErase", "/mgmt.MgmtSvc/PoolDestroy"},
+		},
+		"pool-operator from URI": {
+			policy: policy,
+			cert: &x509.Certificate{
+				URIs: []*url.URL{
+					mustParseURI("https://example.com/pool-operator"),
+					mustParseURI("daos-role:pool-operator"),
+				},
+			},
+			expRoles:   []string{"pool-operator"},
+			expAllowed: []string{"/mgmt.MgmtSvc/PoolDestroy", "/mgmt.MgmtSvc/SystemQuery"},
+			expDenied:  []string{"/mgmt.MgmtSvc/SystemErase", "/ctl.CtlSvc/StorageFormat"},
+		},
+		"multiple roles": {
+			policy: policy,
+			cert: &x509.Certificate{
+				Subject: pkix.Name{OrganizationalUnit: []string{"read-only", "system-admin"}},
+			},
+			expRoles:   []string{"read-only", "system-admin"},
+			expAllowed: []string{"/mgmt.MgmtSvc/SystemErase", "/ctl.CtlSvc/StorageFormat"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			roles := tc.policy.CertRoles(tc.cert)
+			if diff := cmp.Diff(tc.expRoles, roles); diff != "" {
+				t.Fatalf("unexpected roles (-want, +got):\n%s\n", diff)
+			}
+			for _, method := range tc.expAllowed {
+				test.AssertTrue(t, tc.policy.Allows(roles, method), method+" should be allowed")
+			}
+			for _, method := range tc.expDenied {
+				test.AssertFalse(t, tc.policy.Allows(roles, method), method+" should be denied")
+			}
+		})
+	}
+}
+
+func TestSecurity_AccessPolicy_NamedRoles(t *testing.T) {
+	policy, err := LoadAccessPolicy(accessPolicyExample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withDefault := &AccessPolicy{
+		DefaultRole: "read-only",
+		Roles:       policy.Roles,
+	}
+
+	for name, tc := range map[string]struct {
+		policy   *AccessPolicy
+		names    []string
+		expRoles []string
+	}{
+		"no names": {
+			policy: policy,
+		},
+		"no names with default": {
+			policy:   withDefault,
+			expRoles: []string{"read-only"},
+		},
+		"unknown names": {
+			policy: policy,
+			names:  []string{"users", "staff"},
+		},
+		"duplicate names": {
+			policy:   withDefault,
+			names:    []string{"system-admin", "users", "pool-operator", "system-admin"},
+			expRoles: []string{"pool-operator", "system-admin"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			roles := tc.policy.NamedRoles(tc.names)
+			if diff := cmp.Diff(tc.expRoles, roles); diff != "" {
+				t.Fatalf("unexpected roles (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
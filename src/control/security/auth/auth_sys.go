@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -270,17 +271,23 @@ func GetSignedCredential(ctx context.Context, req *CredentialRequest) (*Credenti
 		Flavor: Flavor_AUTH_SYS,
 		Data:   tokenBytes}
 
-	verifier, err := VerifierFromToken(req.SigningKey, &token)
+	return newCredential(ctx, req, &token)
+}
+
+// newCredential creates a credential for the token, with a verifier signed
+// using the request's signing key.
+func newCredential(ctx context.Context, req *CredentialRequest, token *Token) (*Credential, error) {
+	verifier, err := VerifierFromToken(req.SigningKey, token)
 	if err != nil {
 		return nil, errors.WithMessage(err, "Unable to generate verifier")
 	}
 
 	verifierToken := Token{
-		Flavor: Flavor_AUTH_SYS,
+		Flavor: token.Flavor,
 		Data:   verifier}
 
 	credential := Credential{
-		Token:    &token,
+		Token:    token,
 		Verifier: &verifierToken,
 		Origin:   "agent"}
 
@@ -0,0 +1,419 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+// Supported bearer token signature algorithms.
+const (
+	BearerAlgRS256 = "RS256"
+	BearerAlgES256 = "ES256"
+	BearerAlgEdDSA = "EdDSA"
+)
+
+const defaultBearerClockSkew = 30 * time.Second
+
+// ErrNoToken indicates that a token provider has no bearer token for the
+// client.
+var ErrNoToken = errors.New("no bearer token available for client")
+
+type (
+	// BearerAudience is the intended audience of a bearer token. It may be
+	// encoded as either a single string or a list of strings.
+	BearerAudience []string
+
+	// BearerClaims are the claims in a bearer token that identify the
+	// client user. The user is taken from the subject, and the groups from
+	// the "group" and "groups" claims.
+	BearerClaims struct {
+		Issuer    string         `json:"iss"`
+		Subject   string         `json:"sub"`
+		Audience  BearerAudience `json:"aud,omitempty"`
+		ExpiresAt int64          `json:"exp"`
+		NotBefore int64          `json:"nbf,omitempty"`
+		IssuedAt  int64          `json:"iat,omitempty"`
+		Group     string         `json:"group"`
+		Groups    []string       `json:"groups,omitempty"`
+	}
+
+	bearerHeader struct {
+		Alg string `json:"alg"`
+		Typ string `json:"typ,omitempty"`
+	}
+
+	// TokenProvider obtains bearer tokens for client processes.
+	TokenProvider interface {
+		// GetToken returns a bearer token for the client, or ErrNoToken
+		// if none is available.
+		GetToken(ctx context.Context, info *security.DomainInfo) (string, error)
+	}
+
+	// TokenVerifier validates bearer tokens against the public keys of the
+	// trusted token issuers.
+	TokenVerifier struct {
+		keys        map[string]crypto.PublicKey
+		audience    string
+		maxLifetime time.Duration
+		clockSkew   time.Duration
+		now         func() time.Time
+	}
+)
+
+// UnmarshalJSON decodes an audience from either a string or a list of strings.
+func (a *BearerAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = BearerAudience{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return errors.New("aud must be a string or a list of strings")
+	}
+	*a = list
+	return nil
+}
+
+func (a BearerAudience) has(aud string) bool {
+	for _, cur := range a {
+		if cur == aud {
+			return true
+		}
+	}
+	return false
+}
+
+// NewTokenVerifier creates a verifier for tokens issued by the issuers in the
+// configuration. If the configuration is nil, token authentication is disabled
+// and a nil verifier is returned.
+func NewTokenVerifier(cfg *security.TokenAuthConfig) (*TokenVerifier, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, tic := range cfg.Issuers {
+		key, err := security.LoadPublicKey(tic.PublicKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "loading public key for token issuer %q", tic.Issuer)
+		}
+		if _, err := bearerAlgForKey(key); err != nil {
+			return nil, errors.Wrapf(err, "token issuer %q", tic.Issuer)
+		}
+		keys[tic.Issuer] = key
+	}
+
+	clockSkew := cfg.ClockSkew
+	if clockSkew == 0 {
+		clockSkew = defaultBearerClockSkew
+	}
+
+	return &TokenVerifier{
+		keys:        keys,
+		audience:    cfg.Audience,
+		maxLifetime: cfg.MaxLifetime,
+		clockSkew:   clockSkew,
+		now:         time.Now,
+	}, nil
+}
+
+// Verify checks the signature and validity period of a bearer token and returns
+// its claims.
+func (v *TokenVerifier) Verify(token string) (*BearerClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed bearer token")
+	}
+
+	header := new(bearerHeader)
+	if err := decodeBearerSegment(parts[0], header); err != nil {
+		return nil, errors.Wrap(err, "bearer token header")
+	}
+	claims := new(BearerClaims)
+	if err := decodeBearerSegment(parts[1], claims); err != nil {
+		return nil, errors.Wrap(err, "bearer token claims")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.Wrap(err, "bearer token signature")
+	}
+
+	key, found := v.keys[claims.Issuer]
+	if !found {
+		return nil, errors.Errorf("bearer token issuer %q is not trusted", claims.Issuer)
+	}
+	if err := verifyBearerSignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), sig); err != nil {
+		return nil, err
+	}
+
+	if err := v.checkClaims(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (v *TokenVerifier) checkClaims(claims *BearerClaims) error {
+	now := v.now()
+
+	if claims.ExpiresAt == 0 {
+		return errors.New("bearer token has no expiration time")
+	}
+	expires := time.Unix(claims.ExpiresAt, 0)
+	if now.After(expires.Add(v.clockSkew)) {
+		return errors.Errorf("bearer token expired at %s", expires.Format(time.RFC3339))
+	}
+	if claims.NotBefore != 0 && now.Add(v.clockSkew).Before(time.Unix(claims.NotBefore, 0)) {
+		return errors.New("bearer token is not yet valid")
+	}
+	if v.maxLifetime > 0 {
+		issued := now
+		if claims.IssuedAt != 0 {
+			issued = time.Unix(claims.IssuedAt, 0)
+		}
+		if expires.Sub(issued) > v.maxLifetime+v.clockSkew {
+			return errors.Errorf("bearer token lifetime exceeds the maximum of %s", v.maxLifetime)
+		}
+	}
+	if v.audience != "" && !claims.Audience.has(v.audience) {
+		return errors.Errorf("bearer token is not intended for audience %q", v.audience)
+	}
+
+	if claims.Subject == "" {
+		return errors.New("bearer token has no subject")
+	}
+	if claims.Group == "" {
+		return errors.New("bearer token has no group")
+	}
+	return nil
+}
+
+// AuthSysFromBearer verifies the bearer token in an AUTH_TOKEN flavor token and
+// converts the identity in its claims into an AUTH_SYS flavor token.
+func (v *TokenVerifier) AuthSysFromBearer(authToken *Token) (*Token, error) {
+	bearer, err := BearerFromAuthToken(authToken)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := v.Verify(bearer.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]string, len(claims.Groups))
+	for i, grp := range claims.Groups {
+		groups[i] = bearerNameToPrincipalName(grp)
+	}
+	sys := &Sys{
+		Machinename: bearer.Machinename,
+		User:        bearerNameToPrincipalName(claims.Subject),
+		Group:       bearerNameToPrincipalName(claims.Group),
+		Groups:      groups,
+	}
+	data, err := proto.Marshal(sys)
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to marshal AuthSys token")
+	}
+
+	return &Token{
+		Flavor: Flavor_AUTH_SYS,
+		Data:   data,
+	}, nil
+}
+
+// BearerFromAuthToken takes an opaque AuthToken and turns it into a concrete
+// Bearer data structure.
+func BearerFromAuthToken(authToken *Token) (*Bearer, error) {
+	if authToken.GetFlavor() != Flavor_AUTH_TOKEN {
+		return nil, errors.New("Attempting to convert an invalid Bearer Token")
+	}
+
+	bearer := &Bearer{}
+	if err := proto.Unmarshal(authToken.GetData(), bearer); err != nil {
+		return nil, errors.Wrapf(err, "unmarshaling %s", authToken.GetFlavor())
+	}
+	return bearer, nil
+}
+
+// BearerTokenExpiry returns the expiration time claimed by a bearer token. The
+// token is not verified, so the result is only suitable for deciding how long
+// the token may be reused by its holder.
+func BearerTokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("malformed bearer token")
+	}
+
+	claims := new(BearerClaims)
+	if err := decodeBearerSegment(parts[1], claims); err != nil {
+		return time.Time{}, errors.Wrap(err, "bearer token claims")
+	}
+	if claims.ExpiresAt == 0 {
+		return time.Time{}, errors.New("bearer token has no expiration time")
+	}
+
+	return time.Unix(claims.ExpiresAt, 0), nil
+}
+
+// GetBearerCredential returns a credential carrying the bearer token, signed
+// with the agent's key in the same way as an AUTH_SYS credential.
+func GetBearerCredential(ctx context.Context, req *CredentialRequest, token string) (*Credential, error) {
+	if req == nil {
+		return nil, errors.Errorf("%T is nil", req)
+	}
+
+	hostname, err := req.hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	tokenBytes, err := proto.Marshal(&Bearer{
+		Machinename: hostname,
+		Token:       token,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to marshal Bearer token")
+	}
+
+	return newCredential(ctx, req, &Token{
+		Flavor: Flavor_AUTH_TOKEN,
+		Data:   tokenBytes,
+	})
+}
+
+// NewBearerToken creates a bearer token with the given claims, signed with the
+// issuer's private key.
+func NewBearerToken(key crypto.PrivateKey, claims *BearerClaims) (string, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return "", &security.UnsupportedKeyError{}
+	}
+	alg, err := bearerAlgForKey(signer.Public())
+	if err != nil {
+		return "", err
+	}
+
+	header, err := encodeBearerSegment(&bearerHeader{Alg: alg, Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := encodeBearerSegment(claims)
+	if err != nil {
+		return "", err
+	}
+	input := header + "." + payload
+
+	var sig []byte
+	switch signingKey := key.(type) {
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256([]byte(input))
+		sig, err = rsa.SignPKCS1v15(rand.Reader, signingKey, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256([]byte(input))
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, signingKey, digest[:])
+		if err == nil {
+			sig = make([]byte, 64)
+			r.FillBytes(sig[:32])
+			s.FillBytes(sig[32:])
+		}
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(signingKey, []byte(input))
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "signing bearer token")
+	}
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+func bearerAlgForKey(key crypto.PublicKey) (string, error) {
+	switch pubKey := key.(type) {
+	case *rsa.PublicKey:
+		return BearerAlgRS256, nil
+	case *ecdsa.PublicKey:
+		if pubKey.Curve == elliptic.P256() {
+			return BearerAlgES256, nil
+		}
+	case ed25519.PublicKey:
+		return BearerAlgEdDSA, nil
+	}
+	return "", &security.UnsupportedKeyError{}
+}
+
+func verifyBearerSignature(alg string, key crypto.PublicKey, input, sig []byte) error {
+	keyAlg, err := bearerAlgForKey(key)
+	if err != nil {
+		return err
+	}
+	if alg != keyAlg {
+		return errors.Errorf("bearer token algorithm %q does not match the issuer key (%s)", alg, keyAlg)
+	}
+
+	digest := sha256.Sum256(input)
+	switch pubKey := key.(type) {
+	case *rsa.PublicKey:
+		err = rsa.VerifyPKCS1v15(pubKey, crypto.SHA256, digest[:], sig)
+	case *ecdsa.PublicKey:
+		if len(sig) != 64 || !ecdsa.Verify(pubKey, digest[:], new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])) {
+			err = errors.New("invalid signature")
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(pubKey, input, sig) {
+			err = errors.New("invalid signature")
+		}
+	}
+	return errors.Wrap(err, "bearer token verification failed")
+}
+
+func decodeBearerSegment(seg string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func encodeBearerSegment(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// bearerNameToPrincipalName converts a name from a bearer token to an ACL
+// principal name. Names that already include a domain are used as-is.
+func bearerNameToPrincipalName(name string) string {
+	if strings.Contains(name, "@") {
+		return name
+	}
+	return sysNameToPrincipalName(name)
+}
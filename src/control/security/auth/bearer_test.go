@@ -0,0 +1,439 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func testIssuerKeys(t *testing.T) map[string]crypto.Signer {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return map[string]crypto.Signer{
+		"rsa":   rsaKey,
+		"ecdsa": ecKey,
+		"eddsa": edKey,
+	}
+}
+
+func writeIssuerKey(t *testing.T, dir, name string, key crypto.Signer) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name+".pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func newTestTokenVerifier(t *testing.T, keys map[string]crypto.Signer, cfg *security.TokenAuthConfig) *TokenVerifier {
+	t.Helper()
+
+	dir, cleanup := test.CreateTestDir(t)
+	t.Cleanup(cleanup)
+
+	for name, key := range keys {
+		cfg.Issuers = append(cfg.Issuers, &security.TokenIssuerConfig{
+			Issuer:    name,
+			PublicKey: writeIssuerKey(t, dir, name, key),
+		})
+	}
+
+	v, err := NewTokenVerifier(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestAuth_NewTokenVerifier(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	keys := testIssuerKeys(t)
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		cfg    *security.TokenAuthConfig
+		expNil bool
+		expErr error
+	}{
+		"nil": {
+			expNil: true,
+		},
+		"invalid": {
+			cfg:    &security.TokenAuthConfig{},
+			expErr: errors.New("at least one issuer"),
+		},
+		"valid": {
+			cfg: &security.TokenAuthConfig{
+				Issuers: []*security.TokenIssuerConfig{
+					{Issuer: "idp", PublicKey: writeIssuerKey(t, dir, "idp", keys["rsa"])},
+				},
+			},
+		},
+		"missing key": {
+			cfg: &security.TokenAuthConfig{
+				Issuers: []*security.TokenIssuerConfig{
+					{Issuer: "idp", PublicKey: filepath.Join(dir, "missing.pem")},
+				},
+			},
+			expErr: errors.New("no such file"),
+		},
+		"unsupported key": {
+			cfg: &security.TokenAuthConfig{
+				Issuers: []*security.TokenIssuerConfig{
+					{Issuer: "idp", PublicKey: writeIssuerKey(t, dir, "p384", p384Key)},
+				},
+			},
+			expErr: errors.New("unsupported key type"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			v, err := NewTokenVerifier(tc.cfg)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expNil, v == nil, "")
+		})
+	}
+}
+
+func TestAuth_TokenVerifier_Verify(t *testing.T) {
+	keys := testIssuerKeys(t)
+	now := time.Unix(1700000000, 0)
+
+	validClaims := func(issuer string) *BearerClaims {
+		return &BearerClaims{
+			Issuer:    issuer,
+			Subject:   "alice",
+			Audience:  BearerAudience{"daos"},
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(10 * time.Minute).Unix(),
+			Group:     "users",
+			Groups:    []string{"proj1"},
+		}
+	}
+	sign := func(t *testing.T, key crypto.Signer, claims *BearerClaims) string {
+		t.Helper()
+		token, err := NewBearerToken(key, claims)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	withClaims := func(issuer string, update func(*BearerClaims)) func(*testing.T) string {
+		return func(t *testing.T) string {
+			claims := validClaims(issuer)
+			update(claims)
+			return sign(t, keys[issuer], claims)
+		}
+	}
+	rawToken := func(header, claims string, sig []byte) string {
+		enc := base64.RawURLEncoding
+		return enc.EncodeToString([]byte(header)) + "." + enc.EncodeToString([]byte(claims)) + "." + enc.EncodeToString(sig)
+	}
+
+	for name, tc := range map[string]struct {
+		token     func(*testing.T) string
+		expClaims *BearerClaims
+		expErr    error
+	}{
+		"rsa": {
+			token:     withClaims("rsa", func(*BearerClaims) {}),
+			expClaims: validClaims("rsa"),
+		},
+		"ecdsa": {
+			token:     withClaims("ecdsa", func(*BearerClaims) {}),
+			expClaims: validClaims("ecdsa"),
+		},
+		"eddsa": {
+			token:     withClaims("eddsa", func(*BearerClaims) {}),
+			expClaims: validClaims("eddsa"),
+		},
+		"malformed": {
+			token:  func(*testing.T) string { return "not-a-token" },
+			expErr: errors.New("malformed bearer token"),
+		},
+		"bad claims": {
+			token:  func(*testing.T) string { return rawToken(`{"alg":"RS256"}`, `[]`, nil) },
+			expErr: errors.New("bearer token claims"),
+		},
+		"untrusted issuer": {
+			token: func(t *testing.T) string {
+				return sign(t, keys["rsa"], validClaims("evil"))
+			},
+			expErr: errors.New(`issuer "evil" is not trusted`),
+		},
+		"signed by wrong key": {
+			token: func(t *testing.T) string {
+				other, err := rsa.GenerateKey(rand.Reader, 2048)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return sign(t, other, validClaims("rsa"))
+			},
+			expErr: errors.New("verification failed"),
+		},
+		"unsigned": {
+			token: func(*testing.T) string {
+				claims, _ := json.Marshal(validClaims("rsa"))
+				return rawToken(`{"alg":"none"}`, string(claims), nil)
+			},
+			expErr: errors.New(`algorithm "none" does not match`),
+		},
+		"tampered claims": {
+			token: func(t *testing.T) string {
+				parts := strings.Split(sign(t, keys["ecdsa"], validClaims("ecdsa")), ".")
+				claims := validClaims("ecdsa")
+				claims.Subject = "root"
+				data, _ := json.Marshal(claims)
+				parts[1] = base64.RawURLEncoding.EncodeToString(data)
+				return strings.Join(parts, ".")
+			},
+			expErr: errors.New("verification failed"),
+		},
+		"expired": {
+			token: withClaims("rsa", func(c *BearerClaims) {
+				c.ExpiresAt = now.Add(-time.Minute).Unix()
+			}),
+			expErr: errors.New("expired"),
+		},
+		"expired within clock skew": {
+			token: withClaims("rsa", func(c *BearerClaims) {
+				c.ExpiresAt = now.Add(-10 * time.Second).Unix()
+			}),
+			expClaims: func() *BearerClaims {
+				c := validClaims("rsa")
+				c.ExpiresAt = now.Add(-10 * time.Second).Unix()
+				return c
+			}(),
+		},
+		"no expiration": {
+			token: withClaims("rsa", func(c *BearerClaims) {
+				c.ExpiresAt = 0
+			}),
+			expErr: errors.New("no expiration"),
+		},
+		"not yet valid": {
+			token: withClaims("rsa", func(c *BearerClaims) {
+				c.NotBefore = now.Add(time.Minute).Unix()
+			}),
+			expErr: errors.New("not yet valid"),
+		},
+		"lifetime too long": {
+			token: withClaims("rsa", func(c *BearerClaims) {
+				c.ExpiresAt = now.Add(2 * time.Hour).Unix()
+			}),
+			expErr: errors.New("lifetime exceeds"),
+		},
+		"wrong audience": {
+			token: withClaims("rsa", func(c *BearerClaims) {
+				c.Audience = BearerAudience{"other"}
+			}),
+			expErr: errors.New(`not intended for audience "daos"`),
+		},
+		"no subject": {
+			token: withClaims("rsa", func(c *BearerClaims) {
+				c.Subject = ""
+			}),
+			expErr: errors.New("no subject"),
+		},
+		"no group": {
+			token: withClaims("rsa", func(c *BearerClaims) {
+				c.Group = ""
+			}),
+			expErr: errors.New("no group"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			v := newTestTokenVerifier(t, keys, &security.TokenAuthConfig{
+				Audience:    "daos",
+				MaxLifetime: time.Hour,
+			})
+			v.now = func() time.Time { return now }
+
+			claims, err := v.Verify(tc.token(t))
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expClaims, claims); diff != "" {
+				t.Fatalf("unexpected claims (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAuth_BearerAudience_UnmarshalJSON(t *testing.T) {
+	for name, tc := range map[string]struct {
+		data   string
+		expAud BearerAudience
+		expErr error
+	}{
+		"string": {
+			data:   `"daos"`,
+			expAud: BearerAudience{"daos"},
+		},
+		"list": {
+			data:   `["daos", "other"]`,
+			expAud: BearerAudience{"daos", "other"},
+		},
+		"invalid": {
+			data:   `42`,
+			expErr: errors.New("aud must be"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var aud BearerAudience
+			err := json.Unmarshal([]byte(tc.data), &aud)
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expAud, aud); diff != "" {
+				t.Fatalf("unexpected audience (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAuth_BearerTokenExpiry(t *testing.T) {
+	keys := testIssuerKeys(t)
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	for name, tc := range map[string]struct {
+		claims     *BearerClaims
+		token      string
+		expExpires time.Time
+		expErr     error
+	}{
+		"expiration claimed": {
+			claims:     &BearerClaims{Issuer: "ecdsa", ExpiresAt: expires.Unix()},
+			expExpires: expires,
+		},
+		"no expiration": {
+			claims: &BearerClaims{Issuer: "ecdsa"},
+			expErr: errors.New("no expiration time"),
+		},
+		"malformed": {
+			token:  "header.claims",
+			expErr: errors.New("malformed"),
+		},
+		"bad claims": {
+			token:  "header.claims.sig",
+			expErr: errors.New("bearer token claims"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			token := tc.token
+			if tc.claims != nil {
+				var err error
+				if token, err = NewBearerToken(keys["ecdsa"], tc.claims); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotExpires, err := BearerTokenExpiry(token)
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertTrue(t, tc.expExpires.Equal(gotExpires),
+				fmt.Sprintf("want expiry %s, got %s", tc.expExpires, gotExpires))
+		})
+	}
+}
+
+func TestAuth_BearerCredential(t *testing.T) {
+	keys := testIssuerKeys(t)
+	v := newTestTokenVerifier(t, keys, &security.TokenAuthConfig{})
+
+	token, err := NewBearerToken(keys["ecdsa"], &BearerClaims{
+		Issuer:    "ecdsa",
+		Subject:   "alice@EXAMPLE.COM",
+		ExpiresAt: time.Now().Add(time.Minute).Unix(),
+		Group:     "users",
+		Groups:    []string{"proj1", "proj2@EXAMPLE.COM"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := NewCredentialRequest(testDomainInfo(1000, 1000), nil)
+	req.getHostname = func() (string, error) { return "client1.example.com", nil }
+
+	cred, err := GetBearerCredential(test.Context(t), req, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, Flavor_AUTH_TOKEN, cred.Token.Flavor, "")
+	test.AssertEqual(t, Flavor_AUTH_TOKEN, cred.Verifier.Flavor, "")
+	if err := VerifyToken(nil, cred.Token, cred.Verifier.Data); err != nil {
+		t.Fatalf("agent verifier failed: %s", err)
+	}
+
+	if _, err := v.AuthSysFromBearer(&Token{Flavor: Flavor_AUTH_SYS}); err == nil {
+		t.Fatal("expected error for AUTH_SYS token")
+	}
+
+	sysToken, err := v.AuthSysFromBearer(cred.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, Flavor_AUTH_SYS, sysToken.Flavor, "")
+
+	sys := new(Sys)
+	if err := proto.Unmarshal(sysToken.Data, sys); err != nil {
+		t.Fatal(err)
+	}
+	expSys := &Sys{
+		Machinename: "client1",
+		User:        "alice@EXAMPLE.COM",
+		Group:       "users@",
+		Groups:      []string{"proj1@", "proj2@EXAMPLE.COM"},
+	}
+	if diff := cmp.Diff(expSys, sys, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected AUTH_SYS token (-want, +got):\n%s\n", diff)
+	}
+}
@@ -0,0 +1,251 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+const defaultExecTimeout = 5 * time.Second
+
+// Environment variables passed to exec identity and token helpers.
+const (
+	ClientUidEnv = "DAOS_CLIENT_UID"
+	ClientGidEnv = "DAOS_CLIENT_GID"
+	ClientPidEnv = "DAOS_CLIENT_PID"
+)
+
+// ErrNoIdentity indicates that an identity provider has no identity for the
+// client, and the next provider should be consulted.
+var ErrNoIdentity = errors.New("no identity found for client")
+
+type (
+	// Identity is the user and groups that a client credential is
+	// generated for.
+	Identity struct {
+		User   string   `json:"user"`
+		Group  string   `json:"group"`
+		Groups []string `json:"groups,omitempty"`
+	}
+
+	// IdentityProvider resolves the identity of a client process.
+	IdentityProvider interface {
+		Name() string
+		// GetIdentity returns the identity of the client, or
+		// ErrNoIdentity if the provider does not know the client.
+		GetIdentity(ctx context.Context, info *security.DomainInfo) (*Identity, error)
+	}
+)
+
+// WithIdentity sets the user and groups to be used for the request.
+func (r *CredentialRequest) WithIdentity(id *Identity) {
+	r.WithUserAndGroup(id.User, id.Group, id.Groups...)
+}
+
+// NewIdentityProvider creates an identity provider from its configuration.
+func NewIdentityProvider(cfg *security.IdentityProviderConfig) (IdentityProvider, error) {
+	if cfg == nil {
+		return nil, errors.Errorf("nil %T", cfg)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Type {
+	case security.IdentityProviderStatic:
+		return NewStaticIdentityProvider(cfg.ProviderName(), cfg.Path)
+	case security.IdentityProviderExec:
+		return NewExecIdentityProvider(cfg.ProviderName(), cfg.Path, cfg.Args, cfg.Timeout), nil
+	}
+	return nil, errors.Errorf("unknown identity provider type %q", cfg.Type)
+}
+
+// StaticIdentityProvider looks up client identities by UID in a file. The file
+// uses the same format as the client_user_map in the agent configuration.
+type StaticIdentityProvider struct {
+	name  string
+	users security.ClientUserMap
+}
+
+// NewStaticIdentityProvider loads the identities in the given file.
+func NewStaticIdentityProvider(name, path string) (*StaticIdentityProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "identity provider %q", name)
+	}
+
+	var users security.ClientUserMap
+	if err := yaml.UnmarshalStrict(data, &users); err != nil {
+		return nil, errors.Wrapf(err, "identity provider %q: parsing %s", name, path)
+	}
+
+	return &StaticIdentityProvider{
+		name:  name,
+		users: users,
+	}, nil
+}
+
+// Name returns the name of the provider.
+func (p *StaticIdentityProvider) Name() string {
+	return p.name
+}
+
+// GetIdentity looks up the identity for the client's UID.
+func (p *StaticIdentityProvider) GetIdentity(_ context.Context, info *security.DomainInfo) (*Identity, error) {
+	mu := p.users.Lookup(info.Uid())
+	if mu == nil {
+		return nil, ErrNoIdentity
+	}
+
+	return &Identity{
+		User:   mu.User,
+		Group:  mu.Group,
+		Groups: mu.Groups,
+	}, nil
+}
+
+// ExecIdentityProvider runs an external helper to resolve client identities.
+// The helper is passed the client's UID, GID and PID in the environment, and
+// writes the identity to stdout as a JSON object with "user", "group" and
+// optional "groups" fields. Exiting successfully without writing an identity
+// indicates that the helper does not know the client.
+type ExecIdentityProvider struct {
+	name    string
+	path    string
+	args    []string
+	timeout time.Duration
+}
+
+// NewExecIdentityProvider creates a provider that runs the given helper.
+func NewExecIdentityProvider(name, path string, args []string, timeout time.Duration) *ExecIdentityProvider {
+	if timeout == 0 {
+		timeout = defaultExecTimeout
+	}
+
+	return &ExecIdentityProvider{
+		name:    name,
+		path:    path,
+		args:    args,
+		timeout: timeout,
+	}
+}
+
+// Name returns the name of the provider.
+func (p *ExecIdentityProvider) Name() string {
+	return p.name
+}
+
+// GetIdentity runs the helper to get the identity for the client.
+func (p *ExecIdentityProvider) GetIdentity(ctx context.Context, info *security.DomainInfo) (*Identity, error) {
+	desc := fmt.Sprintf("identity provider %q", p.name)
+	out, err := runHelper(ctx, desc, p.path, p.args, p.timeout, info)
+	if err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, ErrNoIdentity
+	}
+
+	id := new(Identity)
+	if err := json.Unmarshal(out, id); err != nil {
+		return nil, errors.Wrapf(err, "%s: parsing output of %s", desc, p.path)
+	}
+	if id.User == "" {
+		return nil, ErrNoIdentity
+	}
+	if id.Group == "" {
+		return nil, errors.Errorf("%s: no group for user %q", desc, id.User)
+	}
+
+	return id, nil
+}
+
+// ExecTokenProvider runs an external helper to obtain bearer tokens for
+// clients. The helper is passed the client's UID, GID and PID in the
+// environment, and writes the token to stdout. Exiting successfully without
+// writing a token indicates that no token is available for the client.
+type ExecTokenProvider struct {
+	path    string
+	args    []string
+	timeout time.Duration
+}
+
+// NewExecTokenProvider creates a provider that runs the configured helper.
+func NewExecTokenProvider(cfg *security.TokenProviderConfig) (*ExecTokenProvider, error) {
+	if cfg == nil {
+		return nil, errors.Errorf("nil %T", cfg)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultExecTimeout
+	}
+
+	return &ExecTokenProvider{
+		path:    cfg.Path,
+		args:    cfg.Args,
+		timeout: timeout,
+	}, nil
+}
+
+// GetToken runs the helper to get a bearer token for the client.
+func (p *ExecTokenProvider) GetToken(ctx context.Context, info *security.DomainInfo) (string, error) {
+	out, err := runHelper(ctx, "token provider", p.path, p.args, p.timeout, info)
+	if err != nil {
+		return "", err
+	}
+	if len(out) == 0 {
+		return "", ErrNoToken
+	}
+
+	return string(out), nil
+}
+
+// runHelper runs an external helper for the client and returns its trimmed
+// output.
+func runHelper(ctx context.Context, desc, path string, args []string, timeout time.Duration, info *security.DomainInfo) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("%s=%d", ClientUidEnv, info.Uid()),
+		fmt.Sprintf("%s=%d", ClientGidEnv, info.Gid()),
+		fmt.Sprintf("%s=%d", ClientPidEnv, info.Pid()),
+	)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Don't wait for children of the helper that hold its output open after
+	// it has been killed.
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, errors.Errorf("%s: %s timed out after %s", desc, path, timeout)
+		}
+		return nil, errors.Wrapf(err, "%s: %s failed: %s", desc, path,
+			strings.TrimSpace(stderr.String()))
+	}
+
+	return bytes.TrimSpace(stdout.Bytes()), nil
+}
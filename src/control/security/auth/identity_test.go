@@ -0,0 +1,266 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func testDomainInfo(uid, gid uint32) *security.DomainInfo {
+	return security.InitDomainInfo(&syscall.Ucred{Pid: 42, Uid: uid, Gid: gid}, "")
+}
+
+func TestAuth_NewIdentityProvider(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	staticPath := test.CreateTestFile(t, dir, "1000:\n  user: alice\n  group: users\n")
+
+	for name, tc := range map[string]struct {
+		cfg     *security.IdentityProviderConfig
+		expName string
+		expType string
+		expErr  error
+	}{
+		"nil": {
+			expErr: errors.New("nil"),
+		},
+		"invalid": {
+			cfg:    &security.IdentityProviderConfig{Type: "ldap", Path: "x"},
+			expErr: errors.New("unknown identity provider type"),
+		},
+		"static": {
+			cfg:     &security.IdentityProviderConfig{Type: security.IdentityProviderStatic, Path: staticPath},
+			expName: "static",
+			expType: "static",
+		},
+		"static missing file": {
+			cfg:    &security.IdentityProviderConfig{Type: security.IdentityProviderStatic, Path: filepath.Join(dir, "missing")},
+			expErr: errors.New("no such file"),
+		},
+		"exec": {
+			cfg: &security.IdentityProviderConfig{
+				Name: "helper",
+				Type: security.IdentityProviderExec,
+				Path: "/usr/bin/true",
+			},
+			expName: "helper",
+			expType: "exec",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			prov, err := NewIdentityProvider(tc.cfg)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expName, prov.Name(), "")
+			test.AssertEqual(t, tc.expType, providerType(prov), "")
+		})
+	}
+}
+
+func providerType(prov IdentityProvider) string {
+	switch prov.(type) {
+	case *StaticIdentityProvider:
+		return "static"
+	case *ExecIdentityProvider:
+		return "exec"
+	}
+	return "unknown"
+}
+
+func TestAuth_StaticIdentityProvider(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	for name, tc := range map[string]struct {
+		content string
+		uid     uint32
+		expId   *Identity
+		expErr  error
+		loadErr error
+	}{
+		"invalid file": {
+			content: "1000: [alice]\n",
+			loadErr: errors.New("parsing"),
+		},
+		"found": {
+			content: `
+1000:
+  user: alice
+  group: users
+  groups: [proj1, proj2]
+`,
+			uid: 1000,
+			expId: &Identity{
+				User:   "alice",
+				Group:  "users",
+				Groups: []string{"proj1", "proj2"},
+			},
+		},
+		"not found": {
+			content: "1000:\n  user: alice\n  group: users\n",
+			uid:     1001,
+			expErr:  ErrNoIdentity,
+		},
+		"default": {
+			content: "default:\n  user: nobody\n  group: nobody\n",
+			uid:     1001,
+			expId: &Identity{
+				User:  "nobody",
+				Group: "nobody",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := test.CreateTestFile(t, dir, tc.content)
+
+			prov, err := NewStaticIdentityProvider("static", path)
+			test.CmpErr(t, tc.loadErr, err)
+			if tc.loadErr != nil {
+				return
+			}
+
+			id, err := prov.GetIdentity(test.Context(t), testDomainInfo(tc.uid, tc.uid))
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expId, id); diff != "" {
+				t.Fatalf("unexpected identity (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAuth_ExecIdentityProvider(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	for name, tc := range map[string]struct {
+		script  string
+		timeout time.Duration
+		expId   *Identity
+		expErr  error
+	}{
+		"identity": {
+			script: `echo '{"user": "alice", "group": "users", "groups": ["proj1"]}'`,
+			expId: &Identity{
+				User:   "alice",
+				Group:  "users",
+				Groups: []string{"proj1"},
+			},
+		},
+		"uses client ids": {
+			script: `echo "{\"user\": \"u$DAOS_CLIENT_UID\", \"group\": \"g$DAOS_CLIENT_GID\", \"groups\": [\"p$DAOS_CLIENT_PID\"]}"`,
+			expId: &Identity{
+				User:   "u1000",
+				Group:  "g2000",
+				Groups: []string{"p42"},
+			},
+		},
+		"no output": {
+			script: "exit 0",
+			expErr: ErrNoIdentity,
+		},
+		"no user": {
+			script: `echo '{}'`,
+			expErr: ErrNoIdentity,
+		},
+		"no group": {
+			script: `echo '{"user": "alice"}'`,
+			expErr: errors.New("no group"),
+		},
+		"invalid output": {
+			script: "echo alice",
+			expErr: errors.New("parsing output"),
+		},
+		"failure": {
+			script: "echo 'lookup failed' >&2; exit 1",
+			expErr: errors.New("lookup failed"),
+		},
+		"timeout": {
+			script:  "sleep 5",
+			timeout: 100 * time.Millisecond,
+			expErr:  errors.New("timed out"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, "helper.sh")
+			if err := os.WriteFile(path, []byte("#!/bin/sh\n"+tc.script+"\n"), 0755); err != nil {
+				t.Fatal(err)
+			}
+
+			prov := NewExecIdentityProvider("exec", path, nil, tc.timeout)
+			id, err := prov.GetIdentity(test.Context(t), testDomainInfo(1000, 2000))
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expId, id); diff != "" {
+				t.Fatalf("unexpected identity (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestAuth_ExecTokenProvider(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	for name, tc := range map[string]struct {
+		script   string
+		cfg      *security.TokenProviderConfig
+		expToken string
+		expErr   error
+	}{
+		"nil config": {
+			expErr: errors.New("nil"),
+		},
+		"invalid config": {
+			cfg:    &security.TokenProviderConfig{},
+			expErr: errors.New("path is required"),
+		},
+		"token": {
+			script:   `echo "token-for-$DAOS_CLIENT_UID"`,
+			expToken: "token-for-1000",
+		},
+		"no token": {
+			script: "exit 0",
+			expErr: ErrNoToken,
+		},
+		"failure": {
+			script: "echo 'not logged in' >&2; exit 1",
+			expErr: errors.New("not logged in"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := tc.cfg
+			if tc.script != "" {
+				path := filepath.Join(dir, "helper.sh")
+				if err := os.WriteFile(path, []byte("#!/bin/sh\n"+tc.script+"\n"), 0755); err != nil {
+					t.Fatal(err)
+				}
+				cfg = &security.TokenProviderConfig{Path: path}
+			}
+
+			prov, err := NewExecTokenProvider(cfg)
+			if err == nil {
+				var token string
+				token, err = prov.GetToken(test.Context(t), testDomainInfo(1000, 2000))
+				test.AssertEqual(t, tc.expToken, token, "")
+			}
+			test.CmpErr(t, tc.expErr, err)
+		})
+	}
+}
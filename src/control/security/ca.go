@@ -0,0 +1,549 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// The CA directory layout matches that created by utils/certs/gen_certificates.sh.
+const (
+	CACertName = "daosCA.crt"
+	CAKeyName  = "daosCA.key"
+	CACRLName  = "daosCA.crl"
+
+	caIndexName  = "index.yaml"
+	caPrivateDir = "private"
+	caCertsDir   = "certs"
+	caOrg        = "DAOS"
+
+	// DefaultCertValidity is the default validity period of the CA and of
+	// the certificates that it issues.
+	DefaultCertValidity = 1095 * day
+
+	pemTypeCert = "CERTIFICATE"
+	pemTypeKey  = "RSA PRIVATE KEY"
+	pemTypeCRL  = "X509 CRL"
+
+	privateDirPerm os.FileMode = 0700
+	publicDirPerm  os.FileMode = 0755
+	publicFilePerm os.FileMode = 0644
+	caIndexPerm    os.FileMode = 0600
+)
+
+// certKeyBits is the size of the RSA keys generated for the CA and the
+// certificates that it issues.
+var certKeyBits = 3072
+
+type (
+	// IssuedCert records a certificate issued by the CA.
+	IssuedCert struct {
+		Serial    string    `yaml:"serial" json:"serial"`
+		Component string    `yaml:"component" json:"component"`
+		Hosts     []string  `yaml:"hosts,omitempty" json:"hosts,omitempty"`
+		Roles     []string  `yaml:"roles,omitempty" json:"roles,omitempty"`
+		CertPath  string    `yaml:"cert" json:"cert"`
+		KeyPath   string    `yaml:"key" json:"key"`
+		NotAfter  time.Time `yaml:"not_after" json:"not_after"`
+		Revoked   time.Time `yaml:"revoked,omitempty" json:"revoked,omitempty"`
+	}
+
+	// caIndex is the record of the certificates issued and revoked by the CA.
+	caIndex struct {
+		CRLNumber int64         `yaml:"crl_number"`
+		Certs     []*IssuedCert `yaml:"certs"`
+	}
+
+	// CertAuthority is a local certificate authority for issuing the
+	// certificates used by DAOS components.
+	CertAuthority struct {
+		dir  string
+		cert *x509.Certificate
+		key  crypto.Signer
+	}
+)
+
+// IsRevoked indicates whether the certificate has been revoked.
+func (ic *IssuedCert) IsRevoked() bool {
+	return !ic.Revoked.IsZero()
+}
+
+// CACertPath returns the path of the CA certificate.
+func (ca *CertAuthority) CACertPath() string {
+	return filepath.Join(ca.dir, caCertsDir, CACertName)
+}
+
+// CRLPath returns the path of the CA's certificate revocation list.
+func (ca *CertAuthority) CRLPath() string {
+	return filepath.Join(ca.dir, caCertsDir, CACRLName)
+}
+
+// CertsDir returns the directory that issued certificates are written to by
+// default.
+func (ca *CertAuthority) CertsDir() string {
+	return filepath.Join(ca.dir, caCertsDir)
+}
+
+func (ca *CertAuthority) keyPath() string {
+	return filepath.Join(ca.dir, caPrivateDir, CAKeyName)
+}
+
+func (ca *CertAuthority) indexPath() string {
+	return filepath.Join(ca.dir, caIndexName)
+}
+
+// writeFile atomically replaces the file at path, so that a partially written
+// file is never loaded by a component that is monitoring it.
+func writeFile(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func newSerial() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 127))
+}
+
+// makeCADir creates the directory with the given permissions if it does not
+// exist. The permissions of an existing directory are left alone, but it is
+// rejected if they are more permissive than those required.
+func makeCADir(path string, perm os.FileMode) error {
+	fi, err := os.Stat(path)
+	if err == nil {
+		if !fi.IsDir() {
+			return errors.Errorf("%s is not a directory", path)
+		}
+		if fi.Mode().Perm()&^perm != 0 {
+			return errors.Errorf("%s has permissions %#o, which must not be more permissive than %#o",
+				path, fi.Mode().Perm(), perm)
+		}
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := os.MkdirAll(path, perm); err != nil {
+		return err
+	}
+	// Apply the permissions regardless of the umask.
+	return os.Chmod(path, perm)
+}
+
+// InitCA creates a new certificate authority in dir, with a CA certificate
+// that is valid for the given duration.
+func InitCA(dir string, validity time.Duration) (*CertAuthority, error) {
+	if dir == "" {
+		return nil, errors.New("CA directory is required")
+	}
+	if validity <= 0 {
+		validity = DefaultCertValidity
+	}
+
+	ca := &CertAuthority{dir: dir}
+	if _, err := os.Stat(ca.CACertPath()); err == nil {
+		return nil, errors.Errorf("CA already initialized in %s", dir)
+	}
+
+	for _, d := range []struct {
+		path string
+		perm os.FileMode
+	}{
+		{dir, privateDirPerm},
+		{filepath.Join(dir, caPrivateDir), privateDirPerm},
+		{ca.CertsDir(), publicDirPerm},
+	} {
+		if err := makeCADir(d.path, d.perm); err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, certKeyBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "generating CA key")
+	}
+	serial, err := newSerial()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{caOrg},
+			CommonName:   "DAOS CA",
+		},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(validity),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		MaxPathLen:            1,
+		KeyUsage: x509.KeyUsageDigitalSignature | x509.KeyUsageContentCommitment |
+			x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating CA certificate")
+	}
+	if ca.cert, err = x509.ParseCertificate(der); err != nil {
+		return nil, err
+	}
+	ca.key = key
+
+	if err := writeFile(ca.keyPath(), pem.EncodeToMemory(&pem.Block{
+		Type:  pemTypeKey,
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}), MaxUserOnlyKeyPerm); err != nil {
+		return nil, err
+	}
+	if err := writeFile(ca.CACertPath(), pem.EncodeToMemory(&pem.Block{
+		Type:  pemTypeCert,
+		Bytes: der,
+	}), publicFilePerm); err != nil {
+		return nil, err
+	}
+
+	idx := new(caIndex)
+	if err := ca.writeCRL(idx); err != nil {
+		return nil, err
+	}
+	if err := ca.writeIndex(idx); err != nil {
+		return nil, err
+	}
+
+	return ca, nil
+}
+
+// LoadCA loads the certificate authority in dir.
+func LoadCA(dir string) (*CertAuthority, error) {
+	ca := &CertAuthority{dir: dir}
+
+	cert, err := LoadCertificate(ca.CACertPath())
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading CA certificate from %s", dir)
+	}
+	key, err := LoadPrivateKey(ca.keyPath())
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading CA key from %s", dir)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.Errorf("CA key %s cannot be used for signing", ca.keyPath())
+	}
+	ca.cert = cert
+	ca.key = signer
+
+	return ca, nil
+}
+
+func (ca *CertAuthority) readIndex() (*caIndex, error) {
+	data, err := os.ReadFile(ca.indexPath())
+	if err != nil {
+		return nil, err
+	}
+
+	idx := new(caIndex)
+	if err := yaml.Unmarshal(data, idx); err != nil {
+		return nil, errors.Wrapf(err, "parsing %s", ca.indexPath())
+	}
+	return idx, nil
+}
+
+func (ca *CertAuthority) writeIndex(idx *caIndex) error {
+	data, err := yaml.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	return writeFile(ca.indexPath(), data, caIndexPerm)
+}
+
+// Certificates returns the certificates issued by the CA.
+func (ca *CertAuthority) Certificates() ([]*IssuedCert, error) {
+	idx, err := ca.readIndex()
+	if err != nil {
+		return nil, err
+	}
+	return idx.Certs, nil
+}
+
+// componentKeyPerms returns the permissions for the key of the component's
+// certificate, matching those required by its default transport config. The
+// admin key may be shared with a group of administrators.
+func componentKeyPerms(comp Component) os.FileMode {
+	if comp == ComponentAdmin {
+		return MaxGroupKeyPerm
+	}
+	return MaxUserOnlyKeyPerm
+}
+
+// Issue creates a certificate and key for the DAOS component in outDir, named
+// for the component. If outDir is not set, they are written to the CA's certs
+// directory, in a subdirectory named for the first host if any are given. An
+// existing certificate or key is only replaced if force is set. The
+// certificate's common name identifies the component, and the hosts are added
+// as subject alternative names. Roles for an access policy may be assigned to
+// admin certificates, and are added as organizational units.
+func (ca *CertAuthority) Issue(comp Component, hosts, roles []string, outDir string, validity time.Duration, force bool) (*IssuedCert, error) {
+	extUsage := []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	switch comp {
+	case ComponentServer:
+		extUsage = append([]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, extUsage...)
+	case ComponentAgent, ComponentAdmin:
+	default:
+		return nil, errors.Errorf("invalid component %q", comp)
+	}
+	if len(roles) > 0 && comp != ComponentAdmin {
+		return nil, errors.New("roles can only be assigned to admin certificates")
+	}
+	if outDir == "" {
+		outDir = ca.CertsDir()
+		if len(hosts) > 0 {
+			outDir = filepath.Join(outDir, hosts[0])
+		}
+	}
+	if validity <= 0 {
+		validity = DefaultCertValidity
+	}
+
+	certPath := filepath.Join(outDir, comp.String()+".crt")
+	keyPath := filepath.Join(outDir, comp.String()+".key")
+	if !force {
+		for _, path := range []string{certPath, keyPath} {
+			if _, err := os.Stat(path); err == nil {
+				return nil, errors.Wrap(os.ErrExist, path)
+			} else if !os.IsNotExist(err) {
+				return nil, err
+			}
+		}
+	}
+
+	idx, err := ca.readIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, certKeyBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "generating key")
+	}
+	serial, err := newSerial()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	notAfter := now.Add(validity)
+	if notAfter.After(ca.cert.NotAfter) {
+		notAfter = ca.cert.NotAfter
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization:       []string{caOrg},
+			OrganizationalUnit: roles,
+			CommonName:         comp.String(),
+		},
+		NotBefore:   now.Add(-time.Minute),
+		NotAfter:    notAfter,
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: extUsage,
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+			continue
+		}
+		tmpl.DNSNames = append(tmpl.DNSNames, host)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating certificate")
+	}
+
+	if err := os.MkdirAll(outDir, publicDirPerm); err != nil {
+		return nil, err
+	}
+	ic := &IssuedCert{
+		Serial:    serial.String(),
+		Component: comp.String(),
+		Hosts:     hosts,
+		Roles:     roles,
+		CertPath:  certPath,
+		KeyPath:   keyPath,
+		NotAfter:  notAfter,
+	}
+
+	// Write the key first, so that a component monitoring the files does
+	// not load the new certificate with the old key.
+	if err := writeFile(ic.KeyPath, pem.EncodeToMemory(&pem.Block{
+		Type:  pemTypeKey,
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}), componentKeyPerms(comp)); err != nil {
+		return nil, err
+	}
+	if err := writeFile(ic.CertPath, pem.EncodeToMemory(&pem.Block{
+		Type:  pemTypeCert,
+		Bytes: der,
+	}), publicFilePerm); err != nil {
+		return nil, err
+	}
+
+	idx.Certs = append(idx.Certs, ic)
+	if err := ca.writeIndex(idx); err != nil {
+		return nil, err
+	}
+
+	return ic, nil
+}
+
+// Revoke revokes the certificate with the given serial number, and updates the
+// certificate revocation list.
+func (ca *CertAuthority) Revoke(serial string) (*IssuedCert, error) {
+	idx, err := ca.readIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	var ic *IssuedCert
+	for _, c := range idx.Certs {
+		if c.Serial == serial {
+			ic = c
+			break
+		}
+	}
+	if ic == nil {
+		return nil, errors.Errorf("no certificate with serial %s was issued by this CA", serial)
+	}
+	if ic.IsRevoked() {
+		return nil, errors.Errorf("certificate with serial %s was already revoked on %s", serial,
+			ic.Revoked.Format(time.RFC3339))
+	}
+	ic.Revoked = time.Now().UTC().Truncate(time.Second)
+
+	if err := ca.writeCRL(idx); err != nil {
+		return nil, err
+	}
+	if err := ca.writeIndex(idx); err != nil {
+		return nil, err
+	}
+
+	return ic, nil
+}
+
+// writeCRL writes a new certificate revocation list for the revoked
+// certificates in the index.
+func (ca *CertAuthority) writeCRL(idx *caIndex) error {
+	idx.CRLNumber++
+
+	tmpl := &x509.RevocationList{
+		Number:     big.NewInt(idx.CRLNumber),
+		ThisUpdate: time.Now(),
+		NextUpdate: ca.cert.NotAfter,
+	}
+	for _, ic := range idx.Certs {
+		if !ic.IsRevoked() {
+			continue
+		}
+		serial, ok := new(big.Int).SetString(ic.Serial, 10)
+		if !ok {
+			return errors.Errorf("invalid serial %q in %s", ic.Serial, ca.indexPath())
+		}
+		tmpl.RevokedCertificateEntries = append(tmpl.RevokedCertificateEntries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: ic.Revoked,
+		})
+	}
+
+	der, err := x509.CreateRevocationList(rand.Reader, tmpl, ca.cert, ca.key)
+	if err != nil {
+		return errors.Wrap(err, "creating certificate revocation list")
+	}
+
+	return writeFile(ca.CRLPath(), pem.EncodeToMemory(&pem.Block{
+		Type:  pemTypeCRL,
+		Bytes: der,
+	}), publicFilePerm)
+}
+
+// LoadCRL loads a PEM encoded certificate revocation list, and verifies that
+// it was signed by the CA.
+func LoadCRL(path string, caCert *x509.Certificate) (*x509.RevocationList, error) {
+	pemData, err := LoadPEMData(path, MaxCertPerm)
+	if err != nil {
+		switch {
+		case os.IsNotExist(err):
+			return nil, FaultMissingCertFile(path)
+		case os.IsPermission(err):
+			return nil, FaultUnreadableCertFile(path)
+		default:
+			return nil, errors.Wrap(err, "could not load CRL")
+		}
+	}
+
+	block, _ := pem.Decode(pemData)
+	if block == nil || block.Type != pemTypeCRL {
+		return nil, FaultInvalidCRL(path, errors.New("no CRL found in PEM data"))
+	}
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	if err != nil {
+		return nil, FaultInvalidCRL(path, err)
+	}
+	if caCert == nil {
+		return nil, FaultInvalidCRL(path, errors.New("no CA certificate to verify against"))
+	}
+	if err := crl.CheckSignatureFrom(caCert); err != nil {
+		return nil, FaultInvalidCRL(path, err)
+	}
+
+	return crl, nil
+}
+
+// checkRevoked returns an error if the certificate is in the revocation list.
+func checkRevoked(crl *x509.RevocationList, cert *x509.Certificate) error {
+	if crl == nil || cert == nil {
+		return nil
+	}
+
+	for _, entry := range crl.RevokedCertificateEntries {
+		if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return FaultRevokedCert(fmt.Sprintf("%s (serial %s)", cert.Subject, cert.SerialNumber),
+				entry.RevocationTime)
+		}
+	}
+	return nil
+}
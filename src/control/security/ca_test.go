@@ -0,0 +1,334 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+)
+
+func newTestCertAuthority(t *testing.T, dir string) *CertAuthority {
+	t.Helper()
+
+	// Smaller keys keep the tests fast.
+	origBits := certKeyBits
+	certKeyBits = 2048
+	t.Cleanup(func() { certKeyBits = origBits })
+
+	ca, err := InitCA(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ca
+}
+
+func checkFilePerm(t *testing.T, path string, exp os.FileMode) {
+	t.Helper()
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, exp, fi.Mode().Perm(), "unexpected permissions for "+path)
+}
+
+func TestSecurity_InitCA(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	caDir := filepath.Join(dir, "daosCA")
+	ca := newTestCertAuthority(t, caDir)
+
+	checkFilePerm(t, caDir, privateDirPerm)
+	checkFilePerm(t, filepath.Join(caDir, caPrivateDir), privateDirPerm)
+	checkFilePerm(t, ca.keyPath(), MaxUserOnlyKeyPerm)
+	checkFilePerm(t, ca.CACertPath(), publicFilePerm)
+	checkFilePerm(t, ca.CRLPath(), publicFilePerm)
+
+	crl, err := LoadCRL(ca.CRLPath(), ca.cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 0, len(crl.RevokedCertificateEntries), "expected empty CRL")
+
+	_, err = InitCA(caDir, 0)
+	test.CmpErr(t, errors.New("already initialized"), err)
+
+	loaded, err := LoadCA(caDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, loaded.cert.Equal(ca.cert), "loaded CA certificate differs")
+
+	_, err = LoadCA(dir)
+	test.CmpErr(t, errors.New("loading CA certificate"), err)
+
+	// An existing directory is used as-is if its permissions are suitable.
+	existingDir := filepath.Join(dir, "existing")
+	if err := os.Mkdir(existingDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	newTestCertAuthority(t, existingDir)
+
+	// An existing directory that is too permissive is rejected rather than
+	// having its permissions changed.
+	openDir := filepath.Join(dir, "open")
+	if err := os.Mkdir(openDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(openDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	_, err = InitCA(openDir, 0)
+	test.CmpErr(t, errors.New("must not be more permissive"), err)
+	checkFilePerm(t, openDir, 0755)
+}
+
+func TestSecurity_CertAuthority_Issue(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	ca := newTestCertAuthority(t, filepath.Join(dir, "daosCA"))
+
+	for name, tc := range map[string]struct {
+		comp        Component
+		hosts       []string
+		roles       []string
+		outDir      string
+		expKeyPerm  os.FileMode
+		expExtUsage []x509.ExtKeyUsage
+		expDNS      []string
+		expIPs      int
+		expDir      string
+		expErr      error
+	}{
+		"undefined component": {
+			comp:   ComponentUndefined,
+			expErr: errors.New("invalid component"),
+		},
+		"server": {
+			comp:        ComponentServer,
+			hosts:       []string{"server-1", "10.0.0.1"},
+			expDir:      filepath.Join(ca.CertsDir(), "server-1"),
+			expKeyPerm:  MaxUserOnlyKeyPerm,
+			expExtUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+			expDNS:      []string{"server-1"},
+			expIPs:      1,
+		},
+		"agent": {
+			comp:        ComponentAgent,
+			outDir:      filepath.Join(dir, "client-1"),
+			expKeyPerm:  MaxUserOnlyKeyPerm,
+			expExtUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		},
+		"server on second host": {
+			comp:        ComponentServer,
+			hosts:       []string{"server-2"},
+			expDir:      filepath.Join(ca.CertsDir(), "server-2"),
+			expKeyPerm:  MaxUserOnlyKeyPerm,
+			expExtUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+			expDNS:      []string{"server-2"},
+		},
+		"roles for server": {
+			comp:   ComponentServer,
+			roles:  []string{"read-only"},
+			expErr: errors.New("only be assigned to admin"),
+		},
+		"admin": {
+			comp:        ComponentAdmin,
+			roles:       []string{"read-only"},
+			expKeyPerm:  MaxGroupKeyPerm,
+			expExtUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ic, err := ca.Issue(tc.comp, tc.hosts, tc.roles, tc.outDir, 0, false)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			expDir := tc.outDir
+			if tc.expDir != "" {
+				expDir = tc.expDir
+			}
+			if expDir == "" {
+				expDir = ca.CertsDir()
+			}
+			test.AssertEqual(t, filepath.Join(expDir, tc.comp.String()+".crt"), ic.CertPath, "")
+			test.AssertEqual(t, filepath.Join(expDir, tc.comp.String()+".key"), ic.KeyPath, "")
+			checkFilePerm(t, ic.CertPath, publicFilePerm)
+			checkFilePerm(t, ic.KeyPath, tc.expKeyPerm)
+
+			// The issued files must be usable by the component.
+			cfg := &TransportConfig{
+				CertificateConfig: CertificateConfig{
+					CARootPath:      ca.CACertPath(),
+					CertificatePath: ic.CertPath,
+					PrivateKeyPath:  ic.KeyPath,
+					CRLPath:         ca.CRLPath(),
+					maxKeyPerms:     tc.expKeyPerm,
+				},
+			}
+			if err := cfg.PreLoadCertData(); err != nil {
+				t.Fatal(err)
+			}
+
+			leaf := cfg.tlsKeypair.Leaf
+			test.AssertEqual(t, tc.comp, CommonNameToComponent(leaf.Subject.CommonName), "")
+			test.AssertEqual(t, ic.Serial, leaf.SerialNumber.String(), "")
+			if diff := cmp.Diff(tc.expExtUsage, leaf.ExtKeyUsage); diff != "" {
+				t.Fatalf("unexpected extended key usage (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expDNS, leaf.DNSNames); diff != "" {
+				t.Fatalf("unexpected DNS names (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expIPs, len(leaf.IPAddresses), "unexpected IP addresses")
+			if diff := cmp.Diff(tc.roles, leaf.Subject.OrganizationalUnit); diff != "" {
+				t.Fatalf("unexpected roles (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+
+	certs, err := ca.Certificates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 4, len(certs), "unexpected number of issued certificates")
+
+	// An existing certificate is only replaced when forced.
+	_, err = ca.Issue(ComponentServer, []string{"server-1"}, nil, "", 0, false)
+	test.CmpErr(t, os.ErrExist, err)
+
+	ic, err := ca.Issue(ComponentServer, []string{"server-1"}, nil, "", 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := LoadCertificate(ic.CertPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, ic.Serial, cert.SerialNumber.String(), "certificate not replaced")
+}
+
+func TestSecurity_CertAuthority_Revoke(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	ca := newTestCertAuthority(t, filepath.Join(dir, "daosCA"))
+
+	srvCert, err := ca.Issue(ComponentServer, nil, nil, filepath.Join(dir, "server"), 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adminCert, err := ca.Issue(ComponentAdmin, nil, nil, filepath.Join(dir, "admin"), 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newCfg := func(ic *IssuedCert, keyPerms os.FileMode) *TransportConfig {
+		return &TransportConfig{
+			CertificateConfig: CertificateConfig{
+				CARootPath:      ca.CACertPath(),
+				CertificatePath: ic.CertPath,
+				PrivateKeyPath:  ic.KeyPath,
+				CRLPath:         ca.CRLPath(),
+				maxKeyPerms:     keyPerms,
+			},
+		}
+	}
+	srvCfg := newCfg(srvCert, MaxUserOnlyKeyPerm)
+	if err := srvCfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	cliCfg := newCfg(adminCert, MaxGroupKeyPerm)
+	if err := cliCfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+
+	srvTLS := serverTLSConfig(srvCfg)
+	cliTLS := clientTLSConfig(cliCfg)
+	if _, _, err := tlsHandshake(srvTLS, cliTLS); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ca.Revoke("12345")
+	test.CmpErr(t, errors.New("no certificate with serial"), err)
+
+	revoked, err := ca.Revoke(adminCert.Serial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, revoked.IsRevoked(), "expected certificate to be revoked")
+
+	_, err = ca.Revoke(adminCert.Serial)
+	test.CmpErr(t, errors.New("already revoked"), err)
+
+	// The server rejects the revoked client certificate once it has loaded
+	// the updated CRL.
+	if err := srvCfg.ReloadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = tlsHandshake(srvTLS, cliTLS)
+	if !fault.IsFaultCode(errors.Cause(err), code.SecurityRevokedCert) {
+		t.Fatalf("expected revoked certificate fault, got %v", err)
+	}
+
+	// A component cannot load its own revoked certificate.
+	err = newCfg(adminCert, MaxGroupKeyPerm).PreLoadCertData()
+	if !fault.IsFaultCode(err, code.SecurityRevokedCert) {
+		t.Fatalf("expected revoked certificate fault, got %v", err)
+	}
+}
+
+func TestSecurity_LoadCRL(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	ca := newTestCertAuthority(t, filepath.Join(dir, "ca1"))
+	other := newTestCertAuthority(t, filepath.Join(dir, "ca2"))
+
+	for name, tc := range map[string]struct {
+		path   string
+		caCert *x509.Certificate
+		expErr error
+	}{
+		"missing": {
+			path:   filepath.Join(dir, "missing.crl"),
+			caCert: ca.cert,
+			expErr: FaultMissingCertFile(filepath.Join(dir, "missing.crl")),
+		},
+		"not a CRL": {
+			path:   ca.CACertPath(),
+			caCert: ca.cert,
+			expErr: FaultInvalidCRL(ca.CACertPath(), nil),
+		},
+		"wrong CA": {
+			path:   other.CRLPath(),
+			caCert: ca.cert,
+			expErr: FaultInvalidCRL(other.CRLPath(), nil),
+		},
+		"success": {
+			path:   ca.CRLPath(),
+			caCert: ca.cert,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := LoadCRL(tc.path, tc.caCert)
+			test.CmpErr(t, tc.expErr, err)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	defaultCertCheckInterval = time.Minute
+	day                      = 24 * time.Hour
+)
+
+// DefaultCertExpiryWarningDays are the numbers of days before a certificate
+// expires at which an expiry warning is raised, if none are configured.
+var DefaultCertExpiryWarningDays = []uint{30, 7, 1}
+
+type (
+	// CertExpiryNotice describes a loaded certificate that is due to expire
+	// within one of the configured warning thresholds, or has expired.
+	CertExpiryNotice struct {
+		Cert      *CertificateInfo
+		Threshold uint // days
+		Remaining time.Duration
+	}
+
+	// CertExpiryHandler is called when a loaded certificate crosses an
+	// expiry warning threshold.
+	CertExpiryHandler func(*CertExpiryNotice)
+
+	// fileStamp identifies a version of a certificate file.
+	fileStamp struct {
+		modTime time.Time
+		size    int64
+	}
+
+	// CertMonitor watches the certificate files in a TransportConfig,
+	// reloading them when they change and raising notices as the loaded
+	// certificates approach expiry.
+	CertMonitor struct {
+		sync.Mutex
+		log        logging.Logger
+		cfg        *TransportConfig
+		interval   time.Duration
+		thresholds []uint
+		onExpiry   CertExpiryHandler
+		loaded     map[string]fileStamp
+		failed     map[string]fileStamp
+		notified   map[string]uint
+		now        func() time.Time
+	}
+)
+
+// Expired indicates whether the certificate has expired.
+func (n *CertExpiryNotice) Expired() bool {
+	return n.Remaining <= 0
+}
+
+func (n *CertExpiryNotice) String() string {
+	if n.Expired() {
+		return fmt.Sprintf("certificate %s (%s) expired on %s", n.Cert.Path, n.Cert.Subject,
+			n.Cert.NotAfter.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("certificate %s (%s) expires in %d day(s) on %s", n.Cert.Path,
+		n.Cert.Subject, int(n.Remaining/day), n.Cert.NotAfter.Format(time.RFC3339))
+}
+
+// NewCertMonitor creates a monitor for the certificates in the supplied
+// TransportConfig, which should already have its certificate data loaded. By
+// default, expiry notices are logged.
+func NewCertMonitor(log logging.Logger, cfg *TransportConfig) *CertMonitor {
+	m := &CertMonitor{
+		log:        log,
+		cfg:        cfg,
+		interval:   cfg.CertCheckInterval,
+		thresholds: cfg.CertExpiryWarningDays,
+		notified:   make(map[string]uint),
+		now:        time.Now,
+	}
+	if m.interval <= 0 {
+		m.interval = defaultCertCheckInterval
+	}
+	if len(m.thresholds) == 0 {
+		m.thresholds = DefaultCertExpiryWarningDays
+	}
+	m.thresholds = append([]uint{}, m.thresholds...)
+	sort.Slice(m.thresholds, func(i, j int) bool { return m.thresholds[i] < m.thresholds[j] })
+	m.onExpiry = m.logExpiry
+	m.loaded = m.fileStamps()
+
+	return m
+}
+
+// WithExpiryHandler sets the function called for expiry notices.
+func (m *CertMonitor) WithExpiryHandler(fn CertExpiryHandler) *CertMonitor {
+	m.onExpiry = fn
+	return m
+}
+
+func (m *CertMonitor) logExpiry(n *CertExpiryNotice) {
+	if n.Expired() {
+		m.log.Error(n.String())
+		return
+	}
+	m.log.Notice(n.String())
+}
+
+func (m *CertMonitor) certFiles() []string {
+	files := []string{m.cfg.CARootPath, m.cfg.CertificatePath, m.cfg.PrivateKeyPath}
+	if m.cfg.CRLPath != "" {
+		files = append(files, m.cfg.CRLPath)
+	}
+	return files
+}
+
+func (m *CertMonitor) fileStamps() map[string]fileStamp {
+	stamps := make(map[string]fileStamp)
+	for _, path := range m.certFiles() {
+		fi, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		stamps[path] = fileStamp{
+			modTime: fi.ModTime(),
+			size:    fi.Size(),
+		}
+	}
+	return stamps
+}
+
+func stampsEqual(a, b map[string]fileStamp) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for path, stamp := range a {
+		if other, found := b[path]; !found || !other.modTime.Equal(stamp.modTime) || other.size != stamp.size {
+			return false
+		}
+	}
+	return true
+}
+
+// Start checks the certificates immediately and then periodically until the
+// context is canceled.
+func (m *CertMonitor) Start(ctx context.Context) {
+	if m.cfg.AllowInsecure {
+		return
+	}
+
+	m.Check()
+	go func() {
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.Check()
+			}
+		}
+	}()
+}
+
+// Check reloads the certificate files if they have changed since they were
+// last loaded, and raises notices for certificates that have crossed an expiry
+// warning threshold since the last check. If the changed files cannot be
+// loaded, the previously loaded certificates remain in use.
+func (m *CertMonitor) Check() {
+	if m.cfg.AllowInsecure {
+		return
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.reloadIfChanged()
+	m.checkExpiry()
+}
+
+func (m *CertMonitor) reloadIfChanged() {
+	stamps := m.fileStamps()
+	if stampsEqual(stamps, m.loaded) {
+		return
+	}
+
+	if err := m.cfg.ReloadCertData(); err != nil {
+		// The files may be part-way through being replaced, so only
+		// report the failure once for each set of changes.
+		if !stampsEqual(stamps, m.failed) {
+			m.log.Errorf("failed to reload changed TLS certificates (still using previous certificates): %s", err)
+		}
+		m.failed = stamps
+		return
+	}
+
+	m.log.Noticef("reloaded TLS certificates from %s", m.cfg.CertificatePath)
+	m.loaded = stamps
+	m.failed = nil
+}
+
+func (m *CertMonitor) checkExpiry() {
+	now := m.now()
+
+	for _, cert := range m.cfg.Certificates() {
+		remaining := cert.ExpiresIn(now)
+
+		var threshold uint
+		var found bool
+		if remaining <= 0 {
+			found = true
+		} else {
+			for _, days := range m.thresholds {
+				if remaining <= time.Duration(days)*day {
+					threshold = days
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			continue
+		}
+
+		// Each threshold is only reported once for a given certificate.
+		key := cert.Path + ":" + cert.Serial
+		if last, seen := m.notified[key]; seen && last <= threshold {
+			continue
+		}
+		m.notified[key] = threshold
+
+		m.onExpiry(&CertExpiryNotice{
+			Cert:      cert,
+			Threshold: threshold,
+			Remaining: remaining,
+		})
+	}
+}
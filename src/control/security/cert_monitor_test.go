@@ -0,0 +1,337 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *rsa.PrivateKey
+	pem  []byte
+}
+
+func newTestKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key := newTestKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "DAOS CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(10 * 365 * day),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue returns PEM encoded certificate and key data for a new certificate
+// signed by the CA.
+func (ca *testCA) issue(t *testing.T, cn string, serial int64, notAfter time.Time) ([]byte, []byte) {
+	t.Helper()
+
+	key := newTestKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+// writeTestFile replaces a file in the same way as a certificate rotation
+// tool would, with a distinct modification time.
+func writeTestFile(t *testing.T, path string, data []byte, perm os.FileMode, mtime time.Time) {
+	t.Helper()
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(tmp, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeTestCerts(t *testing.T, dir, name string, ca *testCA, certPEM, keyPEM []byte, mtime time.Time) *TransportConfig {
+	t.Helper()
+
+	cfg := &TransportConfig{
+		CertificateConfig: CertificateConfig{
+			CARootPath:      filepath.Join(dir, "daosCA.crt"),
+			CertificatePath: filepath.Join(dir, name+".crt"),
+			PrivateKeyPath:  filepath.Join(dir, name+".key"),
+			maxKeyPerms:     MaxUserOnlyKeyPerm,
+		},
+	}
+	writeTestFile(t, cfg.CARootPath, ca.pem, MaxCertPerm, mtime)
+	writeTestFile(t, cfg.CertificatePath, certPEM, MaxCertPerm, mtime)
+	writeTestFile(t, cfg.PrivateKeyPath, keyPEM, MaxUserOnlyKeyPerm, mtime)
+
+	return cfg
+}
+
+func TestSecurity_CertMonitor_Expiry(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	start := time.Now()
+	ca := newTestCA(t)
+	certPEM, keyPEM := ca.issue(t, "server", 2, start.Add(45*day))
+	cfg := writeTestCerts(t, dir, "server", ca, certPEM, keyPEM, start)
+	cfg.CertExpiryWarningDays = []uint{7, 30}
+	if err := cfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+
+	var notices []string
+	mon := NewCertMonitor(log, cfg).WithExpiryHandler(func(n *CertExpiryNotice) {
+		notices = append(notices, n.Cert.Name)
+		if n.Expired() {
+			notices = append(notices, "expired")
+		} else {
+			notices = append(notices, time.Duration(n.Threshold*uint(day)).String())
+		}
+	})
+
+	// The checks build on each other, so they are run in order.
+	for _, tc := range []struct {
+		desc       string
+		now        time.Time
+		expNotices []string
+	}{
+		{
+			desc: "not within a threshold",
+			now:  start,
+		},
+		{
+			desc:       "within largest threshold",
+			now:        start.Add(20 * day),
+			expNotices: []string{"cert", (30 * day).String()},
+		},
+		{
+			desc: "threshold already reported",
+			now:  start.Add(21 * day),
+		},
+		{
+			desc:       "within smallest threshold",
+			now:        start.Add(40 * day),
+			expNotices: []string{"cert", (7 * day).String()},
+		},
+		{
+			desc:       "expired",
+			now:        start.Add(46 * day),
+			expNotices: []string{"cert", "expired"},
+		},
+		{
+			desc: "expiry already reported",
+			now:  start.Add(47 * day),
+		},
+	} {
+		notices = nil
+		mon.now = func() time.Time { return tc.now }
+		mon.Check()
+		if diff := cmp.Diff(tc.expNotices, notices); diff != "" {
+			t.Fatalf("%s: unexpected notices (-want, +got):\n%s\n", tc.desc, diff)
+		}
+	}
+
+	// A replacement certificate resets the notices.
+	certPEM, keyPEM = ca.issue(t, "server", 3, start.Add(50*day))
+	writeTestCerts(t, dir, "server", ca, certPEM, keyPEM, start.Add(time.Minute))
+	notices = nil
+	mon.Check()
+	if diff := cmp.Diff([]string{"cert", (7 * day).String()}, notices); diff != "" {
+		t.Fatalf("unexpected notices after reload (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestSecurity_CertMonitor_Reload(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	start := time.Now()
+	ca := newTestCA(t)
+	certPEM, keyPEM := ca.issue(t, "server", 2, start.Add(365*day))
+	cfg := writeTestCerts(t, dir, "server", ca, certPEM, keyPEM, start)
+	if err := cfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	mon := NewCertMonitor(log, cfg)
+
+	checkSerial := func(t *testing.T, exp string) {
+		t.Helper()
+
+		mon.Check()
+		certs := cfg.Certificates()
+		if len(certs) != 2 {
+			t.Fatalf("expected cert and CA cert, got %d certificates", len(certs))
+		}
+		test.AssertEqual(t, exp, certs[0].Serial, "unexpected certificate serial")
+		test.AssertEqual(t, "1", certs[1].Serial, "unexpected CA certificate serial")
+	}
+
+	checkSerial(t, "2")
+
+	// A partially replaced key pair is not loaded, and the failure is only
+	// reported once.
+	newCertPEM, newKeyPEM := ca.issue(t, "server", 3, start.Add(365*day))
+	writeTestFile(t, cfg.CertificatePath, newCertPEM, MaxCertPerm, start.Add(time.Minute))
+	checkSerial(t, "2")
+	checkSerial(t, "2")
+	test.AssertEqual(t, 1, strings.Count(buf.String(), "failed to reload"), "")
+
+	writeTestFile(t, cfg.PrivateKeyPath, newKeyPEM, MaxUserOnlyKeyPerm, start.Add(time.Minute))
+	checkSerial(t, "3")
+	test.AssertEqual(t, 1, strings.Count(buf.String(), "reloaded TLS certificates"), "")
+}
+
+// tlsHandshake performs a TLS handshake between the server and client configs,
+// and returns the serial numbers of the certificates that each presented.
+func tlsHandshake(srvTLS, cliTLS *tls.Config) (string, string, error) {
+	srvConn, cliConn := net.Pipe()
+	defer srvConn.Close()
+	defer cliConn.Close()
+
+	srv := tls.Server(srvConn, srvTLS)
+	errCh := make(chan error, 1)
+	go func() {
+		err := srv.Handshake()
+		if err != nil {
+			// Unblock the client if the server rejects it.
+			srvConn.Close()
+		}
+		errCh <- err
+	}()
+
+	cli := tls.Client(cliConn, cliTLS)
+	cliErr := cli.Handshake()
+	if cliErr != nil {
+		cliConn.Close()
+	}
+	if err := <-errCh; err != nil {
+		return "", "", err
+	}
+	if cliErr != nil {
+		return "", "", cliErr
+	}
+
+	return cli.ConnectionState().PeerCertificates[0].SerialNumber.String(),
+		srv.ConnectionState().PeerCertificates[0].SerialNumber.String(), nil
+}
+
+func TestSecurity_TLSConfig_Reload(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	start := time.Now()
+	ca := newTestCA(t)
+	certPEM, keyPEM := ca.issue(t, ServerCommonName, 2, start.Add(365*day))
+	srvCfg := writeTestCerts(t, dir, "server", ca, certPEM, keyPEM, start)
+	if err := srvCfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	certPEM, keyPEM = ca.issue(t, "admin", 10, start.Add(365*day))
+	cliCfg := writeTestCerts(t, dir, "admin", ca, certPEM, keyPEM, start)
+	if err := cliCfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+
+	srvTLS := serverTLSConfig(srvCfg)
+	cliTLS := clientTLSConfig(cliCfg)
+
+	handshake := func(t *testing.T) (string, string) {
+		t.Helper()
+
+		srvSerial, cliSerial, err := tlsHandshake(srvTLS, cliTLS)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return srvSerial, cliSerial
+	}
+
+	srvSerial, cliSerial := handshake(t)
+	test.AssertEqual(t, "2", srvSerial, "unexpected server certificate")
+	test.AssertEqual(t, "10", cliSerial, "unexpected client certificate")
+
+	// Reloaded certificates are used for new connections.
+	certPEM, keyPEM = ca.issue(t, ServerCommonName, 3, start.Add(365*day))
+	writeTestCerts(t, dir, "server", ca, certPEM, keyPEM, start.Add(time.Minute))
+	if err := srvCfg.ReloadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	certPEM, keyPEM = ca.issue(t, "admin", 11, start.Add(365*day))
+	writeTestCerts(t, dir, "admin", ca, certPEM, keyPEM, start.Add(time.Minute))
+	if err := cliCfg.ReloadCertData(); err != nil {
+		t.Fatal(err)
+	}
+
+	srvSerial, cliSerial = handshake(t)
+	test.AssertEqual(t, "3", srvSerial, "unexpected server certificate after reload")
+	test.AssertEqual(t, "11", cliSerial, "unexpected client certificate after reload")
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -14,6 +15,7 @@ import (
 	"io/fs"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -91,11 +93,155 @@ func (cm ClientUserMap) Lookup(uid uint32) *MappedClientUser {
 	return cm[defaultMapKey]
 }
 
+// Identity provider types.
+const (
+	// IdentityProviderStatic looks up client identities in a static file.
+	IdentityProviderStatic = "static"
+	// IdentityProviderExec runs an external helper to resolve client
+	// identities.
+	IdentityProviderExec = "exec"
+)
+
+// IdentityProviderConfig defines a source of identities for client users.
+type IdentityProviderConfig struct {
+	Name            string        `yaml:"name,omitempty"`
+	Type            string        `yaml:"type"`
+	Path            string        `yaml:"path"`
+	Args            []string      `yaml:"args,omitempty"`
+	Timeout         time.Duration `yaml:"timeout,omitempty"`
+	CacheExpiration time.Duration `yaml:"cache_expiration,omitempty"`
+}
+
+// ProviderName returns the name of the identity provider, which defaults to
+// its type.
+func (ipc *IdentityProviderConfig) ProviderName() string {
+	if ipc.Name != "" {
+		return ipc.Name
+	}
+	return ipc.Type
+}
+
+// Validate checks the identity provider configuration.
+func (ipc *IdentityProviderConfig) Validate() error {
+	switch ipc.Type {
+	case IdentityProviderStatic, IdentityProviderExec:
+	case "":
+		return errors.New("identity provider type is required")
+	default:
+		return errors.Errorf("unknown identity provider type %q", ipc.Type)
+	}
+
+	if ipc.Path == "" {
+		return errors.Errorf("identity provider %q: path is required", ipc.ProviderName())
+	}
+	if ipc.Timeout < 0 || ipc.CacheExpiration < 0 {
+		return errors.Errorf("identity provider %q: durations must not be negative", ipc.ProviderName())
+	}
+
+	return nil
+}
+
+// TokenProviderConfig defines an external helper that obtains signed bearer
+// tokens for client users.
+type TokenProviderConfig struct {
+	Path    string        `yaml:"path"`
+	Args    []string      `yaml:"args,omitempty"`
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// Validate checks the token provider configuration.
+func (tpc *TokenProviderConfig) Validate() error {
+	if tpc == nil {
+		return nil
+	}
+
+	if tpc.Path == "" {
+		return errors.New("token provider: path is required")
+	}
+	if tpc.Timeout < 0 {
+		return errors.New("token provider: timeout must not be negative")
+	}
+
+	return nil
+}
+
 // CredentialConfig contains configuration details for managing user
 // credentials.
 type CredentialConfig struct {
-	CacheExpiration time.Duration `yaml:"cache_expiration,omitempty"`
-	ClientUserMap   ClientUserMap `yaml:"client_user_map,omitempty"`
+	CacheExpiration   time.Duration             `yaml:"cache_expiration,omitempty"`
+	ClientUserMap     ClientUserMap             `yaml:"client_user_map,omitempty"`
+	IdentityProviders []*IdentityProviderConfig `yaml:"identity_providers,omitempty"`
+	TokenProvider     *TokenProviderConfig      `yaml:"token_provider,omitempty"`
+}
+
+// Validate checks the credential configuration.
+func (cc *CredentialConfig) Validate() error {
+	if cc == nil {
+		return nil
+	}
+
+	names := make(map[string]struct{})
+	for _, ipc := range cc.IdentityProviders {
+		if ipc == nil {
+			return errors.New("identity provider config is nil")
+		}
+		if err := ipc.Validate(); err != nil {
+			return err
+		}
+
+		name := ipc.ProviderName()
+		if _, found := names[name]; found {
+			return errors.Errorf("duplicate identity provider name %q", name)
+		}
+		names[name] = struct{}{}
+	}
+
+	return cc.TokenProvider.Validate()
+}
+
+// TokenIssuerConfig defines a trusted issuer of bearer tokens.
+type TokenIssuerConfig struct {
+	Issuer    string `yaml:"issuer"`
+	PublicKey string `yaml:"public_key"`
+}
+
+// TokenAuthConfig contains the configuration for validating bearer tokens
+// issued by external identity services.
+type TokenAuthConfig struct {
+	Issuers     []*TokenIssuerConfig `yaml:"issuers"`
+	Audience    string               `yaml:"audience,omitempty"`
+	MaxLifetime time.Duration        `yaml:"max_lifetime,omitempty"`
+	ClockSkew   time.Duration        `yaml:"clock_skew,omitempty"`
+}
+
+// Validate checks the token authentication configuration.
+func (tac *TokenAuthConfig) Validate() error {
+	if tac == nil {
+		return nil
+	}
+
+	if len(tac.Issuers) == 0 {
+		return errors.New("token_auth: at least one issuer is required")
+	}
+	issuers := make(map[string]struct{})
+	for _, tic := range tac.Issuers {
+		if tic == nil || tic.Issuer == "" {
+			return errors.New("token_auth: issuer name is required")
+		}
+		if tic.PublicKey == "" {
+			return errors.Errorf("token_auth: issuer %q: public_key is required", tic.Issuer)
+		}
+		if _, found := issuers[tic.Issuer]; found {
+			return errors.Errorf("token_auth: duplicate issuer %q", tic.Issuer)
+		}
+		issuers[tic.Issuer] = struct{}{}
+	}
+
+	if tac.MaxLifetime < 0 || tac.ClockSkew < 0 {
+		return errors.New("token_auth: durations must not be negative")
+	}
+
+	return nil
 }
 
 // TransportConfig contains all the information on whether or not to use
@@ -103,6 +249,9 @@ type CredentialConfig struct {
 type TransportConfig struct {
 	AllowInsecure     bool `yaml:"allow_insecure"`
 	CertificateConfig `yaml:",inline"`
+	// AccessPolicyPath is the path of a policy file that restricts the
+	// methods admin certificates may call by role (server only).
+	AccessPolicyPath string `yaml:"access_policy,omitempty"`
 }
 
 func (tc *TransportConfig) String() string {
@@ -113,15 +262,46 @@ func (tc *TransportConfig) String() string {
 // component. ServerName is only needed if the config is being used as a
 // transport credential for a gRPC tls client.
 type CertificateConfig struct {
-	ServerName      string           `yaml:"-"`
-	ClientCertDir   string           `yaml:"client_cert_dir,omitempty"`
-	CARootPath      string           `yaml:"ca_cert"`
-	CertificatePath string           `yaml:"cert"`
-	PrivateKeyPath  string           `yaml:"key"`
-	tlsKeypair      *tls.Certificate `yaml:"-"`
-	caPool          *x509.CertPool   `yaml:"-"`
-	maxKeyPerms     fs.FileMode      `yaml:"-"`
-	verifyTime      time.Time        `yaml:"-"` // for testing
+	ServerName            string               `yaml:"-"`
+	ClientCertDir         string               `yaml:"client_cert_dir,omitempty"`
+	CARootPath            string               `yaml:"ca_cert"`
+	CertificatePath       string               `yaml:"cert"`
+	PrivateKeyPath        string               `yaml:"key"`
+	CRLPath               string               `yaml:"crl,omitempty"`
+	CertCheckInterval     time.Duration        `yaml:"cert_check_interval,omitempty"`
+	CertExpiryWarningDays []uint               `yaml:"cert_expiry_warning_days,omitempty"`
+	tlsKeypair            *tls.Certificate     `yaml:"-"`
+	caPool                *x509.CertPool       `yaml:"-"`
+	caCert                *x509.Certificate    `yaml:"-"`
+	crl                   *x509.RevocationList `yaml:"-"`
+	maxKeyPerms           fs.FileMode          `yaml:"-"`
+	verifyTime            time.Time            `yaml:"-"` // for testing
+}
+
+// CertificateInfo describes a loaded certificate.
+type CertificateInfo struct {
+	Name      string    `json:"name"`
+	Path      string    `json:"path"`
+	Subject   string    `json:"subject"`
+	Serial    string    `json:"serial"`
+	NotBefore time.Time `json:"not_before"`
+	NotAfter  time.Time `json:"not_after"`
+}
+
+// ExpiresIn returns the time remaining until the certificate expires.
+func (ci *CertificateInfo) ExpiresIn(now time.Time) time.Duration {
+	return ci.NotAfter.Sub(now)
+}
+
+func newCertificateInfo(name, path string, cert *x509.Certificate) *CertificateInfo {
+	return &CertificateInfo{
+		Name:      name,
+		Path:      path,
+		Subject:   cert.Subject.String(),
+		Serial:    cert.SerialNumber.String(),
+		NotBefore: cert.NotBefore,
+		NotAfter:  cert.NotAfter,
+	}
 }
 
 // DefaultAgentTransportConfig provides a default transport config disabling
@@ -180,60 +360,152 @@ func DefaultServerTransportConfig() *TransportConfig {
 	}
 }
 
-// PreLoadCertData reads the certificate files in and parses them into TLS key
-// pair and Certificate pool to provide a mechanism for detecting certificate
-// error before first use.
-func (tc *TransportConfig) PreLoadCertData() error {
-	if tc == nil {
-		return errors.New("nil TransportConfig")
-	}
-	if tc.tlsKeypair != nil && tc.caPool != nil || tc.AllowInsecure {
-		// In this case the data is already preloaded.
-		// In order to reload data use ReloadCertData
-		return nil
-	}
+// certDataLock protects the certificate data loaded into TransportConfigs,
+// which may be replaced while in use when the certificate files are reloaded.
+var certDataLock sync.RWMutex
+
+// certData holds the parsed contents of the certificate files.
+type certData struct {
+	keypair *tls.Certificate
+	caPool  *x509.CertPool
+	caCert  *x509.Certificate
+	crl     *x509.RevocationList
+}
+
+func (tc *TransportConfig) getCertData() (*tls.Certificate, *x509.CertPool) {
+	certDataLock.RLock()
+	defer certDataLock.RUnlock()
+
+	return tc.tlsKeypair, tc.caPool
+}
 
+func (tc *TransportConfig) setCertData(cd *certData) {
+	certDataLock.Lock()
+	defer certDataLock.Unlock()
+
+	tc.tlsKeypair = cd.keypair
+	tc.caPool = cd.caPool
+	tc.caCert = cd.caCert
+	tc.crl = cd.crl
+}
+
+func (tc *TransportConfig) getCRL() *x509.RevocationList {
+	certDataLock.RLock()
+	defer certDataLock.RUnlock()
+
+	return tc.crl
+}
+
+// loadCertData reads and verifies the certificate files. If the files could be
+// parsed but the certificate failed verification, the parsed data is returned
+// along with the error.
+func (tc *TransportConfig) loadCertData() (*certData, error) {
 	if tc.ClientCertDir != "" {
 		if _, err := os.ReadDir(tc.ClientCertDir); errors.Is(err, fs.ErrPermission) {
-			return FaultUnreadableCertFile(tc.ClientCertDir)
+			return nil, FaultUnreadableCertFile(tc.ClientCertDir)
 		} else if err != nil {
-			return errors.Wrap(err, "checking client cert directory")
+			return nil, errors.Wrap(err, "checking client cert directory")
 		}
 	}
 
 	certificate, certPool, err := loadCertWithCustomCA(tc.CARootPath, tc.CertificatePath, tc.PrivateKeyPath, tc.maxKeyPerms)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	tc.tlsKeypair = certificate
-	tc.caPool = certPool
-
 	// Pre-parse the Leaf Certificate
-	tc.tlsKeypair.Leaf, err = x509.ParseCertificate(tc.tlsKeypair.Certificate[0])
+	certificate.Leaf, err = x509.ParseCertificate(certificate.Certificate[0])
 	if err != nil {
-		return err
+		return nil, err
+	}
+	cd := &certData{
+		keypair: certificate,
+		caPool:  certPool,
 	}
 
-	if _, err = tc.tlsKeypair.Leaf.Verify(x509.VerifyOptions{
+	chains, err := certificate.Leaf.Verify(x509.VerifyOptions{
 		CurrentTime: tc.CertificateConfig.verifyTime, // for testing - by default this is 0, which is treated as current time
 		Roots:       certPool,
 		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
-	}); isInvalidCert(err) {
-		return FaultInvalidCertFile(tc.CertificatePath, err)
+	})
+	if isInvalidCert(err) {
+		return cd, FaultInvalidCertFile(tc.CertificatePath, err)
 	} else if err != nil {
-		return err
+		return cd, err
 	}
+	chain := chains[0]
+	cd.caCert = chain[len(chain)-1]
 
-	return nil
+	if tc.CRLPath != "" {
+		if cd.crl, err = LoadCRL(tc.CRLPath, cd.caCert); err != nil {
+			return nil, err
+		}
+		if err := checkRevoked(cd.crl, certificate.Leaf); err != nil {
+			return cd, err
+		}
+	}
+
+	return cd, nil
+}
+
+// PreLoadCertData reads the certificate files in and parses them into TLS key
+// pair and Certificate pool to provide a mechanism for detecting certificate
+// error before first use.
+func (tc *TransportConfig) PreLoadCertData() error {
+	if tc == nil {
+		return errors.New("nil TransportConfig")
+	}
+	if keypair, caPool := tc.getCertData(); keypair != nil && caPool != nil || tc.AllowInsecure {
+		// In this case the data is already preloaded.
+		// In order to reload data use ReloadCertData
+		return nil
+	}
+
+	cd, err := tc.loadCertData()
+	if cd != nil {
+		tc.setCertData(cd)
+	}
+	return err
 }
 
 // ReloadCertData reloads and stores the certificate data in the case when
-// certificate data has changed since initial loading.
+// certificate data has changed since initial loading. If the new data cannot
+// be loaded, the previously loaded data is kept.
 func (tc *TransportConfig) ReloadCertData() error {
-	tc.tlsKeypair = nil
-	tc.caPool = nil
-	return tc.PreLoadCertData()
+	if tc == nil {
+		return errors.New("nil TransportConfig")
+	}
+	if tc.AllowInsecure {
+		return nil
+	}
+
+	cd, err := tc.loadCertData()
+	if err != nil {
+		return err
+	}
+	tc.setCertData(cd)
+
+	return nil
+}
+
+// Certificates returns information about the loaded certificate and the CA
+// certificate that it was verified against.
+func (tc *TransportConfig) Certificates() []*CertificateInfo {
+	if tc == nil || tc.AllowInsecure {
+		return nil
+	}
+
+	certDataLock.RLock()
+	defer certDataLock.RUnlock()
+
+	var infos []*CertificateInfo
+	if tc.tlsKeypair != nil && tc.tlsKeypair.Leaf != nil {
+		infos = append(infos, newCertificateInfo("cert", tc.CertificatePath, tc.tlsKeypair.Leaf))
+	}
+	if tc.caCert != nil {
+		infos = append(infos, newCertificateInfo("ca_cert", tc.CARootPath, tc.caCert))
+	}
+	return infos
 }
 
 // PrivateKey returns the private key stored in the certificates loaded into the TransportConfig
@@ -241,14 +513,11 @@ func (tc *TransportConfig) PrivateKey() (crypto.PrivateKey, error) {
 	if tc.AllowInsecure {
 		return nil, nil
 	}
-	// If we don't have our keys loaded attempt to load them.
-	if tc.tlsKeypair == nil || tc.caPool == nil {
-		err := tc.ReloadCertData()
-		if err != nil {
-			return nil, err
-		}
+	keypair, err := tc.loadedKeypair()
+	if err != nil {
+		return nil, err
 	}
-	return tc.tlsKeypair.PrivateKey, nil
+	return keypair.PrivateKey, nil
 }
 
 // PublicKey returns the private key stored in the certificates loaded into the TransportConfig
@@ -256,12 +525,21 @@ func (tc *TransportConfig) PublicKey() (crypto.PublicKey, error) {
 	if tc.AllowInsecure {
 		return nil, nil
 	}
+	keypair, err := tc.loadedKeypair()
+	if err != nil {
+		return nil, err
+	}
+	return keypair.Leaf.PublicKey, nil
+}
+
+func (tc *TransportConfig) loadedKeypair() (*tls.Certificate, error) {
 	// If we don't have our keys loaded attempt to load them.
-	if tc.tlsKeypair == nil || tc.caPool == nil {
-		err := tc.ReloadCertData()
-		if err != nil {
-			return nil, err
-		}
+	if keypair, caPool := tc.getCertData(); keypair != nil && caPool != nil {
+		return keypair, nil
+	}
+	if err := tc.ReloadCertData(); err != nil {
+		return nil, err
 	}
-	return tc.tlsKeypair.Leaf.PublicKey, nil
+	keypair, _ := tc.getCertData()
+	return keypair, nil
 }
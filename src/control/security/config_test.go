@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -508,3 +509,148 @@ default:
 		})
 	}
 }
+
+func TestSecurity_CredentialConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *CredentialConfig
+		expErr error
+	}{
+		"nil": {},
+		"no providers": {
+			cfg: &CredentialConfig{},
+		},
+		"valid providers": {
+			cfg: &CredentialConfig{
+				IdentityProviders: []*IdentityProviderConfig{
+					{Type: IdentityProviderStatic, Path: "/etc/daos/identities.yml"},
+					{Type: IdentityProviderExec, Path: "/usr/bin/daos_identity", Timeout: time.Second},
+				},
+			},
+		},
+		"missing type": {
+			cfg: &CredentialConfig{
+				IdentityProviders: []*IdentityProviderConfig{
+					{Path: "/etc/daos/identities.yml"},
+				},
+			},
+			expErr: errors.New("type is required"),
+		},
+		"unknown type": {
+			cfg: &CredentialConfig{
+				IdentityProviders: []*IdentityProviderConfig{
+					{Type: "ldap", Path: "ldap://server"},
+				},
+			},
+			expErr: errors.New("unknown identity provider type"),
+		},
+		"missing path": {
+			cfg: &CredentialConfig{
+				IdentityProviders: []*IdentityProviderConfig{
+					{Type: IdentityProviderExec},
+				},
+			},
+			expErr: errors.New("path is required"),
+		},
+		"negative timeout": {
+			cfg: &CredentialConfig{
+				IdentityProviders: []*IdentityProviderConfig{
+					{Type: IdentityProviderExec, Path: "/usr/bin/daos_identity", Timeout: -time.Second},
+				},
+			},
+			expErr: errors.New("negative"),
+		},
+		"duplicate names": {
+			cfg: &CredentialConfig{
+				IdentityProviders: []*IdentityProviderConfig{
+					{Type: IdentityProviderStatic, Path: "/etc/daos/a.yml"},
+					{Type: IdentityProviderStatic, Path: "/etc/daos/b.yml"},
+				},
+			},
+			expErr: errors.New("duplicate identity provider name \"static\""),
+		},
+		"named duplicates": {
+			cfg: &CredentialConfig{
+				IdentityProviders: []*IdentityProviderConfig{
+					{Name: "site", Type: IdentityProviderStatic, Path: "/etc/daos/a.yml"},
+					{Name: "containers", Type: IdentityProviderStatic, Path: "/etc/daos/b.yml"},
+				},
+			},
+		},
+		"token provider": {
+			cfg: &CredentialConfig{
+				TokenProvider: &TokenProviderConfig{Path: "/usr/bin/daos_token"},
+			},
+		},
+		"token provider missing path": {
+			cfg: &CredentialConfig{
+				TokenProvider: &TokenProviderConfig{Timeout: time.Second},
+			},
+			expErr: errors.New("token provider: path is required"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
+
+func TestSecurity_TokenAuthConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *TokenAuthConfig
+		expErr error
+	}{
+		"nil": {},
+		"no issuers": {
+			cfg:    &TokenAuthConfig{},
+			expErr: errors.New("at least one issuer"),
+		},
+		"valid": {
+			cfg: &TokenAuthConfig{
+				Issuers: []*TokenIssuerConfig{
+					{Issuer: "https://idp.example.com", PublicKey: "/etc/daos/certs/idp.pem"},
+					{Issuer: "local", PublicKey: "/etc/daos/certs/local.pem"},
+				},
+				Audience:    "daos",
+				MaxLifetime: time.Hour,
+			},
+		},
+		"missing issuer": {
+			cfg: &TokenAuthConfig{
+				Issuers: []*TokenIssuerConfig{
+					{PublicKey: "/etc/daos/certs/idp.pem"},
+				},
+			},
+			expErr: errors.New("issuer name is required"),
+		},
+		"missing key": {
+			cfg: &TokenAuthConfig{
+				Issuers: []*TokenIssuerConfig{
+					{Issuer: "local"},
+				},
+			},
+			expErr: errors.New("public_key is required"),
+		},
+		"duplicate issuer": {
+			cfg: &TokenAuthConfig{
+				Issuers: []*TokenIssuerConfig{
+					{Issuer: "local", PublicKey: "/etc/daos/certs/a.pem"},
+					{Issuer: "local", PublicKey: "/etc/daos/certs/b.pem"},
+				},
+			},
+			expErr: errors.New("duplicate issuer"),
+		},
+		"negative lifetime": {
+			cfg: &TokenAuthConfig{
+				Issuers: []*TokenIssuerConfig{
+					{Issuer: "local", PublicKey: "/etc/daos/certs/a.pem"},
+				},
+				MaxLifetime: -time.Second,
+			},
+			expErr: errors.New("negative"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
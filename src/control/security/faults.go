@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,7 @@ package security
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
@@ -65,6 +67,28 @@ func FaultInvalidCert(err error) *fault.Fault {
 	return f
 }
 
+// FaultRevokedCert indicates that a certificate has been revoked by the CA.
+func FaultRevokedCert(cert string, revoked time.Time) *fault.Fault {
+	return securityFault(
+		code.SecurityRevokedCert,
+		fmt.Sprintf("certificate %s was revoked on %s", cert, revoked.Format(time.RFC3339)),
+		"issue a new certificate for the component with dmg certs issue",
+	)
+}
+
+// FaultInvalidCRL indicates that a certificate revocation list was invalid.
+func FaultInvalidCRL(filePath string, err error) *fault.Fault {
+	f := securityFault(
+		code.SecurityInvalidCRL,
+		fmt.Sprintf("certificate revocation list at path %q is invalid", filePath),
+		"verify the certificate revocation list was generated by the same CA as the certificates",
+	)
+	if err != nil {
+		f.Reason = err.Error()
+	}
+	return f
+}
+
 func securityFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "security",
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -34,6 +35,8 @@ var methodAuthorizations = map[string][]Component{
 	"/ctl.CtlSvc/StorageNvmeAddDevice":       {ComponentAdmin},
 	"/ctl.CtlSvc/NetworkScan":                {ComponentAdmin},
 	"/ctl.CtlSvc/CollectLog":                 {ComponentAdmin},
+	"/ctl.CtlSvc/CertCheck":                  {ComponentAdmin},
+	"/ctl.CtlSvc/AuditList":                  {ComponentAdmin},
 	"/ctl.CtlSvc/FirmwareQuery":              {ComponentAdmin},
 	"/ctl.CtlSvc/FirmwareUpdate":             {ComponentAdmin},
 	"/ctl.CtlSvc/SmdQuery":                   {ComponentAdmin},
@@ -85,9 +88,13 @@ var methodAuthorizations = map[string][]Component{
 	"/mgmt.MgmtSvc/FaultInjectMgmtPoolFault": {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolUpgrade":              {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemSetAttr":            {ComponentAdmin},
-	"/mgmt.MgmtSvc/SystemGetAttr":            {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemGetAttr":            {ComponentAdmin, ComponentAgent},
 	"/mgmt.MgmtSvc/SystemSetProp":            {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemGetProp":            {ComponentAdmin},
+	"/mgmt.MgmtSvc/OperationStart":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/OperationList":            {ComponentAdmin},
+	"/mgmt.MgmtSvc/OperationGet":             {ComponentAdmin},
+	"/mgmt.MgmtSvc/OperationCancel":          {ComponentAdmin},
 	"/RaftTransport/AppendEntries":           {ComponentServer},
 	"/RaftTransport/AppendEntriesPipeline":   {ComponentServer},
 	"/RaftTransport/RequestVote":             {ComponentServer},
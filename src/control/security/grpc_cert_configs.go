@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -27,29 +28,49 @@ import (
 // validate the certificate chain however they do not validate the SubjectAlternativeName.
 // On the client side we still ensure the CommonName for the server is correct and
 // validate the certificate chain.
+//
+// The key pair and CA pool are looked up for each handshake rather than being
+// fixed in the tls.Config, so that reloaded certificates take effect for new
+// connections. If a certificate revocation list is configured, peer
+// certificates that it lists are rejected.
 
 func serverTLSConfig(cfg *TransportConfig) *tls.Config {
+	keypair, caPool := cfg.getCertData()
+	tlsCfg := newServerTLSConfig(keypair, caPool, cfg.getCRL())
+	tlsCfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		keypair, caPool := cfg.getCertData()
+		return newServerTLSConfig(keypair, caPool, cfg.getCRL()), nil
+	}
+	return tlsCfg
+}
+
+func newServerTLSConfig(keypair *tls.Certificate, caPool *x509.CertPool, crl *x509.RevocationList) *tls.Config {
 	return &tls.Config{
 		ClientAuth:               tls.RequireAndVerifyClientCert,
-		Certificates:             []tls.Certificate{*cfg.tlsKeypair},
-		ClientCAs:                cfg.caPool,
+		Certificates:             []tls.Certificate{*keypair},
+		ClientCAs:                caPool,
 		MinVersion:               tls.VersionTLS12,
 		MaxVersion:               tls.VersionTLS12,
 		PreferServerCipherSuites: true,
 		CipherSuites: []uint16{
 			tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
 		},
+		// The per-handshake config replaces the one set up by the gRPC
+		// transport credentials, so it must also offer HTTP/2.
+		NextProtos: []string{"h2"},
 		VerifyConnection: func(cs tls.ConnectionState) error {
 			opts := x509.VerifyOptions{
-				Roots:         cfg.caPool,
+				Roots:         caPool,
 				Intermediates: x509.NewCertPool(),
 				KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 			}
 			for _, cert := range cs.PeerCertificates[1:] {
 				opts.Intermediates.AddCert(cert)
 			}
-			_, err := cs.PeerCertificates[0].Verify(opts)
-			return err
+			if _, err := cs.PeerCertificates[0].Verify(opts); err != nil {
+				return err
+			}
+			return checkRevoked(crl, cs.PeerCertificates[0])
 		},
 	}
 }
@@ -58,8 +79,10 @@ const ServerCommonName = "server"
 
 func clientTLSConfig(cfg *TransportConfig) *tls.Config {
 	return &tls.Config{
-		Certificates:             []tls.Certificate{*cfg.tlsKeypair},
-		RootCAs:                  cfg.caPool,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			keypair, _ := cfg.getCertData()
+			return keypair, nil
+		},
 		MinVersion:               tls.VersionTLS12,
 		MaxVersion:               tls.VersionTLS12,
 		PreferServerCipherSuites: true,
@@ -79,8 +102,9 @@ func clientTLSConfig(cfg *TransportConfig) *tls.Config {
 		// of the received certificate is "server" to ensure we are
 		// communicating with a DAOS server.
 		VerifyConnection: func(cs tls.ConnectionState) error {
+			_, caPool := cfg.getCertData()
 			opts := x509.VerifyOptions{
-				Roots:         cfg.caPool,
+				Roots:         caPool,
 				Intermediates: x509.NewCertPool(),
 			}
 			for _, cert := range cs.PeerCertificates[1:] {
@@ -90,6 +114,9 @@ func clientTLSConfig(cfg *TransportConfig) *tls.Config {
 			if err != nil {
 				return err
 			}
+			if err := checkRevoked(cfg.getCRL(), cs.PeerCertificates[0]); err != nil {
+				return err
+			}
 			if cs.PeerCertificates[0].Subject.CommonName != ServerCommonName {
 				return errors.New("Server certificate does not identify as Server")
 			}
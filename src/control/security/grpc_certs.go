@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -18,11 +19,8 @@ func GetServerTransportCredentials(cfg *TransportConfig) (credentials.TransportC
 		return nil, errors.New("nil TransportConfig")
 	}
 
-	if cfg.tlsKeypair == nil || cfg.caPool == nil {
-		err := cfg.PreLoadCertData()
-		if err != nil {
-			return nil, err
-		}
+	if err := cfg.PreLoadCertData(); err != nil {
+		return nil, err
 	}
 
 	creds := credentials.NewTLS(serverTLSConfig(cfg))
@@ -34,11 +32,8 @@ func GetClientTransportCredentials(cfg *TransportConfig) (credentials.TransportC
 		return nil, errors.New("nil TransportConfig")
 	}
 
-	if cfg.tlsKeypair == nil || cfg.caPool == nil {
-		err := cfg.PreLoadCertData()
-		if err != nil {
-			return nil, err
-		}
+	if err := cfg.PreLoadCertData(); err != nil {
+		return nil, err
 	}
 
 	creds := credentials.NewTLS(clientTLSConfig(cfg))
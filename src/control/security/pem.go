@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -204,6 +205,41 @@ func LoadPrivateKey(keyPath string) (crypto.PrivateKey, error) {
 	return nil, errors.Wrapf(err, "Invalid key data in PRIVATE KEY block")
 }
 
+// LoadPublicKey loads the public key specified at the given path into a
+// crypto.PublicKey interface compliant object. The file may contain either a
+// public key or a certificate.
+func LoadPublicKey(keyPath string) (crypto.PublicKey, error) {
+	pemData, err := LoadPEMData(keyPath, MaxCertPerm)
+	if err != nil {
+		return nil, err
+	}
+
+	block, extra := pem.Decode(pemData)
+	if block == nil {
+		return nil, fmt.Errorf("%s does not contain PEM data", keyPath)
+	}
+
+	if len(extra) != 0 {
+		return nil, fmt.Errorf("Only one key allowed per file")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		return key, errors.Wrap(err, "Invalid key data in PUBLIC KEY block")
+	case "RSA PUBLIC KEY":
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		return key, errors.Wrap(err, "Invalid key data in RSA PUBLIC KEY block")
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	}
+	return nil, fmt.Errorf("PEM Block is not a Public Key or Certificate")
+}
+
 // ValidateCertDirectory ensures the certificate directory has safe permissions
 // set on it.
 func ValidateCertDirectory(certDir string) error {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +8,13 @@
 package security
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"io/fs"
 	"os"
@@ -163,6 +171,85 @@ func TestLoadCertificate(t *testing.T) {
 		})
 	}
 }
+func TestSecurity_Pem_LoadPublicKey(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	writePEM := func(t *testing.T, name, blockType string, der []byte) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+		if err := os.WriteFile(path, data, MaxCertPerm); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	marshalPKIX := func(t *testing.T, key interface{}) []byte {
+		t.Helper()
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return der
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod("testdata/certs/agent.crt", MaxCertPerm); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		path    string
+		expType string
+		expErr  error
+	}{
+		"ecdsa": {
+			path:    writePEM(t, "ec.pem", "PUBLIC KEY", marshalPKIX(t, &ecKey.PublicKey)),
+			expType: "*ecdsa.PublicKey",
+		},
+		"ed25519": {
+			path:    writePEM(t, "ed.pem", "PUBLIC KEY", marshalPKIX(t, edPub)),
+			expType: "ed25519.PublicKey",
+		},
+		"pkcs1 rsa": {
+			path:    writePEM(t, "rsa.pem", "RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)),
+			expType: "*rsa.PublicKey",
+		},
+		"certificate": {
+			path:    "testdata/certs/agent.crt",
+			expType: "*rsa.PublicKey",
+		},
+		"private key": {
+			path:   writePEM(t, "priv.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)),
+			expErr: errors.New("not a Public Key"),
+		},
+		"bad key data": {
+			path:   writePEM(t, "bad.pem", "PUBLIC KEY", []byte("data")),
+			expErr: errors.New("Invalid key data"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			key, err := LoadPublicKey(tc.path)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expType, fmt.Sprintf("%T", key), "")
+		})
+	}
+}
+
 func TestSecurity_Pem_ValidateCertDirectory(t *testing.T) {
 	for name, tc := range map[string]struct {
 		perms  fs.FileMode
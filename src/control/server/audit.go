@@ -0,0 +1,161 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"crypto/x509"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/audit"
+	"github.com/daos-stack/daos/src/control/lib/gateway"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// maxAuditRequestLen limits the size of the request summary recorded in each
+// audit log entry.
+const maxAuditRequestLen = 1024
+
+// auditedMethods are the management service methods that modify the state of
+// the system, and so are recorded in the audit log.
+var auditedMethods = map[string]struct{}{
+	mgmtpb.MgmtSvc_PoolCreate_FullMethodName:               {},
+	mgmtpb.MgmtSvc_PoolDestroy_FullMethodName:              {},
+	mgmtpb.MgmtSvc_PoolEvict_FullMethodName:                {},
+	mgmtpb.MgmtSvc_PoolExclude_FullMethodName:              {},
+	mgmtpb.MgmtSvc_PoolDrain_FullMethodName:                {},
+	mgmtpb.MgmtSvc_PoolExtend_FullMethodName:               {},
+	mgmtpb.MgmtSvc_PoolReintegrate_FullMethodName:          {},
+	mgmtpb.MgmtSvc_PoolSetProp_FullMethodName:              {},
+	mgmtpb.MgmtSvc_PoolOverwriteACL_FullMethodName:         {},
+	mgmtpb.MgmtSvc_PoolUpdateACL_FullMethodName:            {},
+	mgmtpb.MgmtSvc_PoolDeleteACL_FullMethodName:            {},
+	mgmtpb.MgmtSvc_PoolUpgrade_FullMethodName:              {},
+	mgmtpb.MgmtSvc_ContSetOwner_FullMethodName:             {},
+	mgmtpb.MgmtSvc_SystemStop_FullMethodName:               {},
+	mgmtpb.MgmtSvc_SystemStart_FullMethodName:              {},
+	mgmtpb.MgmtSvc_SystemExclude_FullMethodName:            {},
+	mgmtpb.MgmtSvc_SystemDrain_FullMethodName:              {},
+	mgmtpb.MgmtSvc_SystemErase_FullMethodName:              {},
+	mgmtpb.MgmtSvc_SystemCleanup_FullMethodName:            {},
+	mgmtpb.MgmtSvc_SystemSetAttr_FullMethodName:            {},
+	mgmtpb.MgmtSvc_SystemSetProp_FullMethodName:            {},
+	mgmtpb.MgmtSvc_SystemCheckEnable_FullMethodName:        {},
+	mgmtpb.MgmtSvc_SystemCheckDisable_FullMethodName:       {},
+	mgmtpb.MgmtSvc_SystemCheckStart_FullMethodName:         {},
+	mgmtpb.MgmtSvc_SystemCheckStop_FullMethodName:          {},
+	mgmtpb.MgmtSvc_SystemCheckSetPolicy_FullMethodName:     {},
+	mgmtpb.MgmtSvc_SystemCheckRepair_FullMethodName:        {},
+	mgmtpb.MgmtSvc_FaultInjectReport_FullMethodName:        {},
+	mgmtpb.MgmtSvc_FaultInjectPoolFault_FullMethodName:     {},
+	mgmtpb.MgmtSvc_FaultInjectMgmtPoolFault_FullMethodName: {},
+	mgmtpb.MgmtSvc_OperationStart_FullMethodName:           {},
+	mgmtpb.MgmtSvc_OperationCancel_FullMethodName:          {},
+}
+
+// auditCaller returns the subject and serial number of the caller's
+// certificate, or "unauthenticated" if transport security is disabled.
+func auditCaller(ctx context.Context) (string, string) {
+	cert, err := peerCertFromContext(ctx)
+	if err != nil {
+		return "unauthenticated", ""
+	}
+
+	return cert.Subject.String(), cert.SerialNumber.String()
+}
+
+// auditOnBehalfOf returns the client that the caller made the request for, as
+// passed in the request metadata by the REST gateway. The metadata is only
+// trusted if the caller presented the gateway's certificate, or if the request
+// was made in-process without a peer.
+func auditOnBehalfOf(ctx context.Context, gwCert *x509.Certificate) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	vals := md.Get(gateway.PrincipalMetadataKey)
+	if len(vals) == 0 {
+		return ""
+	}
+
+	if _, ok := peer.FromContext(ctx); ok {
+		cert, err := peerCertFromContext(ctx)
+		if err != nil || gwCert == nil || !cert.Equal(gwCert) {
+			return ""
+		}
+	}
+	return vals[0]
+}
+
+func auditRequest(req interface{}) string {
+	m, ok := req.(protoreflect.ProtoMessage)
+	if !ok {
+		return ""
+	}
+
+	summary := proto.Debug(m)
+	if len(summary) > maxAuditRequestLen {
+		summary = summary[:maxAuditRequestLen] + "..."
+	}
+	return summary
+}
+
+func auditResult(err error) string {
+	if err == nil {
+		return audit.ResultOK
+	}
+
+	// Unwrap the message if it's a gRPC status error.
+	if st, ok := status.FromError(err); ok {
+		err = proto.UnwrapError(st)
+	}
+	return err.Error()
+}
+
+// unaryAuditInterceptor generates a grpc.UnaryServerInterceptor that records
+// each mutating management service request handled by the MS leader in the
+// audit log. Requests denied by the access checks do not reach it, as they are
+// recorded by the access checker. The REST gateway's certificate, if supplied,
+// identifies the callers whose gateway principal is recorded.
+func unaryAuditInterceptor(log logging.Logger, auditLog *audit.Log, ldrChk func() bool, gwCert *x509.Certificate) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, found := auditedMethods[info.FullMethod]; !found || !ldrChk() {
+			return handler(ctx, req)
+		}
+
+		startTime := time.Now()
+		res, err := handler(ctx, req)
+
+		entry := &audit.Entry{
+			Time:     time.Now(),
+			Method:   info.FullMethod,
+			Request:  auditRequest(req),
+			Result:   auditResult(err),
+			Duration: time.Since(startTime),
+		}
+		entry.Caller, entry.Serial = auditCaller(ctx)
+		entry.OnBehalfOf = auditOnBehalfOf(ctx, gwCert)
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			entry.PeerAddr = p.Addr.String()
+		}
+
+		if aErr := auditLog.Append(entry); aErr != nil {
+			log.Errorf("failed to record %s in audit log: %s", info.FullMethod, aErr)
+		}
+
+		return res, err
+	}
+}
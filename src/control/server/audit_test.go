@@ -0,0 +1,208 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/audit"
+	"github.com/daos-stack/daos/src/control/lib/gateway"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestServer_unaryAuditInterceptor(t *testing.T) {
+	adminCert := &x509.Certificate{
+		Raw:          []byte("admin"),
+		SerialNumber: big.NewInt(42),
+		Subject: pkix.Name{
+			CommonName:         "admin",
+			OrganizationalUnit: []string{"pool-operator"},
+		},
+	}
+	gwCert := &x509.Certificate{
+		Raw:          []byte("gateway"),
+		SerialNumber: big.NewInt(7),
+		Subject:      pkix.Name{CommonName: "admin"},
+	}
+
+	for name, tc := range map[string]struct {
+		ctx        context.Context
+		method     string
+		req        interface{}
+		notLeader  bool
+		handlerErr error
+		expEntry   *audit.Entry
+	}{
+		"read-only method not audited": {
+			ctx:    newTestCertCtx(test.Context(t), adminCert),
+			method: mgmtpb.MgmtSvc_PoolQuery_FullMethodName,
+			req:    &mgmtpb.PoolQueryReq{Id: "pool"},
+		},
+		"not leader": {
+			ctx:       newTestCertCtx(test.Context(t), adminCert),
+			method:    mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+			req:       &mgmtpb.PoolDestroyReq{Id: "pool"},
+			notLeader: true,
+		},
+		"success": {
+			ctx:    newTestCertCtx(test.Context(t), adminCert),
+			method: mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+			req:    &mgmtpb.PoolDestroyReq{Id: "pool"},
+			expEntry: &audit.Entry{
+				Method:   mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+				Caller:   "CN=admin,OU=pool-operator",
+				Serial:   "42",
+				PeerAddr: common.LocalhostCtrlAddr().String(),
+				Result:   audit.ResultOK,
+			},
+		},
+		"failure": {
+			ctx:        newTestCertCtx(test.Context(t), adminCert),
+			method:     mgmtpb.MgmtSvc_SystemStop_FullMethodName,
+			req:        &mgmtpb.SystemStopReq{},
+			handlerErr: status.Error(codes.PermissionDenied, "denied"),
+			expEntry: &audit.Entry{
+				Method:   mgmtpb.MgmtSvc_SystemStop_FullMethodName,
+				Caller:   "CN=admin,OU=pool-operator",
+				Serial:   "42",
+				PeerAddr: common.LocalhostCtrlAddr().String(),
+				Result:   "denied",
+			},
+		},
+		"on behalf of gateway client": {
+			ctx: metadata.NewIncomingContext(newTestCertCtx(test.Context(t), gwCert),
+				metadata.Pairs(gateway.PrincipalMetadataKey, "token:alice")),
+			method: mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+			req:    &mgmtpb.PoolDestroyReq{Id: "tank"},
+			expEntry: &audit.Entry{
+				Method:     mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+				Caller:     "CN=admin",
+				Serial:     "7",
+				OnBehalfOf: "token:alice",
+				PeerAddr:   common.LocalhostCtrlAddr().String(),
+				Result:     audit.ResultOK,
+			},
+		},
+		"gateway principal from other client ignored": {
+			ctx: metadata.NewIncomingContext(newTestCertCtx(test.Context(t), adminCert),
+				metadata.Pairs(gateway.PrincipalMetadataKey, "token:alice")),
+			method: mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+			req:    &mgmtpb.PoolDestroyReq{Id: "tank"},
+			expEntry: &audit.Entry{
+				Method:   mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+				Caller:   "CN=admin,OU=pool-operator",
+				Serial:   "42",
+				PeerAddr: common.LocalhostCtrlAddr().String(),
+				Result:   audit.ResultOK,
+			},
+		},
+		"gateway principal from insecure peer ignored": {
+			ctx: metadata.NewIncomingContext(
+				peer.NewContext(test.Context(t), &peer.Peer{Addr: common.LocalhostCtrlAddr()}),
+				metadata.Pairs(gateway.PrincipalMetadataKey, "token:alice")),
+			method: mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+			req:    &mgmtpb.PoolDestroyReq{Id: "tank"},
+			expEntry: &audit.Entry{
+				Method:   mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+				Caller:   "unauthenticated",
+				PeerAddr: common.LocalhostCtrlAddr().String(),
+				Result:   audit.ResultOK,
+			},
+		},
+		"gateway principal in-process": {
+			ctx: metadata.NewIncomingContext(test.Context(t),
+				metadata.Pairs(gateway.PrincipalMetadataKey, "token:alice")),
+			method: mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+			req:    &mgmtpb.PoolDestroyReq{Id: "tank"},
+			expEntry: &audit.Entry{
+				Method:     mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+				Caller:     "unauthenticated",
+				OnBehalfOf: "token:alice",
+				Result:     audit.ResultOK,
+			},
+		},
+		"unauthenticated": {
+			ctx:    test.Context(t),
+			method: mgmtpb.MgmtSvc_SystemStart_FullMethodName,
+			req:    &mgmtpb.SystemStartReq{},
+			expEntry: &audit.Entry{
+				Method: mgmtpb.MgmtSvc_SystemStart_FullMethodName,
+				Caller: "unauthenticated",
+				Result: audit.ResultOK,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			dir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			path := filepath.Join(dir, "audit.log")
+			auditLog, err := audit.Open(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer auditLog.Close()
+
+			interceptor := unaryAuditInterceptor(log, auditLog, func() bool { return !tc.notLeader }, gwCert)
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				return nil, tc.handlerErr
+			}
+
+			_, err = interceptor(tc.ctx, tc.req, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			test.CmpErr(t, tc.handlerErr, err)
+
+			entries, err := audit.Read(path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.expEntry == nil {
+				test.AssertEqual(t, 0, len(entries), "expected no audit entries")
+				return
+			}
+			if len(entries) != 1 {
+				t.Fatalf("expected 1 audit entry, got %d", len(entries))
+			}
+
+			got := entries[0]
+			test.AssertEqual(t, tc.expEntry.Method, got.Method, "")
+			test.AssertEqual(t, tc.expEntry.Caller, got.Caller, "")
+			test.AssertEqual(t, tc.expEntry.Serial, got.Serial, "")
+			test.AssertEqual(t, tc.expEntry.OnBehalfOf, got.OnBehalfOf, "")
+			test.AssertEqual(t, tc.expEntry.PeerAddr, got.PeerAddr, "")
+			test.AssertEqual(t, tc.expEntry.Result, got.Result, "")
+			test.AssertTrue(t, got.Request != "", "expected request summary")
+		})
+	}
+}
+
+func TestServer_auditRequest(t *testing.T) {
+	req := &mgmtpb.SystemSetAttrReq{
+		Attributes: map[string]string{"key": string(make([]byte, maxAuditRequestLen*2))},
+	}
+
+	summary := auditRequest(req)
+	test.AssertEqual(t, maxAuditRequestLen+len("..."), len(summary), "request summary not truncated")
+	test.AssertEqual(t, "", auditRequest(errors.New("not a message")), "")
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -22,6 +23,7 @@ import (
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/gateway"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/engine"
@@ -50,6 +52,8 @@ type Server struct {
 	// control-specific
 	ControlPort       int                       `yaml:"port"`
 	TransportConfig   *security.TransportConfig `yaml:"transport_config"`
+	TokenAuth         *security.TokenAuthConfig `yaml:"token_auth,omitempty"`
+	Gateway           *gateway.Config           `yaml:"gateway,omitempty"`
 	Engines           []*engine.Config          `yaml:"engines"`
 	BdevExclude       []string                  `yaml:"bdev_exclude,omitempty"`
 	DisableVFIO       bool                      `yaml:"disable_vfio"`
@@ -63,6 +67,7 @@ type Server struct {
 	ControlLogJSON    bool                      `yaml:"control_log_json,omitempty"`
 	HelperLogFile     string                    `yaml:"helper_log_file,omitempty"`
 	FWHelperLogFile   string                    `yaml:"firmware_helper_log_file,omitempty"`
+	AuditLogFile      string                    `yaml:"audit_log_file,omitempty"`
 	FaultPath         string                    `yaml:"fault_path,omitempty"`
 	TelemetryPort     int                       `yaml:"telemetry_port,omitempty"`
 	CoreDumpFilter    uint8                     `yaml:"core_dump_filter,omitempty"`
@@ -223,6 +228,18 @@ func (cfg *Server) WithTransportConfig(cfgTransport *security.TransportConfig) *
 	return cfg
 }
 
+// WithTokenAuth sets the configuration for validating bearer tokens.
+func (cfg *Server) WithTokenAuth(tokenAuth *security.TokenAuthConfig) *Server {
+	cfg.TokenAuth = tokenAuth
+	return cfg
+}
+
+// WithGateway sets the configuration of the REST gateway.
+func (cfg *Server) WithGateway(gwCfg *gateway.Config) *Server {
+	cfg.Gateway = gwCfg
+	return cfg
+}
+
 // WithFaultPath sets the fault path (identification string e.g. rack/shelf/node).
 func (cfg *Server) WithFaultPath(fp string) *Server {
 	cfg.FaultPath = fp
@@ -317,6 +334,12 @@ func (cfg *Server) WithFirmwareHelperLogFile(filePath string) *Server {
 	return cfg
 }
 
+// WithAuditLogFile sets the path to the admin audit logfile.
+func (cfg *Server) WithAuditLogFile(filePath string) *Server {
+	cfg.AuditLogFile = filePath
+	return cfg
+}
+
 // WithTelemetryPort sets the port for the telemetry exporter.
 func (cfg *Server) WithTelemetryPort(port int) *Server {
 	cfg.TelemetryPort = port
@@ -679,6 +702,13 @@ func (cfg *Server) Validate(log logging.Logger) (err error) {
 	}
 	cfg.MgmtSvcReplicas = newReps
 
+	if err := cfg.TokenAuth.Validate(); err != nil {
+		return err
+	}
+	if err := cfg.Gateway.Validate(); err != nil {
+		return err
+	}
+
 	if cfg.Metadata.DevicePath != "" && cfg.Metadata.Path == "" {
 		return FaultConfigControlMetadataNoPath
 	}
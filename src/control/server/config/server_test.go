@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -16,6 +17,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -24,6 +26,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/gateway"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/engine"
@@ -233,6 +236,12 @@ func TestServerConfig_Constructed(t *testing.T) {
 
 	var bypass = true
 
+	transportCfg := security.DefaultServerTransportConfig()
+	transportCfg.CertCheckInterval = 5 * time.Minute
+	transportCfg.CertExpiryWarningDays = []uint{30, 7, 1}
+	transportCfg.CRLPath = "/etc/daos/certs/daosCA.crl"
+	transportCfg.AccessPolicyPath = "/etc/daos/daos_access_policy.yml"
+
 	// Next, construct a config to compare against the first one. It should be
 	// possible to construct an identical configuration with the helpers.
 	constructed := DefaultServer().
@@ -249,6 +258,7 @@ func TestServerConfig_Constructed(t *testing.T) {
 		WithControlLogFile("/tmp/daos_server.log").
 		WithHelperLogFile("/tmp/daos_server_helper.log").
 		WithFirmwareHelperLogFile("/tmp/daos_firmware_helper.log").
+		WithAuditLogFile("/var/log/daos/daos_audit.log").
 		WithTelemetryPort(9191).
 		WithSystemName("daos_server").
 		WithSocketDir("./.daos/daos_server").
@@ -260,7 +270,30 @@ func TestServerConfig_Constructed(t *testing.T) {
 		WithClientEnvVars([]string{"foo=bar"}).
 		WithFabricAuthKey("foo:bar").
 		WithHyperthreads(true). // hyper-threads disabled by default
-		WithSystemRamReserved(5)
+		WithSystemRamReserved(5).
+		WithTokenAuth(&security.TokenAuthConfig{
+			Issuers: []*security.TokenIssuerConfig{
+				{Issuer: "https://idp.example.com", PublicKey: "/etc/daos/certs/idp.pem"},
+			},
+			Audience:    "daos",
+			MaxLifetime: time.Hour,
+			ClockSkew:   30 * time.Second,
+		}).
+		WithGateway(&gateway.Config{
+			Address:      "0.0.0.0:8443",
+			Cert:         "/etc/daos/certs/gateway.crt",
+			Key:          "/etc/daos/certs/gateway.key",
+			ClientCACert: "/etc/daos/certs/daosCA.crt",
+			TokenAuth: &security.TokenAuthConfig{
+				Issuers: []*security.TokenIssuerConfig{
+					{Issuer: "https://idp.example.com", PublicKey: "/etc/daos/certs/idp.pem"},
+				},
+			},
+			AccessPolicy: "/etc/daos/daos_access_policy.yml",
+			AdminCert:    "/etc/daos/certs/admin.crt",
+			AdminKey:     "/etc/daos/certs/admin.key",
+		}).
+		WithTransportConfig(transportCfg)
 
 	// add engines explicitly to test functionality applied in WithEngines()
 	constructed.Engines = []*engine.Config{
@@ -531,6 +564,18 @@ func TestServerConfig_Validation(t *testing.T) {
 				return c.WithMgmtSvcReplicas("1.2.3.4:1234", "5.6.7.8:5678", "1.5.3.8:6247")
 			},
 		},
+		"token auth without issuers": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTokenAuth(&security.TokenAuthConfig{})
+			},
+			expErr: errors.New("at least one issuer"),
+		},
+		"gateway without client authentication": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithGateway(&gateway.Config{Cert: "gw.crt", Key: "gw.key"})
+			},
+			expErr: errors.New("client_ca_cert or token_auth"),
+		},
 		"multiple MS replicas (dupes)": {
 			extraConfig: func(c *Server) *Server {
 				return c.WithMgmtSvcReplicas("1.2.3.4", "5.6.7.8", "1.2.3.4")
@@ -0,0 +1,76 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/audit"
+)
+
+func auditEntryToProto(e *audit.Entry) *ctlpb.AuditEntry {
+	return &ctlpb.AuditEntry{
+		Seq:        e.Seq,
+		Time:       uint64(e.Time.UnixNano()),
+		Method:     e.Method,
+		Caller:     e.Caller,
+		Serial:     e.Serial,
+		OnBehalfOf: e.OnBehalfOf,
+		PeerAddr:   e.PeerAddr,
+		Request:    e.Request,
+		Result:     e.Result,
+		Duration:   uint64(e.Duration),
+		Hash:       e.Hash,
+	}
+}
+
+// AuditList returns entries from the admin audit log of the server. Entries
+// are only recorded while the server is the MS leader, so the logs of all MS
+// replicas are needed for a complete history.
+func (cs *ControlService) AuditList(_ context.Context, req *ctlpb.AuditListReq) (*ctlpb.AuditListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+
+	resp := new(ctlpb.AuditListResp)
+	if cs.srvCfg.AuditLogFile == "" {
+		return resp, nil
+	}
+	resp.Enabled = true
+
+	filter := &audit.Filter{
+		Method: req.Method,
+		Limit:  int(req.Limit),
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(int64(req.Since), 0)
+	}
+
+	entries, err := audit.Read(cs.srvCfg.AuditLogFile, filter)
+	switch {
+	case os.IsNotExist(errors.Cause(err)):
+		// Nothing has been recorded yet.
+		return resp, nil
+	case audit.IsChainError(err):
+		// Return the entries that could be verified along with the error.
+		cs.log.Errorf("admin audit log %s failed verification: %s", cs.srvCfg.AuditLogFile, err)
+		resp.VerifyError = err.Error()
+	case err != nil:
+		return nil, err
+	}
+
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, auditEntryToProto(e))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,142 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/audit"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+func TestServer_CtlSvc_AuditList(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	goodLog := filepath.Join(dir, "audit.log")
+	auditLog, err := audit.Open(goodLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entries []*audit.Entry
+	for i, method := range []string{"/mgmt.MgmtSvc/PoolCreate", "/mgmt.MgmtSvc/SystemStop"} {
+		e := &audit.Entry{
+			Time:   start.Add(time.Duration(i) * time.Hour),
+			Method: method,
+			Caller: "CN=admin",
+			Result: audit.ResultOK,
+		}
+		if err := auditLog.Append(e); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, e)
+	}
+	auditLog.Close()
+
+	data, err := os.ReadFile(goodLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tamperedLog := filepath.Join(dir, "tampered.log")
+	tampered := strings.Replace(string(data), "SystemStop", "SystemStart", 1)
+	if err := os.WriteFile(tamperedLog, []byte(tampered), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	badLog := filepath.Join(dir, "bad.log")
+	if err := os.WriteFile(badLog, []byte("not json\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		path    string
+		req     *ctlpb.AuditListReq
+		expResp *ctlpb.AuditListResp
+		expErr  error
+	}{
+		"nil request": {
+			path:   goodLog,
+			expErr: errors.New("nil"),
+		},
+		"not enabled": {
+			req:     &ctlpb.AuditListReq{},
+			expResp: &ctlpb.AuditListResp{},
+		},
+		"nothing recorded": {
+			path:    filepath.Join(dir, "missing.log"),
+			req:     &ctlpb.AuditListReq{},
+			expResp: &ctlpb.AuditListResp{Enabled: true},
+		},
+		"unreadable log": {
+			path:   badLog,
+			req:    &ctlpb.AuditListReq{},
+			expErr: errors.New("audit log line 1"),
+		},
+		"all entries": {
+			path: goodLog,
+			req:  &ctlpb.AuditListReq{},
+			expResp: &ctlpb.AuditListResp{
+				Enabled: true,
+				Entries: []*ctlpb.AuditEntry{
+					auditEntryToProto(entries[0]),
+					auditEntryToProto(entries[1]),
+				},
+			},
+		},
+		"tampered log": {
+			path: tamperedLog,
+			req:  &ctlpb.AuditListReq{},
+			expResp: &ctlpb.AuditListResp{
+				Enabled: true,
+				Entries: []*ctlpb.AuditEntry{
+					auditEntryToProto(entries[0]),
+				},
+				VerifyError: "audit log chain broken at entry 2: entry hash does not match",
+			},
+		},
+		"filtered": {
+			path: goodLog,
+			req: &ctlpb.AuditListReq{
+				Since: uint64(start.Add(30 * time.Minute).Unix()),
+			},
+			expResp: &ctlpb.AuditListResp{
+				Enabled: true,
+				Entries: []*ctlpb.AuditEntry{
+					auditEntryToProto(entries[1]),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithAuditLogFile(tc.path)
+			cs := &ControlService{
+				StorageControlService: *NewStorageControlService(log, nil),
+				srvCfg:                cfg,
+			}
+
+			resp, err := cs.AuditList(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func certInfoToProto(ci *security.CertificateInfo) *ctlpb.CertInfo {
+	return &ctlpb.CertInfo{
+		Name:      ci.Name,
+		Path:      ci.Path,
+		Subject:   ci.Subject,
+		Serial:    ci.Serial,
+		NotBefore: uint64(ci.NotBefore.Unix()),
+		NotAfter:  uint64(ci.NotAfter.Unix()),
+	}
+}
+
+// CertCheck returns details of the TLS certificates currently in use by the
+// server, so that their expiry can be checked remotely.
+func (cs *ControlService) CertCheck(_ context.Context, _ *ctlpb.CertCheckReq) (*ctlpb.CertCheckResp, error) {
+	tc := cs.srvCfg.TransportConfig
+	if tc == nil {
+		return nil, errors.New("no transport config")
+	}
+
+	resp := &ctlpb.CertCheckResp{
+		Insecure: tc.AllowInsecure,
+	}
+	for _, ci := range tc.Certificates() {
+		resp.Certs = append(resp.Certs, certInfoToProto(ci))
+	}
+
+	return resp, nil
+}
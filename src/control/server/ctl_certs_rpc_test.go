@@ -0,0 +1,85 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+func TestServer_certInfoToProto(t *testing.T) {
+	notBefore := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter := notBefore.Add(365 * 24 * time.Hour)
+
+	got := certInfoToProto(&security.CertificateInfo{
+		Name:      "cert",
+		Path:      "/etc/daos/certs/server.crt",
+		Subject:   "CN=server,O=DAOS",
+		Serial:    "42",
+		NotBefore: notBefore,
+		NotAfter:  notAfter,
+	})
+
+	exp := &ctlpb.CertInfo{
+		Name:      "cert",
+		Path:      "/etc/daos/certs/server.crt",
+		Subject:   "CN=server,O=DAOS",
+		Serial:    "42",
+		NotBefore: uint64(notBefore.Unix()),
+		NotAfter:  uint64(notAfter.Unix()),
+	}
+	if diff := cmp.Diff(exp, got, test.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("unexpected cert info (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestServer_CtlSvc_CertCheck(t *testing.T) {
+	for name, tc := range map[string]struct {
+		tc      *security.TransportConfig
+		expResp *ctlpb.CertCheckResp
+		expErr  error
+	}{
+		"no transport config": {
+			expErr: errors.New("no transport config"),
+		},
+		"insecure": {
+			tc:      &security.TransportConfig{AllowInsecure: true},
+			expResp: &ctlpb.CertCheckResp{Insecure: true},
+		},
+		"certificates not loaded": {
+			tc:      security.DefaultServerTransportConfig(),
+			expResp: &ctlpb.CertCheckResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer()
+			cfg.TransportConfig = tc.tc
+			cs := &ControlService{
+				StorageControlService: *NewStorageControlService(log, nil),
+				srvCfg:                cfg,
+			}
+
+			resp, err := cs.CertCheck(test.Context(t), new(ctlpb.CertCheckReq))
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -12,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/dustin/go-humanize"
+	"github.com/google/uuid"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/fault"
@@ -101,6 +103,22 @@ func FaultPoolScmTooSmall(minTotal, minSCM uint64) *fault.Fault {
 	)
 }
 
+func FaultPoolIdempotencyKeyReused(key string) *fault.Fault {
+	return serverFault(
+		code.ServerPoolIdempotencyKeyReused,
+		fmt.Sprintf("idempotency key %q was already used for a different request", key),
+		"use a new idempotency key for each distinct request, and the same key only for its retries",
+	)
+}
+
+func FaultPoolLabelDeprecated(label string, poolUUID uuid.UUID, newLabel string) *fault.Fault {
+	return serverFault(
+		code.ServerPoolLabelDeprecated,
+		fmt.Sprintf("pool label %q is deprecated; pool %s is now labeled %q", label, poolUUID, newLabel),
+		"retry the request with the pool's current label or UUID; deprecated labels are only accepted by requests that do not modify the pool",
+	)
+}
+
 func FaultPoolInvalidRanks(invalid []ranklist.Rank) *fault.Fault {
 	rs := make([]string, len(invalid))
 	for i, r := range invalid {
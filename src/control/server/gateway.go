@@ -0,0 +1,157 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/gateway"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// gatewayBackend calls methods on behalf of the REST gateway, as an admin
+// client of the local server.
+type gatewayBackend struct {
+	client control.UnaryInvoker
+}
+
+// Invoke implements gateway.Backend.
+func (gb *gatewayBackend) Invoke(ctx context.Context, method string, req, resp proto.Message, hosts []string) (*gateway.Result, error) {
+	mr := &control.MethodReq{
+		Method:   method,
+		Message:  req,
+		Response: resp,
+	}
+
+	isMSMethod := strings.HasPrefix(method, "/"+mgmtpb.MgmtSvc_ServiceDesc.ServiceName+"/")
+	if len(hosts) == 0 && !isMSMethod {
+		var err error
+		if hosts, err = gb.memberHosts(ctx); err != nil {
+			return nil, gatewayError(err)
+		}
+	}
+	mr.SetHostList(hosts)
+
+	mResp, err := control.InvokeMethod(ctx, gb.client, mr)
+	if err != nil {
+		return nil, gatewayError(err)
+	}
+
+	result := &gateway.Result{Message: mResp.Message}
+	for _, hr := range mResp.HostResponses {
+		result.HostResponses = append(result.HostResponses, &gateway.HostResponse{
+			Addr:    hr.Addr,
+			Error:   hr.Error,
+			Message: hr.Message,
+		})
+	}
+	return result, nil
+}
+
+// memberHosts returns the control addresses of the system members, on which
+// control service methods are called by default.
+func (gb *gatewayBackend) memberHosts(ctx context.Context) ([]string, error) {
+	resp, err := control.SystemQuery(ctx, gb.client, new(control.SystemQueryReq))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying system members")
+	}
+
+	var hosts []string
+	seen := make(map[string]struct{})
+	for _, m := range resp.Members {
+		if m.Addr == nil {
+			continue
+		}
+		addr := m.Addr.String()
+		if _, found := seen[addr]; found {
+			continue
+		}
+		seen[addr] = struct{}{}
+		hosts = append(hosts, addr)
+	}
+	return hosts, nil
+}
+
+// gatewayError annotates errors with the HTTP status of the gateway response.
+func gatewayError(err error) error {
+	switch {
+	case system.IsPoolNotFound(err), system.IsMemberNotFound(err),
+		system.IsErrSystemAttrNotFound(err), errors.Is(err, daos.Nonexistent):
+		return gateway.WithHTTPStatus(err, http.StatusNotFound)
+	case system.IsUnavailable(err), system.IsNotReady(err), system.IsUninitialized(err):
+		return gateway.WithHTTPStatus(err, http.StatusServiceUnavailable)
+	}
+	return err
+}
+
+// gatewayTransportConfig returns the transport configuration used by the REST
+// gateway to call the local server with the admin certificate in the gateway
+// configuration.
+func (srv *server) gatewayTransportConfig() *security.TransportConfig {
+	tc := security.DefaultClientTransportConfig()
+	tc.AllowInsecure = srv.cfg.TransportConfig.AllowInsecure
+	tc.CARootPath = srv.cfg.TransportConfig.CARootPath
+	tc.CRLPath = srv.cfg.TransportConfig.CRLPath
+	if gwCfg := srv.cfg.Gateway; gwCfg != nil && gwCfg.AdminCert != "" {
+		tc.CertificatePath = gwCfg.AdminCert
+		tc.PrivateKeyPath = gwCfg.AdminKey
+	}
+	return tc
+}
+
+// loadGatewayCert returns the certificate used by the REST gateway, if one is
+// configured with transport security enabled.
+func (srv *server) loadGatewayCert() (*x509.Certificate, error) {
+	if srv.cfg.Gateway == nil || srv.cfg.TransportConfig.AllowInsecure {
+		return nil, nil
+	}
+
+	cert, err := security.LoadCertificate(srv.gatewayTransportConfig().CertificatePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading gateway certificate")
+	}
+	return cert, nil
+}
+
+// startGateway starts the REST gateway, if configured, using the admin
+// certificate in the gateway configuration to call the local server.
+func (srv *server) startGateway(ctx context.Context) error {
+	gwCfg := srv.cfg.Gateway
+	if gwCfg == nil {
+		return nil
+	}
+
+	tc := srv.gatewayTransportConfig()
+
+	client := control.NewClient(
+		control.WithConfig(&control.Config{
+			SystemName:      srv.cfg.SystemName,
+			ControlPort:     srv.cfg.ControlPort,
+			HostList:        []string{srv.ctlAddr.String()},
+			TransportConfig: tc,
+		}),
+		control.WithClientComponent(build.ComponentAdmin),
+		control.WithClientLogger(srv.log),
+	)
+
+	gw, err := gateway.New(srv.log, gwCfg, &gatewayBackend{client: client})
+	if err != nil {
+		return err
+	}
+	return gw.Start(ctx)
+}
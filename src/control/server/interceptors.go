@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +8,7 @@
 package server
 
 import (
+	"crypto/x509"
 	"fmt"
 	"strings"
 	"time"
@@ -22,13 +24,16 @@ import (
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/audit"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/system"
 )
 
-func componentFromContext(ctx context.Context) (comp *security.Component, err error) {
+func peerCertFromContext(ctx context.Context) (*x509.Certificate, error) {
 	clientPeer, ok := peer.FromContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "no peer information found")
@@ -45,64 +50,130 @@ func componentFromContext(ctx context.Context) (comp *security.Component, err er
 		return nil, status.Error(codes.Unauthenticated, "unable to verify client certificates")
 	}
 
-	peerCert := certs[0][0]
+	return certs[0][0], nil
+}
+
+func componentFromContext(ctx context.Context) (comp *security.Component, err error) {
+	peerCert, err := peerCertFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	component := security.CommonNameToComponent(peerCert.Subject.CommonName)
 
 	return &component, nil
 }
 
-func checkAccess(ctx context.Context, FullMethod string) error {
-	component, err := componentFromContext(ctx)
+// accessChecker authorizes gRPC calls based on the component identified by the
+// caller's certificate and, for admin certificates, the roles permitted by the
+// access policy if one is configured. Denied calls are logged, and recorded in
+// the audit log if one is configured.
+type accessChecker struct {
+	log      logging.Logger
+	policy   *security.AccessPolicy
+	auditLog *audit.Log
+	gwCert   *x509.Certificate
+}
+
+func (ac *accessChecker) deny(ctx context.Context, FullMethod, who string, req interface{}) error {
+	caller := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		caller = p.Addr.String()
+	}
+	errMsg := fmt.Sprintf("%s does not have permission to call %s", who, FullMethod)
+	ac.log.Noticef("access denied: %s from %s", errMsg, caller)
+
+	if ac.auditLog != nil {
+		entry := &audit.Entry{
+			Time:     time.Now(),
+			Method:   FullMethod,
+			Request:  auditRequest(req),
+			Result:   "access denied: " + errMsg,
+			PeerAddr: caller,
+		}
+		entry.Caller, entry.Serial = auditCaller(ctx)
+		entry.OnBehalfOf = auditOnBehalfOf(ctx, ac.gwCert)
+		if err := ac.auditLog.Append(entry); err != nil {
+			ac.log.Errorf("failed to record denial of %s in audit log: %s", FullMethod, err)
+		}
+	}
+
+	return status.Error(codes.PermissionDenied, errMsg)
+}
+
+func (ac *accessChecker) checkAccess(ctx context.Context, FullMethod string, req interface{}) error {
+	peerCert, err := peerCertFromContext(ctx)
 	if err != nil {
 		return err
 	}
 
+	component := security.CommonNameToComponent(peerCert.Subject.CommonName)
 	if !component.HasAccess(FullMethod) {
-		errMsg := fmt.Sprintf("%s does not have permission to call %s", component, FullMethod)
-		return status.Error(codes.PermissionDenied, errMsg)
+		return ac.deny(ctx, FullMethod, component.String(), req)
+	}
+
+	if ac.policy != nil && component == security.ComponentAdmin {
+		roles := ac.policy.CertRoles(peerCert)
+		if !ac.policy.Allows(roles, FullMethod) {
+			return ac.deny(ctx, FullMethod, fmt.Sprintf("%s (serial %s, roles [%s])", component,
+				peerCert.SerialNumber, strings.Join(roles, ",")), req)
+		}
 	}
 
 	return nil
 }
 
-func unaryAccessInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	if err := checkAccess(ctx, info.FullMethod); err != nil {
+func (ac *accessChecker) unaryAccessInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := ac.checkAccess(ctx, info.FullMethod, req); err != nil {
 		return nil, errors.Wrapf(err, "access denied for %T", req)
 	}
 
+	// The caller must also be allowed to call the method of a request that
+	// is run as a long-running operation.
+	if opReq, ok := req.(*mgmtpb.OperationStartReq); ok {
+		if err := ac.checkAccess(ctx, opReq.GetMethod(), req); err != nil {
+			return nil, errors.Wrapf(err, "access denied for %T", req)
+		}
+	}
+
 	return handler(ctx, req)
 }
 
-func streamAccessInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-	if err := checkAccess(ss.Context(), info.FullMethod); err != nil {
+func (ac *accessChecker) streamAccessInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := ac.checkAccess(ss.Context(), info.FullMethod, nil); err != nil {
 		return err
 	}
 
 	return handler(srv, ss)
 }
 
-func unaryInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.UnaryServerInterceptor, error) {
+// accessInterceptorsForTransportConfig returns the interceptors that authorize
+// gRPC calls, or nil interceptors if transport security is disabled. Denied
+// calls are recorded in the audit log if one is supplied, along with the client
+// of the REST gateway with the supplied certificate.
+func accessInterceptorsForTransportConfig(log logging.Logger, cfg *security.TransportConfig, auditLog *audit.Log, gwCert *x509.Certificate) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor, error) {
 	if cfg == nil {
-		return nil, errors.New("nil TransportConfig")
+		return nil, nil, errors.New("nil TransportConfig")
 	}
 
 	if cfg.AllowInsecure {
-		return nil, nil
-	}
-
-	return unaryAccessInterceptor, nil
-}
-
-func streamInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.StreamServerInterceptor, error) {
-	if cfg == nil {
-		return nil, errors.New("nil TransportConfig")
+		if cfg.AccessPolicyPath != "" {
+			log.Noticef("access policy %s is not enforced with transport security disabled", cfg.AccessPolicyPath)
+		}
+		return nil, nil, nil
 	}
 
-	if cfg.AllowInsecure {
-		return nil, nil
+	ac := &accessChecker{log: log, auditLog: auditLog, gwCert: gwCert}
+	if cfg.AccessPolicyPath != "" {
+		policy, err := security.LoadAccessPolicy(cfg.AccessPolicyPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		log.Debugf("enforcing access policy %s", cfg.AccessPolicyPath)
+		ac.policy = policy
 	}
 
-	return streamAccessInterceptor, nil
+	return ac.unaryAccessInterceptor, ac.streamAccessInterceptor, nil
 }
 
 var selfServerComponent = func() *build.VersionedComponent {
@@ -258,7 +329,8 @@ func shouldLogMsg(msg interface{}, log logging.Logger, ldrChk func() bool) (prot
 
 // unaryLoggingInterceptor generates a grpc.UnaryServerInterceptor that
 // will log an error if the RPC handler returned an error. If debugging is
-// enabled, it will also log the request and response messages.
+// enabled, it will also log the request and response messages. The handling
+// of RPCs traced by the client is logged along with the trace context.
 //
 // NB: This interceptor should be the last in the chain, i.e. first in the
 // list of interceptors passed to grpc.NewServer.
@@ -268,6 +340,12 @@ func unaryLoggingInterceptor(log logging.Logger, ldrChk func() bool) grpc.UnaryS
 			log.Debugf("gRPC request: %s", proto.Debug(m))
 		}
 
+		traceStr := ""
+		traceID, spanID, traced := control.TraceFromIncomingContext(ctx)
+		if traced {
+			traceStr = fmt.Sprintf(" (trace: %s, parent span: %s)", traceID, spanID)
+		}
+
 		startTime := time.Now()
 		res, err := handler(ctx, req)
 		elapsed := time.Since(startTime)
@@ -282,11 +360,17 @@ func unaryLoggingInterceptor(log logging.Logger, ldrChk func() bool) grpc.UnaryS
 		// Log the unwrapped error if it's not a sentinel error.
 		if logErr != nil {
 			if !isSentinelErr(logErr) {
-				log.Errorf("gRPC handler for %T failed: %s (elapsed: %s)", req, logErr, elapsed)
+				log.Errorf("gRPC handler for %T failed: %s (elapsed: %s)%s", req, logErr, elapsed, traceStr)
+			} else if traced {
+				log.Infof("gRPC handler for %T returned: %s (elapsed: %s)%s", req, logErr, elapsed, traceStr)
 			}
 			return res, err
 		}
 
+		if traced {
+			log.Infof("gRPC handler for %T completed (elapsed: %s)%s", req, elapsed, traceStr)
+		}
+
 		if m, ok := shouldLogMsg(res, log, ldrChk); ok {
 			log.Debugf("gRPC response for %T: %s (elapsed: %s)", req, proto.Debug(m), elapsed)
 		}
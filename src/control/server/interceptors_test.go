@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,19 +12,29 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"math/big"
+	"net/url"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/audit"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
 )
 
 type testStatus struct {
@@ -74,22 +85,70 @@ func TestServer_unaryStatusInterceptor(t *testing.T) {
 	}
 }
 
+func TestServer_unaryLoggingInterceptor(t *testing.T) {
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	for name, tc := range map[string]struct {
+		traced     bool
+		handlerErr error
+		expLog     string
+	}{
+		"not traced": {},
+		"not traced with error": {
+			handlerErr: errors.New("whoops"),
+			expLog:     "failed: whoops",
+		},
+		"traced": {
+			traced: true,
+			expLog: "completed (elapsed: ",
+		},
+		"traced with error": {
+			traced:     true,
+			handlerErr: errors.New("whoops"),
+			expLog:     "failed: whoops",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			if tc.traced {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(control.TraceParentHeader, traceParent))
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, tc.handlerErr
+			}
+
+			_, gotErr := unaryLoggingInterceptor(log, nil)(ctx, nil, nil, handler)
+			test.CmpErr(t, tc.handlerErr, gotErr)
+
+			test.AssertTrue(t, strings.Contains(buf.String(), tc.expLog), "missing log message")
+			test.AssertEqual(t, tc.traced,
+				strings.Contains(buf.String(), "(trace: 4bf92f3577b34da6a3ce929d0e0e4736, parent span: 00f067aa0ba902b7)"),
+				"unexpected trace context in log")
+		})
+	}
+}
+
 // newTestAuthCtx returns a context with a fake peer.PeerInfo
 // set up to validate component access/versioning.
 func newTestAuthCtx(parent context.Context, commonName string) context.Context {
+	return newTestCertCtx(parent, &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: commonName,
+		},
+	})
+}
+
+// newTestCertCtx returns a context with a fake peer.PeerInfo for a peer that
+// presented the supplied certificate.
+func newTestCertCtx(parent context.Context, cert *x509.Certificate) context.Context {
 	ctxPeer := &peer.Peer{
 		Addr: common.LocalhostCtrlAddr(),
 		AuthInfo: credentials.TLSInfo{
 			State: tls.ConnectionState{
-				VerifiedChains: [][]*x509.Certificate{
-					{
-						{
-							Subject: pkix.Name{
-								CommonName: commonName,
-							},
-						},
-					},
-				},
+				VerifiedChains: [][]*x509.Certificate{{cert}},
 			},
 		},
 	}
@@ -97,6 +156,183 @@ func newTestAuthCtx(parent context.Context, commonName string) context.Context {
 	return peer.NewContext(parent, ctxPeer)
 }
 
+func TestServer_accessChecker(t *testing.T) {
+	policy := &security.AccessPolicy{
+		Roles: map[string]*security.AccessRole{
+			"read-only": {
+				Methods: []string{"/mgmt.MgmtSvc/SystemQuery"},
+			},
+			"pool-operator": {
+				Methods: []string{"/mgmt.MgmtSvc/Pool*"},
+			},
+		},
+	}
+	roleURI, err := url.Parse("daos-role:pool-operator")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	adminCert := func(ous ...string) *x509.Certificate {
+		return &x509.Certificate{
+			SerialNumber: big.NewInt(42),
+			Subject: pkix.Name{
+				CommonName:         "admin",
+				OrganizationalUnit: ous,
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		policy    *security.AccessPolicy
+		ctx       context.Context
+		method    string
+		expErr    error
+		expDenied bool
+	}{
+		"no peer": {
+			ctx:    test.Context(t),
+			method: "/mgmt.MgmtSvc/SystemQuery",
+			expErr: errors.New("no peer information"),
+		},
+		"no policy; admin allowed": {
+			ctx:    newTestCertCtx(test.Context(t), adminCert()),
+			method: "/mgmt.MgmtSvc/SystemErase",
+		},
+		"no policy; agent denied": {
+			ctx:       newTestAuthCtx(test.Context(t), "agent"),
+			method:    "/mgmt.MgmtSvc/SystemErase",
+			expErr:    errors.New("agent does not have permission"),
+			expDenied: true,
+		},
+		"policy; agent unaffected": {
+			policy: policy,
+			ctx:    newTestAuthCtx(test.Context(t), "agent"),
+			method: "/mgmt.MgmtSvc/GetAttachInfo",
+		},
+		"policy; admin without role denied": {
+			policy:    policy,
+			ctx:       newTestCertCtx(test.Context(t), adminCert()),
+			method:    "/mgmt.MgmtSvc/SystemQuery",
+			expErr:    errors.New("roles []"),
+			expDenied: true,
+		},
+		"policy; read-only allowed": {
+			policy: policy,
+			ctx:    newTestCertCtx(test.Context(t), adminCert("read-only")),
+			method: "/mgmt.MgmtSvc/SystemQuery",
+		},
+		"policy; read-only denied": {
+			policy:    policy,
+			ctx:       newTestCertCtx(test.Context(t), adminCert("read-only")),
+			method:    "/mgmt.MgmtSvc/SystemErase",
+			expErr:    errors.New("roles [read-only]"),
+			expDenied: true,
+		},
+		"policy; role from URI": {
+			policy: policy,
+			ctx: newTestCertCtx(test.Context(t), func() *x509.Certificate {
+				cert := adminCert()
+				cert.URIs = []*url.URL{roleURI}
+				return cert
+			}()),
+			method: "/mgmt.MgmtSvc/PoolCreate",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			dir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			auditPath := filepath.Join(dir, "audit.log")
+			auditLog, err := audit.Open(auditPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer auditLog.Close()
+
+			ac := &accessChecker{log: log, policy: tc.policy, auditLog: auditLog}
+			err = ac.checkAccess(tc.ctx, tc.method, &mgmtpb.SystemQueryReq{})
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil && tc.expDenied {
+				test.AssertEqual(t, codes.PermissionDenied, status.Code(err), "")
+			}
+			test.AssertEqual(t, tc.expDenied, strings.Contains(buf.String(), "access denied"),
+				"unexpected denial log")
+
+			entries, err := audit.Read(auditPath, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tc.expDenied {
+				test.AssertEqual(t, 0, len(entries), "expected no audit entries")
+				return
+			}
+			if len(entries) != 1 {
+				t.Fatalf("expected 1 audit entry, got %d", len(entries))
+			}
+			test.AssertEqual(t, tc.method, entries[0].Method, "")
+			test.AssertTrue(t, strings.HasPrefix(entries[0].Result, "access denied: "),
+				"unexpected result "+entries[0].Result)
+			test.AssertEqual(t, common.LocalhostCtrlAddr().String(), entries[0].PeerAddr, "")
+		})
+	}
+}
+
+func TestServer_accessInterceptorsForTransportConfig(t *testing.T) {
+	dir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	validPolicy := test.CreateTestFile(t, dir, `
+roles:
+  read-only:
+    methods: [/mgmt.MgmtSvc/SystemQuery]
+`)
+	invalidPolicy := test.CreateTestFile(t, dir, `
+roles:
+  read-only:
+    methods: [/mgmt.MgmtSvc/Bogus]
+`)
+
+	for name, tc := range map[string]struct {
+		cfg    *security.TransportConfig
+		expNil bool
+		expErr error
+	}{
+		"nil config": {
+			expErr: errors.New("nil"),
+		},
+		"insecure": {
+			cfg:    &security.TransportConfig{AllowInsecure: true, AccessPolicyPath: invalidPolicy},
+			expNil: true,
+		},
+		"no policy": {
+			cfg: &security.TransportConfig{},
+		},
+		"valid policy": {
+			cfg: &security.TransportConfig{AccessPolicyPath: validPolicy},
+		},
+		"invalid policy": {
+			cfg:    &security.TransportConfig{AccessPolicyPath: invalidPolicy},
+			expErr: errors.New("Bogus"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			unary, stream, err := accessInterceptorsForTransportConfig(log, tc.cfg, nil, nil)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expNil, unary == nil, "unexpected unary interceptor")
+			test.AssertEqual(t, tc.expNil, stream == nil, "unexpected stream interceptor")
+		})
+	}
+}
+
 type checkVerReq struct {
 	Sys string
 }
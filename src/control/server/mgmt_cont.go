@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -36,11 +37,61 @@ func (svc *mgmtSvc) ListContainers(ctx context.Context, req *mgmtpb.ListContReq)
 }
 
 // ContSetOwner forwards a gRPC request to the DAOS I/O Engine to change a container's ownership.
+//
+// Requests with an idempotency key must be handled by the MS leader, which
+// records their results with the pool.
 func (svc *mgmtSvc) ContSetOwner(ctx context.Context, req *mgmtpb.ContSetOwnerReq) (*mgmtpb.DaosResp, error) {
-	if err := svc.checkReplicaRequest(req); err != nil {
+	key := req.GetIdempotencyKey()
+	if key == "" {
+		if err := svc.checkReplicaRequest(req); err != nil {
+			return nil, err
+		}
+		return svc.contSetOwner(ctx, req)
+	}
+
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	poolUUID, err := svc.resolvePoolID(req.GetPoolId())
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := svc.sysdb.TakePoolLock(ctx, poolUUID)
+	if err != nil {
 		return nil, err
 	}
+	defer lock.Release()
+	ctx = lock.InContext(ctx)
+
+	method := mgmtpb.MgmtSvc_ContSetOwner_FullMethodName
+	prev := &mgmtpb.DaosResp{}
+	if found, err := svc.idempotentResponse(key, method, poolUUID, prev); err != nil {
+		return nil, err
+	} else if found {
+		return prev, nil
+	}
+
+	resp, err := svc.contSetOwner(ctx, req)
+	if err != nil || resp.GetStatus() != 0 {
+		return resp, err
+	}
+
+	ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
+	if err != nil {
+		svc.log.Errorf("failed to record idempotency key %q: %s", key, err)
+		return resp, nil
+	}
+	svc.recordIdempotentResponse(ps, key, method, resp)
+	if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+		svc.log.Errorf("failed to record idempotency key %q: %s", key, err)
+	}
+
+	return resp, nil
+}
 
+func (svc *mgmtSvc) contSetOwner(ctx context.Context, req *mgmtpb.ContSetOwnerReq) (*mgmtpb.DaosResp, error) {
 	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodContSetOwner, req)
 	if err != nil {
 		return nil, err
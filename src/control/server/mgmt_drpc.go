@@ -48,6 +48,7 @@ func (mod *mgmtModule) ID() drpc.ModuleID {
 // a system pool database.
 type poolDatabase interface {
 	FindPoolServiceByLabel(string) (*system.PoolService, error)
+	FindPoolServiceByLabelAlias(string) (*system.PoolService, error)
 	FindPoolServiceByUUID(uuid.UUID) (*system.PoolService, error)
 	PoolServiceList(bool) ([]*system.PoolService, error)
 	AddPoolService(context.Context, *system.PoolService) error
@@ -156,7 +157,12 @@ func (mod *srvModule) handlePoolFindByLabel(reqb []byte) ([]byte, error) {
 
 	resp := new(srvpb.PoolFindByLabelResp)
 
+	// Pool connects are allowed to use the deprecated label of a renamed
+	// pool, so that existing mounts continue to work.
 	ps, err := mod.poolDB.FindPoolServiceByLabel(req.GetLabel())
+	if system.IsPoolNotFound(err) {
+		ps, err = mod.poolDB.FindPoolServiceByLabelAlias(req.GetLabel())
+	}
 	if err != nil || ps.State != system.PoolServiceStateReady {
 		resp.Status = int32(daos.Nonexistent)
 		mod.log.Debugf("PoolFindByLabelResp: %+v", resp)
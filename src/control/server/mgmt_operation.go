@@ -0,0 +1,361 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	// operationRetention is the length of time for which finished
+	// operations are kept in the system database.
+	operationRetention = 24 * time.Hour
+	// operationPruneInterval is the interval between removals of expired
+	// operations.
+	operationPruneInterval = 10 * time.Minute
+	// errOperationInterrupted is recorded for operations that were running
+	// on a previous MS leader.
+	errOperationInterrupted = "interrupted by MS leadership change"
+)
+
+// asyncMethods are the management service methods that may be run as
+// long-running operations.
+var asyncMethods = map[string]struct{}{
+	mgmtpb.MgmtSvc_PoolCreate_FullMethodName:       {},
+	mgmtpb.MgmtSvc_PoolDestroy_FullMethodName:      {},
+	mgmtpb.MgmtSvc_SystemDrain_FullMethodName:      {},
+	mgmtpb.MgmtSvc_SystemCheckStart_FullMethodName: {},
+}
+
+// operationHandler is the signature of the generated handlers of the
+// management service methods.
+type operationHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// operationTracker holds the cancellation functions of the operations that
+// are running on this MS leader.
+type operationTracker struct {
+	sync.Mutex
+	ctx      context.Context
+	cancels  map[string]context.CancelFunc
+	canceled map[string]bool
+}
+
+func newOperationTracker() *operationTracker {
+	return &operationTracker{
+		cancels:  make(map[string]context.CancelFunc),
+		canceled: make(map[string]bool),
+	}
+}
+
+// reset sets the leadership context that operations are run with, which is
+// canceled on leadership loss.
+func (ot *operationTracker) reset(ctx context.Context) {
+	ot.Lock()
+	defer ot.Unlock()
+
+	ot.ctx = ctx
+	ot.cancels = make(map[string]context.CancelFunc)
+	ot.canceled = make(map[string]bool)
+}
+
+// start returns the context for a new operation.
+func (ot *operationTracker) start(id string) (context.Context, error) {
+	ot.Lock()
+	defer ot.Unlock()
+
+	if ot.ctx == nil || ot.ctx.Err() != nil {
+		return nil, system.ErrLeaderStepUpInProgress
+	}
+
+	ctx, cancel := context.WithCancel(ot.ctx)
+	ot.cancels[id] = cancel
+	return ctx, nil
+}
+
+// isRunning returns true if the operation is running on this MS leader.
+func (ot *operationTracker) isRunning(id string) bool {
+	ot.Lock()
+	defer ot.Unlock()
+
+	_, found := ot.cancels[id]
+	return found
+}
+
+// cancel cancels a running operation, returning false if it is not running
+// on this MS leader.
+func (ot *operationTracker) cancel(id string) bool {
+	ot.Lock()
+	defer ot.Unlock()
+
+	cancel, found := ot.cancels[id]
+	if !found {
+		return false
+	}
+	ot.canceled[id] = true
+	cancel()
+	return true
+}
+
+// finish releases the context of an operation, returning true if the
+// operation was canceled.
+func (ot *operationTracker) finish(id string) bool {
+	ot.Lock()
+	defer ot.Unlock()
+
+	if cancel, found := ot.cancels[id]; found {
+		cancel()
+	}
+	canceled := ot.canceled[id]
+	delete(ot.cancels, id)
+	delete(ot.canceled, id)
+	return canceled
+}
+
+func operationToPB(op *system.Operation) *mgmtpb.Operation {
+	return &mgmtpb.Operation{
+		Id:       op.ID,
+		Method:   op.Method,
+		State:    string(op.State),
+		Caller:   op.Caller,
+		Error:    op.Error,
+		Request:  op.Request,
+		Response: op.Response,
+		Created:  uint64(op.Created.Unix()),
+		Updated:  uint64(op.LastUpdate.Unix()),
+	}
+}
+
+// asyncMethodHandler returns the handler of a method that may be run as a
+// long-running operation, along with a new instance of its request message.
+func asyncMethodHandler(method string) (operationHandler, proto.Message, error) {
+	if _, found := asyncMethods[method]; !found {
+		return nil, nil, errors.Errorf("%s may not be run as an operation", method)
+	}
+
+	svcName := mgmtpb.MgmtSvc_ServiceDesc.ServiceName
+	name := strings.TrimPrefix(method, "/"+svcName+"/")
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(svcName + "." + name))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "method %s", method)
+	}
+	md, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, nil, errors.Errorf("%s is not a method", method)
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "method %s", method)
+	}
+
+	for _, gm := range mgmtpb.MgmtSvc_ServiceDesc.Methods {
+		if gm.MethodName == name {
+			return gm.Handler, mt.New().Interface(), nil
+		}
+	}
+	return nil, nil, errors.Errorf("no handler for %s", method)
+}
+
+// OperationStart handles requests to run a management service request as a
+// long-running operation on the MS leader. The operation is recorded in the
+// system database and its ID is returned before the request is run.
+func (svc *mgmtSvc) OperationStart(ctx context.Context, req *mgmtpb.OperationStartReq) (*mgmtpb.OperationStartResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	handler, inner, err := asyncMethodHandler(req.GetMethod())
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(req.GetRequest(), inner); err != nil {
+		return nil, errors.Wrapf(err, "decoding %s request", req.GetMethod())
+	}
+	if err := svc.checkLeaderRequest(inner); err != nil {
+		return nil, err
+	}
+
+	caller, _ := auditCaller(ctx)
+	op := system.NewOperation(req.GetMethod(), req.GetRequest(), caller)
+	opCtx, err := svc.operations.start(op.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := svc.sysdb.AddOperation(op); err != nil {
+		svc.operations.finish(op.ID)
+		return nil, err
+	}
+	svc.log.Debugf("started operation %s for %s", op.ID, op.Method)
+
+	go svc.runOperation(opCtx, op, handler, inner)
+
+	return &mgmtpb.OperationStartResp{Operation: operationToPB(op)}, nil
+}
+
+// runOperation calls the handler of the operation's request and records its
+// outcome in the system database.
+func (svc *mgmtSvc) runOperation(ctx context.Context, op *system.Operation, handler operationHandler, inner proto.Message) {
+	dec := func(in interface{}) error {
+		msg, ok := in.(proto.Message)
+		if !ok {
+			return errors.Errorf("unexpected request type %T", in)
+		}
+		proto.Merge(msg, inner)
+		return nil
+	}
+
+	var respData []byte
+	res, err := handler(svc, ctx, dec, nil)
+	if err == nil {
+		if sg, ok := res.(statusGetter); ok {
+			err = dErrFromStatus(sg)
+		}
+	}
+	if err == nil {
+		if msg, ok := res.(proto.Message); ok {
+			respData, err = proto.Marshal(msg)
+		}
+	}
+
+	if svc.operations.finish(op.ID) {
+		op.State = system.OperationStateCanceled
+		if err != nil {
+			op.Error = err.Error()
+		}
+	} else {
+		op.Finish(respData, err)
+	}
+
+	if uErr := svc.sysdb.UpdateOperation(op); uErr != nil {
+		svc.log.Errorf("failed to record outcome of operation %s: %s", op.ID, uErr)
+		return
+	}
+	svc.log.Debugf("operation %s for %s %s", op.ID, op.Method, op.State)
+}
+
+// OperationList handles requests to list long-running operations.
+func (svc *mgmtSvc) OperationList(ctx context.Context, req *mgmtpb.OperationListReq) (*mgmtpb.OperationListResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	ops, err := svc.sysdb.OperationList()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.OperationListResp)
+	for _, op := range ops {
+		if req.GetState() != "" && string(op.State) != req.GetState() {
+			continue
+		}
+		pbOp := operationToPB(op)
+		// Leave the requests and responses to OperationGet.
+		pbOp.Request = nil
+		pbOp.Response = nil
+		resp.Operations = append(resp.Operations, pbOp)
+	}
+
+	return resp, nil
+}
+
+// OperationGet handles requests to get a long-running operation.
+func (svc *mgmtSvc) OperationGet(ctx context.Context, req *mgmtpb.OperationGetReq) (*mgmtpb.OperationGetResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	op, err := svc.sysdb.GetOperation(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.OperationGetResp{Operation: operationToPB(op)}, nil
+}
+
+// OperationCancel handles requests to cancel a running long-running operation.
+// The operation's request is canceled and the operation is returned in its
+// current state; the canceled state is recorded once the request returns.
+func (svc *mgmtSvc) OperationCancel(ctx context.Context, req *mgmtpb.OperationCancelReq) (*mgmtpb.OperationCancelResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	op, err := svc.sysdb.GetOperation(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if op.State.IsFinished() {
+		return nil, system.FaultOperationNotRunning(op.ID, op.State)
+	}
+
+	if !svc.operations.cancel(op.ID) {
+		// The operation was started by a previous MS leader.
+		op.State = system.OperationStateCanceled
+		if err := svc.sysdb.UpdateOperation(op); err != nil {
+			return nil, err
+		}
+	}
+	svc.log.Debugf("canceled operation %s for %s", op.ID, op.Method)
+
+	return &mgmtpb.OperationCancelResp{Operation: operationToPB(op)}, nil
+}
+
+// failInterruptedOperations marks the operations that were running on a
+// previous MS leader as failed, as their requests were abandoned on
+// leadership loss.
+func (svc *mgmtSvc) failInterruptedOperations() error {
+	ops, err := svc.sysdb.OperationList()
+	if err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		if op.State.IsFinished() || svc.operations.isRunning(op.ID) {
+			continue
+		}
+		op.State = system.OperationStateFailed
+		op.Error = errOperationInterrupted
+		if err := svc.sysdb.UpdateOperation(op); err != nil {
+			return err
+		}
+		svc.log.Noticef("operation %s for %s %s", op.ID, op.Method, errOperationInterrupted)
+	}
+
+	return nil
+}
+
+// operationLoop periodically removes expired operations while this instance
+// is the MS leader.
+func (svc *mgmtSvc) operationLoop(parent context.Context) {
+	pruneTimer := time.NewTicker(operationPruneInterval)
+	defer pruneTimer.Stop()
+
+	svc.log.Debug("starting operationLoop")
+	for {
+		select {
+		case <-parent.Done():
+			svc.log.Debug("stopped operationLoop")
+			return
+		case <-pruneTimer.C:
+			if err := svc.sysdb.RemoveFinishedOperations(time.Now().Add(-operationRetention)); err != nil {
+				svc.log.Errorf("failed to remove expired operations: %s", err)
+			}
+		}
+	}
+}
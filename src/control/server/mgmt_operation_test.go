@@ -0,0 +1,362 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+func waitForOperation(t *testing.T, svc *mgmtSvc, id string) *system.Operation {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(test.Context(t), 10*time.Second)
+	defer cancel()
+	for {
+		op, err := svc.sysdb.GetOperation(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if op.State.IsFinished() {
+			return op
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("operation %s did not finish", id)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// newTestOperationMgmtSvc returns a mgmtSvc whose MS replica has joined the
+// system with support for operation records.
+func newTestOperationMgmtSvc(t *testing.T, log logging.Logger) *mgmtSvc {
+	t.Helper()
+
+	svc := newTestMgmtSvc(t, log)
+	m := system.NewMember(0, test.MockUUID(), []string{"tcp://localhost"},
+		common.LocalhostCtrlAddr(), system.MemberStateJoined)
+	m.DBSchemaVersion = raft.CurrentSchemaVersion
+	if err := svc.sysdb.AddMember(m); err != nil {
+		t.Fatal(err)
+	}
+	return svc
+}
+
+func mustMarshal(t *testing.T, msg proto.Message) []byte {
+	t.Helper()
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestServer_MgmtSvc_OperationStart(t *testing.T) {
+	destroyReq := &mgmtpb.PoolDestroyReq{Sys: build.DefaultSystemName, Id: "nopool"}
+
+	for name, tc := range map[string]struct {
+		req      *mgmtpb.OperationStartReq
+		expErr   error
+		expState system.OperationState
+		expOpErr string
+	}{
+		"wrong system": {
+			req: &mgmtpb.OperationStartReq{
+				Sys:     "bad",
+				Method:  mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+				Request: mustMarshal(t, destroyReq),
+			},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"method not allowed": {
+			req: &mgmtpb.OperationStartReq{
+				Sys:     build.DefaultSystemName,
+				Method:  mgmtpb.MgmtSvc_PoolQuery_FullMethodName,
+				Request: mustMarshal(t, &mgmtpb.PoolQueryReq{Sys: build.DefaultSystemName}),
+			},
+			expErr: errors.New("may not be run as an operation"),
+		},
+		"bad request": {
+			req: &mgmtpb.OperationStartReq{
+				Sys:     build.DefaultSystemName,
+				Method:  mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+				Request: []byte{0xff, 0xff},
+			},
+			expErr: errors.New("decoding"),
+		},
+		"wrong system in request": {
+			req: &mgmtpb.OperationStartReq{
+				Sys:     build.DefaultSystemName,
+				Method:  mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+				Request: mustMarshal(t, &mgmtpb.PoolDestroyReq{Sys: "bad", Id: "nopool"}),
+			},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"request fails": {
+			req: &mgmtpb.OperationStartReq{
+				Sys:     build.DefaultSystemName,
+				Method:  mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+				Request: mustMarshal(t, destroyReq),
+			},
+			expState: system.OperationStateFailed,
+			expOpErr: "unable to find pool",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestOperationMgmtSvc(t, log)
+
+			resp, err := svc.OperationStart(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, string(system.OperationStateRunning), resp.Operation.State,
+				"unexpected initial state")
+			test.AssertEqual(t, tc.req.Method, resp.Operation.Method, "unexpected method")
+
+			op := waitForOperation(t, svc, resp.Operation.Id)
+			test.AssertEqual(t, tc.expState, op.State, "unexpected final state")
+			test.CmpErr(t, errors.New(tc.expOpErr), errors.New(op.Error))
+		})
+	}
+}
+
+func TestServer_MgmtSvc_runOperation(t *testing.T) {
+	for name, tc := range map[string]struct {
+		handler  func(context.Context) (interface{}, error)
+		cancel   bool
+		expState system.OperationState
+		expErr   string
+		expResp  proto.Message
+	}{
+		"success": {
+			handler: func(context.Context) (interface{}, error) {
+				return &mgmtpb.PoolDestroyResp{}, nil
+			},
+			expState: system.OperationStateSucceeded,
+			expResp:  &mgmtpb.PoolDestroyResp{},
+		},
+		"error": {
+			handler: func(context.Context) (interface{}, error) {
+				return nil, errors.New("whoops")
+			},
+			expState: system.OperationStateFailed,
+			expErr:   "whoops",
+		},
+		"status error": {
+			handler: func(context.Context) (interface{}, error) {
+				return &mgmtpb.PoolDestroyResp{Status: int32(daos.Busy)}, nil
+			},
+			expState: system.OperationStateFailed,
+			expErr:   daos.Busy.Error(),
+		},
+		"canceled": {
+			handler: func(ctx context.Context) (interface{}, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+			cancel:   true,
+			expState: system.OperationStateCanceled,
+			expErr:   context.Canceled.Error(),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestOperationMgmtSvc(t, log)
+
+			op := system.NewOperation(mgmtpb.MgmtSvc_PoolDestroy_FullMethodName, nil, "test")
+			opCtx, err := svc.operations.start(op.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := svc.sysdb.AddOperation(op); err != nil {
+				t.Fatal(err)
+			}
+			if tc.cancel {
+				if _, err := svc.OperationCancel(test.Context(t), &mgmtpb.OperationCancelReq{
+					Sys: build.DefaultSystemName,
+					Id:  op.ID,
+				}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			handler := func(_ interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := new(mgmtpb.PoolDestroyReq)
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				test.AssertEqual(t, "pool1", req.Id, "unexpected request")
+				return tc.handler(ctx)
+			}
+			svc.runOperation(opCtx, op, handler, &mgmtpb.PoolDestroyReq{Id: "pool1"})
+
+			got, err := svc.sysdb.GetOperation(op.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expState, got.State, "unexpected state")
+			test.AssertEqual(t, tc.expErr, got.Error, "unexpected error")
+			if tc.expResp != nil {
+				test.AssertEqual(t, string(mustMarshal(t, tc.expResp)), string(got.Response),
+					"unexpected response")
+			}
+			test.AssertFalse(t, svc.operations.isRunning(op.ID), "operation still tracked")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_OperationCancel(t *testing.T) {
+	for name, tc := range map[string]struct {
+		op       *system.Operation
+		id       string
+		expErr   error
+		expState system.OperationState
+	}{
+		"not found": {
+			id:     "op1",
+			expErr: system.FaultOperationNotFound("op1"),
+		},
+		"finished": {
+			op: &system.Operation{
+				ID:    "op1",
+				State: system.OperationStateSucceeded,
+			},
+			id:     "op1",
+			expErr: system.FaultOperationNotRunning("op1", system.OperationStateSucceeded),
+		},
+		"started by previous leader": {
+			op: &system.Operation{
+				ID:    "op1",
+				State: system.OperationStateRunning,
+			},
+			id:       "op1",
+			expState: system.OperationStateCanceled,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestOperationMgmtSvc(t, log)
+			if tc.op != nil {
+				if err := svc.sysdb.AddOperation(tc.op); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err := svc.OperationCancel(test.Context(t), &mgmtpb.OperationCancelReq{
+				Sys: build.DefaultSystemName,
+				Id:  tc.id,
+			})
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			op, err := svc.sysdb.GetOperation(tc.id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expState, op.State, "unexpected state")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_OperationList(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestOperationMgmtSvc(t, log)
+	for _, op := range []*system.Operation{
+		{ID: "op1", State: system.OperationStateSucceeded, Response: []byte{1}},
+		{ID: "op2", State: system.OperationStateRunning},
+	} {
+		if err := svc.sysdb.AddOperation(op); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resp, err := svc.OperationList(test.Context(t), &mgmtpb.OperationListReq{
+		Sys: build.DefaultSystemName,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 2, len(resp.Operations), "unexpected number of operations")
+	for _, op := range resp.Operations {
+		test.AssertTrue(t, op.Response == nil, "response should not be listed")
+	}
+
+	resp, err = svc.OperationList(test.Context(t), &mgmtpb.OperationListReq{
+		Sys:   build.DefaultSystemName,
+		State: string(system.OperationStateRunning),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, len(resp.Operations), "unexpected number of operations")
+	test.AssertEqual(t, "op2", resp.Operations[0].Id, "unexpected operation")
+}
+
+func TestServer_MgmtSvc_failInterruptedOperations(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestOperationMgmtSvc(t, log)
+	for _, op := range []*system.Operation{
+		{ID: "op1", State: system.OperationStateRunning},
+		{ID: "op2", State: system.OperationStateRunning},
+		{ID: "op3", State: system.OperationStateSucceeded},
+	} {
+		if err := svc.sysdb.AddOperation(op); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// op2 was started on this leader.
+	if _, err := svc.operations.start("op2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.failInterruptedOperations(); err != nil {
+		t.Fatal(err)
+	}
+
+	for id, exp := range map[string]system.OperationState{
+		"op1": system.OperationStateFailed,
+		"op2": system.OperationStateRunning,
+		"op3": system.OperationStateSucceeded,
+	} {
+		op, err := svc.sysdb.GetOperation(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.AssertEqual(t, exp, op.State, id+": unexpected state")
+	}
+}
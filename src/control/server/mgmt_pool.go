@@ -8,6 +8,7 @@
 package server
 
 import (
+	"fmt"
 	"math/rand"
 	"sort"
 	"time"
@@ -64,7 +65,8 @@ func (svc *mgmtSvc) makeLockedPoolServiceCall(ctx context.Context, method drpc.M
 }
 
 func (svc *mgmtSvc) makePoolServiceCall(ctx context.Context, method drpc.Method, req poolServiceReq) (*drpc.Response, error) {
-	ps, err := svc.getPoolService(req.GetId())
+	_, allowAlias := poolLabelAliasMethods[method]
+	ps, err := svc.findPoolService(req.GetId(), allowAlias)
 	if err != nil {
 		return nil, err
 	}
@@ -81,9 +83,28 @@ func (svc *mgmtSvc) makePoolServiceCall(ctx context.Context, method drpc.Method,
 	return svc.harness.CallDrpc(ctx, method, req)
 }
 
+// poolLabelAliasMethods are the pool service methods that do not modify the
+// pool, for which the deprecated label of a renamed pool may be used in place
+// of its current label.
+var poolLabelAliasMethods = map[drpc.Method]struct{}{
+	drpc.MethodPoolQuery:       {},
+	drpc.MethodPoolQueryTarget: {},
+	drpc.MethodPoolGetProp:     {},
+	drpc.MethodPoolGetACL:      {},
+	drpc.MethodListContainers:  {},
+}
+
 // resolvePoolID implements a handler for resolving a user-friendly Pool ID into
-// a UUID.
+// a UUID. The deprecated label of a renamed pool is rejected with a fault that
+// names the pool's current label.
 func (svc *mgmtSvc) resolvePoolID(id string) (uuid.UUID, error) {
+	return svc.resolvePoolIDAlias(id, false)
+}
+
+// resolvePoolIDAlias resolves a Pool ID into a UUID as resolvePoolID does, but
+// if allowAlias is set, the deprecated label of a renamed pool is resolved to
+// the pool's UUID.
+func (svc *mgmtSvc) resolvePoolIDAlias(id string, allowAlias bool) (uuid.UUID, error) {
 	if id == "" {
 		return uuid.Nil, errors.New("empty pool id")
 	}
@@ -102,12 +123,41 @@ func (svc *mgmtSvc) resolvePoolID(id string) (uuid.UUID, error) {
 		}
 	}
 
-	return uuid.Nil, system.ErrPoolLabelNotFound(id)
+	ps, err := svc.sysdb.FindPoolServiceByLabelAlias(id)
+	if err != nil {
+		return uuid.Nil, system.ErrPoolLabelNotFound(id)
+	}
+	if !allowAlias {
+		return uuid.Nil, FaultPoolLabelDeprecated(id, ps.PoolUUID, ps.PoolLabel)
+	}
+
+	return ps.PoolUUID, nil
+}
+
+// poolLabelWarning returns a warning for the caller if the pool ID that it
+// supplied is neither the UUID nor the current label of the pool, meaning
+// that it was resolved through a deprecated label alias.
+func poolLabelWarning(id, poolUUID, label string) string {
+	if id == "" || id == label {
+		return ""
+	}
+	if _, err := uuid.Parse(id); err == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("pool label %q is deprecated; pool %s is now labeled %q",
+		id, poolUUID, label)
 }
 
 // getPoolService returns the pool service entry for the given UUID.
 func (svc *mgmtSvc) getPoolService(id string) (*system.PoolService, error) {
-	poolUUID, err := svc.resolvePoolID(id)
+	return svc.findPoolService(id, false)
+}
+
+// findPoolService returns the pool service entry for the given ID, which may
+// be the deprecated label of a renamed pool if allowAlias is set.
+func (svc *mgmtSvc) findPoolService(id string, allowAlias bool) (*system.PoolService, error) {
+	poolUUID, err := svc.resolvePoolIDAlias(id, allowAlias)
 	if err != nil {
 		return nil, err
 	}
@@ -282,14 +332,32 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		return nil, errors.Wrapf(err, "failed to parse pool UUID %q", req.GetUuid())
 	}
 
-	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID)
+	// Pool creates are processed one at a time, so no other create can
+	// reserve the idempotency key between the lookup and the pool service
+	// being added.
+	resp = new(mgmtpb.PoolCreateResp)
+	key := req.GetIdempotencyKey()
+	method := mgmtpb.MgmtSvc_PoolCreate_FullMethodName
+	lock, keyPS, err := svc.lockIdempotentPool(parent, key, poolUUID)
 	if err != nil {
 		return nil, err
 	}
 	defer lock.Release()
 	ctx := lock.InContext(parent)
 
-	resp = new(mgmtpb.PoolCreateResp)
+	if keyPS != nil {
+		if found, err := svc.decodeIdempotentResponse(keyPS, key, method, resp); err != nil {
+			return nil, err
+		} else if found {
+			return resp, nil
+		}
+
+		// The request that reserved the key did not complete, so carry
+		// on with the pool that it added.
+		poolUUID = keyPS.PoolUUID
+		req.Uuid = poolUUID.String()
+	}
+
 	ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
 	if ps != nil {
 		svc.log.Debugf("found pool %s state=%s", ps.PoolUUID, ps.State)
@@ -308,6 +376,7 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 			return nil, errors.Wrap(err, "query on already-created pool failed")
 		}
 
+		resp.Uuid = req.Uuid
 		resp.SvcLdr = qr.SvcLdr
 		resp.SvcReps = ranklist.RanksToUint32(ps.Replicas)
 		resp.TgtRanks = ranklist.RanksToUint32(ps.Storage.CreationRanks())
@@ -335,6 +404,11 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		if _, err := svc.sysdb.FindPoolServiceByLabel(poolLabel); err == nil {
 			return nil, FaultPoolDuplicateLabel(poolLabel)
 		}
+		// The deprecated label of a renamed pool is reserved until it
+		// expires.
+		if _, err := svc.sysdb.FindPoolServiceByLabelAlias(poolLabel); err == nil {
+			return nil, FaultPoolDuplicateLabel(poolLabel)
+		}
 	}
 
 	if !labelExists {
@@ -420,6 +494,7 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 	ps = system.NewPoolService(poolUUID, req.TierBytes, req.MemRatio,
 		ranklist.RanksFromUint32(req.GetRanks()))
 	ps.PoolLabel = poolLabel
+	svc.reserveIdempotencyKey(ps, key, method)
 	if err := svc.sysdb.AddPoolService(ctx, ps); err != nil {
 		return nil, err
 	}
@@ -493,6 +568,8 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 
 	ps.Replicas = ranklist.RanksFromUint32(resp.GetSvcReps())
 	ps.State = system.PoolServiceStateReady
+	resp.Uuid = req.Uuid
+	svc.recordIdempotentResponse(ps, key, method, resp)
 	if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
 		return nil, err
 	}
@@ -661,6 +738,10 @@ func (svc *mgmtSvc) poolEvictConnections(ctx context.Context, req *mgmtpb.PoolDe
 }
 
 // PoolDestroy implements the method defined for the Management Service.
+//
+// The result of a successful destroy with an idempotency key is kept after the
+// pool is removed, so that retries of the request get the same result until
+// the key expires.
 func (svc *mgmtSvc) PoolDestroy(parent context.Context, req *mgmtpb.PoolDestroyReq) (*mgmtpb.PoolDestroyResp, error) {
 	if err := svc.checkLeaderRequest(req); err != nil {
 		return nil, err
@@ -670,6 +751,15 @@ func (svc *mgmtSvc) PoolDestroy(parent context.Context, req *mgmtpb.PoolDestroyR
 }
 
 func (svc *mgmtSvc) poolDestroyNoLeaderCheck(parent context.Context, req *mgmtpb.PoolDestroyReq) (*mgmtpb.PoolDestroyResp, error) {
+	resp := &mgmtpb.PoolDestroyResp{}
+	key := req.GetIdempotencyKey()
+	method := mgmtpb.MgmtSvc_PoolDestroy_FullMethodName
+	if found, err := svc.destroyedPoolResponse(key, method, req.GetId(), resp); err != nil {
+		return nil, err
+	} else if found {
+		return resp, nil
+	}
+
 	poolUUID, err := svc.resolvePoolID(req.Id)
 	if err != nil {
 		return nil, err
@@ -682,6 +772,12 @@ func (svc *mgmtSvc) poolDestroyNoLeaderCheck(parent context.Context, req *mgmtpb
 	defer lock.Release()
 	ctx := lock.InContext(parent)
 
+	if found, err := svc.idempotentResponse(key, method, poolUUID, resp); err != nil {
+		return nil, err
+	} else if found {
+		return resp, nil
+	}
+
 	ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
 	if err != nil {
 		return nil, err
@@ -689,8 +785,6 @@ func (svc *mgmtSvc) poolDestroyNoLeaderCheck(parent context.Context, req *mgmtpb
 	req.SetUUID(poolUUID)
 	req.SvcRanks = ranklist.RanksToUint32(ps.Replicas)
 
-	resp := &mgmtpb.PoolDestroyResp{}
-
 	if ps.State != system.PoolServiceStateDestroying {
 		// If recursive flag is unset, refuse to destroy pool if resident containers exist.
 		if !req.Recursive {
@@ -765,6 +859,14 @@ func (svc *mgmtSvc) poolDestroyNoLeaderCheck(parent context.Context, req *mgmtpb
 
 	ds := daos.Status(resp.Status)
 	if ds == daos.Success {
+		svc.rebuildTracker.remove(poolUUID.String())
+		if key != "" {
+			// Keep the result for retries in the tombstone of the pool.
+			svc.recordIdempotentResponse(ps, key, method, resp)
+			if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+				svc.log.Errorf("failed to record idempotency key %q: %s", key, err)
+			}
+		}
 		if err := svc.sysdb.RemovePoolService(ctx, poolUUID); err != nil {
 			// In rare cases, there may be a race between pool cleanup handlers.
 			// As we know the service entry existed when we started this handler,
@@ -949,6 +1051,10 @@ func (svc *mgmtSvc) PoolQuery(ctx context.Context, req *mgmtpb.PoolQueryReq) (*m
 		req.QueryMask = uint64(daos.DefaultPoolQueryMask)
 	}
 
+	// The pool ID in the request is replaced with the UUID when the
+	// call is made, so keep the caller's version of it.
+	poolID := req.GetId()
+
 	dResp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolQuery, req)
 	if err != nil {
 		return nil, err
@@ -962,6 +1068,10 @@ func (svc *mgmtSvc) PoolQuery(ctx context.Context, req *mgmtpb.PoolQueryReq) (*m
 	// Preserve compatibility with pre-2.6 callers.
 	resp.Leader = resp.SvcLdr
 
+	resp.LabelWarning = poolLabelWarning(poolID, resp.Uuid, resp.Label)
+
+	svc.rebuildTracker.update(resp.Uuid, resp.Rebuild, time.Now())
+
 	return resp, nil
 }
 
@@ -1018,6 +1128,11 @@ func (svc *mgmtSvc) updatePoolLabel(ctx context.Context, sys string, uuid uuid.U
 		// If we're setting a label, first check to see
 		// if a pool has already had the label applied.
 		found, err := svc.sysdb.FindPoolServiceByLabel(label)
+		if system.IsPoolNotFound(err) {
+			// The deprecated label of a renamed pool is reserved
+			// until it expires.
+			found, err = svc.sysdb.FindPoolServiceByLabelAlias(label)
+		}
 		if found != nil && found.PoolUUID != ps.PoolUUID {
 			// If we find a pool with this label but the
 			// UUID differs, then we should fail the request.
@@ -1054,7 +1169,10 @@ func (svc *mgmtSvc) updatePoolLabel(ctx context.Context, sys string, uuid uuid.U
 	}
 
 	// Persist the label update in the MS DB if the
-	// dRPC call succeeded.
+	// dRPC call succeeded. The old label is kept as an
+	// alias so that it continues to resolve for a grace
+	// period after the rename.
+	ps.LabelAliases = ps.RelabelAliases(label, time.Now(), system.DefaultPoolLabelAliasGracePeriod)
 	ps.PoolLabel = label
 	return svc.sysdb.UpdatePoolService(ctx, ps)
 }
@@ -1235,13 +1353,23 @@ func (svc *mgmtSvc) ListPools(ctx context.Context, req *mgmtpb.ListPoolsReq) (*m
 		return nil, err
 	}
 
+	now := time.Now()
 	resp := new(mgmtpb.ListPoolsResp)
 	for _, ps := range psList {
+		var aliases []*mgmtpb.PoolLabelAlias
+		for _, alias := range ps.ActiveLabelAliases(now) {
+			aliases = append(aliases, &mgmtpb.PoolLabelAlias{
+				Label:   alias.Label,
+				Expires: alias.Expires.Format(time.RFC3339),
+			})
+		}
+
 		resp.Pools = append(resp.Pools, &mgmtpb.ListPoolsResp_Pool{
-			Uuid:    ps.PoolUUID.String(),
-			Label:   ps.PoolLabel,
-			SvcReps: ranklist.RanksToUint32(ps.Replicas),
-			State:   ps.State.String(),
+			Uuid:         ps.PoolUUID.String(),
+			Label:        ps.PoolLabel,
+			SvcReps:      ranklist.RanksToUint32(ps.Replicas),
+			State:        ps.State.String(),
+			LabelAliases: aliases,
 		})
 	}
 
@@ -0,0 +1,178 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+// findIdempotentPool returns the pool service, or the tombstone of a destroyed
+// pool, that holds an unexpired idempotency key matching key. If the key is
+// empty or unused, nil is returned.
+func (svc *mgmtSvc) findIdempotentPool(key string) (*system.PoolService, error) {
+	if key == "" {
+		return nil, nil
+	}
+
+	ps, err := svc.sysdb.FindPoolServiceByIdempotencyKey(key)
+	if err == nil {
+		return ps, nil
+	}
+	if !system.IsErrPoolIdempotencyKeyNotFound(err) {
+		return nil, err
+	}
+
+	ps, err = svc.sysdb.FindDestroyedPoolServiceByIdempotencyKey(key)
+	if err == nil {
+		return ps, nil
+	}
+	if !system.IsErrPoolIdempotencyKeyNotFound(err) {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// lockIdempotentPool takes the lock of the pool holding the idempotency key,
+// or of the pool with the supplied UUID if the key is unused. The pool service
+// holding the key, looked up under the lock, is returned with the lock.
+func (svc *mgmtSvc) lockIdempotentPool(ctx context.Context, key string, poolUUID uuid.UUID) (*raft.PoolLock, *system.PoolService, error) {
+	for {
+		prev, err := svc.findIdempotentPool(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		lockUUID := poolUUID
+		if prev != nil {
+			lockUUID = prev.PoolUUID
+		}
+
+		lock, err := svc.sysdb.TakePoolLock(ctx, lockUUID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		cur, err := svc.findIdempotentPool(key)
+		if err != nil {
+			lock.Release()
+			return nil, nil, err
+		}
+		if (cur == nil && prev == nil) || (cur != nil && cur.PoolUUID == lockUUID) {
+			return lock, cur, nil
+		}
+
+		// The key moved while the lock was being taken, so try again.
+		lock.Release()
+	}
+}
+
+// decodeIdempotentResponse decodes the response recorded with the idempotency
+// key of the pool service into resp. If the key has no recorded response,
+// false is returned. If the key was used for a different method, an error is
+// returned.
+func (svc *mgmtSvc) decodeIdempotentResponse(ps *system.PoolService, key, method string, resp proto.Message) (bool, error) {
+	pik, found := ps.FindIdempotencyKey(key, time.Now())
+	if !found {
+		// The key expired after the pool service was found.
+		return false, nil
+	}
+	if pik.Method != method {
+		return false, FaultPoolIdempotencyKeyReused(key)
+	}
+	if pik.IsPending() {
+		return false, nil
+	}
+	if err := proto.Unmarshal(pik.Response, resp); err != nil {
+		return false, errors.Wrapf(err, "failed to decode response for idempotency key %q", key)
+	}
+	svc.log.Debugf("returning result of previous %s request with idempotency key %q", method, key)
+
+	return true, nil
+}
+
+// idempotentResponse looks up the result of a previous request against the
+// pool that was made with the same idempotency key. If one is found, its
+// response is decoded into resp and true is returned. If the key was used for
+// a different method or a different pool, an error is returned.
+func (svc *mgmtSvc) idempotentResponse(key, method string, poolUUID uuid.UUID, resp proto.Message) (bool, error) {
+	ps, err := svc.findIdempotentPool(key)
+	if err != nil || ps == nil {
+		return false, err
+	}
+	if ps.PoolUUID != poolUUID {
+		return false, FaultPoolIdempotencyKeyReused(key)
+	}
+
+	return svc.decodeIdempotentResponse(ps, key, method, resp)
+}
+
+// destroyedPoolResponse looks up the result of a previous request against the
+// destroyed pool with the given ID that was made with the same idempotency
+// key. If the key was used for a different method or a different pool, an
+// error is returned.
+func (svc *mgmtSvc) destroyedPoolResponse(key, method, id string, resp proto.Message) (bool, error) {
+	if key == "" {
+		return false, nil
+	}
+
+	ps, err := svc.sysdb.FindDestroyedPoolServiceByIdempotencyKey(key)
+	if err != nil {
+		if system.IsErrPoolIdempotencyKeyNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if poolUUID, err := uuid.Parse(id); (err != nil || poolUUID != ps.PoolUUID) && id != ps.PoolLabel {
+		return false, FaultPoolIdempotencyKeyReused(key)
+	}
+
+	return svc.decodeIdempotentResponse(ps, key, method, resp)
+}
+
+// reserveIdempotencyKey adds the idempotency key of a request that has not
+// completed to the keys of the pool service, so that once the pool service is
+// stored, the key cannot be used by another request.
+func (svc *mgmtSvc) reserveIdempotencyKey(ps *system.PoolService, key, method string) {
+	if key == "" {
+		return
+	}
+
+	pik := system.NewPoolIdempotencyKey(key, method, nil, system.DefaultPoolIdempotencyKeyTTL)
+	ps.IdempotencyKeys = ps.RecordIdempotencyKey(pik, time.Now())
+}
+
+// recordIdempotentResponse adds the response of a successful request that was
+// made with an idempotency key to the keys of the pool service, so that once
+// the pool service is updated, retries of the request get the same result.
+// Failures are logged rather than returned, as the request itself has already
+// succeeded.
+func (svc *mgmtSvc) recordIdempotentResponse(ps *system.PoolService, key, method string, resp proto.Message) {
+	if key == "" {
+		return
+	}
+
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		svc.log.Errorf("failed to encode response for idempotency key %q: %s", key, err)
+		return
+	}
+	if data == nil {
+		// An empty response is recorded, rather than left pending.
+		data = []byte{}
+	}
+
+	pik := system.NewPoolIdempotencyKey(key, method, data, system.DefaultPoolIdempotencyKeyTTL)
+	ps.IdempotencyKeys = ps.RecordIdempotencyKey(pik, time.Now())
+}
@@ -0,0 +1,315 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// testIdempotencyKey returns an idempotency key for the method that records
+// resp and expires after the ttl.
+func testIdempotencyKey(t *testing.T, key, method string, resp proto.Message, ttl time.Duration) system.PoolIdempotencyKey {
+	t.Helper()
+
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return system.NewPoolIdempotencyKey(key, method, data, ttl)
+}
+
+// otherTestPoolService returns a pool service that is distinct from the one
+// returned by testPoolService.
+func otherTestPoolService() *system.PoolService {
+	return &system.PoolService{
+		PoolLabel: "other-pool",
+		PoolUUID:  uuid.MustParse(badMockUUID),
+		Replicas:  []ranklist.Rank{0, 1, 2},
+		State:     system.PoolServiceStateReady,
+		Storage: &system.PoolServiceStorage{
+			CreationRankStr: ranklist.MustCreateRankSet("0-2").String(),
+		},
+	}
+}
+
+func TestServer_MgmtSvc_idempotentResponse(t *testing.T) {
+	ownerMethod := mgmtpb.MgmtSvc_ContSetOwner_FullMethodName
+	poolUUID := uuid.MustParse(mockUUID)
+	storedResp := &mgmtpb.DaosResp{Status: 0}
+
+	for name, tc := range map[string]struct {
+		storedMethod string
+		storedTTL    time.Duration
+		key          string
+		method       string
+		poolUUID     uuid.UUID
+		expFound     bool
+		expResp      *mgmtpb.DaosResp
+		expErr       error
+	}{
+		"no key": {
+			method:   ownerMethod,
+			poolUUID: poolUUID,
+			expResp:  &mgmtpb.DaosResp{},
+		},
+		"key not found": {
+			key:      "key1",
+			method:   ownerMethod,
+			poolUUID: poolUUID,
+			expResp:  &mgmtpb.DaosResp{},
+		},
+		"key found": {
+			storedMethod: ownerMethod,
+			key:          "key1",
+			method:       ownerMethod,
+			poolUUID:     poolUUID,
+			expFound:     true,
+			expResp:      storedResp,
+		},
+		"key expired": {
+			storedMethod: ownerMethod,
+			storedTTL:    -time.Hour,
+			key:          "key1",
+			method:       ownerMethod,
+			poolUUID:     poolUUID,
+			expResp:      &mgmtpb.DaosResp{},
+		},
+		"key used for different method": {
+			storedMethod: ownerMethod,
+			key:          "key1",
+			method:       mgmtpb.MgmtSvc_PoolDestroy_FullMethodName,
+			poolUUID:     poolUUID,
+			expResp:      &mgmtpb.DaosResp{},
+			expErr:       FaultPoolIdempotencyKeyReused("key1"),
+		},
+		"key used for different pool": {
+			storedMethod: ownerMethod,
+			key:          "key1",
+			method:       ownerMethod,
+			poolUUID:     uuid.MustParse(badMockUUID),
+			expResp:      &mgmtpb.DaosResp{},
+			expErr:       FaultPoolIdempotencyKeyReused("key1"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			ps := testPoolService()
+			if tc.storedMethod != "" {
+				ttl := tc.storedTTL
+				if ttl == 0 {
+					ttl = time.Hour
+				}
+				ps.IdempotencyKeys = []system.PoolIdempotencyKey{
+					testIdempotencyKey(t, "key1", tc.storedMethod, storedResp, ttl),
+				}
+			}
+			addTestPoolService(t, svc.sysdb, ps)
+			addTestPoolService(t, svc.sysdb, otherTestPoolService())
+
+			resp := new(mgmtpb.DaosResp)
+			found, err := svc.idempotentResponse(tc.key, tc.method, tc.poolUUID, resp)
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expFound, found, "unexpected found result")
+			if diff := cmp.Diff(tc.expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("(-want, +got): \n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolDestroy_IdempotencyKey(t *testing.T) {
+	createMethod := mgmtpb.MgmtSvc_PoolCreate_FullMethodName
+	ownerMethod := mgmtpb.MgmtSvc_ContSetOwner_FullMethodName
+
+	for name, tc := range map[string]struct {
+		poolKeys  []system.PoolIdempotencyKey
+		otherKeys []system.PoolIdempotencyKey
+		id        string
+		expErr    error
+	}{
+		"unknown pool": {
+			id:     "missing",
+			expErr: system.ErrPoolLabelNotFound("missing"),
+		},
+		"key used to create pool": {
+			poolKeys: []system.PoolIdempotencyKey{
+				{Key: "key1", Method: createMethod, Expires: time.Now().Add(time.Hour)},
+			},
+			id:     "test-pool",
+			expErr: FaultPoolIdempotencyKeyReused("key1"),
+		},
+		"key used for different pool": {
+			otherKeys: []system.PoolIdempotencyKey{
+				{Key: "key1", Method: ownerMethod, Expires: time.Now().Add(time.Hour)},
+			},
+			id:     mockUUID,
+			expErr: FaultPoolIdempotencyKeyReused("key1"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			ps := testPoolService()
+			ps.IdempotencyKeys = tc.poolKeys
+			addTestPoolService(t, svc.sysdb, ps)
+			other := otherTestPoolService()
+			other.IdempotencyKeys = tc.otherKeys
+			addTestPoolService(t, svc.sysdb, other)
+
+			// The request must be rejected before the engine is called.
+			setupSvcDrpcClient(svc, 0, getMockDrpcClient(nil, errors.New("mock drpc")))
+
+			_, err := svc.PoolDestroy(test.Context(t), &mgmtpb.PoolDestroyReq{
+				Sys:            build.DefaultSystemName,
+				Id:             tc.id,
+				IdempotencyKey: "key1",
+			})
+			test.CmpErr(t, tc.expErr, err)
+
+			// The pool is left in place.
+			got, err := svc.sysdb.FindPoolServiceByUUID(ps.PoolUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, system.PoolServiceStateReady, got.State, "unexpected pool state")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolDestroy_IdempotencyKeyRetry(t *testing.T) {
+	newReq := func(poolID string) *mgmtpb.PoolDestroyReq {
+		return &mgmtpb.PoolDestroyReq{
+			Sys:            build.DefaultSystemName,
+			Id:             poolID,
+			Force:          true,
+			IdempotencyKey: "key1",
+		}
+	}
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	ps := testPoolService()
+	ps.State = system.PoolServiceStateDestroying
+	addTestPoolService(t, svc.sysdb, ps)
+	addTestPoolService(t, svc.sysdb, otherTestPoolService())
+
+	setupSvcDrpcClient(svc, 0, getMockDrpcClient(&mgmtpb.PoolDestroyResp{}, nil))
+	if _, err := svc.PoolDestroy(test.Context(t), newReq("test-pool")); err != nil {
+		t.Fatal(err)
+	}
+
+	// The result is kept in a tombstone after the pool is removed.
+	if _, err := svc.sysdb.FindPoolServiceByUUID(ps.PoolUUID); !system.IsPoolNotFound(err) {
+		t.Fatalf("expected pool to be destroyed, got %v", err)
+	}
+	tomb, err := svc.sysdb.FindDestroyedPoolServiceByIdempotencyKey("key1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, ps.PoolUUID, tomb.PoolUUID, "unexpected tombstone")
+
+	// A retry with the same key returns the original result without
+	// calling the engine again, whether the pool is identified by label
+	// or UUID.
+	setupSvcDrpcClient(svc, 0, getMockDrpcClient(nil, errors.New("mock drpc")))
+	for _, id := range []string{"test-pool", mockUUID} {
+		resp, err := svc.PoolDestroy(test.Context(t), newReq(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(&mgmtpb.PoolDestroyResp{}, resp, test.DefaultCmpOpts()...); diff != "" {
+			t.Fatalf("(-want, +got): \n%s\n", diff)
+		}
+	}
+
+	// Reusing the key for a different pool is rejected.
+	_, err = svc.PoolDestroy(test.Context(t), newReq("other-pool"))
+	test.CmpErr(t, FaultPoolIdempotencyKeyReused("key1"), err)
+
+	// Without the key the destroyed pool is not found.
+	req := newReq("test-pool")
+	req.IdempotencyKey = ""
+	_, err = svc.PoolDestroy(test.Context(t), req)
+	test.CmpErr(t, system.ErrPoolLabelNotFound("test-pool"), err)
+}
+
+func TestServer_MgmtSvc_ContSetOwner_IdempotencyKey(t *testing.T) {
+	newReq := func(poolID string) *mgmtpb.ContSetOwnerReq {
+		return &mgmtpb.ContSetOwnerReq{
+			Sys:            build.DefaultSystemName,
+			ContId:         "contUUID",
+			PoolId:         poolID,
+			OwnerUser:      "user@",
+			IdempotencyKey: "key1",
+		}
+	}
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	addTestPoolService(t, svc.sysdb, testPoolService())
+	addTestPoolService(t, svc.sysdb, otherTestPoolService())
+
+	setupSvcDrpcClient(svc, 0, getMockDrpcClient(&mgmtpb.DaosResp{}, nil))
+	if _, err := svc.ContSetOwner(test.Context(t), newReq("test-pool")); err != nil {
+		t.Fatal(err)
+	}
+
+	// The result is recorded with the pool that the label resolved to.
+	ps, err := svc.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, found := ps.FindIdempotencyKey("key1", time.Now()); !found {
+		t.Fatal("idempotency key not recorded with pool")
+	}
+
+	// A retry with the same key returns the original result without
+	// calling the engine again, whether the pool is identified by label
+	// or UUID.
+	setupSvcDrpcClient(svc, 0, getMockDrpcClient(nil, errors.New("mock drpc")))
+	for _, id := range []string{"test-pool", mockUUID} {
+		resp, err := svc.ContSetOwner(test.Context(t), newReq(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(&mgmtpb.DaosResp{}, resp, test.DefaultCmpOpts()...); diff != "" {
+			t.Fatalf("(-want, +got): \n%s\n", diff)
+		}
+	}
+
+	// Reusing the key for a different pool is rejected.
+	_, err = svc.ContSetOwner(test.Context(t), newReq("other-pool"))
+	test.CmpErr(t, FaultPoolIdempotencyKeyReused("key1"), err)
+
+	// Without the key the request is sent to the engine.
+	req := newReq("test-pool")
+	req.IdempotencyKey = ""
+	_, err = svc.ContSetOwner(test.Context(t), req)
+	test.CmpErr(t, errors.New("mock drpc"), err)
+}
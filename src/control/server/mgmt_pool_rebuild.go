@@ -0,0 +1,104 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+const (
+	// rebuildHistoryWindow is the period of rebuild progress samples used to
+	// calculate the rebuild rate.
+	rebuildHistoryWindow = 10 * time.Minute
+	// rebuildHistoryMaxSamples limits the number of samples held per pool.
+	rebuildHistoryMaxSamples = 64
+)
+
+type rebuildSample struct {
+	time    time.Time
+	objects uint64
+	records uint64
+}
+
+// poolRebuildTracker records the rebuild progress reported in pool query
+// responses so that a rebuild rate and ETA can be derived from the history.
+type poolRebuildTracker struct {
+	sync.Mutex
+	pools map[string][]rebuildSample
+}
+
+func newPoolRebuildTracker() *poolRebuildTracker {
+	return &poolRebuildTracker{
+		pools: make(map[string][]rebuildSample),
+	}
+}
+
+// update adds the progress in the supplied rebuild status to the history of the
+// pool and sets the rate and ETA fields of the status from that history.
+func (prt *poolRebuildTracker) update(poolUUID string, rs *mgmtpb.PoolRebuildStatus, now time.Time) {
+	if prt == nil || rs == nil {
+		return
+	}
+
+	prt.Lock()
+	defer prt.Unlock()
+
+	if rs.Status != 0 || rs.State != mgmtpb.PoolRebuildStatus_BUSY {
+		delete(prt.pools, poolUUID)
+		return
+	}
+
+	samples := prt.pools[poolUUID]
+	if len(samples) > 0 {
+		prev := samples[len(samples)-1]
+		if prev.objects > rs.Objects || prev.records > rs.Records {
+			// Counters went backwards, so a new rebuild has started.
+			// Restart the history so that the unsigned differences
+			// used to calculate the rates cannot wrap.
+			samples = nil
+		}
+	}
+	samples = append(samples, rebuildSample{
+		time:    now,
+		objects: rs.Objects,
+		records: rs.Records,
+	})
+
+	cutoff := now.Add(-rebuildHistoryWindow)
+	for len(samples) > 2 && (samples[0].time.Before(cutoff) || len(samples) > rebuildHistoryMaxSamples) {
+		samples = samples[1:]
+	}
+	prt.pools[poolUUID] = samples
+
+	first, last := samples[0], samples[len(samples)-1]
+	elapsed := last.time.Sub(first.time).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	rs.ObjectRate = float64(last.objects-first.objects) / elapsed
+	rs.RecordRate = float64(last.records-first.records) / elapsed
+	if rs.ObjectRate > 0 && rs.TotalObjects > rs.Objects {
+		rs.EtaSeconds = uint64(math.Ceil(float64(rs.TotalObjects-rs.Objects) / rs.ObjectRate))
+	}
+}
+
+// remove drops the rebuild history of the pool.
+func (prt *poolRebuildTracker) remove(poolUUID string) {
+	if prt == nil {
+		return
+	}
+
+	prt.Lock()
+	defer prt.Unlock()
+
+	delete(prt.pools, poolUUID)
+}
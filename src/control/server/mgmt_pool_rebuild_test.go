@@ -0,0 +1,147 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestServer_poolRebuildTracker_update(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	poolUUID := test.MockUUID(1)
+
+	busy := func(objects, records, total uint64) *mgmtpb.PoolRebuildStatus {
+		return &mgmtpb.PoolRebuildStatus{
+			State:        mgmtpb.PoolRebuildStatus_BUSY,
+			Objects:      objects,
+			Records:      records,
+			TotalObjects: total,
+		}
+	}
+
+	type sample struct {
+		offset time.Duration
+		status *mgmtpb.PoolRebuildStatus
+	}
+
+	for name, tc := range map[string]struct {
+		samples    []sample
+		expStatus  *mgmtpb.PoolRebuildStatus
+		expHistory int
+	}{
+		"single sample; no rate": {
+			samples: []sample{
+				{0, busy(10, 100, 1000)},
+			},
+			expStatus:  busy(10, 100, 1000),
+			expHistory: 1,
+		},
+		"rate and eta": {
+			samples: []sample{
+				{0, busy(100, 1000, 1000)},
+				{10 * time.Second, busy(200, 3000, 1000)},
+			},
+			expStatus: &mgmtpb.PoolRebuildStatus{
+				State:        mgmtpb.PoolRebuildStatus_BUSY,
+				Objects:      200,
+				Records:      3000,
+				TotalObjects: 1000,
+				ObjectRate:   10,
+				RecordRate:   200,
+				EtaSeconds:   80,
+			},
+			expHistory: 2,
+		},
+		"no progress; no eta": {
+			samples: []sample{
+				{0, busy(100, 1000, 1000)},
+				{10 * time.Second, busy(100, 1000, 1000)},
+			},
+			expStatus:  busy(100, 1000, 1000),
+			expHistory: 2,
+		},
+		"counters reset; history restarted": {
+			samples: []sample{
+				{0, busy(100, 1000, 1000)},
+				{10 * time.Second, busy(200, 2000, 1000)},
+				{20 * time.Second, busy(5, 50, 500)},
+			},
+			expStatus:  busy(5, 50, 500),
+			expHistory: 1,
+		},
+		"record count reset; history restarted": {
+			samples: []sample{
+				{0, busy(100, 1000, 1000)},
+				{10 * time.Second, busy(200, 2000, 1000)},
+				{20 * time.Second, busy(300, 50, 1000)},
+			},
+			expStatus:  busy(300, 50, 1000),
+			expHistory: 1,
+		},
+		"old samples dropped": {
+			samples: []sample{
+				{0, busy(0, 0, 1000)},
+				{rebuildHistoryWindow, busy(100, 100, 1000)},
+				{rebuildHistoryWindow + 10*time.Second, busy(200, 200, 1000)},
+				{rebuildHistoryWindow + 20*time.Second, busy(300, 300, 1000)},
+			},
+			expStatus: &mgmtpb.PoolRebuildStatus{
+				State:        mgmtpb.PoolRebuildStatus_BUSY,
+				Objects:      300,
+				Records:      300,
+				TotalObjects: 1000,
+				ObjectRate:   10,
+				RecordRate:   10,
+				EtaSeconds:   70,
+			},
+			expHistory: 3,
+		},
+		"rebuild done; history cleared": {
+			samples: []sample{
+				{0, busy(100, 1000, 1000)},
+				{10 * time.Second, &mgmtpb.PoolRebuildStatus{
+					State:   mgmtpb.PoolRebuildStatus_DONE,
+					Objects: 1000,
+				}},
+			},
+			expStatus: &mgmtpb.PoolRebuildStatus{
+				State:   mgmtpb.PoolRebuildStatus_DONE,
+				Objects: 1000,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			prt := newPoolRebuildTracker()
+
+			var status *mgmtpb.PoolRebuildStatus
+			for _, s := range tc.samples {
+				status = s.status
+				prt.update(poolUUID, status, start.Add(s.offset))
+			}
+
+			if diff := cmp.Diff(tc.expStatus, status, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected status (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expHistory, len(prt.pools[poolUUID]),
+				"unexpected history length")
+		})
+	}
+}
+
+func TestServer_poolRebuildTracker_nil(t *testing.T) {
+	var prt *poolRebuildTracker
+
+	prt.update(test.MockUUID(1), &mgmtpb.PoolRebuildStatus{}, time.Now())
+	prt.remove(test.MockUUID(1))
+}
@@ -119,8 +119,13 @@ func testPoolLabelProp() []*mgmtpb.PoolProperty {
 }
 
 func TestServer_MgmtSvc_PoolCreateAlreadyExists(t *testing.T) {
+	createMethod := mgmtpb.MgmtSvc_PoolCreate_FullMethodName
+	pendingKey := system.NewPoolIdempotencyKey("key1", createMethod, nil, time.Hour)
+
 	for name, tc := range map[string]struct {
 		state     system.PoolServiceState
+		keys      []system.PoolIdempotencyKey
+		reqKey    string
 		queryResp *mgmtpb.PoolQueryResp
 		queryErr  error
 		expResp   *mgmtpb.PoolCreateResp
@@ -138,6 +143,7 @@ func TestServer_MgmtSvc_PoolCreateAlreadyExists(t *testing.T) {
 				SvcLdr: 1,
 			},
 			expResp: &mgmtpb.PoolCreateResp{
+				Uuid:      test.MockUUID(1),
 				SvcLdr:    1,
 				SvcReps:   []uint32{1},
 				TgtRanks:  []uint32{1},
@@ -155,6 +161,53 @@ func TestServer_MgmtSvc_PoolCreateAlreadyExists(t *testing.T) {
 				Status: int32(daos.TryAgain),
 			},
 		},
+		"creating; retry with idempotency key": {
+			state:  system.PoolServiceStateCreating,
+			keys:   []system.PoolIdempotencyKey{pendingKey},
+			reqKey: "key1",
+			expResp: &mgmtpb.PoolCreateResp{
+				Status: int32(daos.TryAgain),
+			},
+		},
+		"ready; retry with idempotency key": {
+			state:  system.PoolServiceStateReady,
+			keys:   []system.PoolIdempotencyKey{pendingKey},
+			reqKey: "key1",
+			queryResp: &mgmtpb.PoolQueryResp{
+				SvcLdr: 1,
+			},
+			expResp: &mgmtpb.PoolCreateResp{
+				Uuid:      test.MockUUID(1),
+				SvcLdr:    1,
+				SvcReps:   []uint32{1},
+				TgtRanks:  []uint32{1},
+				TierBytes: []uint64{1, 2},
+			},
+		},
+		"ready; result recorded with idempotency key": {
+			state: system.PoolServiceStateReady,
+			keys: []system.PoolIdempotencyKey{
+				testIdempotencyKey(t, "key1", createMethod, &mgmtpb.PoolCreateResp{
+					Uuid:   test.MockUUID(1),
+					SvcLdr: 2,
+				}, time.Hour),
+			},
+			reqKey:   "key1",
+			queryErr: errors.New("query error"),
+			expResp: &mgmtpb.PoolCreateResp{
+				Uuid:   test.MockUUID(1),
+				SvcLdr: 2,
+			},
+		},
+		"idempotency key used for different method": {
+			state: system.PoolServiceStateReady,
+			keys: []system.PoolIdempotencyKey{
+				testIdempotencyKey(t, "key1", mgmtpb.MgmtSvc_ContSetOwner_FullMethodName,
+					&mgmtpb.DaosResp{}, time.Hour),
+			},
+			reqKey: "key1",
+			expErr: FaultPoolIdempotencyKeyReused("key1"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -177,7 +230,8 @@ func TestServer_MgmtSvc_PoolCreateAlreadyExists(t *testing.T) {
 					PerRankTierStorage: []uint64{1, 2},
 					MemRatio:           0.5,
 				},
-				Replicas: []ranklist.Rank{1},
+				Replicas:        []ranklist.Rank{1},
+				IdempotencyKeys: tc.keys,
 			}); err != nil {
 				t.Fatal(err)
 			}
@@ -189,6 +243,11 @@ func TestServer_MgmtSvc_PoolCreateAlreadyExists(t *testing.T) {
 				TotalBytes: engine.ScmMinBytesPerTarget,
 				Properties: testPoolLabelProp(),
 			}
+			if tc.reqKey != "" {
+				// A retry with an idempotency key has a new random UUID.
+				req.Uuid = test.MockUUID(2)
+				req.IdempotencyKey = tc.reqKey
+			}
 
 			gotResp, gotErr := svc.PoolCreate(ctx, req)
 			test.CmpErr(t, tc.expErr, gotErr)
@@ -501,6 +560,7 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 				TgtRanks:  []uint32{0, 1},
 			},
 			expResp: &mgmtpb.PoolCreateResp{
+				Uuid:      test.MockUUID(1),
 				TierBytes: []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
 				TgtRanks:  []uint32{0, 1},
 			},
@@ -530,6 +590,7 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 				TgtRanks:     []uint32{0, 1},
 			},
 			expResp: &mgmtpb.PoolCreateResp{
+				Uuid:         test.MockUUID(1),
 				TierBytes:    []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
 				MemFileBytes: 50 * humanize.GiByte,
 				TgtRanks:     []uint32{0, 1},
@@ -553,6 +614,7 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 				TgtRanks: []uint32{0, 1},
 			},
 			expResp: &mgmtpb.PoolCreateResp{
+				Uuid: test.MockUUID(1),
 				TierBytes: []uint64{
 					engine.ScmMinBytesPerTarget * 8,
 					engine.NvmeMinBytesPerTarget * 8,
@@ -576,6 +638,7 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 				TgtRanks: []uint32{0, 1},
 			},
 			expResp: &mgmtpb.PoolCreateResp{
+				Uuid: test.MockUUID(1),
 				TierBytes: []uint64{
 					(100 * humanize.GiByte * DefaultPoolScmRatio) / 2,
 					(100 * humanize.GiByte * DefaultPoolNvmeRatio) / 2,
@@ -2304,6 +2367,18 @@ func TestServer_MgmtSvc_PoolQuery(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	renamed := newTestMgmtSvc(t, log)
+	renamedPool := test.MockUUID(8)
+	addTestPoolService(t, renamed.sysdb, &system.PoolService{
+		PoolUUID:  uuid.MustParse(renamedPool),
+		PoolLabel: "new",
+		LabelAliases: []system.PoolLabelAlias{
+			{Label: "old", Expires: time.Now().Add(time.Hour)},
+		},
+		State:    system.PoolServiceStateReady,
+		Replicas: []ranklist.Rank{0},
+	})
+
 	for name, tc := range map[string]struct {
 		mgmtSvc       *mgmtSvc
 		setupMockDrpc func(_ *mgmtSvc, _ error)
@@ -2378,6 +2453,37 @@ func TestServer_MgmtSvc_PoolQuery(t *testing.T) {
 				Leader: 42,
 			},
 		},
+		"successful query; current label": {
+			req: &mgmtpb.PoolQueryReq{
+				Id: "0",
+			},
+			expResp: &mgmtpb.PoolQueryResp{
+				State: mgmtpb.PoolServiceState_Ready,
+				Uuid:  mockUUID,
+				Label: "0",
+			},
+		},
+		"successful query; deprecated label": {
+			mgmtSvc: renamed,
+			req: &mgmtpb.PoolQueryReq{
+				Id: "old",
+			},
+			setupMockDrpc: func(svc *mgmtSvc, err error) {
+				resp := &mgmtpb.PoolQueryResp{
+					State: mgmtpb.PoolServiceState_Ready,
+					Uuid:  renamedPool,
+					Label: "new",
+				}
+				setupMockDrpcClient(svc, resp, nil)
+			},
+			expResp: &mgmtpb.PoolQueryResp{
+				State: mgmtpb.PoolServiceState_Ready,
+				Uuid:  renamedPool,
+				Label: "new",
+				LabelWarning: fmt.Sprintf("pool label %q is deprecated; pool %s is now labeled %q",
+					"old", renamedPool, "new"),
+			},
+		},
 		"successful query; mdonssd enabled": {
 			req: &mgmtpb.PoolQueryReq{
 				Id: mockUUID,
@@ -2584,6 +2690,97 @@ func TestServer_MgmtSvc_PoolSetProp(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_PoolSetProp_LabelAlias(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ms := newTestMgmtSvc(t, log)
+	addTestPools(t, ms.sysdb, mockUUID)
+	addTestPoolService(t, ms.sysdb, &system.PoolService{
+		PoolUUID:  test.MockPoolUUID(2),
+		PoolLabel: "other",
+		State:     system.PoolServiceStateReady,
+		Replicas:  []ranklist.Rank{0},
+	})
+	setupSvcDrpcClient(ms, 0, getMockDrpcClient(&mgmtpb.PoolSetPropResp{}, nil))
+
+	labelReq := func(id, label string) *mgmtpb.PoolSetPropReq {
+		return &mgmtpb.PoolSetPropReq{
+			Sys: build.DefaultSystemName,
+			Id:  id,
+			Properties: []*mgmtpb.PoolProperty{
+				{
+					Number: daos.PoolPropertyLabel,
+					Value:  &mgmtpb.PoolProperty_Strval{Strval: label},
+				},
+			},
+		}
+	}
+
+	if _, err := ms.PoolSetProp(test.Context(t), labelReq(mockUUID, "renamed")); err != nil {
+		t.Fatal(err)
+	}
+
+	// The old label should continue to resolve to the renamed pool for
+	// requests that do not modify it.
+	gotUUID, err := ms.resolvePoolIDAlias("0", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, mockUUID, gotUUID.String(), "old label resolved to wrong pool")
+
+	// Requests that modify the pool must use its current label.
+	expErr := FaultPoolLabelDeprecated("0", uuid.MustParse(mockUUID), "renamed")
+	_, err = ms.resolvePoolID("0")
+	test.CmpErr(t, expErr, err)
+	_, err = ms.PoolSetProp(test.Context(t), labelReq("0", "again"))
+	test.CmpErr(t, expErr, err)
+	_, err = ms.PoolDestroy(test.Context(t), &mgmtpb.PoolDestroyReq{
+		Sys: build.DefaultSystemName,
+		Id:  "0",
+	})
+	test.CmpErr(t, expErr, err)
+
+	ps, err := ms.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "renamed", ps.PoolLabel, "unexpected pool label")
+	test.AssertEqual(t, 1, len(ps.LabelAliases), "unexpected number of aliases")
+	test.AssertEqual(t, "0", ps.LabelAliases[0].Label, "unexpected alias")
+	test.AssertTrue(t, ps.LabelAliases[0].Expires.After(time.Now()), "alias already expired")
+
+	// Another pool may not take the label while it is still an alias.
+	_, err = ms.PoolSetProp(test.Context(t), labelReq(test.MockPoolUUID(2).String(), "0"))
+	test.CmpErr(t, FaultPoolDuplicateLabel("0"), err)
+
+	// The alias should be reported when listing pools.
+	resp, err := ms.ListPools(test.Context(t), newTestListPoolsReq())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pool := range resp.Pools {
+		if pool.Uuid != mockUUID {
+			test.AssertEqual(t, 0, len(pool.LabelAliases), "unexpected aliases")
+			continue
+		}
+		test.AssertEqual(t, 1, len(pool.LabelAliases), "unexpected number of aliases")
+		test.AssertEqual(t, "0", pool.LabelAliases[0].Label, "unexpected alias")
+	}
+
+	// Renaming back to the old label removes it from the aliases.
+	if _, err := ms.PoolSetProp(test.Context(t), labelReq(mockUUID, "0")); err != nil {
+		t.Fatal(err)
+	}
+	ps, err = ms.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "0", ps.PoolLabel, "unexpected pool label")
+	test.AssertEqual(t, 1, len(ps.LabelAliases), "unexpected number of aliases")
+	test.AssertEqual(t, "renamed", ps.LabelAliases[0].Label, "unexpected alias")
+}
+
 func TestServer_MgmtSvc_PoolGetProp(t *testing.T) {
 	for name, tc := range map[string]struct {
 		setupMockDrpc func(_ *mgmtSvc, _ error)
@@ -83,6 +83,8 @@ type mgmtSvc struct {
 	serialReqs        batchReqChan
 	groupUpdateReqs   chan bool
 	lastMapVer        uint32
+	rebuildTracker    *poolRebuildTracker
+	operations        *operationTracker
 }
 
 func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c control.UnaryInvoker, p *events.PubSub) *mgmtSvc {
@@ -99,6 +101,8 @@ func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c cont
 		batchReqs:         make(batchReqChan),
 		serialReqs:        make(batchReqChan),
 		groupUpdateReqs:   make(chan bool),
+		rebuildTracker:    newPoolRebuildTracker(),
+		operations:        newOperationTracker(),
 	}
 }
 
@@ -163,7 +167,9 @@ func (svc *mgmtSvc) checkReplicaRequest(req proto.Message) error {
 // startLeaderLoops kicks off the leader-only processing loops
 // that will be canceled on leadership loss.
 func (svc *mgmtSvc) startLeaderLoops(ctx context.Context) {
+	svc.operations.reset(ctx)
 	go svc.leaderTaskLoop(ctx)
+	go svc.operationLoop(ctx)
 }
 
 // startAsyncLoops kicks off the asynchronous processing loops.
@@ -23,7 +23,9 @@ import (
 	uuid "github.com/google/uuid"
 	"github.com/pkg/errors"
 	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
@@ -39,6 +41,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/system"
 	"github.com/daos-stack/daos/src/control/system/checker"
@@ -212,6 +215,7 @@ func (svc *mgmtSvc) join(ctx context.Context, req *mgmtpb.JoinReq, peerAddr *net
 		FaultDomain:             fd,
 		Incarnation:             req.Incarnation,
 		CheckMode:               req.CheckMode,
+		DBSchemaVersion:         uint(req.DbSchemaVersion),
 	})
 	if err != nil {
 		if system.IsJoinFailure(err) {
@@ -1503,11 +1507,34 @@ func (svc *mgmtSvc) SystemSetAttr(ctx context.Context, req *mgmtpb.SystemSetAttr
 	return &mgmtpb.DaosResp{}, nil
 }
 
+// checkAgentAttrKeys verifies that a system attribute request made by an agent
+// only reads ACL templates, which agents look up on behalf of clients.
+func checkAgentAttrKeys(ctx context.Context, keys []string) error {
+	comp, err := componentFromContext(ctx)
+	if err != nil || *comp != security.ComponentAgent {
+		return nil
+	}
+
+	if len(keys) == 0 {
+		return status.Error(codes.PermissionDenied, "agent may not list all system attributes")
+	}
+	for _, key := range keys {
+		if !strings.HasPrefix(key, control.ACLTemplateAttrPrefix) {
+			return status.Errorf(codes.PermissionDenied, "agent may not read system attribute %q", key)
+		}
+	}
+
+	return nil
+}
+
 // SystemGetAttr gets system-level attributes.
 func (svc *mgmtSvc) SystemGetAttr(ctx context.Context, req *mgmtpb.SystemGetAttrReq) (resp *mgmtpb.SystemGetAttrResp, err error) {
 	if err := svc.checkReplicaRequest(req); err != nil {
 		return nil, err
 	}
+	if err := checkAgentAttrKeys(ctx, req.GetKeys()); err != nil {
+		return nil, err
+	}
 
 	props, err := system.GetAttributes(svc.sysdb, req.GetKeys())
 	if err != nil {
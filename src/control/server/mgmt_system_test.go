@@ -3309,3 +3309,66 @@ func TestMgmtSvc_resumeGroupUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_MgmtSvc_SystemGetAttr(t *testing.T) {
+	attrs := map[string]string{
+		"unrelated":                          "value",
+		control.ACLTemplateAttrPrefix + "ro": "A::OWNER@:r",
+	}
+
+	for name, tc := range map[string]struct {
+		ctx      context.Context
+		keys     []string
+		expAttrs map[string]string
+		expErr   error
+	}{
+		"admin reads all": {
+			ctx:      newTestAuthCtx(test.Context(t), "admin"),
+			expAttrs: attrs,
+		},
+		"admin reads key": {
+			ctx:      newTestAuthCtx(test.Context(t), "admin"),
+			keys:     []string{"unrelated"},
+			expAttrs: map[string]string{"unrelated": "value"},
+		},
+		"agent reads ACL template": {
+			ctx:  newTestAuthCtx(test.Context(t), "agent"),
+			keys: []string{control.ACLTemplateAttrPrefix + "ro"},
+			expAttrs: map[string]string{
+				control.ACLTemplateAttrPrefix + "ro": "A::OWNER@:r",
+			},
+		},
+		"agent reads all": {
+			ctx:    newTestAuthCtx(test.Context(t), "agent"),
+			expErr: errors.New("may not list all"),
+		},
+		"agent reads other key": {
+			ctx:    newTestAuthCtx(test.Context(t), "agent"),
+			keys:   []string{control.ACLTemplateAttrPrefix + "ro", "unrelated"},
+			expErr: errors.New("may not read system attribute \"unrelated\""),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if err := system.SetAttributes(svc.sysdb, attrs); err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := svc.SystemGetAttr(tc.ctx, &mgmtpb.SystemGetAttrReq{
+				Sys:  build.DefaultSystemName,
+				Keys: tc.keys,
+			})
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expAttrs, resp.Attributes); diff != "" {
+				t.Fatalf("unexpected attributes (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -25,6 +26,9 @@ import (
 type SecurityModule struct {
 	log    logging.Logger
 	config *security.TransportConfig
+	// tokenVerifier validates bearer tokens in AUTH_TOKEN credentials. If
+	// nil, token authentication is disabled.
+	tokenVerifier *auth.TokenVerifier
 }
 
 // NewSecurityModule creates a new security module with a transport config
@@ -69,7 +73,24 @@ func (m *SecurityModule) processValidateCredentials(body []byte) ([]byte, error)
 		return m.validateRespWithStatus(daos.NoPermission)
 	}
 
-	resp := &auth.ValidateCredResp{Token: cred.Token}
+	token := cred.Token
+	if token.GetFlavor() == auth.Flavor_AUTH_TOKEN {
+		if m.tokenVerifier == nil {
+			m.log.Error("bearer token received, but token authentication is not enabled")
+			return m.validateRespWithStatus(daos.NoPermission)
+		}
+
+		// The engine only understands AUTH_SYS tokens, so the identity in
+		// the bearer token is passed on in that form once it has been
+		// verified.
+		token, err = m.tokenVerifier.AuthSysFromBearer(token)
+		if err != nil {
+			m.log.Errorf("bearer token validation failed: %v", err)
+			return m.validateRespWithStatus(daos.NoPermission)
+		}
+	}
+
+	resp := &auth.ValidateCredResp{Token: token}
 	responseBytes, err := proto.Marshal(resp)
 	if err != nil {
 		return nil, drpc.MarshalingFailure()
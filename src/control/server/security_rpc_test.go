@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -15,6 +16,7 @@ import (
 	"math/big"
 	"os"
 	"path/filepath"
+	"syscall"
 	"testing"
 	"time"
 
@@ -346,3 +348,113 @@ func TestSrvSecurityModule_ValidateCred_Secure_BadVerifier(t *testing.T) {
 		Status: int32(daos.NoPermission),
 	})
 }
+
+func TestSrvSecurityModule_ValidateCred_Bearer(t *testing.T) {
+	tmpDir, tmpCleanup := test.CreateTestDir(t)
+	defer tmpCleanup()
+
+	issuerKey := generateTestCert(t, tmpDir)
+	tokenAuthCfg := &security.TokenAuthConfig{
+		Issuers: []*security.TokenIssuerConfig{
+			{Issuer: "test-idp", PublicKey: filepath.Join(tmpDir, "test.crt")},
+		},
+	}
+	machineName, err := auth.GetMachineName()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validClaims := func() *auth.BearerClaims {
+		return &auth.BearerClaims{
+			Issuer:    "test-idp",
+			Subject:   "alice",
+			ExpiresAt: time.Now().Add(time.Minute).Unix(),
+			Group:     "users",
+			Groups:    []string{"proj1"},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		tokenAuth *security.TokenAuthConfig
+		claims    func() *auth.BearerClaims
+		expStatus daos.Status
+		expSys    *auth.Sys
+	}{
+		"token auth disabled": {
+			claims:    validClaims,
+			expStatus: daos.NoPermission,
+		},
+		"valid token": {
+			tokenAuth: tokenAuthCfg,
+			claims:    validClaims,
+			expSys: &auth.Sys{
+				Machinename: machineName,
+				User:        "alice@",
+				Group:       "users@",
+				Groups:      []string{"proj1@"},
+			},
+		},
+		"expired token": {
+			tokenAuth: tokenAuthCfg,
+			claims: func() *auth.BearerClaims {
+				claims := validClaims()
+				claims.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+				return claims
+			},
+			expStatus: daos.NoPermission,
+		},
+		"untrusted issuer": {
+			tokenAuth: tokenAuthCfg,
+			claims: func() *auth.BearerClaims {
+				claims := validClaims()
+				claims.Issuer = "other-idp"
+				return claims
+			},
+			expStatus: daos.NoPermission,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tokenVerifier, err := auth.NewTokenVerifier(tc.tokenAuth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mod := NewSecurityModule(log, insecureTransportConfig())
+			mod.tokenVerifier = tokenVerifier
+
+			bearer, err := auth.NewBearerToken(issuerKey, tc.claims())
+			if err != nil {
+				t.Fatal(err)
+			}
+			credReq := auth.NewCredentialRequest(security.InitDomainInfo(&syscall.Ucred{Uid: 1000, Gid: 1000}, ""), nil)
+			cred, err := auth.GetBearerCredential(test.Context(t), credReq, bearer)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			respBytes, err := callValidateCreds(t, mod, getMarshaledValidateCredReq(t, cred.Token, cred.Verifier))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp := &auth.ValidateCredResp{}
+			if err := proto.Unmarshal(respBytes, resp); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, int32(tc.expStatus), resp.Status, "")
+			if tc.expSys == nil {
+				return
+			}
+
+			sys, err := auth.AuthSysFromAuthToken(resp.Token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expSys, sys, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected AUTH_SYS token (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -25,6 +26,7 @@ import (
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/audit"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
@@ -32,6 +34,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/security/auth"
 	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/server/engine"
 	"github.com/daos-stack/daos/src/control/server/storage"
@@ -394,9 +397,42 @@ func (srv *server) addEngines(ctx context.Context) error {
 	return nil
 }
 
+// openAuditLog opens the admin audit log if one is configured.
+func (srv *server) openAuditLog() (*audit.Log, error) {
+	if srv.cfg.AuditLogFile == "" {
+		return nil, nil
+	}
+
+	auditLog, err := audit.Open(srv.cfg.AuditLogFile)
+	if err != nil {
+		return nil, err
+	}
+	srv.OnShutdown(func() {
+		if err := auditLog.Close(); err != nil {
+			srv.log.Errorf("failed to close audit log: %s", err)
+		}
+	})
+
+	// Record the head of the log so that later truncation can be detected.
+	seq, hash := auditLog.Head()
+	srv.log.Noticef("admin audit log %s opened at entry %d (hash %q)", auditLog.Path(), seq, hash)
+
+	return auditLog, nil
+}
+
 // setupGrpc creates a new grpc server and registers services.
 func (srv *server) setupGrpc() error {
-	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig, srv.sysdb.IsLeader)
+	auditLog, err := srv.openAuditLog()
+	if err != nil {
+		return err
+	}
+
+	gwCert, err := srv.loadGatewayCert()
+	if err != nil {
+		return err
+	}
+
+	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig, srv.sysdb.IsLeader, auditLog, gwCert)
 	if err != nil {
 		return err
 	}
@@ -454,6 +490,12 @@ func (srv *server) registerEvents() {
 
 			srv.mgmtSvc.startLeaderLoops(ctx)
 			registerLeaderSubscriptions(srv)
+			go func() {
+				srv.sysdb.WaitForLeaderStepUp()
+				if err := srv.mgmtSvc.failInterruptedOperations(); err != nil {
+					srv.log.Errorf("failed to resolve interrupted operations: %s", err)
+				}
+			}()
 			srv.log.Debugf("requesting immediate GroupUpdate after leader change")
 			go func() {
 				for {
@@ -501,11 +543,23 @@ func (srv *server) start(ctx context.Context) error {
 	srv.log.Infof("%s v%s (pid %d) listening on %s", build.ControlPlaneName,
 		build.DaosVersion, os.Getpid(), srv.ctlAddr)
 
+	security.NewCertMonitor(srv.log, srv.cfg.TransportConfig).
+		WithExpiryHandler(func(n *security.CertExpiryNotice) {
+			srv.pubSub.Publish(newCertExpiringEvent(n))
+		}).
+		Start(ctx)
+
+	tokenVerifier, err := auth.NewTokenVerifier(srv.cfg.TokenAuth)
+	if err != nil {
+		return errors.Wrap(err, "token authentication setup")
+	}
+
 	drpcSetupReq := &drpcServerSetupReq{
 		log:     srv.log,
 		sockDir: srv.cfg.SocketDir,
 		engines: srv.harness.Instances(),
 		tc:      srv.cfg.TransportConfig,
+		tv:      tokenVerifier,
 		sysdb:   srv.sysdb,
 		events:  srv.pubSub,
 	}
@@ -521,6 +575,10 @@ func (srv *server) start(ctx context.Context) error {
 
 	srv.mgmtSvc.startAsyncLoops(ctx)
 
+	if err := srv.startGateway(ctx); err != nil {
+		return errors.Wrap(err, "REST gateway setup")
+	}
+
 	if srv.cfg.AutoFormat {
 		srv.log.Notice("--auto flag set on server start so formatting storage now")
 		if _, err := srv.ctlSvc.StorageFormat(ctx, &ctlpb.StorageFormatReq{}); err != nil {
@@ -532,6 +590,14 @@ func (srv *server) start(ctx context.Context) error {
 		"%s harness exited", build.ControlPlaneName)
 }
 
+func newCertExpiringEvent(n *security.CertExpiryNotice) *events.RASEvent {
+	sev := events.RASSeverityWarning
+	if n.Expired() {
+		sev = events.RASSeverityError
+	}
+	return events.NewGenericEvent(events.RASCertExpiring, sev, n.String(), n.Cert.Path)
+}
+
 func waitFabricReady(ctx context.Context, log logging.Logger, cfg *config.Server) error {
 	ifaces := make([]string, 0, len(cfg.Engines))
 	for _, eng := range cfg.Engines {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +10,7 @@ package server
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"os"
@@ -24,6 +26,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/audit"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -722,7 +725,10 @@ func registerLeaderSubscriptions(srv *server) {
 }
 
 // getGrpcOpts generates a set of gRPC options for the server based on the supplied configuration.
-func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldrChk func() bool) ([]grpc.ServerOption, error) {
+// If an audit log is supplied, mutating requests handled by the MS leader and all requests
+// denied by the access checks are recorded in it, along with the client of the REST gateway
+// with the supplied certificate.
+func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldrChk func() bool, auditLog *audit.Log, gwCert *x509.Certificate) ([]grpc.ServerOption, error) {
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		unaryLoggingInterceptor(log, ldrChk), // must be first in order to properly log errors
 		unaryErrorInterceptor,
@@ -738,16 +744,16 @@ func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldr
 	}
 	srvOpts := []grpc.ServerOption{tcOpt}
 
-	uintOpt, err := unaryInterceptorForTransportConfig(cfgTransport)
+	uintOpt, sintOpt, err := accessInterceptorsForTransportConfig(log, cfgTransport, auditLog, gwCert)
 	if err != nil {
 		return nil, err
 	}
 	if uintOpt != nil {
 		unaryInterceptors = append(unaryInterceptors, uintOpt)
 	}
-	sintOpt, err := streamInterceptorForTransportConfig(cfgTransport)
-	if err != nil {
-		return nil, err
+	if auditLog != nil {
+		// Placed after the access interceptor, which records denied requests itself.
+		unaryInterceptors = append(unaryInterceptors, unaryAuditInterceptor(log, auditLog, ldrChk, gwCert))
 	}
 	if sintOpt != nil {
 		streamInterceptors = append(streamInterceptors, sintOpt)
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -330,3 +331,20 @@ func IsErrSystemAttrNotFound(err error) bool {
 	_, ok := errors.Cause(err).(*errSystemAttrNotFound)
 	return ok
 }
+
+type errPoolIdempotencyKeyNotFound struct {
+	key string
+}
+
+func (err *errPoolIdempotencyKeyNotFound) Error() string {
+	return fmt.Sprintf("unable to find pool idempotency key %q", err.key)
+}
+
+func ErrPoolIdempotencyKeyNotFound(key string) *errPoolIdempotencyKeyNotFound {
+	return &errPoolIdempotencyKeyNotFound{key: key}
+}
+
+func IsErrPoolIdempotencyKeyNotFound(err error) bool {
+	_, ok := errors.Cause(err).(*errPoolIdempotencyKeyNotFound)
+	return ok
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -33,6 +34,28 @@ func FaultPoolLocked(poolUUID, lockID uuid.UUID, lockTime time.Time) *fault.Faul
 		"retry the pool operation")
 }
 
+// FaultOperationNotFound generates a fault indicating that no long-running
+// operation exists with the given ID.
+func FaultOperationNotFound(id string) *fault.Fault {
+	return systemFault(code.SystemOperationNotFound,
+		fmt.Sprintf("operation %s not found", id),
+		"list the operations to find a valid operation ID (finished operations are removed after a while)")
+}
+
+// IsOperationNotFound returns true if the error indicates that a long-running
+// operation was not found.
+func IsOperationNotFound(err error) bool {
+	return fault.IsFaultCode(err, code.SystemOperationNotFound)
+}
+
+// FaultOperationNotRunning generates a fault indicating that a long-running
+// operation has already finished.
+func FaultOperationNotRunning(id string, state OperationState) *fault.Fault {
+	return systemFault(code.SystemOperationNotRunning,
+		fmt.Sprintf("operation %s is not running (state: %s)", id, state),
+		"get the operation to see its outcome")
+}
+
 func systemFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "system",
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -201,6 +202,7 @@ type Member struct {
 	Info                    string        `json:"info"`
 	FaultDomain             *FaultDomain  `json:"fault_domain"`
 	LastUpdate              time.Time     `json:"last_update"`
+	DBSchemaVersion         uint          `json:"db_schema_version"`
 }
 
 // MarshalJSON marshals system.Member to JSON.
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -105,6 +106,7 @@ type JoinRequest struct {
 	FaultDomain             *FaultDomain
 	Incarnation             uint64
 	CheckMode               bool
+	DBSchemaVersion         uint
 }
 
 // JoinResponse contains information returned from join membership update.
@@ -182,6 +184,7 @@ func (m *Membership) Join(req *JoinRequest) (resp *JoinResponse, err error) {
 		curMember.SecondaryFabricContexts = req.SecondaryFabricContexts
 		curMember.FaultDomain = req.FaultDomain
 		curMember.Incarnation = req.Incarnation
+		curMember.DBSchemaVersion = req.DBSchemaVersion
 		if err := m.db.UpdateMember(curMember); err != nil {
 			return nil, err
 		}
@@ -213,6 +216,7 @@ func (m *Membership) Join(req *JoinRequest) (resp *JoinResponse, err error) {
 		PrimaryFabricContexts:   req.FabricContexts,
 		SecondaryFabricContexts: req.SecondaryFabricContexts,
 		FaultDomain:             req.FaultDomain,
+		DBSchemaVersion:         req.DBSchemaVersion,
 		State:                   MemberStateJoined,
 	}
 	if err := m.db.AddMember(newMember); err != nil {
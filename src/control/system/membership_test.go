@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -799,6 +800,25 @@ func TestSystem_Membership_Join(t *testing.T) {
 				MapVersion: expMapVer,
 			},
 		},
+		"successful rejoin with db schema version": {
+			req: &JoinRequest{
+				Rank:             curMember.Rank,
+				UUID:             curMember.UUID,
+				ControlAddr:      curMember.Addr,
+				PrimaryFabricURI: curMember.Addr.String(),
+				FaultDomain:      curMember.FaultDomain,
+				DBSchemaVersion:  1,
+			},
+			expResp: &JoinResponse{
+				Member: func() *Member {
+					m := MockMember(t, 0, MemberStateJoined).WithFaultDomain(fd1)
+					m.DBSchemaVersion = 1
+					return m
+				}(),
+				PrevState:  curMember.State,
+				MapVersion: expMapVer,
+			},
+		},
 		"rejoin with existing UUID and unknown rank": {
 			req: &JoinRequest{
 				Rank:             Rank(42),
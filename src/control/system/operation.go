@@ -0,0 +1,74 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OperationState indicates the progress of a long-running operation.
+type OperationState string
+
+const (
+	// OperationStateRunning indicates that the operation has not finished.
+	OperationStateRunning OperationState = "running"
+	// OperationStateSucceeded indicates that the operation completed successfully.
+	OperationStateSucceeded OperationState = "succeeded"
+	// OperationStateFailed indicates that the operation completed with an error.
+	OperationStateFailed OperationState = "failed"
+	// OperationStateCanceled indicates that the operation was canceled before
+	// it completed.
+	OperationStateCanceled OperationState = "canceled"
+)
+
+// IsFinished returns true if the state is a final one.
+func (os OperationState) IsFinished() bool {
+	return os != OperationStateRunning
+}
+
+// Operation records a management service request that is run asynchronously
+// on the MS leader, and its outcome.
+type Operation struct {
+	ID         string
+	Method     string
+	State      OperationState
+	Caller     string
+	Error      string
+	Request    []byte
+	Response   []byte
+	Created    time.Time
+	LastUpdate time.Time
+}
+
+// NewOperation returns a running operation with a new ID for the serialized
+// request of the given method.
+func NewOperation(method string, req []byte, caller string) *Operation {
+	now := time.Now()
+	return &Operation{
+		ID:         uuid.New().String(),
+		Method:     method,
+		State:      OperationStateRunning,
+		Caller:     caller,
+		Request:    req,
+		Created:    now,
+		LastUpdate: now,
+	}
+}
+
+// Finish sets the final state of the operation from the result of its
+// request.
+func (op *Operation) Finish(resp []byte, err error) {
+	if err != nil {
+		op.State = OperationStateFailed
+		op.Error = err.Error()
+		return
+	}
+	op.State = OperationStateSucceeded
+	op.Response = resp
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -35,18 +36,48 @@ type (
 	// changes made to the daos.PoolServiceState type.
 	PoolServiceState daos.PoolServiceState
 
+	// PoolLabelAlias records a previous label of a pool and the time after
+	// which it no longer resolves to the pool.
+	PoolLabelAlias struct {
+		Label   string
+		Expires time.Time
+	}
+
+	// PoolIdempotencyKey records the result of a request against a pool
+	// that was made with an idempotency key, so that retries of the
+	// request get the same result until the key expires. Keys are kept
+	// with the pool service, and in a tombstone after the pool is
+	// destroyed until they expire. A key reserved by a request that has
+	// not completed has no response.
+	PoolIdempotencyKey struct {
+		Key      string
+		Method   string
+		Response []byte
+		Expires  time.Time
+	}
+
 	// PoolService represents a pool service created to manage metadata
 	// for a DAOS Pool.
 	PoolService struct {
-		PoolUUID   uuid.UUID
-		PoolLabel  string
-		State      PoolServiceState
-		Replicas   []ranklist.Rank
-		Storage    *PoolServiceStorage
-		LastUpdate time.Time
+		PoolUUID        uuid.UUID
+		PoolLabel       string
+		LabelAliases    []PoolLabelAlias
+		IdempotencyKeys []PoolIdempotencyKey
+		State           PoolServiceState
+		Replicas        []ranklist.Rank
+		Storage         *PoolServiceStorage
+		LastUpdate      time.Time
 	}
 )
 
+// DefaultPoolLabelAliasGracePeriod is the length of time for which a pool's
+// previous label continues to resolve to the pool after a rename.
+const DefaultPoolLabelAliasGracePeriod = 7 * 24 * time.Hour
+
+// DefaultPoolIdempotencyKeyTTL is the length of time for which the result of
+// a pool request made with an idempotency key is kept.
+const DefaultPoolIdempotencyKeyTTL = 24 * time.Hour
+
 const (
 	PoolServiceStateCreating   = PoolServiceState(daos.PoolServiceStateCreating)
 	PoolServiceStateReady      = PoolServiceState(daos.PoolServiceStateReady)
@@ -72,6 +103,112 @@ func NewPoolService(uuid uuid.UUID, tierStorage []uint64, memRatio float32, rank
 	}
 }
 
+// ActiveLabelAliases returns the aliases of the pool that have not expired at the
+// given time.
+func (ps *PoolService) ActiveLabelAliases(now time.Time) []PoolLabelAlias {
+	var active []PoolLabelAlias
+	for _, alias := range ps.LabelAliases {
+		if now.Before(alias.Expires) {
+			active = append(active, alias)
+		}
+	}
+	return active
+}
+
+// HasLabelAlias returns true if the label is an unexpired alias of the pool at
+// the given time.
+func (ps *PoolService) HasLabelAlias(label string, now time.Time) bool {
+	for _, alias := range ps.ActiveLabelAliases(now) {
+		if alias.Label == label {
+			return true
+		}
+	}
+	return false
+}
+
+// RelabelAliases returns the aliases the pool should have after its label is
+// changed to newLabel at the given time. The current label becomes an alias
+// that expires after the grace period, expired aliases are dropped and any
+// alias matching the new label is removed. The returned slice is never nil.
+func (ps *PoolService) RelabelAliases(newLabel string, now time.Time, grace time.Duration) []PoolLabelAlias {
+	aliases := []PoolLabelAlias{}
+	for _, alias := range ps.ActiveLabelAliases(now) {
+		if alias.Label == newLabel || alias.Label == ps.PoolLabel {
+			continue
+		}
+		aliases = append(aliases, alias)
+	}
+
+	if ps.PoolLabel != "" && ps.PoolLabel != newLabel && grace > 0 {
+		aliases = append(aliases, PoolLabelAlias{
+			Label:   ps.PoolLabel,
+			Expires: now.Add(grace),
+		})
+	}
+
+	return aliases
+}
+
+// NewPoolIdempotencyKey returns a record of the result of a pool request made
+// with the given key, which expires after the ttl.
+func NewPoolIdempotencyKey(key, method string, resp []byte, ttl time.Duration) PoolIdempotencyKey {
+	return PoolIdempotencyKey{
+		Key:      key,
+		Method:   method,
+		Response: resp,
+		Expires:  time.Now().Add(ttl),
+	}
+}
+
+// IsExpired returns true if the key has expired at the given time.
+func (pik PoolIdempotencyKey) IsExpired(now time.Time) bool {
+	return !now.Before(pik.Expires)
+}
+
+// FindIdempotencyKey returns the pool's idempotency key matching key that has
+// not expired at the given time, or false if there is none.
+func (ps *PoolService) FindIdempotencyKey(key string, now time.Time) (PoolIdempotencyKey, bool) {
+	for _, pik := range ps.IdempotencyKeys {
+		if pik.Key == key && !pik.IsExpired(now) {
+			return pik, true
+		}
+	}
+	return PoolIdempotencyKey{}, false
+}
+
+// IsPending returns true if the request that reserved the key has not
+// recorded its response.
+func (pik PoolIdempotencyKey) IsPending() bool {
+	return pik.Response == nil
+}
+
+// UnexpiredIdempotencyKeys returns the pool's idempotency keys that have not
+// expired at the given time. The returned slice is never nil.
+func (ps *PoolService) UnexpiredIdempotencyKeys(now time.Time) []PoolIdempotencyKey {
+	keys := []PoolIdempotencyKey{}
+	for _, pik := range ps.IdempotencyKeys {
+		if !pik.IsExpired(now) {
+			keys = append(keys, pik)
+		}
+	}
+
+	return keys
+}
+
+// RecordIdempotencyKey returns the idempotency keys the pool should have after
+// the supplied key is recorded at the given time. Expired keys are dropped and
+// any key with the same name is replaced. The returned slice is never nil.
+func (ps *PoolService) RecordIdempotencyKey(pik PoolIdempotencyKey, now time.Time) []PoolIdempotencyKey {
+	keys := []PoolIdempotencyKey{}
+	for _, cur := range ps.UnexpiredIdempotencyKeys(now) {
+		if cur.Key != pik.Key {
+			keys = append(keys, cur)
+		}
+	}
+
+	return append(keys, pik)
+}
+
 // CreationRanks returns the set of target ranks associated
 // with the pool's creation.
 func (pss *PoolServiceStorage) CreationRanks() []ranklist.Rank {
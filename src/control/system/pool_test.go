@@ -0,0 +1,202 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	. "github.com/daos-stack/daos/src/control/system"
+)
+
+func TestSystem_PoolService_RelabelAliases(t *testing.T) {
+	now := time.Now()
+	grace := time.Hour
+	expired := PoolLabelAlias{Label: "expired", Expires: now.Add(-time.Minute)}
+	active := PoolLabelAlias{Label: "active", Expires: now.Add(time.Minute)}
+
+	for name, tc := range map[string]struct {
+		ps         *PoolService
+		newLabel   string
+		grace      time.Duration
+		expAliases []PoolLabelAlias
+	}{
+		"no current label": {
+			ps:         &PoolService{},
+			newLabel:   "new",
+			grace:      grace,
+			expAliases: []PoolLabelAlias{},
+		},
+		"same label": {
+			ps:         &PoolService{PoolLabel: "old"},
+			newLabel:   "old",
+			grace:      grace,
+			expAliases: []PoolLabelAlias{},
+		},
+		"rename": {
+			ps:       &PoolService{PoolLabel: "old"},
+			newLabel: "new",
+			grace:    grace,
+			expAliases: []PoolLabelAlias{
+				{Label: "old", Expires: now.Add(grace)},
+			},
+		},
+		"rename with aliases disabled": {
+			ps:         &PoolService{PoolLabel: "old"},
+			newLabel:   "new",
+			expAliases: []PoolLabelAlias{},
+		},
+		"label removed": {
+			ps:    &PoolService{PoolLabel: "old"},
+			grace: grace,
+			expAliases: []PoolLabelAlias{
+				{Label: "old", Expires: now.Add(grace)},
+			},
+		},
+		"expired aliases dropped": {
+			ps: &PoolService{
+				PoolLabel:    "old",
+				LabelAliases: []PoolLabelAlias{expired, active},
+			},
+			newLabel: "new",
+			grace:    grace,
+			expAliases: []PoolLabelAlias{
+				active,
+				{Label: "old", Expires: now.Add(grace)},
+			},
+		},
+		"rename back to alias": {
+			ps: &PoolService{
+				PoolLabel:    "old",
+				LabelAliases: []PoolLabelAlias{active},
+			},
+			newLabel: "active",
+			grace:    grace,
+			expAliases: []PoolLabelAlias{
+				{Label: "old", Expires: now.Add(grace)},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotAliases := tc.ps.RelabelAliases(tc.newLabel, now, tc.grace)
+
+			if diff := cmp.Diff(tc.expAliases, gotAliases); diff != "" {
+				t.Fatalf("unexpected aliases (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_PoolService_HasLabelAlias(t *testing.T) {
+	now := time.Now()
+	ps := &PoolService{
+		PoolLabel: "current",
+		LabelAliases: []PoolLabelAlias{
+			{Label: "expired", Expires: now.Add(-time.Minute)},
+			{Label: "active", Expires: now.Add(time.Minute)},
+		},
+	}
+
+	test.AssertFalse(t, ps.HasLabelAlias("current", now), "current label is not an alias")
+	test.AssertFalse(t, ps.HasLabelAlias("expired", now), "expired alias should not match")
+	test.AssertTrue(t, ps.HasLabelAlias("active", now), "active alias should match")
+	test.AssertFalse(t, ps.HasLabelAlias("active", now.Add(time.Hour)),
+		"alias should not match after expiry")
+}
+
+func TestSystem_PoolService_RecordIdempotencyKey(t *testing.T) {
+	now := time.Now()
+	mockKey := func(key string, expires time.Time) PoolIdempotencyKey {
+		return PoolIdempotencyKey{Key: key, Method: "method", Expires: expires}
+	}
+
+	for name, tc := range map[string]struct {
+		keys    []PoolIdempotencyKey
+		add     PoolIdempotencyKey
+		expKeys []PoolIdempotencyKey
+	}{
+		"no keys": {
+			add:     mockKey("key1", now.Add(time.Hour)),
+			expKeys: []PoolIdempotencyKey{mockKey("key1", now.Add(time.Hour))},
+		},
+		"keeps active keys": {
+			keys: []PoolIdempotencyKey{mockKey("key1", now.Add(time.Hour))},
+			add:  mockKey("key2", now.Add(time.Hour)),
+			expKeys: []PoolIdempotencyKey{
+				mockKey("key1", now.Add(time.Hour)),
+				mockKey("key2", now.Add(time.Hour)),
+			},
+		},
+		"drops expired keys": {
+			keys: []PoolIdempotencyKey{
+				mockKey("key1", now.Add(-time.Minute)),
+				mockKey("key2", now.Add(time.Hour)),
+			},
+			add: mockKey("key3", now.Add(time.Hour)),
+			expKeys: []PoolIdempotencyKey{
+				mockKey("key2", now.Add(time.Hour)),
+				mockKey("key3", now.Add(time.Hour)),
+			},
+		},
+		"replaces key with same name": {
+			keys:    []PoolIdempotencyKey{mockKey("key1", now.Add(time.Minute))},
+			add:     mockKey("key1", now.Add(time.Hour)),
+			expKeys: []PoolIdempotencyKey{mockKey("key1", now.Add(time.Hour))},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ps := &PoolService{IdempotencyKeys: tc.keys}
+
+			got := ps.RecordIdempotencyKey(tc.add, now)
+			if diff := cmp.Diff(tc.expKeys, got); diff != "" {
+				t.Fatalf("unexpected keys (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_PoolService_FindIdempotencyKey(t *testing.T) {
+	now := time.Now()
+	ps := &PoolService{
+		IdempotencyKeys: []PoolIdempotencyKey{
+			{Key: "expired", Method: "method", Expires: now.Add(-time.Minute)},
+			{Key: "active", Method: "method", Expires: now.Add(time.Minute)},
+		},
+	}
+
+	_, found := ps.FindIdempotencyKey("missing", now)
+	test.AssertFalse(t, found, "missing key should not be found")
+	_, found = ps.FindIdempotencyKey("expired", now)
+	test.AssertFalse(t, found, "expired key should not be found")
+	pik, found := ps.FindIdempotencyKey("active", now)
+	test.AssertTrue(t, found, "active key should be found")
+	test.AssertEqual(t, "active", pik.Key, "unexpected key")
+	_, found = ps.FindIdempotencyKey("active", now.Add(time.Hour))
+	test.AssertFalse(t, found, "key should not be found after expiry")
+}
+
+func TestSystem_PoolService_UnexpiredIdempotencyKeys(t *testing.T) {
+	now := time.Now()
+	ps := &PoolService{
+		IdempotencyKeys: []PoolIdempotencyKey{
+			{Key: "expired", Method: "method", Expires: now.Add(-time.Minute)},
+			{Key: "pending", Method: "method", Expires: now.Add(time.Minute)},
+			{Key: "recorded", Method: "method", Response: []byte{}, Expires: now.Add(time.Minute)},
+		},
+	}
+
+	got := ps.UnexpiredIdempotencyKeys(now)
+	if diff := cmp.Diff(ps.IdempotencyKeys[1:], got); diff != "" {
+		t.Fatalf("(-want, +got): \n%s\n", diff)
+	}
+	test.AssertTrue(t, got[0].IsPending(), "key without response should be pending")
+	test.AssertFalse(t, got[1].IsPending(), "key with empty response should not be pending")
+	test.AssertEqual(t, 0, len(ps.UnexpiredIdempotencyKeys(now.Add(time.Hour))), "keys should expire")
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -30,7 +31,11 @@ import (
 
 const (
 	// CurrentSchemaVersion indicates the current db schema version.
-	CurrentSchemaVersion = 0
+	CurrentSchemaVersion = 1
+
+	// operationsSchemaVersion is the db schema version that added the
+	// long-running operation records.
+	operationsSchemaVersion = 1
 )
 
 var (
@@ -65,7 +70,9 @@ type (
 	// dbData is the raft-replicated system database. It
 	// should never be updated directly; updates must be
 	// applied in order to ensure that they are sent to
-	// all participating replicas.
+	// all participating replicas. The schema version is the
+	// lowest version able to read the data, and is raised
+	// as updates using newer versions are applied.
 	dbData struct {
 		sync.RWMutex
 		log logging.Logger
@@ -77,6 +84,7 @@ type (
 		Pools         *PoolDatabase
 		Checker       *CheckerDatabase
 		System        *SystemDatabase
+		Operations    *OperationDatabase
 		SchemaVersion uint
 	}
 
@@ -260,9 +268,10 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 				FaultDomains: system.NewFaultDomainTree(),
 			},
 			Pools: &PoolDatabase{
-				Ranks:  make(PoolRankMap),
-				Uuids:  make(PoolUuidMap),
-				Labels: make(PoolLabelMap),
+				Ranks:     make(PoolRankMap),
+				Uuids:     make(PoolUuidMap),
+				Labels:    make(PoolLabelMap),
+				Destroyed: make(PoolUuidMap),
 			},
 			Checker: &CheckerDatabase{
 				Findings: make(CheckerFindingMap),
@@ -270,7 +279,9 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 			System: &SystemDatabase{
 				Attributes: make(map[string]string),
 			},
-			SchemaVersion: CurrentSchemaVersion,
+			Operations: &OperationDatabase{
+				Operations: make(OperationMap),
+			},
 		},
 	}
 	// NB: We may remove this once the locking stuff is solid.
@@ -291,6 +302,29 @@ func (db *Database) isReplica(ctrlAddr *net.TCPAddr) bool {
 	return false
 }
 
+// checkReplicaSchema returns an error unless every replica has joined the
+// system with a server that supports the given db schema version, so that
+// updates using that version are not sent to replicas that cannot apply them.
+func (db *Database) checkReplicaSchema(version uint) error {
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	for _, rep := range db.cfg.Replicas {
+		supported := false
+		for _, m := range db.data.Members.Addrs[rep.String()] {
+			if m.DBSchemaVersion >= version {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return errors.Errorf("replica %s does not support db schema version %d", rep, version)
+		}
+	}
+
+	return nil
+}
+
 // SystemName returns the system name set in the configuration.
 func (db *Database) SystemName() string {
 	return db.cfg.SystemName
@@ -946,6 +980,29 @@ func (db *Database) FindPoolServiceByLabel(label string) (*system.PoolService, e
 	return nil, system.ErrPoolLabelNotFound(label)
 }
 
+// FindPoolServiceByLabelAlias searches the pool database for a pool service
+// with an unexpired alias matching the label, i.e. a renamed pool that was
+// previously labeled with it, and logs a deprecation warning if one is found.
+// If no pool service is found, an error is returned.
+func (db *Database) FindPoolServiceByLabelAlias(label string) (*system.PoolService, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	now := time.Now()
+	for _, p := range db.data.Pools.Uuids {
+		if p.HasLabelAlias(label, now) {
+			db.log.Noticef("pool label %q is deprecated; pool %s is now labeled %q",
+				label, p.PoolUUID, p.PoolLabel)
+			return copyPoolService(p), nil
+		}
+	}
+
+	return nil, system.ErrPoolLabelNotFound(label)
+}
+
 // TakePoolLock attempts to take a lock on the pool with the given UUID,
 // if the supplied context does not already contain a valid lock for that
 // pool.
@@ -994,6 +1051,9 @@ func (db *Database) AddPoolService(ctx context.Context, ps *system.PoolService)
 	if p, err := db.FindPoolServiceByUUID(ps.PoolUUID); err == nil {
 		return errors.Errorf("pool %s already exists", p.PoolUUID)
 	}
+	if err := db.checkIdempotencyKeys(ps); err != nil {
+		return err
+	}
 
 	if err := db.submitPoolUpdate(raftOpAddPoolService, ps); err != nil {
 		return err
@@ -1018,6 +1078,8 @@ func (db *Database) RemovePoolService(ctx context.Context, poolUUID uuid.UUID) e
 	if err != nil {
 		return errors.Wrapf(err, "failed to retrieve pool %s", poolUUID)
 	}
+	// Replicas expire idempotency keys relative to the removal time.
+	ps.LastUpdate = time.Now()
 
 	if err := db.submitPoolUpdate(raftOpRemovePoolService, ps); err != nil {
 		return err
@@ -1058,6 +1120,9 @@ func (db *Database) UpdatePoolService(ctx context.Context, ps *system.PoolServic
 		db.log.Debugf("ignoring invalid pool service update: %+v -> %+v", p, ps)
 		return nil
 	}
+	if err := db.checkIdempotencyKeys(ps); err != nil {
+		return err
+	}
 
 	if err := db.submitPoolUpdate(raftOpUpdatePoolService, ps); err != nil {
 		return err
@@ -1066,6 +1131,70 @@ func (db *Database) UpdatePoolService(ctx context.Context, ps *system.PoolServic
 	return nil
 }
 
+// checkIdempotencyKeys returns an error if any of the unexpired idempotency
+// keys of the pool service is held by another pool service or by the
+// tombstone of another destroyed pool.
+func (db *Database) checkIdempotencyKeys(ps *system.PoolService) error {
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	now := time.Now()
+	for _, pik := range ps.UnexpiredIdempotencyKeys(now) {
+		for _, pools := range []PoolUuidMap{db.data.Pools.Uuids, db.data.Pools.Destroyed} {
+			for _, p := range pools {
+				if p.PoolUUID == ps.PoolUUID {
+					continue
+				}
+				if _, found := p.FindIdempotencyKey(pik.Key, now); found {
+					return errors.Errorf("idempotency key %q is held by pool %s", pik.Key, p.PoolUUID)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// FindPoolServiceByIdempotencyKey searches the pool database for the pool
+// service with an unexpired idempotency key matching key. If no such pool
+// service is found, an error is returned.
+func (db *Database) FindPoolServiceByIdempotencyKey(key string) (*system.PoolService, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	now := time.Now()
+	for _, p := range db.data.Pools.Uuids {
+		if _, found := p.FindIdempotencyKey(key, now); found {
+			return copyPoolService(p), nil
+		}
+	}
+
+	return nil, system.ErrPoolIdempotencyKeyNotFound(key)
+}
+
+// FindDestroyedPoolServiceByIdempotencyKey searches the tombstones of destroyed
+// pools for one with an unexpired idempotency key matching key. If no such
+// tombstone is found, an error is returned.
+func (db *Database) FindDestroyedPoolServiceByIdempotencyKey(key string) (*system.PoolService, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	now := time.Now()
+	for _, p := range db.data.Pools.Destroyed {
+		if _, found := p.FindIdempotencyKey(key, now); found {
+			return copyPoolService(p), nil
+		}
+	}
+
+	return nil, system.ErrPoolIdempotencyKeyNotFound(key)
+}
+
 func (db *Database) handlePoolRepsUpdate(evt *events.RASEvent) {
 	ei := evt.GetPoolSvcInfo()
 	if ei == nil {
@@ -0,0 +1,156 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+var (
+	errOperationExists = errors.New("operation already exists")
+)
+
+type (
+	// OperationMap allows the lookup of an Operation by its ID.
+	OperationMap map[string]*system.Operation
+
+	// OperationDatabase is the database containing all long-running
+	// operations.
+	OperationDatabase struct {
+		Operations OperationMap
+	}
+)
+
+func copyOperation(in *system.Operation) *system.Operation {
+	out := *in
+	out.Request = append([]byte(nil), in.Request...)
+	out.Response = append([]byte(nil), in.Response...)
+	return &out
+}
+
+func (odb *OperationDatabase) addOperation(op *system.Operation) error {
+	if _, found := odb.Operations[op.ID]; found {
+		return errOperationExists
+	}
+	odb.Operations[op.ID] = copyOperation(op)
+
+	return nil
+}
+
+func (odb *OperationDatabase) updateOperation(op *system.Operation) error {
+	if _, found := odb.Operations[op.ID]; !found {
+		return system.FaultOperationNotFound(op.ID)
+	}
+	odb.Operations[op.ID] = copyOperation(op)
+
+	return nil
+}
+
+func (odb *OperationDatabase) removeOperation(op *system.Operation) error {
+	if _, found := odb.Operations[op.ID]; !found {
+		return system.FaultOperationNotFound(op.ID)
+	}
+
+	delete(odb.Operations, op.ID)
+	return nil
+}
+
+// AddOperation adds an operation to the database.
+func (db *Database) AddOperation(op *system.Operation) error {
+	db.Lock()
+	defer db.Unlock()
+
+	if _, err := db.GetOperation(op.ID); err == nil {
+		return errOperationExists
+	}
+	return db.submitOperationUpdate(raftOpAddOperation, op)
+}
+
+// UpdateOperation updates an operation that is already in the database.
+func (db *Database) UpdateOperation(op *system.Operation) error {
+	db.Lock()
+	defer db.Unlock()
+
+	if _, err := db.GetOperation(op.ID); err != nil {
+		return err
+	}
+	return db.submitOperationUpdate(raftOpUpdateOperation, op)
+}
+
+// RemoveOperation removes an operation from the database.
+func (db *Database) RemoveOperation(op *system.Operation) error {
+	db.Lock()
+	defer db.Unlock()
+
+	if _, err := db.GetOperation(op.ID); err != nil {
+		return err
+	}
+	return db.submitOperationUpdate(raftOpRemoveOperation, op)
+}
+
+// GetOperation looks up an operation by ID.
+func (db *Database) GetOperation(id string) (*system.Operation, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	if op, found := db.data.Operations.Operations[id]; found {
+		return copyOperation(op), nil
+	}
+
+	return nil, system.FaultOperationNotFound(id)
+}
+
+// OperationList returns all operations in the database, ordered by creation
+// time.
+func (db *Database) OperationList() ([]*system.Operation, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, err
+	}
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	out := make([]*system.Operation, 0, len(db.data.Operations.Operations))
+	for _, op := range db.data.Operations.Operations {
+		out = append(out, copyOperation(op))
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Created.Equal(out[j].Created) {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].Created.Before(out[j].Created)
+	})
+
+	return out, nil
+}
+
+// RemoveFinishedOperations removes finished operations that were last updated
+// before the given time.
+func (db *Database) RemoveFinishedOperations(before time.Time) error {
+	ops, err := db.OperationList()
+	if err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		if !op.State.IsFinished() || !op.LastUpdate.Before(before) {
+			continue
+		}
+		if err := db.RemoveOperation(op); err != nil && !system.IsOperationNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -26,10 +27,13 @@ type (
 
 	// PoolDatabase contains a set of maps for looking up DAOS Pool
 	// Service instances and methods for managing the pool membership.
+	// Destroyed holds tombstones of destroyed pools with unexpired
+	// idempotency keys, so that retried requests get the same result.
 	PoolDatabase struct {
-		Ranks  PoolRankMap
-		Uuids  PoolUuidMap
-		Labels PoolLabelMap
+		Ranks     PoolRankMap
+		Uuids     PoolUuidMap
+		Labels    PoolLabelMap
+		Destroyed PoolUuidMap
 	}
 )
 
@@ -138,6 +142,14 @@ func (pdb *PoolDatabase) updateService(cur, new *system.PoolService) {
 	// TODO: Update svc rank map
 	cur.Replicas = new.Replicas
 
+	if new.LabelAliases != nil {
+		cur.LabelAliases = new.LabelAliases
+	}
+
+	if new.IdempotencyKeys != nil {
+		cur.IdempotencyKeys = new.IdempotencyKeys
+	}
+
 	if cur.PoolLabel != "" {
 		delete(pdb.Labels, cur.PoolLabel)
 	}
@@ -148,8 +160,35 @@ func (pdb *PoolDatabase) updateService(cur, new *system.PoolService) {
 }
 
 // removeService is responsible for removing a PoolService entry and
-// updating all of the relevant maps.
+// updating all of the relevant maps. If the pool has unexpired idempotency
+// keys with recorded responses, a tombstone holding them is kept until they
+// expire. The time of the update is used to expire keys so that all
+// replicas agree.
 func (pdb *PoolDatabase) removeService(ps *system.PoolService) {
+	if pdb.Destroyed == nil {
+		pdb.Destroyed = make(PoolUuidMap)
+	}
+	for uuid, tomb := range pdb.Destroyed {
+		if len(tomb.UnexpiredIdempotencyKeys(ps.LastUpdate)) == 0 {
+			delete(pdb.Destroyed, uuid)
+		}
+	}
+	keys := []system.PoolIdempotencyKey{}
+	for _, pik := range ps.UnexpiredIdempotencyKeys(ps.LastUpdate) {
+		if !pik.IsPending() {
+			keys = append(keys, pik)
+		}
+	}
+	if len(keys) > 0 {
+		pdb.Destroyed[ps.PoolUUID] = &system.PoolService{
+			PoolUUID:        ps.PoolUUID,
+			PoolLabel:       ps.PoolLabel,
+			IdempotencyKeys: keys,
+			State:           ps.State,
+			LastUpdate:      ps.LastUpdate,
+		}
+	}
+
 	delete(pdb.Uuids, ps.PoolUUID)
 	if ps.PoolLabel != "" {
 		delete(pdb.Labels, ps.PoolLabel)
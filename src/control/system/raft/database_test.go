@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -235,6 +236,7 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 	maxPools := 1024
 	maxAttrs := 4096
 	maxFindings := 512
+	maxOperations := 128
 
 	log, buf := logging.NewTestLogger(t.Name())
 	defer test.ShowBufferOnFailure(t, buf)
@@ -271,8 +273,12 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 		ps := &PoolService{
 			PoolUUID:  uuid.New(),
 			PoolLabel: fmt.Sprintf("pool%04d", i),
-			State:     system.PoolServiceStateReady,
-			Replicas:  <-replicas,
+			IdempotencyKeys: []PoolIdempotencyKey{
+				NewPoolIdempotencyKey(fmt.Sprintf("key%04d", i), "/mgmt.MgmtSvc/PoolCreate",
+					[]byte{byte(i)}, time.Hour),
+			},
+			State:    system.PoolServiceStateReady,
+			Replicas: <-replicas,
 			Storage: &PoolServiceStorage{
 				CreationRankStr:    fmt.Sprintf("[0-%d]", maxRanks),
 				CurrentRankStr:     fmt.Sprintf("[0-%d]", maxRanks),
@@ -301,6 +307,18 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 		(*fsm)(db0).Apply(rl)
 	}
 
+	for i := 0; i < maxOperations; i++ {
+		op := NewOperation("/mgmt.MgmtSvc/PoolCreate", []byte{byte(i)}, "admin")
+		data, err := createRaftUpdate(raftOpAddOperation, op)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rl := &raft.Log{
+			Data: data,
+		}
+		(*fsm)(db0).Apply(rl)
+	}
+
 	attrs := make(map[string]string)
 	for i := 0; i < maxAttrs; i++ {
 		attrs[fmt.Sprintf("prop%04d", i)] = fmt.Sprintf("value%04d", i)
@@ -361,7 +379,7 @@ func TestSystem_Database_SnapshotRestoreBadVersion(t *testing.T) {
 	db1, cleanup1 := TestDatabase(t, log)
 	defer cleanup1()
 
-	wantErr := errors.Errorf("%d != %d", db0.data.SchemaVersion, CurrentSchemaVersion)
+	wantErr := errors.Errorf("%d > %d", db0.data.SchemaVersion, CurrentSchemaVersion)
 	gotErr := (*fsm)(db1).Restore(sink.Reader())
 	test.CmpErr(t, wantErr, gotErr)
 }
@@ -716,6 +734,164 @@ func TestSystem_Database_SystemAttrs(t *testing.T) {
 	}
 }
 
+func TestSystem_Database_Operations(t *testing.T) {
+	now := time.Now()
+	mockOp := func(id string, state OperationState, age time.Duration) *Operation {
+		return &Operation{
+			ID:         id,
+			Method:     "/mgmt.MgmtSvc/PoolCreate",
+			State:      state,
+			Created:    now.Add(-age),
+			LastUpdate: now.Add(-age),
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		startOps     []*Operation
+		removeBefore time.Time
+		update       *Operation
+		getID        string
+		expIDs       []string
+		expErr       error
+	}{
+		"empty": {
+			expIDs: []string{},
+		},
+		"get missing": {
+			startOps: []*Operation{mockOp("op1", OperationStateRunning, 0)},
+			getID:    "op2",
+			expErr:   FaultOperationNotFound("op2"),
+		},
+		"update missing": {
+			update: mockOp("op1", OperationStateFailed, 0),
+			expErr: FaultOperationNotFound("op1"),
+		},
+		"update": {
+			startOps: []*Operation{mockOp("op1", OperationStateRunning, 0)},
+			update:   mockOp("op1", OperationStateSucceeded, 0),
+			getID:    "op1",
+			expIDs:   []string{"op1"},
+		},
+		"list in creation order": {
+			startOps: []*Operation{
+				mockOp("op1", OperationStateRunning, time.Minute),
+				mockOp("op2", OperationStateRunning, time.Hour),
+			},
+			expIDs: []string{"op2", "op1"},
+		},
+		"remove old finished operations": {
+			startOps: []*Operation{
+				mockOp("op1", OperationStateSucceeded, 2*time.Hour),
+				mockOp("op2", OperationStateRunning, 2*time.Hour),
+				mockOp("op3", OperationStateFailed, time.Minute),
+				mockOp("op4", OperationStateCanceled, 3*time.Hour),
+			},
+			removeBefore: now.Add(-time.Hour),
+			expIDs:       []string{"op2", "op3"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			addReplicaMember(t, db, CurrentSchemaVersion)
+			for _, op := range tc.startOps {
+				lastUpdate := op.LastUpdate
+				if err := db.AddOperation(op); err != nil {
+					t.Fatal(err)
+				}
+				// Keep the mock update time for the removal test.
+				db.data.Operations.Operations[op.ID].LastUpdate = lastUpdate
+			}
+
+			if tc.update != nil {
+				err := db.UpdateOperation(tc.update)
+				test.CmpErr(t, tc.expErr, err)
+				if err != nil {
+					return
+				}
+			}
+
+			if !tc.removeBefore.IsZero() {
+				if err := db.RemoveFinishedOperations(tc.removeBefore); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if tc.getID != "" {
+				op, err := db.GetOperation(tc.getID)
+				test.CmpErr(t, tc.expErr, err)
+				if err != nil {
+					return
+				}
+				if tc.update != nil {
+					test.AssertEqual(t, tc.update.State, op.State, "unexpected state")
+				}
+			}
+
+			ops, err := db.OperationList()
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotIDs := []string{}
+			for _, op := range ops {
+				gotIDs = append(gotIDs, op.ID)
+			}
+			if diff := cmp.Diff(tc.expIDs, gotIDs); diff != "" {
+				t.Fatalf("unexpected operations (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+// addReplicaMember adds a member at the database's replica address that
+// supports the given schema version.
+func addReplicaMember(t *testing.T, db *Database, schemaVersion uint) {
+	t.Helper()
+
+	m := MockMember(t, 0, MemberStateJoined)
+	m.Addr = db.replicaAddr
+	m.DBSchemaVersion = schemaVersion
+	if err := db.AddMember(m); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSystem_Database_OperationsSchema(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	op := NewOperation("/mgmt.MgmtSvc/PoolCreate", nil, "admin")
+
+	// The replica has not joined, so may not support operations.
+	test.CmpErr(t, errors.New("does not support"), db.AddOperation(op))
+
+	addReplicaMember(t, db, 0)
+	test.CmpErr(t, errors.New("does not support"), db.AddOperation(op))
+	test.AssertEqual(t, uint(0), db.data.SchemaVersion, "unexpected schema version")
+
+	m, err := db.FindMemberByRank(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.DBSchemaVersion = CurrentSchemaVersion
+	if err := db.UpdateMember(m); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddOperation(op); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, uint(operationsSchemaVersion), db.data.SchemaVersion, "unexpected schema version")
+
+	notReplica := MockDatabaseWithAddr(t, log, nil)
+	_, err = notReplica.GetOperation(op.ID)
+	test.AssertTrue(t, IsNotReplica(err), "expected not replica error from GetOperation")
+	_, err = notReplica.OperationList()
+	test.AssertTrue(t, IsNotReplica(err), "expected not replica error from OperationList")
+}
+
 func TestSystem_Database_OnEvent(t *testing.T) {
 	puuid := uuid.New()
 	puuidAnother := uuid.New()
@@ -897,6 +1073,279 @@ func TestSystemDatabase_PoolServiceList(t *testing.T) {
 	}
 }
 
+func TestSystem_Database_FindPoolServiceByLabel(t *testing.T) {
+	ps := &PoolService{
+		PoolUUID:  uuid.New(),
+		PoolLabel: "current",
+		LabelAliases: []system.PoolLabelAlias{
+			{Label: "expired", Expires: time.Now().Add(-time.Minute)},
+			{Label: "previous", Expires: time.Now().Add(time.Hour)},
+		},
+		State:      system.PoolServiceStateReady,
+		Replicas:   []Rank{1},
+		LastUpdate: time.Now(),
+	}
+
+	for name, tc := range map[string]struct {
+		label       string
+		expErr      error
+		expAliasErr error
+	}{
+		"current label": {
+			label:       "current",
+			expAliasErr: system.ErrPoolLabelNotFound("current"),
+		},
+		"unexpired alias": {
+			label:  "previous",
+			expErr: system.ErrPoolLabelNotFound("previous"),
+		},
+		"expired alias": {
+			label:       "expired",
+			expErr:      system.ErrPoolLabelNotFound("expired"),
+			expAliasErr: system.ErrPoolLabelNotFound("expired"),
+		},
+		"unknown label": {
+			label:       "unknown",
+			expErr:      system.ErrPoolLabelNotFound("unknown"),
+			expAliasErr: system.ErrPoolLabelNotFound("unknown"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			db := MockDatabase(t, log)
+			lock, err := db.TakePoolLock(ctx, ps.PoolUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := db.AddPoolService(lock.InContext(ctx), ps); err != nil {
+				t.Fatal(err)
+			}
+			lock.Release()
+
+			for _, lookup := range []struct {
+				find   func(string) (*system.PoolService, error)
+				expErr error
+			}{
+				{db.FindPoolServiceByLabel, tc.expErr},
+				{db.FindPoolServiceByLabelAlias, tc.expAliasErr},
+			} {
+				gotPS, gotErr := lookup.find(tc.label)
+				test.CmpErr(t, lookup.expErr, gotErr)
+				if lookup.expErr != nil {
+					continue
+				}
+
+				test.AssertEqual(t, ps.PoolUUID, gotPS.PoolUUID, "unexpected pool")
+				test.AssertEqual(t, ps.PoolLabel, gotPS.PoolLabel, "unexpected pool label")
+			}
+		})
+	}
+}
+
+func TestSystem_Database_FindPoolServiceByIdempotencyKey(t *testing.T) {
+	mockKey := func(key string, ttl time.Duration) system.PoolIdempotencyKey {
+		return system.NewPoolIdempotencyKey(key, "/mgmt.MgmtSvc/PoolCreate", []byte{1}, ttl)
+	}
+	mockPool := func(idx int32, keys ...system.PoolIdempotencyKey) *system.PoolService {
+		return &system.PoolService{
+			PoolUUID:        uuid.MustParse(test.MockUUID(idx)),
+			PoolLabel:       fmt.Sprintf("pool%d", idx),
+			IdempotencyKeys: keys,
+			State:           system.PoolServiceStateReady,
+			Storage:         &system.PoolServiceStorage{},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		pools   []*system.PoolService
+		key     string
+		expUUID uuid.UUID
+		expErr  error
+	}{
+		"no pools": {
+			key:    "key1",
+			expErr: system.ErrPoolIdempotencyKeyNotFound("key1"),
+		},
+		"not found": {
+			pools:  []*system.PoolService{mockPool(1, mockKey("key2", time.Hour))},
+			key:    "key1",
+			expErr: system.ErrPoolIdempotencyKeyNotFound("key1"),
+		},
+		"found": {
+			pools: []*system.PoolService{
+				mockPool(1, mockKey("key2", time.Hour)),
+				mockPool(2, mockKey("key1", time.Hour)),
+			},
+			key:     "key1",
+			expUUID: uuid.MustParse(test.MockUUID(2)),
+		},
+		"expired": {
+			pools:  []*system.PoolService{mockPool(1, mockKey("key1", -time.Minute))},
+			key:    "key1",
+			expErr: system.ErrPoolIdempotencyKeyNotFound("key1"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for _, ps := range tc.pools {
+				data, err := createRaftUpdate(raftOpAddPoolService, ps)
+				if err != nil {
+					t.Fatal(err)
+				}
+				(*fsm)(db).Apply(&raft.Log{Data: data})
+			}
+
+			got, err := db.FindPoolServiceByIdempotencyKey(tc.key)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expUUID, got.PoolUUID, "unexpected pool")
+		})
+	}
+}
+
+func TestSystem_Database_DestroyedPoolTombstones(t *testing.T) {
+	createMethod := "/mgmt.MgmtSvc/PoolCreate"
+	destroyMethod := "/mgmt.MgmtSvc/PoolDestroy"
+	now := time.Now()
+	mockKey := func(key, method string, resp []byte, expires time.Time) system.PoolIdempotencyKey {
+		return system.PoolIdempotencyKey{Key: key, Method: method, Response: resp, Expires: expires}
+	}
+	mockPool := func(idx int32, keys ...system.PoolIdempotencyKey) *system.PoolService {
+		return &system.PoolService{
+			PoolUUID:        uuid.MustParse(test.MockUUID(idx)),
+			PoolLabel:       fmt.Sprintf("pool%d", idx),
+			IdempotencyKeys: keys,
+			State:           system.PoolServiceStateReady,
+			Storage:         &system.PoolServiceStorage{},
+		}
+	}
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	apply := func(op raftOp, ps *system.PoolService) {
+		t.Helper()
+		data, err := createRaftUpdate(op, ps)
+		if err != nil {
+			t.Fatal(err)
+		}
+		(*fsm)(db).Apply(&raft.Log{Data: data})
+	}
+
+	pools := []*system.PoolService{
+		// Destroy recorded with a key that expires after the removal.
+		mockPool(1,
+			mockKey("key1", createMethod, nil, now.Add(time.Hour)),
+			mockKey("key2", destroyMethod, []byte{}, now.Add(time.Hour))),
+		// Only a pending key, so no tombstone is kept.
+		mockPool(2, mockKey("key3", createMethod, nil, now.Add(time.Hour))),
+		// Key that expires before the next removal.
+		mockPool(3, mockKey("key4", destroyMethod, []byte{}, now.Add(time.Minute))),
+		mockPool(4),
+	}
+	for _, ps := range pools {
+		apply(raftOpAddPoolService, ps)
+	}
+
+	for _, ps := range pools[:3] {
+		ps.LastUpdate = now
+		apply(raftOpRemovePoolService, ps)
+	}
+
+	tomb, err := db.FindDestroyedPoolServiceByIdempotencyKey("key2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, pools[0].PoolUUID, tomb.PoolUUID, "unexpected tombstone")
+	test.AssertEqual(t, "pool1", tomb.PoolLabel, "unexpected tombstone label")
+	test.AssertEqual(t, 1, len(tomb.IdempotencyKeys), "pending keys should not be kept")
+	for _, key := range []string{"key1", "key3"} {
+		_, err := db.FindDestroyedPoolServiceByIdempotencyKey(key)
+		test.CmpErr(t, system.ErrPoolIdempotencyKeyNotFound(key), err)
+	}
+	if _, err := db.FindDestroyedPoolServiceByIdempotencyKey("key4"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Tombstones with expired keys are pruned by later removals.
+	pools[3].LastUpdate = now.Add(2 * time.Minute)
+	apply(raftOpRemovePoolService, pools[3])
+	test.AssertEqual(t, 1, len(db.data.Pools.Destroyed), "unexpected tombstones")
+	if _, found := db.data.Pools.Destroyed[pools[0].PoolUUID]; !found {
+		t.Fatal("unexpired tombstone was pruned")
+	}
+}
+
+func TestSystem_Database_checkIdempotencyKeys(t *testing.T) {
+	mockKey := func(key string) system.PoolIdempotencyKey {
+		return system.NewPoolIdempotencyKey(key, "/mgmt.MgmtSvc/PoolCreate", nil, time.Hour)
+	}
+	mockPool := func(idx int32, keys ...system.PoolIdempotencyKey) *system.PoolService {
+		return &system.PoolService{
+			PoolUUID:        uuid.MustParse(test.MockUUID(idx)),
+			PoolLabel:       fmt.Sprintf("pool%d", idx),
+			IdempotencyKeys: keys,
+			State:           system.PoolServiceStateReady,
+			Storage:         &system.PoolServiceStorage{},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		pools     []*system.PoolService
+		destroyed []*system.PoolService
+		ps        *system.PoolService
+		expErr    error
+	}{
+		"no keys": {
+			pools: []*system.PoolService{mockPool(1, mockKey("key1"))},
+			ps:    mockPool(2),
+		},
+		"unused key": {
+			pools: []*system.PoolService{mockPool(1, mockKey("key1"))},
+			ps:    mockPool(2, mockKey("key2")),
+		},
+		"key held by same pool": {
+			pools: []*system.PoolService{mockPool(1, mockKey("key1"))},
+			ps:    mockPool(1, mockKey("key1")),
+		},
+		"key held by another pool": {
+			pools:  []*system.PoolService{mockPool(1, mockKey("key1"))},
+			ps:     mockPool(2, mockKey("key1")),
+			expErr: errors.New("held by pool"),
+		},
+		"key held by destroyed pool": {
+			destroyed: []*system.PoolService{mockPool(1, mockKey("key1"))},
+			ps:        mockPool(2, mockKey("key1")),
+			expErr:    errors.New("held by pool"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for _, ps := range tc.pools {
+				db.data.Pools.addService(ps)
+			}
+			for _, ps := range tc.destroyed {
+				db.data.Pools.Destroyed[ps.PoolUUID] = ps
+			}
+
+			test.CmpErr(t, tc.expErr, db.checkIdempotencyKeys(tc.ps))
+		})
+	}
+}
+
 func TestSystem_Database_GroupMap(t *testing.T) {
 	membersWithStates := func(states ...MemberState) []*Member {
 		members := make([]*Member, len(states))
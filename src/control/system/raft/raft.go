@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -51,6 +52,9 @@ const (
 	raftOpUpdateCheckerFinding
 	raftOpRemoveCheckerFinding
 	raftOpClearCheckerFindings
+	raftOpAddOperation
+	raftOpUpdateOperation
+	raftOpRemoveOperation
 
 	sysDBFile = "daos_system.db"
 )
@@ -90,6 +94,9 @@ func (ro raftOp) String() string {
 		"updateCheckerFinding",
 		"removeCheckerFinding",
 		"clearCheckerFindings",
+		"addOperation",
+		"updateOperation",
+		"removeOperation",
 	}[ro]
 }
 
@@ -489,6 +496,22 @@ func (db *Database) submitCheckerUpdate(op raftOp, f *checker.Finding) error {
 	return db.submitRaftUpdate(data)
 }
 
+// submitOperationUpdate submits the given long-running operation update, if
+// all replicas are able to apply it.
+func (db *Database) submitOperationUpdate(op raftOp, o *system.Operation) error {
+	if err := db.checkReplicaSchema(operationsSchemaVersion); err != nil {
+		return errors.Wrapf(err, "unable to submit %s", op)
+	}
+
+	o.LastUpdate = time.Now()
+	data, err := createRaftUpdate(op, o)
+	if err != nil {
+		return err
+	}
+	db.log.Debugf("operation %s (%s) updated @ %s", o.ID, o.State, common.FormatTime(o.LastUpdate))
+	return db.submitRaftUpdate(data)
+}
+
 // submitRaftUpdate submits the serialized operation to the raft service.
 func (db *Database) submitRaftUpdate(data []byte) error {
 	return db.raft.withReadLock(func(svc raftService) error {
@@ -548,6 +571,8 @@ func (f *fsm) Apply(l *raft.Log) interface{} {
 		f.data.applySystemUpdate(c.Op, c.Data, f.EmergencyShutdown)
 	case raftOpAddCheckerFinding, raftOpUpdateCheckerFinding, raftOpRemoveCheckerFinding, raftOpClearCheckerFindings:
 		f.data.applyCheckerUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpAddOperation, raftOpUpdateOperation, raftOpRemoveOperation:
+		f.data.applyOperationUpdate(c.Op, c.Data, f.EmergencyShutdown)
 	default:
 		f.EmergencyShutdown(errors.Errorf("unhandled Apply operation: %d", c.Op))
 		return nil
@@ -696,6 +721,38 @@ func (d *dbData) applyCheckerUpdate(op raftOp, data []byte, panicFn func(error))
 	}
 }
 
+// applyOperationUpdate is responsible for applying the long-running
+// operation update to the database.
+func (d *dbData) applyOperationUpdate(op raftOp, data []byte, panicFn func(error)) {
+	o := new(system.Operation)
+	if err := json.Unmarshal(data, o); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode operation update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	var err error
+	switch op {
+	case raftOpAddOperation:
+		err = d.Operations.addOperation(o)
+	case raftOpUpdateOperation:
+		err = d.Operations.updateOperation(o)
+	case raftOpRemoveOperation:
+		err = d.Operations.removeOperation(o)
+	default:
+		err = errors.Errorf("unhandled Operation Apply operation: %d", op)
+	}
+	if err != nil {
+		panicFn(err)
+		return
+	}
+	if d.SchemaVersion < operationsSchemaVersion {
+		d.SchemaVersion = operationsSchemaVersion
+	}
+}
+
 // Snapshot is called to support log compaction, so that we don't have to keep
 // every log entry from the start of the system. Instead, the raft service periodically
 // creates a point-in-time snapshot which can be used to restore the current state, or
@@ -720,8 +777,8 @@ func (f *fsm) Restore(rc io.ReadCloser) error {
 		return err
 	}
 
-	if db.data.SchemaVersion != CurrentSchemaVersion {
-		return errors.Errorf("restored schema version %d != %d",
+	if db.data.SchemaVersion > CurrentSchemaVersion {
+		return errors.Errorf("restored schema version %d > %d",
 			db.data.SchemaVersion, CurrentSchemaVersion)
 	}
 
@@ -732,6 +789,8 @@ func (f *fsm) Restore(rc io.ReadCloser) error {
 	f.data.MapVersion = db.data.MapVersion
 	f.data.System = db.data.System
 	f.data.Checker = db.data.Checker
+	f.data.Operations = db.data.Operations
+	f.data.SchemaVersion = db.data.SchemaVersion
 	f.data.Version = db.data.Version
 	f.data.Unlock()
 	f.log.Debugf("db snapshot loaded (map version %d; data version %d)", db.data.MapVersion, db.data.Version)
@@ -43,6 +43,14 @@ struct stats_cycle {
 
 #define SW_CYCLE_MAX	10000
 
+/*
+ * Rebuild/Reintegration takes 30% CPU when there is no space pressure, the ratio
+ * can be lowered or raised per pool by the rebuild priority pool property.
+ */
+#define REBUILD_RATIO		30
+#define REBUILD_RATIO_LOW	10
+#define REBUILD_RATIO_HIGH	60
+
 struct stats_window {
 	/* All schedule cycles in the stats window */
 	struct stats_cycle	sw_cycles[SW_CYCLE_MAX];
@@ -147,6 +155,8 @@ struct sched_pool_info {
 	int			spi_gc_sleeping;
 	int			spi_ref;
 	uint32_t		spi_req_cnt;
+	/* Percentage of CPU taken by rebuild/reintegration ULTs */
+	uint32_t		spi_rebuild_ratio;
 	struct stats_window	spi_stats_window;
 };
 
@@ -640,6 +650,7 @@ cur_pool_info(struct sched_info *info, uuid_t pool_uuid)
 
 	D_INIT_LIST_HEAD(&spi->spi_hash_link);
 	uuid_copy(spi->spi_pool_id, pool_uuid);
+	spi->spi_rebuild_ratio = REBUILD_RATIO;
 
 	for (type = SCHED_REQ_UPDATE; type < SCHED_REQ_MAX; type++) {
 		list = pool2req_list(spi, type);
@@ -1065,15 +1076,13 @@ done:
 		apportion_wts(avail_wts, kick, SCHED_REQ_SCRUB);
 }
 
-/* Rebuild/Reintegration takes 30% CPU when there is no space pressure */
-#define REBUILD_RATIO	30
-
 /*
  * When there is no space pressure, all IO requests will be kicked off immediately,
  * internal sys ULTs will be throttled.
  */
 static void
-throttle_sys(struct stats_window *sw, uint32_t *kick, struct pressure_ratio *pr)
+throttle_sys(struct stats_window *sw, uint32_t *kick, struct pressure_ratio *pr,
+	     uint32_t rebuild_ratio)
 {
 	uint64_t	*kicked_wts, io_wts, tot_wts, avail_wts;
 	unsigned int	 io_ratio;
@@ -1089,7 +1098,7 @@ throttle_sys(struct stats_window *sw, uint32_t *kick, struct pressure_ratio *pr)
 		return;
 
 	if (kicked_wts[SCHED_REQ_MIGRATE] != 0 || kick[SCHED_REQ_MIGRATE] != 0)
-		io_ratio = 100 - REBUILD_RATIO;
+		io_ratio = 100 - rebuild_ratio;
 	else
 		io_ratio = 100 - pr->pr_gc_ratio;
 
@@ -1172,7 +1181,8 @@ process_pool_cb(d_list_t *rlink, void *arg)
 	pr = &pressure_gauge[press];
 
 	if (press == SCHED_SPACE_PRESS_NONE)
-		throttle_sys(&spi->spi_stats_window, &kick[SCHED_REQ_UPDATE], pr);
+		throttle_sys(&spi->spi_stats_window, &kick[SCHED_REQ_UPDATE], pr,
+			     spi->spi_rebuild_ratio);
 	else
 		throttle_io(info, spi, &kick[SCHED_REQ_UPDATE], pr);
 
@@ -1527,6 +1537,31 @@ req_wakeup_internal(struct dss_xstream *dx, struct sched_request *req)
 	ABT_thread_resume(req->sr_ult);
 }
 
+int
+sched_set_rebuild_priority(uuid_t pool_uuid, unsigned int priority)
+{
+	struct dss_xstream	*dx = dss_current_xstream();
+	struct sched_pool_info	*spi;
+
+	spi = cur_pool_info(&dx->dx_sched_info, pool_uuid);
+	if (spi == NULL)
+		return -DER_NOMEM;
+
+	switch (priority) {
+	case DAOS_REBUILD_PRIORITY_LOW:
+		spi->spi_rebuild_ratio = REBUILD_RATIO_LOW;
+		break;
+	case DAOS_REBUILD_PRIORITY_HIGH:
+		spi->spi_rebuild_ratio = REBUILD_RATIO_HIGH;
+		break;
+	default:
+		spi->spi_rebuild_ratio = REBUILD_RATIO;
+		break;
+	}
+
+	return 0;
+}
+
 void
 sched_req_wakeup(struct sched_request *req)
 {
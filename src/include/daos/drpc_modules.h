@@ -23,6 +23,7 @@ enum drpc_module {
 	DRPC_MODULE_MGMT		= 2,	/* daos_server mgmt */
 	DRPC_MODULE_SRV			= 3,	/* daos_server */
 	DRPC_MODULE_SEC			= 4,	/* daos_server security */
+	DRPC_MODULE_AGENT_ADMIN		= 5,	/* daos_agent administration */
 
 	NUM_DRPC_MODULES			/* Must be last */
 };
@@ -75,6 +76,7 @@ enum drpc_mgmt_method {
 	DRPC_METHOD_MGMT_CHK_PROP               = 245,
 	DRPC_METHOD_MGMT_CHK_ACT                = 246,
 	DRPC_METHOD_MGMT_SETUP_CLIENT_TELEM     = 247,
+	DRPC_METHOD_MGMT_GET_ACL_TEMPLATE       = 248,
 
 	NUM_DRPC_MGMT_METHODS /* Must be last */
 };
@@ -99,4 +101,12 @@ enum drpc_sec_method {
 	NUM_DRPC_SEC_METHODS			/* Must be last */
 };
 
+enum drpc_agent_admin_method {
+	DRPC_METHOD_AGENT_ADMIN_LIST_CLIENTS	= 501,
+	DRPC_METHOD_AGENT_ADMIN_EVICT_CLIENT	= 502,
+	DRPC_METHOD_AGENT_ADMIN_RELOAD_CONFIG	= 503,
+
+	NUM_DRPC_AGENT_ADMIN_METHODS		/* Must be last */
+};
+
 #endif /* __DAOS_DRPC_MODULES_H__ */
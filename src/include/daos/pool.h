@@ -62,7 +62,10 @@
 #define DAOS_PO_QUERY_PROP_REINT_MODE		(1ULL << (PROP_BIT_START + 24))
 #define DAOS_PO_QUERY_PROP_SVC_OPS_ENABLED      (1ULL << (PROP_BIT_START + 25))
 #define DAOS_PO_QUERY_PROP_SVC_OPS_ENTRY_AGE    (1ULL << (PROP_BIT_START + 26))
-#define DAOS_PO_QUERY_PROP_BIT_END              42
+#define DAOS_PO_QUERY_PROP_REBUILD_THROTTLE     (1ULL << (PROP_BIT_START + 27))
+#define DAOS_PO_QUERY_PROP_REBUILD_PRIORITY     (1ULL << (PROP_BIT_START + 28))
+#define DAOS_PO_QUERY_PROP_REBUILD_PAUSE        (1ULL << (PROP_BIT_START + 29))
+#define DAOS_PO_QUERY_PROP_BIT_END              45
 
 #define DAOS_PO_QUERY_PROP_ALL                                                                     \
 	(DAOS_PO_QUERY_PROP_LABEL | DAOS_PO_QUERY_PROP_SPACE_RB | DAOS_PO_QUERY_PROP_SELF_HEAL |   \
@@ -76,7 +79,9 @@
 	 DAOS_PO_QUERY_PROP_OBJ_VERSION | DAOS_PO_QUERY_PROP_PERF_DOMAIN |                         \
 	 DAOS_PO_QUERY_PROP_CHECKPOINT_MODE | DAOS_PO_QUERY_PROP_CHECKPOINT_FREQ |                 \
 	 DAOS_PO_QUERY_PROP_CHECKPOINT_THRESH | DAOS_PO_QUERY_PROP_REINT_MODE |                    \
-	 DAOS_PO_QUERY_PROP_SVC_OPS_ENABLED | DAOS_PO_QUERY_PROP_SVC_OPS_ENTRY_AGE)
+	 DAOS_PO_QUERY_PROP_SVC_OPS_ENABLED | DAOS_PO_QUERY_PROP_SVC_OPS_ENTRY_AGE |               \
+	 DAOS_PO_QUERY_PROP_REBUILD_THROTTLE | DAOS_PO_QUERY_PROP_REBUILD_PRIORITY |               \
+	 DAOS_PO_QUERY_PROP_REBUILD_PAUSE)
 
 /*
  * Version 1 corresponds to 2.2 (aggregation optimizations)
@@ -140,6 +140,12 @@ enum daos_pool_props {
 	DAOS_PROP_PO_SVC_OPS_ENABLED,
 	/** Metadata duplicate operations SVC_OPS KVS max entry age (seconds), default 300 */
 	DAOS_PROP_PO_SVC_OPS_ENTRY_AGE,
+	/** Percentage of the migration in-flight budget available to rebuild, default 100% */
+	DAOS_PROP_PO_REBUILD_THROTTLE,
+	/** Share of engine scheduling given to rebuild, low|normal|high default is normal */
+	DAOS_PROP_PO_REBUILD_PRIORITY,
+	/** Rebuild data movement paused (1) or active (0) */
+	DAOS_PROP_PO_REBUILD_PAUSE,
 	DAOS_PROP_PO_MAX,
 };
 
@@ -225,6 +231,28 @@ enum {
  */
 #define DAOS_PROP_PO_REINT_MODE_DEFAULT	DAOS_REINT_MODE_DATA_SYNC
 
+/** Rebuild throttle percentages */
+#define DAOS_PROP_PO_REBUILD_THROTTLE_MIN	1
+#define DAOS_PROP_PO_REBUILD_THROTTLE_MAX	100
+#define DAOS_PROP_PO_REBUILD_THROTTLE_DEFAULT	DAOS_PROP_PO_REBUILD_THROTTLE_MAX
+
+/** Rebuild scheduling priority */
+enum {
+	DAOS_REBUILD_PRIORITY_LOW = 0,
+	DAOS_REBUILD_PRIORITY_NORMAL = 1,
+	DAOS_REBUILD_PRIORITY_HIGH = 2,
+};
+
+#define DAOS_PROP_PO_REBUILD_PRIORITY_DEFAULT	DAOS_REBUILD_PRIORITY_NORMAL
+
+/** Rebuild pause state */
+enum {
+	DAOS_REBUILD_PAUSE_OFF = 0,
+	DAOS_REBUILD_PAUSE_ON = 1,
+};
+
+#define DAOS_PROP_PO_REBUILD_PAUSE_DEFAULT	DAOS_REBUILD_PAUSE_OFF
+
 /**
  * Pool checksum scrubbing schedule type
  * It is expected that these stay contiguous.
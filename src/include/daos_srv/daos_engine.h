@@ -268,6 +268,17 @@ bool sched_req_is_aborted(struct sched_request *req);
  */
 int sched_req_space_check(struct sched_request *req);
 
+/**
+ * Set the share of CPU given to rebuild/reintegration ULTs of the pool on
+ * current xstream when there is no space pressure.
+ *
+ * \param[in] pool_uuid	Pool UUID.
+ * \param[in] priority	DAOS_REBUILD_PRIORITY_LOW|NORMAL|HIGH.
+ *
+ * \retval		Zero on success, negative value on error.
+ */
+int sched_set_rebuild_priority(uuid_t pool_uuid, unsigned int priority);
+
 /**
  * Wrapper of ABT_cond_wait(), inform scheduler that it's going
  * to be blocked for a relative long time.
@@ -126,6 +126,10 @@ struct ds_pool {
 	uint32_t                 sp_checkpoint_freq;
 	uint32_t                 sp_checkpoint_thresh;
 	uint32_t		 sp_reint_mode;
+	/** rebuild throttle percentage, priority and pause state */
+	uint32_t		 sp_rebuild_throttle;
+	uint32_t		 sp_rebuild_priority;
+	uint32_t		 sp_rebuild_pause;
 };
 
 int ds_pool_lookup(const uuid_t uuid, struct ds_pool **pool);
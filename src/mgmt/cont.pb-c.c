@@ -52,7 +52,7 @@ void   mgmt__cont_set_owner_req__free_unpacked
   assert(message->base.descriptor == &mgmt__cont_set_owner_req__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-static const ProtobufCFieldDescriptor mgmt__cont_set_owner_req__field_descriptors[6] =
+static const ProtobufCFieldDescriptor mgmt__cont_set_owner_req__field_descriptors[7] =
 {
   {
     "sys",
@@ -126,9 +126,22 @@ static const ProtobufCFieldDescriptor mgmt__cont_set_owner_req__field_descriptor
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "idempotency_key",
+    7,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ContSetOwnerReq, idempotency_key),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__cont_set_owner_req__field_indices_by_name[] = {
   1,   /* field[1] = cont_id */
+  6,   /* field[6] = idempotency_key */
   4,   /* field[4] = owner_group */
   3,   /* field[3] = owner_user */
   2,   /* field[2] = pool_id */
@@ -138,7 +151,7 @@ static const unsigned mgmt__cont_set_owner_req__field_indices_by_name[] = {
 static const ProtobufCIntRange mgmt__cont_set_owner_req__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 6 }
+  { 0, 7 }
 };
 const ProtobufCMessageDescriptor mgmt__cont_set_owner_req__descriptor =
 {
@@ -148,7 +161,7 @@ const ProtobufCMessageDescriptor mgmt__cont_set_owner_req__descriptor =
   "Mgmt__ContSetOwnerReq",
   "mgmt",
   sizeof(Mgmt__ContSetOwnerReq),
-  6,
+  7,
   mgmt__cont_set_owner_req__field_descriptors,
   mgmt__cont_set_owner_req__field_indices_by_name,
   1,  mgmt__cont_set_owner_req__number_ranges,
@@ -54,10 +54,14 @@ struct  _Mgmt__ContSetOwnerReq
    */
   size_t n_svc_ranks;
   uint32_t *svc_ranks;
+  /*
+   * Optional key identifying retries of the request
+   */
+  char *idempotency_key;
 };
 #define MGMT__CONT_SET_OWNER_REQ__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__cont_set_owner_req__descriptor) \
-    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, (char *)protobuf_c_empty_string }
 
 
 /* Mgmt__ContSetOwnerReq methods */
@@ -213,10 +213,14 @@ struct  _Mgmt__PoolCreateReq
    * Fraction of meta-blob-sz to use as mem-file-sz
    */
   float mem_ratio;
+  /*
+   * Optional key identifying retries of the request
+   */
+  char *idempotency_key;
 };
 #define MGMT__POOL_CREATE_REQ__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_create_req__descriptor) \
-    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0,NULL, 0, 0, 0,NULL, 0, 0,NULL, 0,NULL, 0 }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0,NULL, 0, 0, 0,NULL, 0, 0,NULL, 0,NULL, 0, (char *)protobuf_c_empty_string }
 
 
 /*
@@ -256,10 +260,14 @@ struct  _Mgmt__PoolCreateResp
    * MD-on-SSD mode flag
    */
   protobuf_c_boolean md_on_ssd_active;
+  /*
+   * pool UUID, set by the MS for requests with an idempotency key
+   */
+  char *uuid;
 };
 #define MGMT__POOL_CREATE_RESP__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_create_resp__descriptor) \
-    , 0, 0, 0,NULL, 0,NULL, 0,NULL, 0, 0 }
+    , 0, 0, 0,NULL, 0,NULL, 0,NULL, 0, 0, (char *)protobuf_c_empty_string }
 
 
 /*
@@ -289,10 +297,14 @@ struct  _Mgmt__PoolDestroyReq
    * destroy regardless of any child containers
    */
   protobuf_c_boolean recursive;
+  /*
+   * Optional key identifying retries of the request
+   */
+  char *idempotency_key;
 };
 #define MGMT__POOL_DESTROY_REQ__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_destroy_req__descriptor) \
-    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0,NULL, 0 }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0,NULL, 0, (char *)protobuf_c_empty_string }
 
 
 /*
@@ -787,10 +799,26 @@ struct  _Mgmt__PoolRebuildStatus
   Mgmt__PoolRebuildStatus__State state;
   uint64_t objects;
   uint64_t records;
+  /*
+   * objects to be rebuilt
+   */
+  uint64_t total_objects;
+  /*
+   * objects rebuilt per second, tracked by the MS
+   */
+  double object_rate;
+  /*
+   * records rebuilt per second, tracked by the MS
+   */
+  double record_rate;
+  /*
+   * estimated seconds until completion, tracked by the MS
+   */
+  uint64_t eta_seconds;
 };
 #define MGMT__POOL_REBUILD_STATUS__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_rebuild_status__descriptor) \
-    , 0, MGMT__POOL_REBUILD_STATUS__STATE__IDLE, 0, 0 }
+    , 0, MGMT__POOL_REBUILD_STATUS__STATE__IDLE, 0, 0, 0, 0, 0, 0 }
 
 
 /*
@@ -889,10 +917,14 @@ struct  _Mgmt__PoolQueryResp
    * MD-on-SSD mode flag
    */
   protobuf_c_boolean md_on_ssd_active;
+  /*
+   * set if the pool was identified by a deprecated label
+   */
+  char *label_warning;
 };
 #define MGMT__POOL_QUERY_RESP__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_query_resp__descriptor) \
-    , 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, 0, NULL, 0,NULL, 0, 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, 0, MGMT__POOL_SERVICE_STATE__Creating, 0, 0,NULL, 0, 0, (char *)protobuf_c_empty_string, 0 }
+    , 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, 0, NULL, 0,NULL, 0, 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, 0, MGMT__POOL_SERVICE_STATE__Creating, 0, 0,NULL, 0, 0, (char *)protobuf_c_empty_string, 0, (char *)protobuf_c_empty_string }
 
 
 typedef enum {
@@ -39,6 +39,8 @@
 /* Max migrate ULT number on the server */
 #define MIGRATE_DEFAULT_MAX_ULT	4096
 #define ENV_MIGRATE_ULT_CNT	"D_MIGRATE_ULT_CNT"
+/* Interval to check if paused rebuild has been resumed, in msecs */
+#define MIGRATE_PAUSE_INTERVAL	1000
 struct migrate_one {
 	daos_key_t		 mo_dkey;
 	uint64_t		 mo_dkey_hash;
@@ -1961,6 +1963,37 @@ out:
 	return rc;
 }
 
+/* Max in-flight ULTs on the target xstream, scaled by the rebuild throttle of the pool. */
+static uint32_t
+migrate_tgt_max_ult(struct migrate_pool_tls *tls)
+{
+	uint32_t throttle = tls->mpt_pool->spc_pool->sp_rebuild_throttle;
+
+	if (throttle == 0 || throttle >= DAOS_PROP_PO_REBUILD_THROTTLE_MAX)
+		return tls->mpt_inflight_max_ult;
+
+	/* Leave room for at least one dkey ULT */
+	return max(tls->mpt_inflight_max_ult * throttle / 100, 2U);
+}
+
+/* Max in-flight data size on the target xstream, scaled by the rebuild throttle of the pool. */
+static uint64_t
+migrate_tgt_max_size(struct migrate_pool_tls *tls)
+{
+	uint32_t throttle = tls->mpt_pool->spc_pool->sp_rebuild_throttle;
+
+	if (throttle == 0 || throttle >= DAOS_PROP_PO_REBUILD_THROTTLE_MAX)
+		return tls->mpt_inflight_max_size;
+
+	return tls->mpt_inflight_max_size * throttle / 100;
+}
+
+static bool
+migrate_tgt_paused(struct migrate_pool_tls *tls)
+{
+	return tls->mpt_pool->spc_pool->sp_rebuild_pause == DAOS_REBUILD_PAUSE_ON;
+}
+
 static int
 migrate_tgt_enter(struct migrate_pool_tls *tls)
 {
@@ -1970,9 +2003,9 @@ migrate_tgt_enter(struct migrate_pool_tls *tls)
 	D_ASSERT(dss_get_module_info()->dmi_xs_id != 0);
 
 	dkey_cnt = atomic_load(tls->mpt_tgt_dkey_ult_cnt);
-	while (tls->mpt_inflight_max_ult / 2 <= dkey_cnt) {
+	while (migrate_tgt_max_ult(tls) / 2 <= dkey_cnt) {
 		D_DEBUG(DB_REBUILD, DF_RB ": tgt %u max %u\n", DP_RB_MPT(tls), dkey_cnt,
-			tls->mpt_inflight_max_ult);
+			migrate_tgt_max_ult(tls));
 
 		ABT_mutex_lock(tls->mpt_inflight_mutex);
 		ABT_cond_wait(tls->mpt_inflight_cond, tls->mpt_inflight_mutex);
@@ -2029,7 +2062,7 @@ migrate_tgt_try_wakeup(struct migrate_pool_tls *tls)
 
 	D_ASSERT(dss_get_module_info()->dmi_xs_id != 0);
 	dkey_cnt = atomic_load(tls->mpt_tgt_dkey_ult_cnt);
-	if (tls->mpt_inflight_max_ult / 2 > dkey_cnt) {
+	if (migrate_tgt_max_ult(tls) / 2 > dkey_cnt) {
 		ABT_mutex_lock(tls->mpt_inflight_mutex);
 		ABT_cond_broadcast(tls->mpt_inflight_cond);
 		ABT_mutex_unlock(tls->mpt_inflight_mutex);
@@ -2076,13 +2109,19 @@ migrate_one_ult(void *arg)
 
 	D_ASSERT(data_size != (daos_size_t)-1);
 	D_DEBUG(DB_REBUILD, DF_RB ": mrone %p inflight_size " DF_U64 " max " DF_U64 "\n",
-		DP_RB_MPT(tls), mrone, tls->mpt_inflight_size, tls->mpt_inflight_max_size);
+		DP_RB_MPT(tls), mrone, tls->mpt_inflight_size, migrate_tgt_max_size(tls));
+
+	while (migrate_tgt_paused(tls) && !tls->mpt_fini) {
+		D_DEBUG(DB_REBUILD, DF_RB ": mrone %p wait for rebuild to be resumed\n",
+			DP_RB_MPT(tls), mrone);
+		dss_sleep(MIGRATE_PAUSE_INTERVAL);
+	}
 
-	while (tls->mpt_inflight_size + data_size >= tls->mpt_inflight_max_size &&
-	       tls->mpt_inflight_max_size != 0 && tls->mpt_inflight_size != 0 &&
+	while (tls->mpt_inflight_size + data_size >= migrate_tgt_max_size(tls) &&
+	       migrate_tgt_max_size(tls) != 0 && tls->mpt_inflight_size != 0 &&
 	       !tls->mpt_fini) {
 		D_DEBUG(DB_REBUILD, DF_RB ": mrone %p wait " DF_U64 "/" DF_U64 "/" DF_U64 "\n",
-			DP_RB_MPT(tls), mrone, tls->mpt_inflight_size, tls->mpt_inflight_max_size,
+			DP_RB_MPT(tls), mrone, tls->mpt_inflight_size, migrate_tgt_max_size(tls),
 			data_size);
 		ABT_mutex_lock(tls->mpt_inflight_mutex);
 		ABT_cond_wait(tls->mpt_inflight_cond, tls->mpt_inflight_mutex);
@@ -334,6 +334,15 @@ pool_query_bits(daos_pool_info_t *po_info, daos_prop_t *prop)
 		case DAOS_PROP_PO_SVC_OPS_ENTRY_AGE:
 			bits |= DAOS_PO_QUERY_PROP_SVC_OPS_ENTRY_AGE;
 			break;
+		case DAOS_PROP_PO_REBUILD_THROTTLE:
+			bits |= DAOS_PO_QUERY_PROP_REBUILD_THROTTLE;
+			break;
+		case DAOS_PROP_PO_REBUILD_PRIORITY:
+			bits |= DAOS_PO_QUERY_PROP_REBUILD_PRIORITY;
+			break;
+		case DAOS_PROP_PO_REBUILD_PAUSE:
+			bits |= DAOS_PO_QUERY_PROP_REBUILD_PAUSE;
+			break;
 		default:
 			D_ERROR("ignore bad dpt_type %d.\n", entry->dpe_type);
 			break;
@@ -102,6 +102,9 @@ struct pool_iv_prop {
 	uint32_t	pip_reint_mode;
 	uint32_t         pip_svc_ops_enabled;
 	uint32_t         pip_svc_ops_entry_age;
+	uint32_t         pip_rebuild_throttle;
+	uint32_t         pip_rebuild_priority;
+	uint32_t         pip_rebuild_pause;
 	char		pip_iv_buf[0];
 };
 
@@ -224,6 +224,15 @@ pool_iv_prop_l2g(daos_prop_t *prop, struct pool_iv_prop *iv_prop)
 		case DAOS_PROP_PO_SVC_OPS_ENTRY_AGE:
 			iv_prop->pip_svc_ops_entry_age = prop_entry->dpe_val;
 			break;
+		case DAOS_PROP_PO_REBUILD_THROTTLE:
+			iv_prop->pip_rebuild_throttle = prop_entry->dpe_val;
+			break;
+		case DAOS_PROP_PO_REBUILD_PRIORITY:
+			iv_prop->pip_rebuild_priority = prop_entry->dpe_val;
+			break;
+		case DAOS_PROP_PO_REBUILD_PAUSE:
+			iv_prop->pip_rebuild_pause = prop_entry->dpe_val;
+			break;
 		default:
 			D_ASSERTF(0, "bad dpe_type %d\n", prop_entry->dpe_type);
 			break;
@@ -364,6 +373,15 @@ pool_iv_prop_g2l(struct pool_iv_prop *iv_prop, daos_prop_t *prop)
 		case DAOS_PROP_PO_SVC_OPS_ENTRY_AGE:
 			prop_entry->dpe_val = iv_prop->pip_svc_ops_entry_age;
 			break;
+		case DAOS_PROP_PO_REBUILD_THROTTLE:
+			prop_entry->dpe_val = iv_prop->pip_rebuild_throttle;
+			break;
+		case DAOS_PROP_PO_REBUILD_PRIORITY:
+			prop_entry->dpe_val = iv_prop->pip_rebuild_priority;
+			break;
+		case DAOS_PROP_PO_REBUILD_PAUSE:
+			prop_entry->dpe_val = iv_prop->pip_rebuild_pause;
+			break;
 		default:
 			D_ASSERTF(0, "bad dpe_type %d\n", prop_entry->dpe_type);
 			break;
@@ -53,6 +53,9 @@ RDB_STRING_KEY(ds_pool_prop_, checkpoint_mode);
 RDB_STRING_KEY(ds_pool_prop_, checkpoint_freq);
 RDB_STRING_KEY(ds_pool_prop_, checkpoint_thresh);
 RDB_STRING_KEY(ds_pool_prop_, reint_mode);
+RDB_STRING_KEY(ds_pool_prop_, rebuild_throttle);
+RDB_STRING_KEY(ds_pool_prop_, rebuild_priority);
+RDB_STRING_KEY(ds_pool_prop_, rebuild_pause);
 
 /** default properties, should cover all optional pool properties */
 struct daos_prop_entry pool_prop_entries_default[DAOS_PROP_PO_NUM] = {
@@ -162,6 +165,18 @@ struct daos_prop_entry pool_prop_entries_default[DAOS_PROP_PO_NUM] = {
     {
 	.dpe_type = DAOS_PROP_PO_SVC_OPS_ENTRY_AGE,
 	.dpe_val  = DAOS_PROP_PO_SVC_OPS_ENTRY_AGE_DEFAULT,
+    },
+    {
+	.dpe_type = DAOS_PROP_PO_REBUILD_THROTTLE,
+	.dpe_val  = DAOS_PROP_PO_REBUILD_THROTTLE_DEFAULT,
+    },
+    {
+	.dpe_type = DAOS_PROP_PO_REBUILD_PRIORITY,
+	.dpe_val  = DAOS_PROP_PO_REBUILD_PRIORITY_DEFAULT,
+    },
+    {
+	.dpe_type = DAOS_PROP_PO_REBUILD_PAUSE,
+	.dpe_val  = DAOS_PROP_PO_REBUILD_PAUSE_DEFAULT,
     }};
 
 daos_prop_t pool_prop_default = {
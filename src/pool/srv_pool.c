@@ -436,6 +436,9 @@ pool_prop_default_copy(daos_prop_t *prop_def, daos_prop_t *prop)
 		case DAOS_PROP_PO_CHECKPOINT_THRESH:
 		case DAOS_PROP_PO_CHECKPOINT_FREQ:
 		case DAOS_PROP_PO_REINT_MODE:
+		case DAOS_PROP_PO_REBUILD_THROTTLE:
+		case DAOS_PROP_PO_REBUILD_PRIORITY:
+		case DAOS_PROP_PO_REBUILD_PAUSE:
 			entry_def->dpe_val = entry->dpe_val;
 			break;
 		case DAOS_PROP_PO_ACL:
@@ -740,6 +743,27 @@ pool_prop_write(struct rdb_tx *tx, const rdb_path_t *kvs, daos_prop_t *prop)
 			if (rc)
 				return rc;
 			break;
+		case DAOS_PROP_PO_REBUILD_THROTTLE:
+			val32 = entry->dpe_val;
+			d_iov_set(&value, &val32, sizeof(val32));
+			rc = rdb_tx_update(tx, kvs, &ds_pool_prop_rebuild_throttle, &value);
+			if (rc)
+				return rc;
+			break;
+		case DAOS_PROP_PO_REBUILD_PRIORITY:
+			val32 = entry->dpe_val;
+			d_iov_set(&value, &val32, sizeof(val32));
+			rc = rdb_tx_update(tx, kvs, &ds_pool_prop_rebuild_priority, &value);
+			if (rc)
+				return rc;
+			break;
+		case DAOS_PROP_PO_REBUILD_PAUSE:
+			val32 = entry->dpe_val;
+			d_iov_set(&value, &val32, sizeof(val32));
+			rc = rdb_tx_update(tx, kvs, &ds_pool_prop_rebuild_pause, &value);
+			if (rc)
+				return rc;
+			break;
 		default:
 			D_ERROR("bad dpe_type %d.\n", entry->dpe_type);
 			return -DER_INVAL;
@@ -3368,6 +3392,54 @@ pool_prop_read(struct rdb_tx *tx, const struct pool_svc *svc, uint64_t bits,
 		idx++;
 	}
 
+	if (bits & DAOS_PO_QUERY_PROP_REBUILD_THROTTLE) {
+		d_iov_set(&value, &val32, sizeof(val32));
+		rc = rdb_tx_lookup(tx, &svc->ps_root, &ds_pool_prop_rebuild_throttle, &value);
+		if (rc == -DER_NONEXIST) { /* needs to be upgraded */
+			rc    = 0;
+			val32 = DAOS_PROP_PO_REBUILD_THROTTLE_DEFAULT;
+			prop->dpp_entries[idx].dpe_flags |= DAOS_PROP_ENTRY_NOT_SET;
+		} else if (rc != 0) {
+			D_GOTO(out_prop, rc);
+		}
+		D_ASSERT(idx < nr);
+		prop->dpp_entries[idx].dpe_type = DAOS_PROP_PO_REBUILD_THROTTLE;
+		prop->dpp_entries[idx].dpe_val  = val32;
+		idx++;
+	}
+
+	if (bits & DAOS_PO_QUERY_PROP_REBUILD_PRIORITY) {
+		d_iov_set(&value, &val32, sizeof(val32));
+		rc = rdb_tx_lookup(tx, &svc->ps_root, &ds_pool_prop_rebuild_priority, &value);
+		if (rc == -DER_NONEXIST) { /* needs to be upgraded */
+			rc    = 0;
+			val32 = DAOS_PROP_PO_REBUILD_PRIORITY_DEFAULT;
+			prop->dpp_entries[idx].dpe_flags |= DAOS_PROP_ENTRY_NOT_SET;
+		} else if (rc != 0) {
+			D_GOTO(out_prop, rc);
+		}
+		D_ASSERT(idx < nr);
+		prop->dpp_entries[idx].dpe_type = DAOS_PROP_PO_REBUILD_PRIORITY;
+		prop->dpp_entries[idx].dpe_val  = val32;
+		idx++;
+	}
+
+	if (bits & DAOS_PO_QUERY_PROP_REBUILD_PAUSE) {
+		d_iov_set(&value, &val32, sizeof(val32));
+		rc = rdb_tx_lookup(tx, &svc->ps_root, &ds_pool_prop_rebuild_pause, &value);
+		if (rc == -DER_NONEXIST) { /* needs to be upgraded */
+			rc    = 0;
+			val32 = DAOS_PROP_PO_REBUILD_PAUSE_DEFAULT;
+			prop->dpp_entries[idx].dpe_flags |= DAOS_PROP_ENTRY_NOT_SET;
+		} else if (rc != 0) {
+			D_GOTO(out_prop, rc);
+		}
+		D_ASSERT(idx < nr);
+		prop->dpp_entries[idx].dpe_type = DAOS_PROP_PO_REBUILD_PAUSE;
+		prop->dpp_entries[idx].dpe_val  = val32;
+		idx++;
+	}
+
 	*prop_out = prop;
 	return 0;
 
@@ -5140,6 +5212,9 @@ pool_query_handler(crt_rpc_t *rpc, int handler_version)
 			case DAOS_PROP_PO_REINT_MODE:
 			case DAOS_PROP_PO_SVC_OPS_ENABLED:
 			case DAOS_PROP_PO_SVC_OPS_ENTRY_AGE:
+			case DAOS_PROP_PO_REBUILD_THROTTLE:
+			case DAOS_PROP_PO_REBUILD_PRIORITY:
+			case DAOS_PROP_PO_REBUILD_PAUSE:
 			case DAOS_PROP_PO_DATA_THRESH:
 				if (entry->dpe_val != iv_entry->dpe_val) {
 					D_ERROR("type %d mismatch "DF_U64" - "
@@ -5802,6 +5877,48 @@ pool_upgrade_props(struct rdb_tx *tx, struct pool_svc *svc, uuid_t pool_uuid, cr
 		need_commit = true;
 	}
 
+	d_iov_set(&value, &val32, sizeof(val32));
+	rc = rdb_tx_lookup(tx, &svc->ps_root, &ds_pool_prop_rebuild_throttle, &value);
+	if (rc && rc != -DER_NONEXIST) {
+		D_GOTO(out_free, rc);
+	} else if (rc == -DER_NONEXIST) {
+		val32 = DAOS_PROP_PO_REBUILD_THROTTLE_DEFAULT;
+		rc = rdb_tx_update(tx, &svc->ps_root, &ds_pool_prop_rebuild_throttle, &value);
+		if (rc != 0) {
+			D_ERROR("failed to write pool rebuild throttle prop, "DF_RC"\n", DP_RC(rc));
+			D_GOTO(out_free, rc);
+		}
+		need_commit = true;
+	}
+
+	d_iov_set(&value, &val32, sizeof(val32));
+	rc = rdb_tx_lookup(tx, &svc->ps_root, &ds_pool_prop_rebuild_priority, &value);
+	if (rc && rc != -DER_NONEXIST) {
+		D_GOTO(out_free, rc);
+	} else if (rc == -DER_NONEXIST) {
+		val32 = DAOS_PROP_PO_REBUILD_PRIORITY_DEFAULT;
+		rc = rdb_tx_update(tx, &svc->ps_root, &ds_pool_prop_rebuild_priority, &value);
+		if (rc != 0) {
+			D_ERROR("failed to write pool rebuild priority prop, "DF_RC"\n", DP_RC(rc));
+			D_GOTO(out_free, rc);
+		}
+		need_commit = true;
+	}
+
+	d_iov_set(&value, &val32, sizeof(val32));
+	rc = rdb_tx_lookup(tx, &svc->ps_root, &ds_pool_prop_rebuild_pause, &value);
+	if (rc && rc != -DER_NONEXIST) {
+		D_GOTO(out_free, rc);
+	} else if (rc == -DER_NONEXIST) {
+		val32 = DAOS_PROP_PO_REBUILD_PAUSE_DEFAULT;
+		rc = rdb_tx_update(tx, &svc->ps_root, &ds_pool_prop_rebuild_pause, &value);
+		if (rc != 0) {
+			D_ERROR("failed to write pool rebuild pause prop, "DF_RC"\n", DP_RC(rc));
+			D_GOTO(out_free, rc);
+		}
+		need_commit = true;
+	}
+
 	rc = rdb_tx_lookup(tx, &svc->ps_root, &ds_pool_prop_upgrade_global_version,
 			   &value);
 	if (rc && rc != -DER_NONEXIST) {
@@ -865,6 +865,8 @@ pool_alloc_ref(void *key, unsigned int ksize, void *varg,
 	pool->sp_map_version = arg->pca_map_version;
 	pool->sp_reclaim = DAOS_RECLAIM_LAZY; /* default reclaim strategy */
 	pool->sp_data_thresh = DAOS_PROP_PO_DATA_THRESH_DEFAULT;
+	pool->sp_rebuild_throttle = DAOS_PROP_PO_REBUILD_THROTTLE_DEFAULT;
+	pool->sp_rebuild_priority = DAOS_PROP_PO_REBUILD_PRIORITY_DEFAULT;
 
 	/** set up ds_pool metrics */
 	rc = ds_pool_metrics_start(pool);
@@ -2199,6 +2201,9 @@ update_vos_prop_on_targets(void *in)
 			sched_req_wakeup(child->spc_chkpt_req);
 	}
 	child->spc_reint_mode = pool->sp_reint_mode;
+
+	if (ret == 0)
+		ret = sched_set_rebuild_priority(pool->sp_uuid, pool->sp_rebuild_priority);
 out:
 	ds_pool_child_put(child);
 
@@ -2237,6 +2242,9 @@ ds_pool_tgt_prop_update(struct ds_pool *pool, struct pool_iv_prop *iv_prop)
 	pool->sp_scrub_freq_sec = iv_prop->pip_scrub_freq;
 	pool->sp_scrub_thresh = iv_prop->pip_scrub_thresh;
 	pool->sp_reint_mode = iv_prop->pip_reint_mode;
+	pool->sp_rebuild_throttle = iv_prop->pip_rebuild_throttle;
+	pool->sp_rebuild_priority = iv_prop->pip_rebuild_priority;
+	pool->sp_rebuild_pause = iv_prop->pip_rebuild_pause;
 
 	arg.uvp_pool                     = pool;
 	arg.uvp_checkpoint_props_changed = false;
@@ -97,6 +97,51 @@ void   auth__sys__free_unpacked
   assert(message->base.descriptor == &auth__sys__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   auth__bearer__init
+                     (Auth__Bearer         *message)
+{
+  static const Auth__Bearer init_value = AUTH__BEARER__INIT;
+  *message = init_value;
+}
+size_t auth__bearer__get_packed_size
+                     (const Auth__Bearer *message)
+{
+  assert(message->base.descriptor == &auth__bearer__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t auth__bearer__pack
+                     (const Auth__Bearer *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &auth__bearer__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t auth__bearer__pack_to_buffer
+                     (const Auth__Bearer *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &auth__bearer__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Auth__Bearer *
+       auth__bearer__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Auth__Bearer *)
+     protobuf_c_message_unpack (&auth__bearer__descriptor,
+                                allocator, len, data);
+}
+void   auth__bearer__free_unpacked
+                     (Auth__Bearer *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &auth__bearer__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 void   auth__credential__init
                      (Auth__Credential         *message)
 {
@@ -431,6 +476,57 @@ const ProtobufCMessageDescriptor auth__sys__descriptor =
   (ProtobufCMessageInit) auth__sys__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor auth__bearer__field_descriptors[2] =
+{
+  {
+    "machinename",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Auth__Bearer, machinename),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "token",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Auth__Bearer, token),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned auth__bearer__field_indices_by_name[] = {
+  0,   /* field[0] = machinename */
+  1,   /* field[1] = token */
+};
+static const ProtobufCIntRange auth__bearer__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor auth__bearer__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "auth.Bearer",
+  "Bearer",
+  "Auth__Bearer",
+  "auth",
+  sizeof(Auth__Bearer),
+  2,
+  auth__bearer__field_descriptors,
+  auth__bearer__field_indices_by_name,
+  1,  auth__bearer__number_ranges,
+  (ProtobufCMessageInit) auth__bearer__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 static const ProtobufCFieldDescriptor auth__credential__field_descriptors[3] =
 {
   {
@@ -635,18 +731,20 @@ const ProtobufCMessageDescriptor auth__validate_cred_resp__descriptor =
   (ProtobufCMessageInit) auth__validate_cred_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCEnumValue auth__flavor__enum_values_by_number[2] =
+static const ProtobufCEnumValue auth__flavor__enum_values_by_number[3] =
 {
   { "AUTH_NONE", "AUTH__FLAVOR__AUTH_NONE", 0 },
   { "AUTH_SYS", "AUTH__FLAVOR__AUTH_SYS", 1 },
+  { "AUTH_TOKEN", "AUTH__FLAVOR__AUTH_TOKEN", 2 },
 };
 static const ProtobufCIntRange auth__flavor__value_ranges[] = {
-{0, 0},{0, 2}
+{0, 0},{0, 3}
 };
-static const ProtobufCEnumValueIndex auth__flavor__enum_values_by_name[2] =
+static const ProtobufCEnumValueIndex auth__flavor__enum_values_by_name[3] =
 {
   { "AUTH_NONE", 0 },
   { "AUTH_SYS", 1 },
+  { "AUTH_TOKEN", 2 },
 };
 const ProtobufCEnumDescriptor auth__flavor__descriptor =
 {
@@ -655,9 +753,9 @@ const ProtobufCEnumDescriptor auth__flavor__descriptor =
   "Flavor",
   "Auth__Flavor",
   "auth",
-  2,
+  3,
   auth__flavor__enum_values_by_number,
-  2,
+  3,
   auth__flavor__enum_values_by_name,
   1,
   auth__flavor__value_ranges,
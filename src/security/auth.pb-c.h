@@ -17,6 +17,7 @@ PROTOBUF_C__BEGIN_DECLS
 
 typedef struct _Auth__Token Auth__Token;
 typedef struct _Auth__Sys Auth__Sys;
+typedef struct _Auth__Bearer Auth__Bearer;
 typedef struct _Auth__Credential Auth__Credential;
 typedef struct _Auth__GetCredResp Auth__GetCredResp;
 typedef struct _Auth__ValidateCredReq Auth__ValidateCredReq;
@@ -30,7 +31,8 @@ typedef struct _Auth__ValidateCredResp Auth__ValidateCredResp;
  */
 typedef enum _Auth__Flavor {
   AUTH__FLAVOR__AUTH_NONE = 0,
-  AUTH__FLAVOR__AUTH_SYS = 1
+  AUTH__FLAVOR__AUTH_SYS = 1,
+  AUTH__FLAVOR__AUTH_TOKEN = 2
     PROTOBUF_C__FORCE_ENUM_TO_BE_INT_SIZE(AUTH__FLAVOR)
 } Auth__Flavor;
 
@@ -90,6 +92,26 @@ struct  _Auth__Sys
     , 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, (char *)protobuf_c_empty_string }
 
 
+/*
+ * Token structure for AUTH_TOKEN flavor cred
+ */
+struct  _Auth__Bearer
+{
+  ProtobufCMessage base;
+  /*
+   * machine name
+   */
+  char *machinename;
+  /*
+   * signed token issued by an external identity service
+   */
+  char *token;
+};
+#define AUTH__BEARER__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&auth__bearer__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string }
+
+
 /*
  * Token and verifier are expected to have the same flavor type.
  */
@@ -211,6 +233,25 @@ Auth__Sys *
 void   auth__sys__free_unpacked
                      (Auth__Sys *message,
                       ProtobufCAllocator *allocator);
+/* Auth__Bearer methods */
+void   auth__bearer__init
+                     (Auth__Bearer         *message);
+size_t auth__bearer__get_packed_size
+                     (const Auth__Bearer   *message);
+size_t auth__bearer__pack
+                     (const Auth__Bearer   *message,
+                      uint8_t             *out);
+size_t auth__bearer__pack_to_buffer
+                     (const Auth__Bearer   *message,
+                      ProtobufCBuffer     *buffer);
+Auth__Bearer *
+       auth__bearer__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   auth__bearer__free_unpacked
+                     (Auth__Bearer *message,
+                      ProtobufCAllocator *allocator);
 /* Auth__Credential methods */
 void   auth__credential__init
                      (Auth__Credential         *message);
@@ -295,6 +336,9 @@ typedef void (*Auth__Token_Closure)
 typedef void (*Auth__Sys_Closure)
                  (const Auth__Sys *message,
                   void *closure_data);
+typedef void (*Auth__Bearer_Closure)
+                 (const Auth__Bearer *message,
+                  void *closure_data);
 typedef void (*Auth__Credential_Closure)
                  (const Auth__Credential *message,
                   void *closure_data);
@@ -316,6 +360,7 @@ typedef void (*Auth__ValidateCredResp_Closure)
 extern const ProtobufCEnumDescriptor    auth__flavor__descriptor;
 extern const ProtobufCMessageDescriptor auth__token__descriptor;
 extern const ProtobufCMessageDescriptor auth__sys__descriptor;
+extern const ProtobufCMessageDescriptor auth__bearer__descriptor;
 extern const ProtobufCMessageDescriptor auth__credential__descriptor;
 extern const ProtobufCMessageDescriptor auth__get_cred_resp__descriptor;
 extern const ProtobufCMessageDescriptor auth__validate_cred_req__descriptor;